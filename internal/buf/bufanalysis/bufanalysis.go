@@ -65,6 +65,17 @@ var (
 	}
 )
 
+const (
+	// SeverityError is the error severity.
+	//
+	// This is the default severity.
+	SeverityError Severity = iota + 1
+	// SeverityWarning is the warning severity.
+	//
+	// Warnings do not result in failures unless explicitly requested.
+	SeverityWarning
+)
+
 // Format is a FileAnnotation format.
 type Format int
 
@@ -92,6 +103,21 @@ func ParseFormat(s string) (Format, error) {
 	return 0, fmt.Errorf("unknown format: %q", s)
 }
 
+// Severity is the severity of a FileAnnotation.
+type Severity int
+
+// String implements fmt.Stringer.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return strconv.Itoa(int(s))
+	}
+}
+
 // FileInfo is a minimal FileInfo interface.
 type FileInfo interface {
 	Path() string
@@ -138,6 +164,10 @@ type FileAnnotation interface {
 	Type() string
 	// Message is the message of the annotation.
 	Message() string
+	// Severity is the severity of the annotation.
+	//
+	// This is SeverityError unless the annotation was created with NewFileAnnotationWithSeverity.
+	Severity() Severity
 }
 
 // NewFileAnnotation returns a new FileAnnotation.
@@ -158,9 +188,79 @@ func NewFileAnnotation(
 		endColumn,
 		typeString,
 		message,
+		SeverityError,
 	)
 }
 
+// NewFileAnnotationWithSeverity returns a new FileAnnotation with the given Severity.
+func NewFileAnnotationWithSeverity(
+	fileInfo FileInfo,
+	startLine int,
+	startColumn int,
+	endLine int,
+	endColumn int,
+	typeString string,
+	message string,
+	severity Severity,
+) FileAnnotation {
+	return newFileAnnotation(
+		fileInfo,
+		startLine,
+		startColumn,
+		endLine,
+		endColumn,
+		typeString,
+		message,
+		severity,
+	)
+}
+
+// HasErrorFileAnnotations returns true if any of the FileAnnotations are of SeverityError.
+func HasErrorFileAnnotations(fileAnnotations []FileAnnotation) bool {
+	for _, fileAnnotation := range fileAnnotations {
+		if fileAnnotation.Severity() == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// FileAnnotationsWithAbsolutePaths returns copies of the FileAnnotations with
+// the absolute paths of their files set.
+//
+// The absolute path of each FileInfo is returned by getAbsolutePath. If
+// getAbsolutePath returns an empty string, no absolute path is set, which
+// should be the case for files that are not on disk.
+//
+// The absolute path is only included in the JSON format.
+func FileAnnotationsWithAbsolutePaths(
+	fileAnnotations []FileAnnotation,
+	getAbsolutePath func(FileInfo) (string, error),
+) ([]FileAnnotation, error) {
+	result := make([]FileAnnotation, len(fileAnnotations))
+	for i, fileAnnotation := range fileAnnotations {
+		resultFileAnnotation := newFileAnnotation(
+			fileAnnotation.FileInfo(),
+			fileAnnotation.StartLine(),
+			fileAnnotation.StartColumn(),
+			fileAnnotation.EndLine(),
+			fileAnnotation.EndColumn(),
+			fileAnnotation.Type(),
+			fileAnnotation.Message(),
+			fileAnnotation.Severity(),
+		)
+		if fileInfo := fileAnnotation.FileInfo(); fileInfo != nil {
+			absolutePath, err := getAbsolutePath(fileInfo)
+			if err != nil {
+				return nil, err
+			}
+			resultFileAnnotation.absolutePath = absolutePath
+		}
+		result[i] = resultFileAnnotation
+	}
+	return result, nil
+}
+
 // SortFileAnnotations sorts the FileAnnotations.
 //
 // The order of sorting is:
@@ -177,11 +277,25 @@ func SortFileAnnotations(fileAnnotations []FileAnnotation) {
 }
 
 // PrintFileAnnotations prints the file annotations separated by newlines.
-func PrintFileAnnotations(writer io.Writer, fileAnnotations []FileAnnotation, formatString string) error {
+func PrintFileAnnotations(
+	writer io.Writer,
+	fileAnnotations []FileAnnotation,
+	formatString string,
+	options ...PrintFileAnnotationsOption,
+) error {
+	printFileAnnotationsOptions := newPrintFileAnnotationsOptions()
+	for _, option := range options {
+		option(printFileAnnotationsOptions)
+	}
 	format, err := ParseFormat(formatString)
 	if err != nil {
 		return err
 	}
+	var numOmitted int
+	if maxCount := printFileAnnotationsOptions.maxCount; maxCount > 0 && len(fileAnnotations) > maxCount {
+		numOmitted = len(fileAnnotations) - maxCount
+		fileAnnotations = fileAnnotations[:maxCount]
+	}
 	for _, fileAnnotation := range fileAnnotations {
 		s, err := FormatFileAnnotation(fileAnnotation, format)
 		if err != nil {
@@ -191,9 +305,32 @@ func PrintFileAnnotations(writer io.Writer, fileAnnotations []FileAnnotation, fo
 			return err
 		}
 	}
+	// the JSON format is one object per line, so a footer would not be parseable
+	if numOmitted > 0 && format != FormatJSON {
+		if _, err := fmt.Fprintf(writer, "... and %d more\n", numOmitted); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// PrintFileAnnotationsOption is an option for PrintFileAnnotations.
+type PrintFileAnnotationsOption func(*printFileAnnotationsOptions)
+
+// PrintFileAnnotationsWithMaxCount returns a new PrintFileAnnotationsOption that
+// prints at most maxCount FileAnnotations.
+//
+// If there are more FileAnnotations, the number of FileAnnotations that were not
+// printed is printed afterwards, except for FormatJSON.
+//
+// The default is to print all FileAnnotations. A maxCount of 0 or less also
+// prints all FileAnnotations.
+func PrintFileAnnotationsWithMaxCount(maxCount int) PrintFileAnnotationsOption {
+	return func(printFileAnnotationsOptions *printFileAnnotationsOptions) {
+		printFileAnnotationsOptions.maxCount = maxCount
+	}
+}
+
 // FormatFileAnnotation formats the FileAnnotation.
 func FormatFileAnnotation(fileAnnotation FileAnnotation, format Format) (string, error) {
 	switch format {
@@ -284,3 +421,11 @@ func fileAnnotationCompareTo(a FileAnnotation, b FileAnnotation) int {
 	}
 	return 0
 }
+
+type printFileAnnotationsOptions struct {
+	maxCount int
+}
+
+func newPrintFileAnnotationsOptions() *printFileAnnotationsOptions {
+	return &printFileAnnotationsOptions{}
+}
@@ -77,6 +77,30 @@ func NewFileAnnotation(
 	)
 }
 
+// NewFileAnnotationWithSeverity returns a new FileAnnotation with the given Severity.
+func NewFileAnnotationWithSeverity(
+	t *testing.T,
+	path string,
+	startLine int,
+	startColumn int,
+	endLine int,
+	endColumn int,
+	typeString string,
+	severity bufanalysis.Severity,
+) bufanalysis.FileAnnotation {
+	return newFileAnnotationWithSeverity(
+		t,
+		path,
+		startLine,
+		startColumn,
+		endLine,
+		endColumn,
+		typeString,
+		"",
+		severity,
+	)
+}
+
 func newFileAnnotation(
 	t *testing.T,
 	path string,
@@ -86,6 +110,30 @@ func newFileAnnotation(
 	endColumn int,
 	typeString string,
 	message string,
+) bufanalysis.FileAnnotation {
+	return newFileAnnotationWithSeverity(
+		t,
+		path,
+		startLine,
+		startColumn,
+		endLine,
+		endColumn,
+		typeString,
+		message,
+		bufanalysis.SeverityError,
+	)
+}
+
+func newFileAnnotationWithSeverity(
+	t *testing.T,
+	path string,
+	startLine int,
+	startColumn int,
+	endLine int,
+	endColumn int,
+	typeString string,
+	message string,
+	severity bufanalysis.Severity,
 ) bufanalysis.FileAnnotation {
 	var fileInfo bufcore.FileInfo
 	var err error
@@ -97,7 +145,7 @@ func newFileAnnotation(
 		)
 		require.NoError(t, err)
 	}
-	return bufanalysis.NewFileAnnotation(
+	return bufanalysis.NewFileAnnotationWithSeverity(
 		fileInfo,
 		startLine,
 		startColumn,
@@ -105,6 +153,7 @@ func newFileAnnotation(
 		endColumn,
 		typeString,
 		message,
+		severity,
 	)
 }
 
@@ -147,7 +196,7 @@ func normalizeFileAnnotations(
 			)
 			require.NoError(t, err)
 		}
-		normalizedFileAnnotations[i] = bufanalysis.NewFileAnnotation(
+		normalizedFileAnnotations[i] = bufanalysis.NewFileAnnotationWithSeverity(
 			fileInfo,
 			a.StartLine(),
 			a.StartColumn(),
@@ -155,6 +204,7 @@ func normalizeFileAnnotations(
 			a.EndColumn(),
 			a.Type(),
 			"",
+			a.Severity(),
 		)
 	}
 	return normalizedFileAnnotations
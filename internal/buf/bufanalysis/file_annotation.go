@@ -28,6 +28,10 @@ type fileAnnotation struct {
 	endColumn   int
 	typeString  string
 	message     string
+	severity    Severity
+
+	// only set with FileAnnotationsWithAbsolutePaths
+	absolutePath string
 }
 
 func newFileAnnotation(
@@ -38,6 +42,7 @@ func newFileAnnotation(
 	endColumn int,
 	typeString string,
 	message string,
+	severity Severity,
 ) *fileAnnotation {
 	return &fileAnnotation{
 		fileInfo:    fileInfo,
@@ -47,6 +52,7 @@ func newFileAnnotation(
 		endColumn:   endColumn,
 		typeString:  typeString,
 		message:     message,
+		severity:    severity,
 	}
 }
 
@@ -78,6 +84,10 @@ func (f *fileAnnotation) Message() string {
 	return f.message
 }
 
+func (f *fileAnnotation) Severity() Severity {
+	return f.severity
+}
+
 func (f *fileAnnotation) String() string {
 	if f == nil {
 		return ""
@@ -109,6 +119,9 @@ func (f *fileAnnotation) String() string {
 	_, _ = buffer.WriteRune(':')
 	_, _ = buffer.WriteString(strconv.Itoa(column))
 	_, _ = buffer.WriteRune(':')
+	if f.severity == SeverityWarning {
+		_, _ = buffer.WriteString("warning: ")
+	}
 	_, _ = buffer.WriteString(message)
 	return buffer.String()
 }
@@ -154,7 +167,11 @@ func (f *fileAnnotation) MSVSString() string {
 		_, _ = buffer.WriteRune(',')
 		_, _ = buffer.WriteString(strconv.Itoa(column))
 	}
-	_, _ = buffer.WriteString(") : error ")
+	if f.severity == SeverityWarning {
+		_, _ = buffer.WriteString(") : warning ")
+	} else {
+		_, _ = buffer.WriteString(") : error ")
+	}
 	_, _ = buffer.WriteString(typeString)
 	_, _ = buffer.WriteString(" : ")
 	_, _ = buffer.WriteString(message)
@@ -166,6 +183,12 @@ func (f *fileAnnotation) toExternalFileAnnotation() externalFileAnnotation {
 	if f.fileInfo != nil {
 		path = f.fileInfo.ExternalPath()
 	}
+	severity := ""
+	// errors are the default and are not printed so that the output is
+	// unchanged for consumers that predate severities
+	if f.severity == SeverityWarning {
+		severity = f.severity.String()
+	}
 	return externalFileAnnotation{
 		Path:        path,
 		StartLine:   f.startLine,
@@ -174,6 +197,9 @@ func (f *fileAnnotation) toExternalFileAnnotation() externalFileAnnotation {
 		EndColumn:   f.endColumn,
 		Type:        f.typeString,
 		Message:     f.message,
+		Severity:    severity,
+
+		AbsolutePath: f.absolutePath,
 	}
 }
 
@@ -185,4 +211,8 @@ type externalFileAnnotation struct {
 	EndColumn   int    `json:"end_column,omitempty" yaml:"end_column,omitempty"`
 	Type        string `json:"type,omitempty" yaml:"type,omitempty"`
 	Message     string `json:"message,omitempty" yaml:"message,omitempty"`
+	Severity    string `json:"severity,omitempty" yaml:"severity,omitempty"`
+
+	// AbsolutePath is only set for files on disk.
+	AbsolutePath string `json:"absolute_path,omitempty" yaml:"absolute_path,omitempty"`
 }
@@ -0,0 +1,119 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufapiclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+
+	"github.com/bufbuild/buf/internal/pkg/transport/grpc/grpcclient"
+	"github.com/bufbuild/buf/internal/pkg/transport/http/httpclient"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// addressClientConnProvider is a grpcclient.ClientConnProvider that uses a separate
+// ClientConnProvider for specific addresses.
+type addressClientConnProvider struct {
+	defaultClientConnProvider   grpcclient.ClientConnProvider
+	addressToClientConnProvider map[string]grpcclient.ClientConnProvider
+}
+
+func (a *addressClientConnProvider) NewClientConn(ctx context.Context, address string) (grpc.ClientConnInterface, error) {
+	if clientConnProvider, ok := a.addressToClientConnProvider[address]; ok {
+		return clientConnProvider.NewClientConn(ctx, address)
+	}
+	return a.defaultClientConnProvider.NewClientConn(ctx, address)
+}
+
+// addressHTTPClient is a httpclient.Client that uses a separate Client for specific
+// addresses.
+type addressHTTPClient struct {
+	defaultHTTPClient   httpclient.Client
+	addressToHTTPClient map[string]httpclient.Client
+}
+
+func (a *addressHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	if httpClient, ok := a.addressToHTTPClient[request.URL.Host]; ok {
+		return httpClient.Do(request)
+	}
+	return a.defaultHTTPClient.Do(request)
+}
+
+func (a *addressHTTPClient) ParseAddress(address string) string {
+	if httpClient, ok := a.addressToHTTPClient[address]; ok {
+		return httpClient.ParseAddress(address)
+	}
+	return a.defaultHTTPClient.ParseAddress(address)
+}
+
+func (a *addressHTTPClient) Transport() http.RoundTripper {
+	return a.defaultHTTPClient.Transport()
+}
+
+// newRegistryClientConnProvider returns a new ClientConnProvider for the registryProviderOptions.
+func newRegistryClientConnProvider(
+	ctx context.Context,
+	logger *zap.Logger,
+	tlsConfig *tls.Config,
+	registryProviderOptions *registryProviderOptions,
+) (grpcclient.ClientConnProvider, error) {
+	clientConnProvider, err := NewGRPCClientConnProvider(ctx, logger, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(registryProviderOptions.addressToTLSConfig) == 0 {
+		return clientConnProvider, nil
+	}
+	addressToClientConnProvider := make(map[string]grpcclient.ClientConnProvider, len(registryProviderOptions.addressToTLSConfig))
+	for address, addressTLSConfig := range registryProviderOptions.addressToTLSConfig {
+		addressClientConnProvider, err := NewGRPCClientConnProvider(ctx, logger, addressTLSConfig)
+		if err != nil {
+			return nil, err
+		}
+		addressToClientConnProvider[registryProviderOptions.mapAddress(address)] = addressClientConnProvider
+	}
+	return &addressClientConnProvider{
+		defaultClientConnProvider:   clientConnProvider,
+		addressToClientConnProvider: addressToClientConnProvider,
+	}, nil
+}
+
+// newRegistryHTTPClient returns a new HTTP Client for the registryProviderOptions.
+func newRegistryHTTPClient(
+	tlsConfig *tls.Config,
+	registryProviderOptions *registryProviderOptions,
+) (httpclient.Client, error) {
+	httpClient, err := NewHTTPClient(tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(registryProviderOptions.addressToTLSConfig) == 0 {
+		return httpClient, nil
+	}
+	addressToHTTPClient := make(map[string]httpclient.Client, len(registryProviderOptions.addressToTLSConfig))
+	for address, addressTLSConfig := range registryProviderOptions.addressToTLSConfig {
+		addressHTTPClient, err := NewHTTPClient(addressTLSConfig)
+		if err != nil {
+			return nil, err
+		}
+		addressToHTTPClient[registryProviderOptions.mapAddress(address)] = addressHTTPClient
+	}
+	return &addressHTTPClient{
+		defaultHTTPClient:   httpClient,
+		addressToHTTPClient: addressToHTTPClient,
+	}, nil
+}
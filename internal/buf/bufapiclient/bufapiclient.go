@@ -25,6 +25,7 @@ import (
 	"github.com/bufbuild/buf/internal/pkg/transport/grpc/grpcclient"
 	"github.com/bufbuild/buf/internal/pkg/transport/http/httpclient"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 // NewRegistryProvider creates a new registryv1alpha1apiclient.Provider for either grpc or twirp.
@@ -41,7 +42,7 @@ func NewRegistryProvider(
 		option(registryProviderOptions)
 	}
 	if registryProviderOptions.useGRPC {
-		clientConnProvider, err := NewGRPCClientConnProvider(ctx, logger, tlsConfig)
+		clientConnProvider, err := newRegistryClientConnProvider(ctx, logger, tlsConfig, registryProviderOptions)
 		if err != nil {
 			return nil, err
 		}
@@ -52,7 +53,7 @@ func NewRegistryProvider(
 			registryv1alpha1apiclientgrpc.WithContextModifierProvider(registryProviderOptions.contextModifierProvider),
 		), nil
 	}
-	httpClient, err := NewHTTPClient(tlsConfig)
+	httpClient, err := newRegistryHTTPClient(tlsConfig, registryProviderOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +72,14 @@ type registryProviderOptions struct {
 	useGRPC                 bool
 	addressMapper           func(string) string
 	contextModifierProvider func(string) (func(context.Context) context.Context, error)
+	addressToTLSConfig      map[string]*tls.Config
+}
+
+func (r *registryProviderOptions) mapAddress(address string) string {
+	if r.addressMapper != nil {
+		return r.addressMapper(address)
+	}
+	return address
 }
 
 // RegistryProviderWithGRPC returns a new RegistryProviderOption that turns on gRPC.
@@ -97,6 +106,17 @@ func RegistryProviderWithContextModifierProvider(contextModifierProvider func(ad
 	}
 }
 
+// RegistryProviderWithAddressToTLSConfig returns a new RegistryProviderOption that
+// uses the given TLS configs for the given addresses instead of the default TLS config.
+//
+// The addresses are the addresses before they are mapped. A nil TLS config means
+// that no TLS is used for the address.
+func RegistryProviderWithAddressToTLSConfig(addressToTLSConfig map[string]*tls.Config) RegistryProviderOption {
+	return func(options *registryProviderOptions) {
+		options.addressToTLSConfig = addressToTLSConfig
+	}
+}
+
 // NewGRPCClientConnProvider returns a new gRPC ClientConnProvider.
 //
 // TODO: move this to another location.
@@ -128,3 +148,53 @@ func NewHTTPClient(
 		httpclient.ClientWithObservability(),
 	)
 }
+
+// RegistryInvoker invokes arbitrary registry RPCs.
+//
+// This is used for debugging and calling RPCs that are not otherwise exposed.
+type RegistryInvoker interface {
+	// Invoke invokes the method on the service with the given fully-qualified name at the address.
+	//
+	// The response is unmarshalled into response.
+	Invoke(
+		ctx context.Context,
+		address string,
+		serviceName string,
+		methodName string,
+		request proto.Message,
+		response proto.Message,
+	) error
+}
+
+// NewRegistryInvoker returns a new RegistryInvoker.
+//
+// The same options as NewRegistryProvider are accepted.
+func NewRegistryInvoker(
+	ctx context.Context,
+	logger *zap.Logger,
+	tlsConfig *tls.Config,
+	options ...RegistryProviderOption,
+) (RegistryInvoker, error) {
+	registryProviderOptions := &registryProviderOptions{}
+	for _, option := range options {
+		option(registryProviderOptions)
+	}
+	registryInvoker := &registryInvoker{
+		addressMapper:           registryProviderOptions.addressMapper,
+		contextModifierProvider: registryProviderOptions.contextModifierProvider,
+	}
+	if registryProviderOptions.useGRPC {
+		clientConnProvider, err := newRegistryClientConnProvider(ctx, logger, tlsConfig, registryProviderOptions)
+		if err != nil {
+			return nil, err
+		}
+		registryInvoker.clientConnProvider = clientConnProvider
+		return registryInvoker, nil
+	}
+	httpClient, err := newRegistryHTTPClient(tlsConfig, registryProviderOptions)
+	if err != nil {
+		return nil, err
+	}
+	registryInvoker.httpClient = httpClient
+	return registryInvoker, nil
+}
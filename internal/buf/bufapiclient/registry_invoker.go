@@ -0,0 +1,128 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufapiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/bufbuild/buf/internal/pkg/rpc/rpctwirp"
+	"github.com/bufbuild/buf/internal/pkg/transport/grpc/grpcclient"
+	"github.com/bufbuild/buf/internal/pkg/transport/http/httpclient"
+	"github.com/twitchtv/twirp"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
+)
+
+type registryInvoker struct {
+	// only one of clientConnProvider and httpClient is set
+	clientConnProvider      grpcclient.ClientConnProvider
+	httpClient              httpclient.Client
+	addressMapper           func(string) string
+	contextModifierProvider func(string) (func(context.Context) context.Context, error)
+}
+
+func (r *registryInvoker) Invoke(
+	ctx context.Context,
+	address string,
+	serviceName string,
+	methodName string,
+	request proto.Message,
+	response proto.Message,
+) error {
+	if r.contextModifierProvider != nil {
+		contextModifier, err := r.contextModifierProvider(address)
+		if err != nil {
+			return err
+		}
+		ctx = contextModifier(ctx)
+	}
+	if r.addressMapper != nil {
+		address = r.addressMapper(address)
+	}
+	if r.clientConnProvider != nil {
+		clientConn, err := r.clientConnProvider.NewClientConn(ctx, address)
+		if err != nil {
+			return err
+		}
+		return clientConn.Invoke(ctx, "/"+serviceName+"/"+methodName, request, response)
+	}
+	// we reuse the twirp client interceptor so that errors are converted
+	// the same way as for the generated twirp clients
+	_, err := rpctwirp.NewClientInterceptor()(
+		func(ctx context.Context, request interface{}) (interface{}, error) {
+			return response, r.invokeTwirp(
+				ctx,
+				r.httpClient.ParseAddress(address)+"/"+serviceName+"/"+methodName,
+				request.(proto.Message),
+				response,
+			)
+		},
+	)(ctx, request)
+	return err
+}
+
+func (r *registryInvoker) invokeTwirp(
+	ctx context.Context,
+	url string,
+	request proto.Message,
+	response proto.Message,
+) (retErr error) {
+	requestData, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(requestData))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Accept", "application/protobuf")
+	httpRequest.Header.Set("Content-Type", "application/protobuf")
+	httpResponse, err := r.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, httpResponse.Body.Close())
+	}()
+	responseData, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("could not read HTTP response: %v", err)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return getTwirpError(httpResponse.StatusCode, responseData)
+	}
+	return proto.Unmarshal(responseData, response)
+}
+
+// getTwirpError parses the JSON error that twirp returns for non-200 responses.
+func getTwirpError(statusCode int, responseData []byte) error {
+	var twirpErrorJSON struct {
+		Code string `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if err := json.Unmarshal(responseData, &twirpErrorJSON); err != nil || twirpErrorJSON.Code == "" {
+		return twirp.NewError(twirp.Internal, fmt.Sprintf("got HTTP status code %d", statusCode))
+	}
+	errorCode := twirp.ErrorCode(twirpErrorJSON.Code)
+	if !twirp.IsValidErrorCode(errorCode) {
+		errorCode = twirp.Internal
+	}
+	return twirp.NewError(errorCode, twirpErrorJSON.Msg)
+}
@@ -17,6 +17,7 @@ package bufapp
 import (
 	"crypto/tls"
 	"fmt"
+	"time"
 
 	"github.com/bufbuild/buf/internal/pkg/app/appname"
 	"github.com/bufbuild/buf/internal/pkg/cert/certclient"
@@ -26,12 +27,21 @@ import (
 const currentVersion = "v1"
 
 // ExternalConfig is an external config.
+//
+// This is read from config.yaml in the configuration directory, i.e. ~/.config/buf/config.yaml.
+// Settings that can also be set with a flag or an environment variable take precedence
+// in the order flag, environment variable, this configuration, and then the built-in default.
 type ExternalConfig struct {
 	// if editing ExternalConfig, make sure to update externalConfigIsEmpty at the bottom of this file!
 
 	Version string                             `json:"version,omitempty" yaml:"version,omitempty"`
 	TLS     certclient.ExternalClientTLSConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
 	Remotes []netconfig.ExternalRemote         `json:"remotes,omitempty" yaml:"remotes,omitempty"`
+
+	// DefaultRemote is the remote used by commands that take a remote if none is given.
+	DefaultRemote string `json:"default_remote,omitempty" yaml:"default_remote,omitempty"`
+	// Timeout is the default value of the timeout flag, for example "30s".
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 }
 
 // IsEmpty returns true if the externalConfig is empty.
@@ -40,13 +50,23 @@ func (e ExternalConfig) IsEmpty() bool {
 	// this if you have a slice field, i.e. Remotes
 	return e.Version == "" &&
 		e.TLS.IsEmpty() &&
-		len(e.Remotes) == 0
+		len(e.Remotes) == 0 &&
+		e.DefaultRemote == "" &&
+		e.Timeout == ""
 }
 
 // Config is a config.
 type Config struct {
 	TLS            *tls.Config
 	RemoteProvider netconfig.RemoteProvider
+	// AddressToTLS contains the TLS configuration of the remotes that override TLS.
+	//
+	// A nil value means that TLS is not used for the remote.
+	AddressToTLS map[string]*tls.Config
+	// DefaultRemote is empty if not set.
+	DefaultRemote string
+	// Timeout is 0 if not set.
+	Timeout time.Duration
 }
 
 // NewConfig returns a new Config for the ExternalConfig.
@@ -65,8 +85,46 @@ func NewConfig(
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse remotes configuration at %q: %v", container.ConfigDirPath(), err)
 	}
+	addressToTLS := make(map[string]*tls.Config)
+	for _, externalRemote := range externalConfig.Remotes {
+		if externalRemote.TLS.IsEmpty() {
+			continue
+		}
+		remoteTLSConfig, err := certclient.NewClientTLSConfig(container, externalRemote.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tls configuration for remote %q at %q: %v", externalRemote.Address, container.ConfigDirPath(), err)
+		}
+		addressToTLS[externalRemote.Address] = remoteTLSConfig
+	}
+	timeout, err := GetTimeout(container, externalConfig)
+	if err != nil {
+		return nil, err
+	}
 	return &Config{
 		TLS:            tlsConfig,
 		RemoteProvider: remoteProvider,
+		AddressToTLS:   addressToTLS,
+		DefaultRemote:  externalConfig.DefaultRemote,
+		Timeout:        timeout,
 	}, nil
 }
+
+// GetTimeout returns the timeout of the ExternalConfig.
+//
+// Returns 0 if the timeout is not set.
+func GetTimeout(
+	container appname.Container,
+	externalConfig ExternalConfig,
+) (time.Duration, error) {
+	if externalConfig.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(externalConfig.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse timeout %q at %q: %v", externalConfig.Timeout, container.ConfigDirPath(), err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout %q at %q must be positive", externalConfig.Timeout, container.ConfigDirPath())
+	}
+	return timeout, nil
+}
@@ -16,10 +16,74 @@ package bufapp
 
 import (
 	"testing"
+	"time"
 
+	"github.com/bufbuild/buf/internal/pkg/app"
+	"github.com/bufbuild/buf/internal/pkg/app/appname"
+	"github.com/bufbuild/buf/internal/pkg/cert/certclient"
+	"github.com/bufbuild/buf/internal/pkg/netconfig"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExternalConfigIsEmpty(t *testing.T) {
 	assert.True(t, ExternalConfig{}.IsEmpty())
 }
+
+func TestNewConfig(t *testing.T) {
+	t.Parallel()
+	container, err := appname.NewContainer(app.NewEnvContainer(map[string]string{"BUF_CONFIG_DIR": "/config"}), "buf")
+	require.NoError(t, err)
+	config, err := NewConfig(
+		container,
+		ExternalConfig{
+			Version: "v1",
+			Remotes: []netconfig.ExternalRemote{
+				{
+					Address: "foo",
+					TLS: certclient.ExternalClientTLSConfig{
+						Use: "false",
+					},
+				},
+				{
+					Address: "goo",
+				},
+			},
+			DefaultRemote: "foo",
+			Timeout:       "30s",
+		},
+	)
+	require.NoError(t, err)
+	assert.NotNil(t, config.TLS)
+	tlsConfig, ok := config.AddressToTLS["foo"]
+	assert.True(t, ok)
+	assert.Nil(t, tlsConfig)
+	_, ok = config.AddressToTLS["goo"]
+	assert.False(t, ok)
+	assert.Equal(t, "foo", config.DefaultRemote)
+	assert.Equal(t, 30*time.Second, config.Timeout)
+
+	_, err = NewConfig(
+		container,
+		ExternalConfig{
+			Version: "v1",
+			Timeout: "30",
+		},
+	)
+	assert.Error(t, err)
+	_, err = NewConfig(
+		container,
+		ExternalConfig{
+			Version: "v1",
+			Remotes: []netconfig.ExternalRemote{
+				{
+					Address: "foo",
+					TLS: certclient.ExternalClientTLSConfig{
+						Use: "unknown",
+					},
+				},
+			},
+		},
+	)
+	assert.Error(t, err)
+}
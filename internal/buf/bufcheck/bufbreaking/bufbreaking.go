@@ -65,7 +65,14 @@ type Config struct {
 	Rules                  []Rule
 	IgnoreIDToRootPaths    map[string]map[string]struct{}
 	IgnoreRootPaths        map[string]struct{}
+	AllowCommentIgnores    bool
 	IgnoreUnstablePackages bool
+	// IgnoreFileMoves results in previous files that were moved to a new path
+	// being compared against the file at the new path, instead of being
+	// treated as deleted.
+	//
+	// This is not part of the external config, and is set by callers.
+	IgnoreFileMoves bool
 }
 
 // GetRules returns the rules.
@@ -82,6 +89,7 @@ func NewConfigV1Beta1(externalConfig ExternalConfigV1Beta1) (*Config, error) {
 		Except:                        externalConfig.Except,
 		IgnoreRootPaths:               externalConfig.Ignore,
 		IgnoreIDOrCategoryToRootPaths: externalConfig.IgnoreOnly,
+		AllowCommentIgnores:           externalConfig.AllowCommentIgnores,
 		IgnoreUnstablePackages:        externalConfig.IgnoreUnstablePackages,
 	}.NewConfig(
 		bufbreakingv1beta1.VersionSpec,
@@ -92,6 +100,25 @@ func NewConfigV1Beta1(externalConfig ExternalConfigV1Beta1) (*Config, error) {
 	return internalConfigToConfig(internalConfig), nil
 }
 
+// NewConfigForRuleIDsV1Beta1 returns a copy of the Config that only runs the rules
+// with the given onlyIDs, if any are given, and none of the rules with the given ignoreIDs.
+//
+// All IDs must be known rule IDs, and all onlyIDs must be rules of the Config.
+// If an ID is in both onlyIDs and ignoreIDs, the rule is not run.
+// The ignore paths of the Config still apply.
+func NewConfigForRuleIDsV1Beta1(config *Config, onlyIDs []string, ignoreIDs []string) (*Config, error) {
+	internalConfig, err := internal.NewConfigForRuleIDs(
+		configToInternalConfig(config),
+		bufbreakingv1beta1.VersionSpec,
+		onlyIDs,
+		ignoreIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return internalConfigToConfig(internalConfig), nil
+}
+
 // GetAllRulesV1Beta1 gets all known rules.
 //
 // Should only be used for printing.
@@ -107,6 +134,29 @@ func GetAllRulesV1Beta1() ([]bufcheck.Rule, error) {
 	return rulesToBufcheckRules(config.Rules), nil
 }
 
+// GetRulesIntroducedSinceV1Beta1 gets the rules that were introduced in a buf
+// version later than the given buf version, for example "v0.39.1".
+//
+// Should only be used for printing.
+func GetRulesIntroducedSinceV1Beta1(bufVersion string) ([]bufcheck.Rule, error) {
+	ids, err := internal.GetIDsIntroducedSince(bufbreakingv1beta1.VersionSpec, bufVersion)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	config, err := NewConfigV1Beta1(
+		ExternalConfigV1Beta1{
+			Use: ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rulesToBufcheckRules(config.Rules), nil
+}
+
 // ExternalConfigV1Beta1 is an external config.
 type ExternalConfigV1Beta1 struct {
 	Use    []string `json:"use,omitempty" yaml:"use,omitempty"`
@@ -115,6 +165,7 @@ type ExternalConfigV1Beta1 struct {
 	Ignore []string `json:"ignore,omitempty" yaml:"ignore,omitempty"`
 	// IgnoreIDOrCategoryToRootPaths
 	IgnoreOnly             map[string][]string `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
+	AllowCommentIgnores    bool                `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty"`
 	IgnoreUnstablePackages bool                `json:"ignore_unstable_packages,omitempty" yaml:"ignore_unstable_packages,omitempty"`
 }
 
@@ -123,6 +174,7 @@ func internalConfigToConfig(internalConfig *internal.Config) *Config {
 		Rules:                  internalRulesToRules(internalConfig.Rules),
 		IgnoreIDToRootPaths:    internalConfig.IgnoreIDToRootPaths,
 		IgnoreRootPaths:        internalConfig.IgnoreRootPaths,
+		AllowCommentIgnores:    internalConfig.AllowCommentIgnores,
 		IgnoreUnstablePackages: internalConfig.IgnoreUnstablePackages,
 	}
 }
@@ -132,6 +184,7 @@ func configToInternalConfig(config *Config) *internal.Config {
 		Rules:                  rulesToInternalRules(config.Rules),
 		IgnoreIDToRootPaths:    config.IgnoreIDToRootPaths,
 		IgnoreRootPaths:        config.IgnoreRootPaths,
+		AllowCommentIgnores:    config.AllowCommentIgnores,
 		IgnoreUnstablePackages: config.IgnoreUnstablePackages,
 	}
 }
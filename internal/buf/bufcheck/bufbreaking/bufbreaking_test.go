@@ -151,6 +151,16 @@ func TestRunBreakingFieldNoDeleteUnlessNumberReserved(t *testing.T) {
 	)
 }
 
+func TestRunBreakingFieldNoDeleteUnlessReserved(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_field_no_delete_unless_reserved",
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 5, 1, 10, 2, "FIELD_NO_DELETE_UNLESS_RESERVED"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 5, 1, 10, 2, "FIELD_NO_DELETE_UNLESS_RESERVED"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 12, 1, 14, 2, "FIELD_NO_DELETE_UNLESS_RESERVED"),
+	)
+}
+
 func TestRunBreakingFieldSameCType(t *testing.T) {
 	testBreaking(
 		t,
@@ -202,6 +212,21 @@ func TestRunBreakingFieldSameJSONName(t *testing.T) {
 	)
 }
 
+func TestRunBreakingFieldSameJSONNameExplicit(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_field_same_json_name_explicit",
+		// json_name removed, default differs from the previous explicit value
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 6, 3, 6, 30, "FIELD_SAME_JSON_NAME"),
+		// json_name added with a value that differs from the default
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 8, 29, 8, 49, "FIELD_SAME_JSON_NAME"),
+		// field renamed without json_name, so the default changed
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 11, 3, 11, 33, "FIELD_SAME_JSON_NAME"),
+		// json_name changed from one explicit value to another
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 12, 31, 12, 48, "FIELD_SAME_JSON_NAME"),
+	)
+}
+
 func TestRunBreakingFieldSameJSType(t *testing.T) {
 	testBreaking(
 		t,
@@ -280,26 +305,88 @@ func TestRunBreakingFieldSameType(t *testing.T) {
 	testBreaking(
 		t,
 		"breaking_field_same_type",
-		bufanalysistesting.NewFileAnnotationNoLocation(t, "1.proto", "FIELD_SAME_TYPE"),
-		bufanalysistesting.NewFileAnnotationNoLocation(t, "1.proto", "FIELD_SAME_TYPE"),
-		bufanalysistesting.NewFileAnnotationNoLocation(t, "1.proto", "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 8, 12, 8, 17, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 9, 12, 9, 15, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 11, 3, 11, 6, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 12, 3, 12, 6, "FIELD_SAME_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 13, 3, 13, 18, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 19, 16, 19, 21, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 20, 16, 20, 19, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 22, 7, 22, 10, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 23, 7, 23, 10, "FIELD_SAME_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 24, 7, 24, 22, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 36, 14, 36, 19, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 37, 14, 37, 17, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 39, 5, 39, 8, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "1.proto", 40, 5, 40, 8, "FIELD_SAME_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 41, 5, 41, 20, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "2.proto", 64, 5, 64, 10, "FIELD_SAME_TYPE"),
 		bufanalysistesting.NewFileAnnotation(t, "2.proto", 65, 5, 65, 9, "FIELD_SAME_TYPE"),
 	)
 }
 
+func TestRunBreakingFieldSameTypeMap(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_field_same_type_map",
+		// key type change
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 10, 3, 10, 18, "FIELD_SAME_TYPE"),
+		// value type change
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 11, 3, 11, 19, "FIELD_SAME_TYPE"),
+		// scalar value type change
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 13, 3, 13, 20, "FIELD_SAME_TYPE"),
+	)
+}
+
+func TestRunBreakingFieldWireCompatibleType(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_field_wire_compatible_type",
+		// int32 to sint32
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 6, 3, 6, 9, "FIELD_WIRE_COMPATIBLE_TYPE"),
+		// fixed32 to int32
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 8, 3, 8, 8, "FIELD_WIRE_COMPATIBLE_TYPE"),
+		// int32 to fixed32
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 9, 3, 9, 10, "FIELD_WIRE_COMPATIBLE_TYPE"),
+		// float to fixed32
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 12, 3, 12, 10, "FIELD_WIRE_COMPATIBLE_TYPE"),
+		// bytes to string
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 14, 3, 14, 9, "FIELD_WIRE_COMPATIBLE_TYPE"),
+		// bytes to message
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 15, 3, 15, 6, "FIELD_WIRE_COMPATIBLE_TYPE"),
+		// message type name change
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 17, 3, 17, 8, "FIELD_WIRE_COMPATIBLE_TYPE"),
+		// map value type change
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 20, 3, 20, 21, "FIELD_WIRE_COMPATIBLE_TYPE"),
+	)
+}
+
+func TestRunBreakingCommentIgnores(t *testing.T) {
+	fileAnnotations := testBreakingWithIgnoreFileMoves(
+		t,
+		"breaking_comment_ignores",
+		false,
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 13, 3, 13, 8, "FIELD_SAME_TYPE"),
+		bufanalysistesting.NewFileAnnotationWithSeverity(t, "1.proto", 17, 1, 19, 2, "FIELD_SAME_TYPE", bufanalysis.SeverityWarning),
+	)
+	require.Len(t, fileAnnotations, 2)
+	assert.Equal(
+		t,
+		`Unused comment ignore "buf:breaking:ignore FIELD_SAME_TYPE" on "a.Three".`,
+		fileAnnotations[1].Message(),
+	)
+}
+
+func TestRunBreakingCommentIgnoresNotAllowed(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_comment_ignores_not_allowed",
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 6, 1, 8, 2, "FIELD_NO_DELETE"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 12, 3, 12, 8, "FIELD_SAME_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 13, 3, 13, 8, "FIELD_SAME_TYPE"),
+	)
+}
+
 func TestRunBreakingFileNoDelete(t *testing.T) {
 	testBreaking(
 		t,
@@ -318,6 +405,26 @@ func TestRunBreakingFileNoDeleteUnstable(t *testing.T) {
 	)
 }
 
+func TestRunBreakingIgnoreFileMovesFalse(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_ignore_file_moves",
+		bufanalysistesting.NewFileAnnotationNoLocationOrPath(t, "FILE_NO_DELETE"),
+		bufanalysistesting.NewFileAnnotationNoLocationOrPath(t, "FILE_NO_DELETE"),
+		bufanalysistesting.NewFileAnnotation(t, "a/v1/foo.proto", 8, 3, 8, 8, "FIELD_SAME_TYPE"),
+	)
+}
+
+func TestRunBreakingIgnoreFileMovesTrue(t *testing.T) {
+	testBreakingIgnoreFileMoves(
+		t,
+		"breaking_ignore_file_moves",
+		bufanalysistesting.NewFileAnnotationNoLocationOrPath(t, "FILE_NO_DELETE"),
+		bufanalysistesting.NewFileAnnotation(t, "a/v1/foo.proto", 5, 1, 5, 32, "FILE_SAME_GO_PACKAGE"),
+		bufanalysistesting.NewFileAnnotation(t, "a/v1/foo.proto", 8, 3, 8, 8, "FIELD_SAME_TYPE"),
+	)
+}
+
 func TestRunBreakingFileSamePackage(t *testing.T) {
 	testBreaking(
 		t,
@@ -539,6 +646,29 @@ func TestRunBreakingServiceNoDelete(t *testing.T) {
 	)
 }
 
+func TestGetRulesIntroducedSinceV1Beta1(t *testing.T) {
+	t.Parallel()
+	rules, err := bufbreaking.GetRulesIntroducedSinceV1Beta1("v0.39.1")
+	require.NoError(t, err)
+	ids := make([]string, len(rules))
+	for i, rule := range rules {
+		ids[i] = rule.ID()
+	}
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"FIELD_NO_DELETE_UNLESS_RESERVED",
+			"FIELD_WIRE_COMPATIBLE_TYPE",
+		},
+		ids,
+	)
+	rules, err = bufbreaking.GetRulesIntroducedSinceV1Beta1("v0.40.0")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+	_, err = bufbreaking.GetRulesIntroducedSinceV1Beta1("v0.x.0")
+	assert.Error(t, err)
+}
+
 func TestRunBreakingIgnoreUnstablePackagesTrue(t *testing.T) {
 	testBreaking(
 		t,
@@ -555,6 +685,16 @@ func TestRunBreakingIgnoreUnstablePackagesTrue(t *testing.T) {
 	)
 }
 
+func TestRunBreakingIgnoreUnstablePackagesCurrent(t *testing.T) {
+	// the package is checked in the current image, so a.v1beta1 moving
+	// to a.v1 is checked, and b.v1 moving to b.v1beta1 is ignored
+	testBreaking(
+		t,
+		"breaking_ignore_unstable_packages_current",
+		bufanalysistesting.NewFileAnnotationNoLocation(t, "a/v1/1.proto", "ENUM_NO_DELETE"),
+	)
+}
+
 func TestRunBreakingIgnoreUnstablePackagesFalse(t *testing.T) {
 	testBreaking(
 		t,
@@ -579,6 +719,25 @@ func testBreaking(
 	relDirPath string,
 	expectedFileAnnotations ...bufanalysis.FileAnnotation,
 ) {
+	testBreakingWithIgnoreFileMoves(t, relDirPath, false, expectedFileAnnotations...)
+}
+
+func testBreakingIgnoreFileMoves(
+	t *testing.T,
+	relDirPath string,
+	expectedFileAnnotations ...bufanalysis.FileAnnotation,
+) {
+	testBreakingWithIgnoreFileMoves(t, relDirPath, true, expectedFileAnnotations...)
+}
+
+// testBreakingWithIgnoreFileMoves returns the actual FileAnnotations so that
+// callers can make additional assertions, such as on messages.
+func testBreakingWithIgnoreFileMoves(
+	t *testing.T,
+	relDirPath string,
+	ignoreFileMoves bool,
+	expectedFileAnnotations ...bufanalysis.FileAnnotation,
+) []bufanalysis.FileAnnotation {
 	t.Parallel()
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -648,10 +807,12 @@ func testBreaking(
 	require.Empty(t, fileAnnotations)
 	image = bufimage.ImageWithoutImports(image)
 
+	breakingConfig := *config.Breaking
+	breakingConfig.IgnoreFileMoves = ignoreFileMoves
 	handler := bufbreaking.NewHandler(logger)
 	fileAnnotations, err = handler.Check(
 		ctx,
-		config.Breaking,
+		&breakingConfig,
 		previousImage,
 		image,
 	)
@@ -661,6 +822,7 @@ func testBreaking(
 		expectedFileAnnotations,
 		fileAnnotations,
 	)
+	return fileAnnotations
 }
 
 func testGetConfig(
@@ -0,0 +1,144 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufbreaking
+
+import (
+	"github.com/bufbuild/buf/internal/pkg/protosource"
+)
+
+// withFileMoves returns the previous files with the paths of moved files
+// changed to their new paths.
+//
+// A previous file is considered moved if its path no longer exists, and exactly
+// one added file has the same package and defines all of its top-level
+// declarations. Previous files that do not define any top-level declarations
+// are never considered moved.
+func withFileMoves(previousFiles []protosource.File, files []protosource.File) ([]protosource.File, error) {
+	previousFilePathToFile, err := protosource.FilePathToFile(previousFiles...)
+	if err != nil {
+		return nil, err
+	}
+	filePathToFile, err := protosource.FilePathToFile(files...)
+	if err != nil {
+		return nil, err
+	}
+	var addedFiles []protosource.File
+	for _, file := range files {
+		if _, ok := previousFilePathToFile[file.Path()]; !ok {
+			addedFiles = append(addedFiles, file)
+		}
+	}
+	if len(addedFiles) == 0 {
+		return previousFiles, nil
+	}
+	addedFileToFullNames := make(map[protosource.File]map[string]struct{}, len(addedFiles))
+	for _, addedFile := range addedFiles {
+		addedFileToFullNames[addedFile] = getTopLevelFullNames(addedFile)
+	}
+	// the path of each added file to the paths of the previous files that match it
+	//
+	// if an added file matches multiple previous files, none are treated as moved
+	addedFilePathToPreviousFilePaths := make(map[string][]string)
+	for _, previousFile := range previousFiles {
+		if _, ok := filePathToFile[previousFile.Path()]; ok {
+			continue
+		}
+		previousFullNames := getTopLevelFullNames(previousFile)
+		if len(previousFullNames) == 0 {
+			continue
+		}
+		var matchingFilePaths []string
+		for _, addedFile := range addedFiles {
+			if addedFile.Package() != previousFile.Package() {
+				continue
+			}
+			if fullNamesContainAll(addedFileToFullNames[addedFile], previousFullNames) {
+				matchingFilePaths = append(matchingFilePaths, addedFile.Path())
+			}
+		}
+		if len(matchingFilePaths) == 1 {
+			addedFilePathToPreviousFilePaths[matchingFilePaths[0]] = append(
+				addedFilePathToPreviousFilePaths[matchingFilePaths[0]],
+				previousFile.Path(),
+			)
+		}
+	}
+	previousFilePathToMovedFilePath := make(map[string]string)
+	for addedFilePath, previousFilePaths := range addedFilePathToPreviousFilePaths {
+		if len(previousFilePaths) == 1 {
+			previousFilePathToMovedFilePath[previousFilePaths[0]] = addedFilePath
+		}
+	}
+	if len(previousFilePathToMovedFilePath) == 0 {
+		return previousFiles, nil
+	}
+	result := make([]protosource.File, len(previousFiles))
+	for i, previousFile := range previousFiles {
+		if movedFilePath, ok := previousFilePathToMovedFilePath[previousFile.Path()]; ok {
+			result[i] = newMovedFile(previousFile, movedFilePath)
+		} else {
+			result[i] = previousFile
+		}
+	}
+	return result, nil
+}
+
+func getTopLevelFullNames(file protosource.File) map[string]struct{} {
+	fullNames := make(map[string]struct{})
+	for _, message := range file.Messages() {
+		fullNames[message.FullName()] = struct{}{}
+	}
+	for _, enum := range file.Enums() {
+		fullNames[enum.FullName()] = struct{}{}
+	}
+	for _, service := range file.Services() {
+		fullNames[service.FullName()] = struct{}{}
+	}
+	return fullNames
+}
+
+func fullNamesContainAll(fullNames map[string]struct{}, subset map[string]struct{}) bool {
+	for fullName := range subset {
+		if _, ok := fullNames[fullName]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// file is an alias so that it can be embedded in movedFile, as the
+// field name File would conflict with the File method.
+type file = protosource.File
+
+type movedFile struct {
+	file
+
+	path string
+}
+
+func newMovedFile(file protosource.File, path string) *movedFile {
+	return &movedFile{
+		file: file,
+		path: path,
+	}
+}
+
+func (f *movedFile) File() protosource.File {
+	return f
+}
+
+func (f *movedFile) Path() string {
+	return f.path
+}
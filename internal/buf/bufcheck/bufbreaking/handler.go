@@ -25,6 +25,11 @@ import (
 	"go.uber.org/zap"
 )
 
+// breakingIgnorePrefix is the comment prefix that ignores a breaking rule
+// for the declaration the comment is attached to, i.e.
+// "// buf:breaking:ignore FIELD_NO_DELETE".
+const breakingIgnorePrefix = "buf:breaking:ignore"
+
 type handler struct {
 	logger *zap.Logger
 	runner *internal.Runner
@@ -35,9 +40,11 @@ func newHandler(
 ) *handler {
 	return &handler{
 		logger: logger,
-		// comment ignores are not allowed for breaking changes
-		// so do not set the ignore prefix per the RunnerWithIgnorePrefix comments
-		runner: internal.NewRunner(logger),
+		runner: internal.NewRunner(
+			logger,
+			internal.RunnerWithIgnorePrefix(breakingIgnorePrefix),
+			internal.RunnerWithWarnUnusedIgnores(),
+		),
 	}
 }
 
@@ -55,5 +62,11 @@ func (h *handler) Check(
 	if err != nil {
 		return nil, err
 	}
+	if config.IgnoreFileMoves {
+		previousFiles, err = withFileMoves(previousFiles, files)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return h.runner.Check(ctx, configToInternalConfig(config), previousFiles, files)
 }
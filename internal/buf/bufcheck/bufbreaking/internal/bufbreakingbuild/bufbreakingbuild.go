@@ -80,6 +80,12 @@ var (
 		"fields are not deleted from a given message unless the number is reserved",
 		bufbreakingcheck.CheckFieldNoDeleteUnlessNumberReserved,
 	)
+	// FieldNoDeleteUnlessReservedRuleBuilder is a rule builder.
+	FieldNoDeleteUnlessReservedRuleBuilder = internal.NewNopRuleBuilder(
+		"FIELD_NO_DELETE_UNLESS_RESERVED",
+		"fields are not deleted from a given message unless both the number and name are reserved",
+		bufbreakingcheck.CheckFieldNoDeleteUnlessReserved,
+	)
 	// FieldSameCTypeRuleBuilder is a rule builder.
 	FieldSameCTypeRuleBuilder = internal.NewNopRuleBuilder(
 		"FIELD_SAME_CTYPE",
@@ -122,6 +128,12 @@ var (
 		"fields have the same types in a given message",
 		bufbreakingcheck.CheckFieldSameType,
 	)
+	// FieldWireCompatibleTypeRuleBuilder is a rule builder.
+	FieldWireCompatibleTypeRuleBuilder = internal.NewNopRuleBuilder(
+		"FIELD_WIRE_COMPATIBLE_TYPE",
+		"fields have wire-compatible types in a given message",
+		bufbreakingcheck.CheckFieldWireCompatibleType,
+	)
 	// FileNoDeleteRuleBuilder is a rule builder.
 	FileNoDeleteRuleBuilder = internal.NewNopRuleBuilder(
 		"FILE_NO_DELETE",
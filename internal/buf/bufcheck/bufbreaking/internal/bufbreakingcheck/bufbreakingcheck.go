@@ -181,6 +181,18 @@ func checkFieldNoDeleteUnlessNameReserved(add addFunc, previousMessage protosour
 	return checkFieldNoDeleteWithRules(add, previousMessage, message, false, true)
 }
 
+// CheckFieldNoDeleteUnlessReserved is a check function.
+var CheckFieldNoDeleteUnlessReserved = newMessagePairCheckFunc(checkFieldNoDeleteUnlessReserved)
+
+func checkFieldNoDeleteUnlessReserved(add addFunc, previousMessage protosource.Message, message protosource.Message) error {
+	return checkFieldNoDeleteWithRules(add, previousMessage, message, true, true)
+}
+
+// checkFieldNoDeleteWithRules checks that no fields were deleted, unless the
+// deleted field is reserved per the rules.
+//
+// If both allowIfNumberReserved and allowIfNameReserved are set, both the number
+// and the name must be reserved.
 func checkFieldNoDeleteWithRules(add addFunc, previousMessage protosource.Message, message protosource.Message, allowIfNumberReserved bool, allowIfNameReserved bool) error {
 	previousNumberToField, err := protosource.NumberToMessageField(previousMessage)
 	if err != nil {
@@ -195,15 +207,16 @@ func checkFieldNoDeleteWithRules(add addFunc, previousMessage protosource.Messag
 			if !isDeletedFieldAllowedWithRules(previousField, message, allowIfNumberReserved, allowIfNameReserved) {
 				// otherwise prints as hex
 				previousNumberString := strconv.FormatInt(int64(previousNumber), 10)
-				suffix := ""
-				if allowIfNumberReserved && allowIfNameReserved {
-					return errors.New("both allowIfNumberReserved and allowIfNameReserved set")
+				var notReserved []string
+				if allowIfNumberReserved && !protosource.NumberInReservedRanges(previousField.Number(), message.ReservedTagRanges()...) {
+					notReserved = append(notReserved, fmt.Sprintf(`the number "%d"`, previousField.Number()))
 				}
-				if allowIfNumberReserved {
-					suffix = fmt.Sprintf(` without reserving the number "%d"`, previousField.Number())
+				if allowIfNameReserved && !protosource.NameInReservedNames(previousField.Name(), message.ReservedNames()...) {
+					notReserved = append(notReserved, fmt.Sprintf(`the name %q`, previousField.Name()))
 				}
-				if allowIfNameReserved {
-					suffix = fmt.Sprintf(` without reserving the name %q`, previousField.Name())
+				suffix := ""
+				if len(notReserved) > 0 {
+					suffix = " without reserving " + strings.Join(notReserved, " and ")
 				}
 				add(message, message.Location(), `Previously present field %q with name %q on message %q was deleted%s.`, previousNumberString, previousField.Name(), message.Name(), suffix)
 			}
@@ -213,8 +226,11 @@ func checkFieldNoDeleteWithRules(add addFunc, previousMessage protosource.Messag
 }
 
 func isDeletedFieldAllowedWithRules(previousField protosource.Field, message protosource.Message, allowIfNumberReserved bool, allowIfNameReserved bool) bool {
-	return (allowIfNumberReserved && protosource.NumberInReservedRanges(previousField.Number(), message.ReservedTagRanges()...)) ||
-		(allowIfNameReserved && protosource.NameInReservedNames(previousField.Name(), message.ReservedNames()...))
+	if !allowIfNumberReserved && !allowIfNameReserved {
+		return false
+	}
+	return (!allowIfNumberReserved || protosource.NumberInReservedRanges(previousField.Number(), message.ReservedTagRanges()...)) &&
+		(!allowIfNameReserved || protosource.NameInReservedNames(previousField.Name(), message.ReservedNames()...))
 }
 
 // CheckFieldSameCType is a check function.
@@ -233,10 +249,25 @@ func checkFieldSameCType(add addFunc, previousField protosource.Field, field pro
 var CheckFieldSameJSONName = newFieldPairCheckFunc(checkFieldSameJSONName)
 
 func checkFieldSameJSONName(add addFunc, previousField protosource.Field, field protosource.Field) error {
-	if previousField.JSONName() != field.JSONName() {
-		// otherwise prints as hex
-		numberString := strconv.FormatInt(int64(field.Number()), 10)
-		add(field, withBackupLocation(field.JSONNameLocation(), field.Location()), `Field %q with name %q on message %q changed option "json_name" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousField.JSONName(), field.JSONName())
+	previousJSONName := getEffectiveJSONName(previousField)
+	jsonName := getEffectiveJSONName(field)
+	if previousJSONName == jsonName {
+		return nil
+	}
+	// otherwise prints as hex
+	numberString := strconv.FormatInt(int64(field.Number()), 10)
+	location := withBackupLocation(field.JSONNameLocation(), field.Location())
+	previousIsExplicit := isExplicitJSONName(previousField, previousJSONName)
+	isExplicit := isExplicitJSONName(field, jsonName)
+	switch {
+	case previousIsExplicit && !isExplicit:
+		add(field, location, `Field %q with name %q on message %q removed option "json_name", changing the JSON name from %q to the default %q.`, numberString, field.Name(), field.Message().Name(), previousJSONName, jsonName)
+	case !previousIsExplicit && isExplicit:
+		add(field, location, `Field %q with name %q on message %q added option "json_name", changing the JSON name from the default %q to %q.`, numberString, field.Name(), field.Message().Name(), previousJSONName, jsonName)
+	case !previousIsExplicit && !isExplicit:
+		add(field, location, `Field %q with name %q on message %q changed its default JSON name from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousJSONName, jsonName)
+	default:
+		add(field, location, `Field %q with name %q on message %q changed option "json_name" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousJSONName, jsonName)
 	}
 	return nil
 }
@@ -311,29 +342,56 @@ func checkFieldSameOneof(add addFunc, previousField protosource.Field, field pro
 }
 
 // CheckFieldSameType is a check function.
-var CheckFieldSameType = newFieldPairCheckFunc(checkFieldSameType)
+var CheckFieldSameType = newFilesCheckFunc(checkFieldSameType)
+
+func checkFieldSameType(add addFunc, previousFiles []protosource.File, files []protosource.File) error {
+	return forEachFieldPair(
+		previousFiles,
+		files,
+		func(previousField protosource.Field, field protosource.Field, previousMapEntry protosource.Message, mapEntry protosource.Message) error {
+			return checkFieldSameTypePair(add, previousField, field, previousMapEntry, mapEntry)
+		},
+	)
+}
+
+// previousMapEntry and mapEntry are the map entry messages of previousField and field
+// respectively if they are map fields, and nil otherwise.
+func checkFieldSameTypePair(
+	add addFunc,
+	previousField protosource.Field,
+	field protosource.Field,
+	previousMapEntry protosource.Message,
+	mapEntry protosource.Message,
+) error {
+	if previousMapEntry != nil || mapEntry != nil {
+		previousTypeString, err := getFieldTypeString(previousField, previousMapEntry)
+		if err != nil {
+			return err
+		}
+		typeString, err := getFieldTypeString(field, mapEntry)
+		if err != nil {
+			return err
+		}
+		if previousTypeString != typeString {
+			location := field.TypeNameLocation()
+			if previousField.Type() != field.Type() {
+				location = field.TypeLocation()
+			}
+			// otherwise prints as hex
+			previousNumberString := strconv.FormatInt(int64(previousField.Number()), 10)
+			add(
+				field,
+				withBackupLocation(location, field.Location()),
+				`Field %q on message %q changed type from %q to %q.`,
+				previousNumberString,
+				field.Message().Name(),
+				previousTypeString,
+				typeString,
+			)
+		}
+		return nil
+	}
 
-// TODO: locations not working for map entries
-// TODO: weird output for map entries:
-//
-// breaking_field_same_type/1.proto:1:1:Field "2" on message "SixEntry" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:1:1:Field "2" on message "SixEntry" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:1:1:Field "2" on message "SixEntry" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:8:3:Field "1" on message "Two" changed type from "int32" to "int64".
-// breaking_field_same_type/1.proto:9:3:Field "2" on message "Two" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:11:3:Field "4" on message "Two" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:12:3:Field "5" on message "Two" changed type from ".a.Two.FiveEntry" to ".a.Two".
-// breaking_field_same_type/1.proto:19:7:Field "1" on message "Five" changed type from "int32" to "int64".
-// breaking_field_same_type/1.proto:20:7:Field "2" on message "Five" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:22:7:Field "4" on message "Five" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:23:7:Field "5" on message "Five" changed type from ".a.Three.Four.Five.FiveEntry" to ".a.Two".
-// breaking_field_same_type/1.proto:36:5:Field "1" on message "Seven" changed type from "int32" to "int64".
-// breaking_field_same_type/1.proto:37:5:Field "2" on message "Seven" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:39:5:Field "4" on message "Seven" changed type from ".a.One" to ".a.Two".
-// breaking_field_same_type/1.proto:40:5:Field "5" on message "Seven" changed type from ".a.Three.Seven.FiveEntry" to ".a.Two".
-// breaking_field_same_type/2.proto:64:5:Field "1" on message "Nine" changed type from "int32" to "int64".
-// breaking_field_same_type/2.proto:65:5:Field "2" on message "Nine" changed type from ".a.One" to ".a.Nine".
-func checkFieldSameType(add addFunc, previousField protosource.Field, field protosource.Field) error {
 	if previousField.Type() != field.Type() {
 		// otherwise prints as hex
 		previousNumberString := strconv.FormatInt(int64(previousField.Number()), 10)
@@ -360,6 +418,69 @@ func checkFieldSameType(add addFunc, previousField protosource.Field, field prot
 	return nil
 }
 
+// CheckFieldWireCompatibleType is a check function.
+var CheckFieldWireCompatibleType = newFilesCheckFunc(checkFieldWireCompatibleType)
+
+func checkFieldWireCompatibleType(add addFunc, previousFiles []protosource.File, files []protosource.File) error {
+	return forEachFieldPair(
+		previousFiles,
+		files,
+		func(previousField protosource.Field, field protosource.Field, previousMapEntry protosource.Message, mapEntry protosource.Message) error {
+			return checkFieldWireCompatibleTypePair(add, previousField, field, previousMapEntry, mapEntry)
+		},
+	)
+}
+
+// previousMapEntry and mapEntry are the map entry messages of previousField and field
+// respectively if they are map fields, and nil otherwise.
+func checkFieldWireCompatibleTypePair(
+	add addFunc,
+	previousField protosource.Field,
+	field protosource.Field,
+	previousMapEntry protosource.Message,
+	mapEntry protosource.Message,
+) error {
+	if previousMapEntry != nil || mapEntry != nil {
+		// any change to a map declaration is reported, as for FIELD_SAME_TYPE
+		return checkFieldSameTypePair(add, previousField, field, previousMapEntry, mapEntry)
+	}
+	// otherwise prints as hex
+	previousNumberString := strconv.FormatInt(int64(previousField.Number()), 10)
+	if previousField.Type() != field.Type() {
+		if reason := getWireIncompatibilityReason(previousField.Type(), field.Type()); reason != "" {
+			add(
+				field,
+				// fields of message and enum types only have a location for the type name
+				withBackupLocation(field.TypeLocation(), field.TypeNameLocation()),
+				`Field %q on message %q changed type from %q to %q, which is not wire compatible as %s.`,
+				previousNumberString,
+				field.Message().Name(),
+				previousField.Type().String(),
+				field.Type().String(),
+				reason,
+			)
+		}
+		return nil
+	}
+	switch field.Type() {
+	case protosource.FieldDescriptorProtoTypeGroup, protosource.FieldDescriptorProtoTypeMessage:
+		// enums are encoded as varints regardless of their type, so only
+		// messages and groups need to keep the same type name
+		if previousField.TypeName() != field.TypeName() {
+			add(
+				field,
+				field.TypeNameLocation(),
+				`Field %q on message %q changed type from %q to %q.`,
+				previousNumberString,
+				field.Message().Name(),
+				strings.TrimPrefix(previousField.TypeName(), "."),
+				strings.TrimPrefix(field.TypeName(), "."),
+			)
+		}
+	}
+	return nil
+}
+
 // CheckFileNoDelete is a check function.
 var CheckFileNoDelete = newFilesCheckFunc(checkFileNoDelete)
 
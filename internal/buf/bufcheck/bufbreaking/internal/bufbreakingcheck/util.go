@@ -15,6 +15,7 @@
 package bufbreakingcheck
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
@@ -33,13 +34,28 @@ func newFilesCheckFunc(
 ) func(string, internal.IgnoreFunc, []protosource.File, []protosource.File) ([]bufanalysis.FileAnnotation, error) {
 	return func(id string, ignoreFunc internal.IgnoreFunc, previousFiles []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
 		helper := internal.NewHelper(id, ignoreFunc)
-		if err := f(helper.AddFileAnnotationf, previousFiles, files); err != nil {
+		if err := f(newDescriptorIgnoreAddFunc(helper), previousFiles, files); err != nil {
 			return nil, err
 		}
 		return helper.FileAnnotations(), nil
 	}
 }
 
+// newDescriptorIgnoreAddFunc returns a new addFunc that also checks the location
+// of the entire descriptor for comment ignores.
+//
+// Most failures are reported on a sub-location of the descriptor such as the type
+// of a field, which does not have the comments of the declaration.
+func newDescriptorIgnoreAddFunc(helper *internal.Helper) addFunc {
+	return func(descriptor protosource.Descriptor, location protosource.Location, format string, args ...interface{}) {
+		var extraIgnoreLocations []protosource.Location
+		if locationDescriptor, ok := descriptor.(protosource.LocationDescriptor); ok {
+			extraIgnoreLocations = append(extraIgnoreLocations, locationDescriptor.Location())
+		}
+		helper.AddFileAnnotationWithExtraIgnoreLocationsf(descriptor, location, extraIgnoreLocations, format, args...)
+	}
+}
+
 func newFilePairCheckFunc(
 	f func(addFunc, protosource.File, protosource.File) error,
 ) func(string, internal.IgnoreFunc, []protosource.File, []protosource.File) ([]bufanalysis.FileAnnotation, error) {
@@ -260,3 +276,143 @@ func withBackupLocation(primary protosource.Location, secondary protosource.Loca
 	}
 	return secondary
 }
+
+// getEffectiveJSONName returns the JSON name used for the field.
+//
+// Compilers generally populate json_name for every field, but images produced
+// by other tooling may not, in which case the default JSON name is computed.
+func getEffectiveJSONName(field protosource.Field) string {
+	if jsonName := field.JSONName(); jsonName != "" {
+		return jsonName
+	}
+	return getDefaultJSONName(field.Name())
+}
+
+// isExplicitJSONName returns true if the json_name option was set on the field
+// to something other than the default JSON name.
+func isExplicitJSONName(field protosource.Field, effectiveJSONName string) bool {
+	return effectiveJSONName != getDefaultJSONName(field.Name())
+}
+
+// getDefaultJSONName returns the JSON name protoc derives for a field name.
+//
+// Underscores are removed and the character following each underscore is
+// converted to upper case.
+func getDefaultJSONName(fieldName string) string {
+	var builder strings.Builder
+	capitalizeNext := false
+	for _, c := range fieldName {
+		if c == '_' {
+			capitalizeNext = true
+			continue
+		}
+		if capitalizeNext && 'a' <= c && c <= 'z' {
+			c = c - 'a' + 'A'
+		}
+		capitalizeNext = false
+		builder.WriteRune(c)
+	}
+	return builder.String()
+}
+
+// forEachFieldPair calls f for every field in previousFiles that has a field with the
+// same number in the message with the same name in files.
+//
+// The fields of map entries are not given, instead previousMapEntry and mapEntry are
+// the map entry messages of previousField and field respectively if they are map
+// fields, and nil otherwise. This allows changes to be reported in terms of the map
+// declaration.
+func forEachFieldPair(
+	previousFiles []protosource.File,
+	files []protosource.File,
+	f func(previousField protosource.Field, field protosource.Field, previousMapEntry protosource.Message, mapEntry protosource.Message) error,
+) error {
+	previousFullNameToMessage, err := protosource.FullNameToMessage(previousFiles...)
+	if err != nil {
+		return err
+	}
+	fullNameToMessage, err := protosource.FullNameToMessage(files...)
+	if err != nil {
+		return err
+	}
+	for previousFullName, previousMessage := range previousFullNameToMessage {
+		if previousMessage.IsMapEntry() {
+			continue
+		}
+		message, ok := fullNameToMessage[previousFullName]
+		if !ok {
+			continue
+		}
+		previousNumberToField, err := protosource.NumberToMessageField(previousMessage)
+		if err != nil {
+			return err
+		}
+		numberToField, err := protosource.NumberToMessageField(message)
+		if err != nil {
+			return err
+		}
+		for previousNumber, previousField := range previousNumberToField {
+			if field, ok := numberToField[previousNumber]; ok {
+				if err := f(
+					previousField,
+					field,
+					getMapEntry(previousField, previousFullNameToMessage),
+					getMapEntry(field, fullNameToMessage),
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// getMapEntry returns the map entry message of the field if the field is a map field,
+// and nil otherwise.
+func getMapEntry(field protosource.Field, fullNameToMessage map[string]protosource.Message) protosource.Message {
+	if field.Type() != protosource.FieldDescriptorProtoTypeMessage {
+		return nil
+	}
+	message, ok := fullNameToMessage[strings.TrimPrefix(field.TypeName(), ".")]
+	if !ok || !message.IsMapEntry() {
+		return nil
+	}
+	return message
+}
+
+// getFieldTypeString returns the type of the field as it is declared, for example
+// "int32", "foo.Bar", or "map<string, foo.Bar>".
+//
+// mapEntry is the map entry message of the field if the field is a map field, and nil otherwise.
+func getFieldTypeString(field protosource.Field, mapEntry protosource.Message) (string, error) {
+	if mapEntry == nil {
+		switch field.Type() {
+		case protosource.FieldDescriptorProtoTypeEnum, protosource.FieldDescriptorProtoTypeGroup, protosource.FieldDescriptorProtoTypeMessage:
+			return strings.TrimPrefix(field.TypeName(), "."), nil
+		default:
+			return field.Type().String(), nil
+		}
+	}
+	numberToField, err := protosource.NumberToMessageField(mapEntry)
+	if err != nil {
+		return "", err
+	}
+	// map entries always have the key as field 1 and the value as field 2
+	keyField, ok := numberToField[1]
+	if !ok {
+		return "", fmt.Errorf("map entry %q has no key field", mapEntry.FullName())
+	}
+	valueField, ok := numberToField[2]
+	if !ok {
+		return "", fmt.Errorf("map entry %q has no value field", mapEntry.FullName())
+	}
+	keyTypeString, err := getFieldTypeString(keyField, nil)
+	if err != nil {
+		return "", err
+	}
+	valueTypeString, err := getFieldTypeString(valueField, nil)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("map<%s, %s>", keyTypeString, valueTypeString), nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufbreakingcheck
+
+import (
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/pkg/protosource"
+)
+
+const (
+	wireEncodingVarint          = "a varint"
+	wireEncodingZigZagVarint    = "a ZigZag-encoded varint"
+	wireEncodingFixed32         = "a fixed 32-bit value"
+	wireEncodingFixed64         = "a fixed 64-bit value"
+	wireEncodingLengthDelimited = "a length-delimited value"
+	wireEncodingGroup           = "a group"
+)
+
+var (
+	fieldDescriptorProtoTypeToWireEncoding = map[protosource.FieldDescriptorProtoType]string{
+		protosource.FieldDescriptorProtoTypeDouble:   wireEncodingFixed64,
+		protosource.FieldDescriptorProtoTypeFloat:    wireEncodingFixed32,
+		protosource.FieldDescriptorProtoTypeInt64:    wireEncodingVarint,
+		protosource.FieldDescriptorProtoTypeUint64:   wireEncodingVarint,
+		protosource.FieldDescriptorProtoTypeInt32:    wireEncodingVarint,
+		protosource.FieldDescriptorProtoTypeFixed64:  wireEncodingFixed64,
+		protosource.FieldDescriptorProtoTypeFixed32:  wireEncodingFixed32,
+		protosource.FieldDescriptorProtoTypeBool:     wireEncodingVarint,
+		protosource.FieldDescriptorProtoTypeString:   wireEncodingLengthDelimited,
+		protosource.FieldDescriptorProtoTypeGroup:    wireEncodingGroup,
+		protosource.FieldDescriptorProtoTypeMessage:  wireEncodingLengthDelimited,
+		protosource.FieldDescriptorProtoTypeBytes:    wireEncodingLengthDelimited,
+		protosource.FieldDescriptorProtoTypeUint32:   wireEncodingVarint,
+		protosource.FieldDescriptorProtoTypeEnum:     wireEncodingVarint,
+		protosource.FieldDescriptorProtoTypeSfixed32: wireEncodingFixed32,
+		protosource.FieldDescriptorProtoTypeSfixed64: wireEncodingFixed64,
+		protosource.FieldDescriptorProtoTypeSint32:   wireEncodingZigZagVarint,
+		protosource.FieldDescriptorProtoTypeSint64:   wireEncodingZigZagVarint,
+	}
+	// wireCompatibleTypeGroups are the groups of types that can be changed
+	// to any other type in the same group without breaking the wire format.
+	//
+	// Values that do not fit in the new type are truncated in the same manner
+	// as a cast in C++, see https://developers.google.com/protocol-buffers/docs/proto3#updating.
+	wireCompatibleTypeGroups = [][]protosource.FieldDescriptorProtoType{
+		{
+			protosource.FieldDescriptorProtoTypeInt32,
+			protosource.FieldDescriptorProtoTypeInt64,
+			protosource.FieldDescriptorProtoTypeUint32,
+			protosource.FieldDescriptorProtoTypeUint64,
+			protosource.FieldDescriptorProtoTypeBool,
+			protosource.FieldDescriptorProtoTypeEnum,
+		},
+		{
+			protosource.FieldDescriptorProtoTypeSint32,
+			protosource.FieldDescriptorProtoTypeSint64,
+		},
+		{
+			protosource.FieldDescriptorProtoTypeFixed32,
+			protosource.FieldDescriptorProtoTypeSfixed32,
+		},
+		{
+			protosource.FieldDescriptorProtoTypeFixed64,
+			protosource.FieldDescriptorProtoTypeSfixed64,
+		},
+	}
+	typeToWireCompatibleTypeGroupIndex = getTypeToWireCompatibleTypeGroupIndex()
+)
+
+// getWireIncompatibilityReason returns the reason a field that changed from
+// previousType to fieldType is not wire compatible, or "" if it is.
+//
+// Besides the wireCompatibleTypeGroups, changing from string to bytes and from
+// a message to bytes are wire compatible, as every previously-valid value can be
+// read as bytes. The reverse is not, as not every bytes value is valid UTF-8 or
+// an encoded message.
+func getWireIncompatibilityReason(previousType protosource.FieldDescriptorProtoType, fieldType protosource.FieldDescriptorProtoType) string {
+	if previousType == fieldType {
+		return ""
+	}
+	if previousGroupIndex, ok := typeToWireCompatibleTypeGroupIndex[previousType]; ok {
+		if groupIndex, ok := typeToWireCompatibleTypeGroupIndex[fieldType]; ok && previousGroupIndex == groupIndex {
+			return ""
+		}
+	}
+	switch {
+	case fieldType == protosource.FieldDescriptorProtoTypeBytes:
+		switch previousType {
+		case protosource.FieldDescriptorProtoTypeString, protosource.FieldDescriptorProtoTypeMessage:
+			return ""
+		}
+	case previousType == protosource.FieldDescriptorProtoTypeBytes && fieldType == protosource.FieldDescriptorProtoTypeString:
+		return "bytes values are not necessarily valid UTF-8"
+	case previousType == protosource.FieldDescriptorProtoTypeBytes && fieldType == protosource.FieldDescriptorProtoTypeMessage:
+		return "bytes values are not necessarily encoded messages"
+	}
+	previousWireEncoding := fieldDescriptorProtoTypeToWireEncoding[previousType]
+	wireEncoding := fieldDescriptorProtoTypeToWireEncoding[fieldType]
+	if previousWireEncoding == wireEncoding {
+		return fmt.Sprintf(
+			"%s and %s are both encoded as %s but the encoded values are interpreted differently",
+			previousType.String(),
+			fieldType.String(),
+			wireEncoding,
+		)
+	}
+	return fmt.Sprintf(
+		"%s is encoded as %s and %s is encoded as %s",
+		previousType.String(),
+		previousWireEncoding,
+		fieldType.String(),
+		wireEncoding,
+	)
+}
+
+func getTypeToWireCompatibleTypeGroupIndex() map[protosource.FieldDescriptorProtoType]int {
+	typeToWireCompatibleTypeGroupIndex := make(map[protosource.FieldDescriptorProtoType]int)
+	for i, wireCompatibleTypeGroup := range wireCompatibleTypeGroups {
+		for _, fieldType := range wireCompatibleTypeGroup {
+			typeToWireCompatibleTypeGroupIndex[fieldType] = i
+		}
+	}
+	return typeToWireCompatibleTypeGroupIndex
+}
@@ -0,0 +1,184 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufbreakingcheck
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/internal/pkg/protosource"
+	"github.com/stretchr/testify/assert"
+)
+
+var testFieldDescriptorProtoTypes = []protosource.FieldDescriptorProtoType{
+	protosource.FieldDescriptorProtoTypeDouble,
+	protosource.FieldDescriptorProtoTypeFloat,
+	protosource.FieldDescriptorProtoTypeInt64,
+	protosource.FieldDescriptorProtoTypeUint64,
+	protosource.FieldDescriptorProtoTypeInt32,
+	protosource.FieldDescriptorProtoTypeFixed64,
+	protosource.FieldDescriptorProtoTypeFixed32,
+	protosource.FieldDescriptorProtoTypeBool,
+	protosource.FieldDescriptorProtoTypeString,
+	protosource.FieldDescriptorProtoTypeGroup,
+	protosource.FieldDescriptorProtoTypeMessage,
+	protosource.FieldDescriptorProtoTypeBytes,
+	protosource.FieldDescriptorProtoTypeUint32,
+	protosource.FieldDescriptorProtoTypeEnum,
+	protosource.FieldDescriptorProtoTypeSfixed32,
+	protosource.FieldDescriptorProtoTypeSfixed64,
+	protosource.FieldDescriptorProtoTypeSint32,
+	protosource.FieldDescriptorProtoTypeSint64,
+}
+
+func TestGetWireIncompatibilityReason(t *testing.T) {
+	t.Parallel()
+	// every type is compatible with itself, and every pair not listed is incompatible
+	previousTypeToCompatibleTypes := map[protosource.FieldDescriptorProtoType][]protosource.FieldDescriptorProtoType{
+		protosource.FieldDescriptorProtoTypeDouble: nil,
+		protosource.FieldDescriptorProtoTypeFloat:  nil,
+		protosource.FieldDescriptorProtoTypeInt64: {
+			protosource.FieldDescriptorProtoTypeUint64,
+			protosource.FieldDescriptorProtoTypeInt32,
+			protosource.FieldDescriptorProtoTypeBool,
+			protosource.FieldDescriptorProtoTypeUint32,
+			protosource.FieldDescriptorProtoTypeEnum,
+		},
+		protosource.FieldDescriptorProtoTypeUint64: {
+			protosource.FieldDescriptorProtoTypeInt64,
+			protosource.FieldDescriptorProtoTypeInt32,
+			protosource.FieldDescriptorProtoTypeBool,
+			protosource.FieldDescriptorProtoTypeUint32,
+			protosource.FieldDescriptorProtoTypeEnum,
+		},
+		protosource.FieldDescriptorProtoTypeInt32: {
+			protosource.FieldDescriptorProtoTypeInt64,
+			protosource.FieldDescriptorProtoTypeUint64,
+			protosource.FieldDescriptorProtoTypeBool,
+			protosource.FieldDescriptorProtoTypeUint32,
+			protosource.FieldDescriptorProtoTypeEnum,
+		},
+		protosource.FieldDescriptorProtoTypeFixed64: {
+			protosource.FieldDescriptorProtoTypeSfixed64,
+		},
+		protosource.FieldDescriptorProtoTypeFixed32: {
+			protosource.FieldDescriptorProtoTypeSfixed32,
+		},
+		protosource.FieldDescriptorProtoTypeBool: {
+			protosource.FieldDescriptorProtoTypeInt64,
+			protosource.FieldDescriptorProtoTypeUint64,
+			protosource.FieldDescriptorProtoTypeInt32,
+			protosource.FieldDescriptorProtoTypeUint32,
+			protosource.FieldDescriptorProtoTypeEnum,
+		},
+		protosource.FieldDescriptorProtoTypeString: {
+			protosource.FieldDescriptorProtoTypeBytes,
+		},
+		protosource.FieldDescriptorProtoTypeGroup: nil,
+		protosource.FieldDescriptorProtoTypeMessage: {
+			protosource.FieldDescriptorProtoTypeBytes,
+		},
+		protosource.FieldDescriptorProtoTypeBytes: nil,
+		protosource.FieldDescriptorProtoTypeUint32: {
+			protosource.FieldDescriptorProtoTypeInt64,
+			protosource.FieldDescriptorProtoTypeUint64,
+			protosource.FieldDescriptorProtoTypeInt32,
+			protosource.FieldDescriptorProtoTypeBool,
+			protosource.FieldDescriptorProtoTypeEnum,
+		},
+		protosource.FieldDescriptorProtoTypeEnum: {
+			protosource.FieldDescriptorProtoTypeInt64,
+			protosource.FieldDescriptorProtoTypeUint64,
+			protosource.FieldDescriptorProtoTypeInt32,
+			protosource.FieldDescriptorProtoTypeBool,
+			protosource.FieldDescriptorProtoTypeUint32,
+		},
+		protosource.FieldDescriptorProtoTypeSfixed32: {
+			protosource.FieldDescriptorProtoTypeFixed32,
+		},
+		protosource.FieldDescriptorProtoTypeSfixed64: {
+			protosource.FieldDescriptorProtoTypeFixed64,
+		},
+		protosource.FieldDescriptorProtoTypeSint32: {
+			protosource.FieldDescriptorProtoTypeSint64,
+		},
+		protosource.FieldDescriptorProtoTypeSint64: {
+			protosource.FieldDescriptorProtoTypeSint32,
+		},
+	}
+	assert.Len(t, previousTypeToCompatibleTypes, len(testFieldDescriptorProtoTypes))
+	for _, previousType := range testFieldDescriptorProtoTypes {
+		compatibleTypes, ok := previousTypeToCompatibleTypes[previousType]
+		assert.True(t, ok, previousType.String())
+		compatibleTypeMap := map[protosource.FieldDescriptorProtoType]struct{}{
+			previousType: {},
+		}
+		for _, compatibleType := range compatibleTypes {
+			compatibleTypeMap[compatibleType] = struct{}{}
+		}
+		for _, fieldType := range testFieldDescriptorProtoTypes {
+			reason := getWireIncompatibilityReason(previousType, fieldType)
+			if _, ok := compatibleTypeMap[fieldType]; ok {
+				assert.Empty(t, reason, "%s to %s", previousType.String(), fieldType.String())
+			} else {
+				assert.NotEmpty(t, reason, "%s to %s", previousType.String(), fieldType.String())
+			}
+		}
+	}
+}
+
+func TestGetWireIncompatibilityReasonMessages(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		previousType   protosource.FieldDescriptorProtoType
+		fieldType      protosource.FieldDescriptorProtoType
+		expectedReason string
+	}{
+		{
+			previousType:   protosource.FieldDescriptorProtoTypeInt32,
+			fieldType:      protosource.FieldDescriptorProtoTypeSint32,
+			expectedReason: "int32 is encoded as a varint and sint32 is encoded as a ZigZag-encoded varint",
+		},
+		{
+			previousType:   protosource.FieldDescriptorProtoTypeFixed32,
+			fieldType:      protosource.FieldDescriptorProtoTypeInt32,
+			expectedReason: "fixed32 is encoded as a fixed 32-bit value and int32 is encoded as a varint",
+		},
+		{
+			previousType:   protosource.FieldDescriptorProtoTypeFloat,
+			fieldType:      protosource.FieldDescriptorProtoTypeFixed32,
+			expectedReason: "float and fixed32 are both encoded as a fixed 32-bit value but the encoded values are interpreted differently",
+		},
+		{
+			previousType:   protosource.FieldDescriptorProtoTypeBytes,
+			fieldType:      protosource.FieldDescriptorProtoTypeString,
+			expectedReason: "bytes values are not necessarily valid UTF-8",
+		},
+		{
+			previousType:   protosource.FieldDescriptorProtoTypeBytes,
+			fieldType:      protosource.FieldDescriptorProtoTypeMessage,
+			expectedReason: "bytes values are not necessarily encoded messages",
+		},
+	}
+	for _, testCase := range testCases {
+		assert.Equal(
+			t,
+			testCase.expectedReason,
+			getWireIncompatibilityReason(testCase.previousType, testCase.fieldType),
+			"%s to %s",
+			testCase.previousType.String(),
+			testCase.fieldType.String(),
+		)
+	}
+}
@@ -21,8 +21,9 @@ import "github.com/bufbuild/buf/internal/buf/bufcheck/internal"
 
 // VersionSpec is the version specification for v1beta1.
 var VersionSpec = &internal.VersionSpec{
-	RuleBuilders:      v1beta1RuleBuilders,
-	DefaultCategories: v1beta1DefaultCategories,
-	AllCategories:     v1beta1AllCategories,
-	IDToCategories:    v1beta1IDToCategories,
+	RuleBuilders:          v1beta1RuleBuilders,
+	DefaultCategories:     v1beta1DefaultCategories,
+	AllCategories:         v1beta1AllCategories,
+	IDToCategories:        v1beta1IDToCategories,
+	IDToIntroducedVersion: v1beta1IDToIntroducedVersion,
 }
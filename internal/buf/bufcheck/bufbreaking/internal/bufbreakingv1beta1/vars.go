@@ -31,6 +31,7 @@ var (
 		bufbreakingbuild.FieldNoDeleteRuleBuilder,
 		bufbreakingbuild.FieldNoDeleteUnlessNameReservedRuleBuilder,
 		bufbreakingbuild.FieldNoDeleteUnlessNumberReservedRuleBuilder,
+		bufbreakingbuild.FieldNoDeleteUnlessReservedRuleBuilder,
 		bufbreakingbuild.FieldSameCTypeRuleBuilder,
 		bufbreakingbuild.FieldSameJSONNameRuleBuilder,
 		bufbreakingbuild.FieldSameJSTypeRuleBuilder,
@@ -38,6 +39,7 @@ var (
 		bufbreakingbuild.FieldSameNameRuleBuilder,
 		bufbreakingbuild.FieldSameOneofRuleBuilder,
 		bufbreakingbuild.FieldSameTypeRuleBuilder,
+		bufbreakingbuild.FieldWireCompatibleTypeRuleBuilder,
 		bufbreakingbuild.FileNoDeleteRuleBuilder,
 		bufbreakingbuild.FileSameCsharpNamespaceRuleBuilder,
 		bufbreakingbuild.FileSameGoPackageRuleBuilder,
@@ -88,6 +90,7 @@ var (
 		"PACKAGE",
 		"WIRE_JSON",
 		"WIRE",
+		"OTHER",
 	}
 	// v1beta1IDToCategories are the revision 1 ID to categories.
 	v1beta1IDToCategories = map[string][]string{
@@ -125,6 +128,9 @@ var (
 			"WIRE_JSON",
 			"WIRE",
 		},
+		"FIELD_NO_DELETE_UNLESS_RESERVED": {
+			"OTHER",
+		},
 		"FIELD_SAME_CTYPE": {
 			"FILE",
 			"PACKAGE",
@@ -161,6 +167,9 @@ var (
 			"WIRE_JSON",
 			"WIRE",
 		},
+		"FIELD_WIRE_COMPATIBLE_TYPE": {
+			"OTHER",
+		},
 		"FILE_NO_DELETE": {
 			"FILE",
 		},
@@ -322,4 +331,10 @@ var (
 			"FILE",
 		},
 	}
+	// v1beta1IDToIntroducedVersion are the ID to the buf version that introduced
+	// the rule, for rules added after the initial release of v1beta1.
+	v1beta1IDToIntroducedVersion = map[string]string{
+		"FIELD_NO_DELETE_UNLESS_RESERVED": "v0.40.0",
+		"FIELD_WIRE_COMPATIBLE_TYPE":      "v0.40.0",
+	}
 )
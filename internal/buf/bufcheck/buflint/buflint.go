@@ -20,6 +20,7 @@ package buflint
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"sort"
 	"strings"
@@ -70,10 +71,17 @@ type Config struct {
 	//
 	// Rules will be sorted by first categories, then id when Configs are
 	// created from this package, i.e. created wth ConfigBuilder.NewConfig.
-	Rules               []Rule
-	IgnoreIDToRootPaths map[string]map[string]struct{}
-	IgnoreRootPaths     map[string]struct{}
-	AllowCommentIgnores bool
+	Rules                  []Rule
+	IgnoreIDToRootPaths    map[string]map[string]struct{}
+	IgnoreRootPaths        map[string]struct{}
+	AllowCommentIgnores    bool
+	IgnoreUnstablePackages bool
+	// EnumZeroValueSuffix is the enum zero value suffix used for fixes.
+	EnumZeroValueSuffix string
+	// RuleIDToSeverity is the severity of the FileAnnotations of each rule.
+	//
+	// Rules that are not in the map have SeverityError.
+	RuleIDToSeverity map[string]bufanalysis.Severity
 }
 
 // GetRules returns the rules.
@@ -89,18 +97,57 @@ func NewConfigV1Beta1(externalConfig ExternalConfigV1Beta1) (*Config, error) {
 		IgnoreRootPaths:                      externalConfig.Ignore,
 		IgnoreIDOrCategoryToRootPaths:        externalConfig.IgnoreOnly,
 		AllowCommentIgnores:                  externalConfig.AllowCommentIgnores,
+		IgnoreUnstablePackages:               externalConfig.IgnoreUnstablePackages,
 		EnumZeroValueSuffix:                  externalConfig.EnumZeroValueSuffix,
 		RPCAllowSameRequestResponse:          externalConfig.RPCAllowSameRequestResponse,
 		RPCAllowGoogleProtobufEmptyRequests:  externalConfig.RPCAllowGoogleProtobufEmptyRequests,
 		RPCAllowGoogleProtobufEmptyResponses: externalConfig.RPCAllowGoogleProtobufEmptyResponses,
+		RPCHTTPAnnotationAllowStreaming:      externalConfig.RPCHTTPAnnotationAllowStreaming,
+		RPCStreamingSuffix:                   externalConfig.RPCStreamingSuffix,
 		ServiceSuffix:                        externalConfig.ServiceSuffix,
+		FieldPresence:                        externalConfig.FieldPresence,
+		PackageNoStutterAllow:                externalConfig.PackageNoStutterAllow,
+		PackageDirectoryMatchRootPrefix:      externalConfig.PackageDirectoryMatchRootPrefix,
+		CustomForbidFieldTypes:               externalConfig.Custom.ForbidFieldTypes,
+		CustomRequireFieldOptions:            externalConfig.Custom.RequireFieldOptions,
+		CustomRequireFileOptions:             externalConfig.Custom.RequireFileOptions,
+		CustomForbidMessageNameRegex:         externalConfig.Custom.ForbidMessageNameRegex,
+		CustomAllowFieldNumberRanges:         externalConfig.Custom.AllowFieldNumberRanges,
+		CustomForbidFieldNumberRanges:        externalConfig.Custom.ForbidFieldNumberRanges,
 	}.NewConfig(
 		buflintv1beta1.VersionSpec,
 	)
 	if err != nil {
 		return nil, err
 	}
-	return internalConfigToConfig(internalConfig), nil
+	ruleIDToSeverity, err := getRuleIDToSeverity(externalConfig.RuleSeverities, buflintv1beta1.VersionSpec.IDToCategories)
+	if err != nil {
+		return nil, err
+	}
+	config := internalConfigToConfig(internalConfig)
+	config.RuleIDToSeverity = ruleIDToSeverity
+	return config, nil
+}
+
+// NewConfigForRuleIDsV1Beta1 returns a copy of the Config that only runs the rules
+// with the given onlyIDs, if any are given, and none of the rules with the given ignoreIDs.
+//
+// All IDs must be known rule IDs, and all onlyIDs must be rules of the Config.
+// If an ID is in both onlyIDs and ignoreIDs, the rule is not run.
+// The ignore paths of the Config still apply.
+func NewConfigForRuleIDsV1Beta1(config *Config, onlyIDs []string, ignoreIDs []string) (*Config, error) {
+	internalConfig, err := internal.NewConfigForRuleIDs(
+		configToInternalConfig(config),
+		buflintv1beta1.VersionSpec,
+		onlyIDs,
+		ignoreIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newConfig := internalConfigToConfig(internalConfig)
+	newConfig.RuleIDToSeverity = config.RuleIDToSeverity
+	return newConfig, nil
 }
 
 // GetAllRulesV1Beta1 gets all known rules.
@@ -118,6 +165,29 @@ func GetAllRulesV1Beta1() ([]bufcheck.Rule, error) {
 	return rulesToBufcheckRules(config.Rules), nil
 }
 
+// GetRulesIntroducedSinceV1Beta1 gets the rules that were introduced in a buf
+// version later than the given buf version, for example "v0.39.1".
+//
+// Should only be used for printing.
+func GetRulesIntroducedSinceV1Beta1(bufVersion string) ([]bufcheck.Rule, error) {
+	ids, err := internal.GetIDsIntroducedSince(buflintv1beta1.VersionSpec, bufVersion)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	config, err := NewConfigV1Beta1(
+		ExternalConfigV1Beta1{
+			Use: ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rulesToBufcheckRules(config.Rules), nil
+}
+
 // ExternalConfigV1Beta1 is an external config.
 type ExternalConfigV1Beta1 struct {
 	Use    []string `json:"use,omitempty" yaml:"use,omitempty"`
@@ -130,23 +200,46 @@ type ExternalConfigV1Beta1 struct {
 	RPCAllowSameRequestResponse          bool                `json:"rpc_allow_same_request_response,omitempty" yaml:"rpc_allow_same_request_response,omitempty"`
 	RPCAllowGoogleProtobufEmptyRequests  bool                `json:"rpc_allow_google_protobuf_empty_requests,omitempty" yaml:"rpc_allow_google_protobuf_empty_requests,omitempty"`
 	RPCAllowGoogleProtobufEmptyResponses bool                `json:"rpc_allow_google_protobuf_empty_responses,omitempty" yaml:"rpc_allow_google_protobuf_empty_responses,omitempty"`
+	RPCHTTPAnnotationAllowStreaming      bool                `json:"rpc_http_annotation_allow_streaming,omitempty" yaml:"rpc_http_annotation_allow_streaming,omitempty"`
+	RPCStreamingSuffix                   string              `json:"rpc_streaming_suffix,omitempty" yaml:"rpc_streaming_suffix,omitempty"`
 	ServiceSuffix                        string              `json:"service_suffix,omitempty" yaml:"service_suffix,omitempty"`
+	FieldPresence                        string              `json:"field_presence,omitempty" yaml:"field_presence,omitempty"`
+	PackageNoStutterAllow                []string            `json:"package_no_stutter_allow,omitempty" yaml:"package_no_stutter_allow,omitempty"`
+	PackageDirectoryMatchRootPrefix      string              `json:"package_directory_match_root_prefix,omitempty" yaml:"package_directory_match_root_prefix,omitempty"`
 	AllowCommentIgnores                  bool                `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty"`
+	IgnoreUnstablePackages               bool                `json:"ignore_unstable_packages,omitempty" yaml:"ignore_unstable_packages,omitempty"`
+	// RuleSeverities maps rule IDs to either "error" or "warning".
+	RuleSeverities map[string]string `json:"rule_severities,omitempty" yaml:"rule_severities,omitempty"`
+
+	// Custom is the config for the CUSTOM rule.
+	Custom ExternalCustomConfigV1Beta1 `json:"custom,omitempty" yaml:"custom,omitempty"`
+}
+
+// ExternalCustomConfigV1Beta1 is an external config for the custom constraints
+// checked by the CUSTOM rule.
+type ExternalCustomConfigV1Beta1 struct {
+	ForbidFieldTypes        []string `json:"forbid_field_types,omitempty" yaml:"forbid_field_types,omitempty"`
+	RequireFieldOptions     []string `json:"require_field_options,omitempty" yaml:"require_field_options,omitempty"`
+	RequireFileOptions      []string `json:"require_file_options,omitempty" yaml:"require_file_options,omitempty"`
+	ForbidMessageNameRegex  string   `json:"forbid_message_name_regex,omitempty" yaml:"forbid_message_name_regex,omitempty"`
+	AllowFieldNumberRanges  []string `json:"allow_field_number_ranges,omitempty" yaml:"allow_field_number_ranges,omitempty"`
+	ForbidFieldNumberRanges []string `json:"forbid_field_number_ranges,omitempty" yaml:"forbid_field_number_ranges,omitempty"`
 }
 
 // PrintFileAnnotations prints the FileAnnotations to the Writer.
 //
-// Also accepts config-ignore-yaml.
+// Also accepts config-ignore-yaml, which always includes all FileAnnotations.
 func PrintFileAnnotations(
 	writer io.Writer,
 	fileAnnotations []bufanalysis.FileAnnotation,
 	formatString string,
+	options ...bufanalysis.PrintFileAnnotationsOption,
 ) error {
 	switch s := strings.ToLower(strings.TrimSpace(formatString)); s {
 	case "config-ignore-yaml":
 		return printFileAnnotationsConfigIgnoreYAML(writer, fileAnnotations)
 	default:
-		return bufanalysis.PrintFileAnnotations(writer, fileAnnotations, s)
+		return bufanalysis.PrintFileAnnotations(writer, fileAnnotations, s, options...)
 	}
 }
 
@@ -208,19 +301,45 @@ lint:
 
 func internalConfigToConfig(internalConfig *internal.Config) *Config {
 	return &Config{
-		Rules:               internalRulesToRules(internalConfig.Rules),
-		IgnoreIDToRootPaths: internalConfig.IgnoreIDToRootPaths,
-		IgnoreRootPaths:     internalConfig.IgnoreRootPaths,
-		AllowCommentIgnores: internalConfig.AllowCommentIgnores,
+		Rules:                  internalRulesToRules(internalConfig.Rules),
+		IgnoreIDToRootPaths:    internalConfig.IgnoreIDToRootPaths,
+		IgnoreRootPaths:        internalConfig.IgnoreRootPaths,
+		AllowCommentIgnores:    internalConfig.AllowCommentIgnores,
+		IgnoreUnstablePackages: internalConfig.IgnoreUnstablePackages,
+		EnumZeroValueSuffix:    internalConfig.EnumZeroValueSuffix,
+	}
+}
+
+// getRuleIDToSeverity parses the severities of the rule_severities section.
+func getRuleIDToSeverity(ruleSeverities map[string]string, idToCategories map[string][]string) (map[string]bufanalysis.Severity, error) {
+	if len(ruleSeverities) == 0 {
+		return nil, nil
+	}
+	ruleIDToSeverity := make(map[string]bufanalysis.Severity, len(ruleSeverities))
+	for id, severityString := range ruleSeverities {
+		if _, ok := idToCategories[id]; !ok {
+			return nil, fmt.Errorf("rule_severities: %q is not a known rule id", id)
+		}
+		switch strings.ToLower(strings.TrimSpace(severityString)) {
+		case "error":
+			ruleIDToSeverity[id] = bufanalysis.SeverityError
+		case "warning":
+			ruleIDToSeverity[id] = bufanalysis.SeverityWarning
+		default:
+			return nil, fmt.Errorf("rule_severities: unknown severity %q for %q, must be one of error or warning", severityString, id)
+		}
 	}
+	return ruleIDToSeverity, nil
 }
 
 func configToInternalConfig(config *Config) *internal.Config {
 	return &internal.Config{
-		Rules:               rulesToInternalRules(config.Rules),
-		IgnoreIDToRootPaths: config.IgnoreIDToRootPaths,
-		IgnoreRootPaths:     config.IgnoreRootPaths,
-		AllowCommentIgnores: config.AllowCommentIgnores,
+		Rules:                  rulesToInternalRules(config.Rules),
+		IgnoreIDToRootPaths:    config.IgnoreIDToRootPaths,
+		IgnoreRootPaths:        config.IgnoreRootPaths,
+		AllowCommentIgnores:    config.AllowCommentIgnores,
+		IgnoreUnstablePackages: config.IgnoreUnstablePackages,
+		EnumZeroValueSuffix:    config.EnumZeroValueSuffix,
 	}
 }
 
@@ -33,6 +33,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // Hint on how to get these:
@@ -143,6 +145,149 @@ func TestRunComments(t *testing.T) {
 	)
 }
 
+func TestRunCustom(t *testing.T) {
+	fileAnnotations := testLint(
+		t,
+		"custom",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 9, 3, 9, 8, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 10, 3, 10, 22, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 11, 3, 11, 21, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 12, 3, 12, 6, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 13, 9, 13, 12, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 16, 9, 16, 18, "CUSTOM"),
+	)
+	require.Len(t, fileAnnotations, 6)
+	assert.Equal(t, `Field "two" in message "Foo" has forbidden type "bytes".`, fileAnnotations[0].Message())
+	assert.Equal(t, `Field "three" in message "Foo" has forbidden type "google.protobuf.Any".`, fileAnnotations[1].Message())
+	assert.Equal(t, `Field "four" in message "Foo" has forbidden type "bytes".`, fileAnnotations[2].Message())
+	assert.Equal(t, `Field "five" in message "Foo" has forbidden type "a.Baz".`, fileAnnotations[3].Message())
+}
+
+func TestRunCustomFileOptions(t *testing.T) {
+	testLint(
+		t,
+		"custom_file_options",
+		bufanalysistesting.NewFileAnnotation(t, "b.proto", 6, 1, 6, 33, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "c.proto", 3, 1, 3, 11, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "c.proto", 3, 1, 3, 11, "CUSTOM"),
+	)
+}
+
+func TestRunCustomFieldNumberRanges(t *testing.T) {
+	testLint(
+		t,
+		"custom_field_number_ranges",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 7, 15, 7, 16, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 9, 16, 9, 18, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 11, 15, 11, 19, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 14, 18, 14, 20, "CUSTOM"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 18, 17, 18, 19, "CUSTOM"),
+	)
+}
+
+func TestRunCustomNoConstraints(t *testing.T) {
+	testLintConfigModifier(
+		t,
+		"custom",
+		func(config *bufconfig.Config) {
+			lintConfig, err := buflint.NewConfigV1Beta1(
+				buflint.ExternalConfigV1Beta1{
+					Use: []string{"CUSTOM"},
+				},
+			)
+			require.NoError(t, err)
+			config.Lint = lintConfig
+		},
+	)
+}
+
+func TestNewConfigCustomError(t *testing.T) {
+	t.Parallel()
+	_, err := buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				RequireFieldOptions: []string{"deprecated"},
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				ForbidMessageNameRegex: "(",
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				RequireFileOptions: []string{"deprecated"},
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				RequireFileOptions: []string{"optimize_for=FAST"},
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				RequireFileOptions: []string{"java_multiple_files="},
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				AllowFieldNumberRanges: []string{"0-15"},
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				ForbidFieldNumberRanges: []string{"15-1"},
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				ForbidFieldNumberRanges: []string{"a-max"},
+			},
+		},
+	)
+	require.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use: []string{"CUSTOM"},
+			Custom: buflint.ExternalCustomConfigV1Beta1{
+				RequireFileOptions:      []string{"java_multiple_files=true", "optimize_for=LITE_RUNTIME", "go_package=apb"},
+				AllowFieldNumberRanges:  []string{"1-15", "100-max"},
+				ForbidFieldNumberRanges: []string{"5"},
+			},
+		},
+	)
+	require.NoError(t, err)
+}
+
 func TestRunDirectorySamePackage(t *testing.T) {
 	testLint(
 		t,
@@ -174,6 +319,24 @@ func TestRunImportNoWeak(t *testing.T) {
 	)
 }
 
+func TestRunEnumAllowAliasConsistent(t *testing.T) {
+	// the compiler rejects both violations, so we modify the image
+	testLintModifiers(
+		t,
+		"enum_allow_alias_consistent",
+		nil,
+		func(image bufimage.Image) {
+			fileDescriptorProto := image.GetFile("a.proto").Proto()
+			// UNNECESSARY_UNO is no longer an alias of UNNECESSARY_ONE
+			fileDescriptorProto.GetEnumType()[0].GetValue()[2].Number = proto.Int32(2)
+			// MISSING_UNO is now an alias of MISSING_ONE
+			fileDescriptorProto.GetEnumType()[1].GetValue()[2].Number = proto.Int32(1)
+		},
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 3, 6, 29, "ENUM_ALLOW_ALIAS_CONSISTENT"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 15, 17, 15, 18, "ENUM_ALLOW_ALIAS_CONSISTENT"),
+	)
+}
+
 func TestRunEnumFirstValueZero(t *testing.T) {
 	testLint(
 		t,
@@ -220,7 +383,7 @@ func TestRunEnumPascalCase(t *testing.T) {
 }
 
 func TestRunEnumValuePrefix(t *testing.T) {
-	testLint(
+	fileAnnotations := testLint(
 		t,
 		"enum_value_prefix",
 		bufanalysistesting.NewFileAnnotation(t, "a.proto", 10, 3, 10, 12, "ENUM_VALUE_PREFIX"),
@@ -234,6 +397,29 @@ func TestRunEnumValuePrefix(t *testing.T) {
 		bufanalysistesting.NewFileAnnotation(t, "a.proto", 34, 5, 34, 17, "ENUM_VALUE_PREFIX"),
 		bufanalysistesting.NewFileAnnotation(t, "a.proto", 35, 5, 35, 18, "ENUM_VALUE_PREFIX"),
 		bufanalysistesting.NewFileAnnotation(t, "a.proto", 36, 5, 36, 18, "ENUM_VALUE_PREFIX"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 42, 3, 42, 9, "ENUM_VALUE_PREFIX"),
+	)
+	require.Len(t, fileAnnotations, 12)
+	assert.Equal(
+		t,
+		`Enum value name "TESt_FAIL" should be prefixed with "TEST_", i.e. "TEST_FAIL".`,
+		fileAnnotations[0].Message(),
+	)
+	assert.Equal(
+		t,
+		`Enum value name "test__FAIL_6" should be prefixed with "TEST_", i.e. "TEST_FAIL_6".`,
+		fileAnnotations[2].Message(),
+	)
+	assert.Equal(
+		t,
+		`Enum value name "TEST_1_FAIL_7" should be prefixed with "TEST1_", i.e. "TEST1_FAIL_7".`,
+		fileAnnotations[6].Message(),
+	)
+	// there is no name other than the prefix to suggest
+	assert.Equal(
+		t,
+		`Enum value name "TEST_2" should be prefixed with "TEST2_".`,
+		fileAnnotations[11].Message(),
 	)
 }
 
@@ -412,6 +598,26 @@ func TestRunPackageDirectoryMatch(t *testing.T) {
 	)
 }
 
+func TestRunPackageDirectoryMatchRootPrefix(t *testing.T) {
+	testLint(
+		t,
+		"package_directory_match_root_prefix",
+		bufanalysistesting.NewFileAnnotation(t, "a/b/a_b2.proto", 3, 1, 3, 13, "PACKAGE_DIRECTORY_MATCH"),
+		bufanalysistesting.NewFileAnnotation(t, "proto/c/c.proto", 3, 1, 3, 13, "PACKAGE_DIRECTORY_MATCH"),
+	)
+}
+
+func TestNewConfigPackageDirectoryMatchRootPrefixError(t *testing.T) {
+	t.Parallel()
+	_, err := buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use:                             []string{"PACKAGE_DIRECTORY_MATCH"},
+			PackageDirectoryMatchRootPrefix: "../proto",
+		},
+	)
+	require.Error(t, err)
+}
+
 func TestRunPackageLowerSnakeCase(t *testing.T) {
 	testLint(
 		t,
@@ -654,6 +860,115 @@ func TestRunServicePascalCase(t *testing.T) {
 	)
 }
 
+func TestRunFieldPresence(t *testing.T) {
+	testLint(
+		t,
+		"field_presence",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 3, 6, 17, "FIELD_PRESENCE"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 15, 3, 15, 19, "FIELD_PRESENCE"),
+	)
+}
+
+func TestRunFieldPresenceForbid(t *testing.T) {
+	testLint(
+		t,
+		"field_presence_forbid",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 7, 3, 7, 26, "FIELD_PRESENCE"),
+	)
+}
+
+func TestRunFieldPresenceUnknown(t *testing.T) {
+	t.Parallel()
+	_, err := buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			Use:           []string{"FIELD_PRESENCE"},
+			FieldPresence: "optional",
+		},
+	)
+	require.Error(t, err)
+}
+
+func TestRunPackageNoStutter(t *testing.T) {
+	testLint(
+		t,
+		"package_no_stutter",
+		bufanalysistesting.NewFileAnnotation(t, "user/v1/user.proto", 9, 9, 9, 17, "PACKAGE_NO_STUTTER"),
+		bufanalysistesting.NewFileAnnotation(t, "user/v1/user.proto", 12, 6, 12, 15, "PACKAGE_NO_STUTTER"),
+		bufanalysistesting.NewFileAnnotation(t, "user/v1/user.proto", 15, 9, 15, 20, "PACKAGE_NO_STUTTER"),
+		bufanalysistesting.NewFileAnnotation(t, "user_profile/user_profile.proto", 5, 9, 5, 24, "PACKAGE_NO_STUTTER"),
+	)
+}
+
+func TestRunRPCHTTPAnnotation(t *testing.T) {
+	testLint(
+		t,
+		"rpc_http_annotation",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 13, 3, 13, 56, "RPC_HTTP_ANNOTATION"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 14, 3, 16, 4, "RPC_HTTP_ANNOTATION"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 17, 3, 17, 60, "RPC_HTTP_ANNOTATION"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 18, 3, 18, 63, "RPC_HTTP_ANNOTATION"),
+	)
+}
+
+func TestRunRPCHTTPAnnotationAllowStreaming(t *testing.T) {
+	testLint(
+		t,
+		"rpc_http_annotation_allow_streaming",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 13, 3, 13, 56, "RPC_HTTP_ANNOTATION"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 14, 3, 16, 4, "RPC_HTTP_ANNOTATION"),
+	)
+}
+
+func TestRunCommentRPCRequestResponse(t *testing.T) {
+	testLint(
+		t,
+		"comment_rpc_request_response",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 11, 3, 11, 47, "COMMENT_RPC_REQUEST_RESPONSE"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 20, 3, 20, 19, "COMMENT_RPC_REQUEST_RESPONSE"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 23, 1, 26, 2, "COMMENT_RPC_REQUEST_RESPONSE"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 31, 5, 31, 21, "COMMENT_RPC_REQUEST_RESPONSE"),
+	)
+}
+
+func TestRunFieldNoGroup(t *testing.T) {
+	testLint(
+		t,
+		"field_no_group",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 12, 6, 17, "FIELD_NO_GROUP"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 8, 14, 8, 19, "FIELD_NO_GROUP"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 18, 14, 18, 19, "FIELD_NO_GROUP"),
+	)
+}
+
+func TestRunSyntaxSpecified(t *testing.T) {
+	testLint(
+		t,
+		"syntax_specified",
+		bufanalysistesting.NewFileAnnotationNoLocation(t, "b.proto", "SYNTAX_SPECIFIED"),
+	)
+}
+
+func TestRunRPCStreamingSuffix(t *testing.T) {
+	testLint(
+		t,
+		"rpc_streaming_suffix",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 9, 7, 9, 16, "RPC_STREAMING_SUFFIX"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 10, 7, 10, 13, "RPC_STREAMING_SUFFIX"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 12, 7, 12, 15, "RPC_STREAMING_SUFFIX"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 14, 7, 14, 11, "RPC_STREAMING_SUFFIX"),
+	)
+}
+
+func TestRunRPCStreamingSuffixCustom(t *testing.T) {
+	testLint(
+		t,
+		"rpc_streaming_suffix_custom",
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 9, 7, 9, 19, "RPC_STREAMING_SUFFIX"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 10, 7, 10, 13, "RPC_STREAMING_SUFFIX"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 12, 7, 12, 21, "RPC_STREAMING_SUFFIX"),
+	)
+}
+
 func TestRunServiceSuffix(t *testing.T) {
 	testLint(
 		t,
@@ -831,12 +1146,148 @@ func TestCommentIgnoresCascadeOn(t *testing.T) {
 	)
 }
 
+func TestRunIgnoreUnstablePackagesTrue(t *testing.T) {
+	testLint(
+		t,
+		"ignore_unstable_packages_true",
+		bufanalysistesting.NewFileAnnotation(t, "a/v1/a.proto", 5, 6, 5, 9, "ENUM_PASCAL_CASE"),
+	)
+}
+
+func TestRunIgnoreUnstablePackagesFalse(t *testing.T) {
+	testLint(
+		t,
+		"ignore_unstable_packages_false",
+		bufanalysistesting.NewFileAnnotation(t, "a/v1/a.proto", 5, 6, 5, 9, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "a/v1beta1/a.proto", 5, 6, 5, 9, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "b/v1alpha1/a.proto", 5, 6, 5, 9, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "c/v1test/a.proto", 5, 6, 5, 9, "ENUM_PASCAL_CASE"),
+	)
+}
+
+func TestRunIgnoreUnstablePackagesConfigModifier(t *testing.T) {
+	testLintConfigModifier(
+		t,
+		"ignore_unstable_packages_false",
+		func(config *bufconfig.Config) {
+			config.Lint.IgnoreUnstablePackages = true
+		},
+		bufanalysistesting.NewFileAnnotation(t, "a/v1/a.proto", 5, 6, 5, 9, "ENUM_PASCAL_CASE"),
+	)
+}
+
+func TestRunRuleSeverities(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	config, image := testBuildConfigAndImage(ctx, t, filepath.Join("testdata", "rule_severities"), nil)
+	fileAnnotations, err := buflint.NewHandler(zap.NewNop()).Check(ctx, config.Lint, image)
+	require.NoError(t, err)
+	require.Len(t, fileAnnotations, 2)
+	assert.Equal(t, "ENUM_PASCAL_CASE", fileAnnotations[0].Type())
+	assert.Equal(t, bufanalysis.SeverityError, fileAnnotations[0].Severity())
+	assert.Equal(t, "FIELD_LOWER_SNAKE_CASE", fileAnnotations[1].Type())
+	assert.Equal(t, bufanalysis.SeverityWarning, fileAnnotations[1].Severity())
+	assert.True(t, bufanalysis.HasErrorFileAnnotations(fileAnnotations))
+
+	// the severities are kept when the rules are restricted
+	lintConfig, err := buflint.NewConfigForRuleIDsV1Beta1(config.Lint, []string{"FIELD_LOWER_SNAKE_CASE"}, nil)
+	require.NoError(t, err)
+	fileAnnotations, err = buflint.NewHandler(zap.NewNop()).Check(ctx, lintConfig, image)
+	require.NoError(t, err)
+	require.Len(t, fileAnnotations, 1)
+	assert.Equal(t, bufanalysis.SeverityWarning, fileAnnotations[0].Severity())
+	assert.False(t, bufanalysis.HasErrorFileAnnotations(fileAnnotations))
+}
+
+func TestNewConfigV1Beta1RuleSeveritiesError(t *testing.T) {
+	t.Parallel()
+	_, err := buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			RuleSeverities: map[string]string{"FOO": "warning"},
+		},
+	)
+	assert.Error(t, err)
+	_, err = buflint.NewConfigV1Beta1(
+		buflint.ExternalConfigV1Beta1{
+			RuleSeverities: map[string]string{"FIELD_LOWER_SNAKE_CASE": "info"},
+		},
+	)
+	assert.Error(t, err)
+}
+
+func TestGetRulesIntroducedSinceV1Beta1(t *testing.T) {
+	t.Parallel()
+	rules, err := buflint.GetRulesIntroducedSinceV1Beta1("v0.39.1")
+	require.NoError(t, err)
+	ids := make([]string, len(rules))
+	for i, rule := range rules {
+		ids[i] = rule.ID()
+	}
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"COMMENT_RPC_REQUEST_RESPONSE",
+			"CUSTOM",
+			"ENUM_ALLOW_ALIAS_CONSISTENT",
+			"FIELD_NO_GROUP",
+			"FIELD_PRESENCE",
+			"PACKAGE_NO_STUTTER",
+			"RESERVED_NOT_USED",
+			"RPC_HTTP_ANNOTATION",
+			"RPC_STREAMING_SUFFIX",
+			"SYNTAX_SPECIFIED",
+		},
+		ids,
+	)
+	// the pre-release suffix is ignored, so a development build of a release
+	// is treated as the release
+	rules, err = buflint.GetRulesIntroducedSinceV1Beta1("0.40.0-dev")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+	_, err = buflint.GetRulesIntroducedSinceV1Beta1("v0.40")
+	assert.Error(t, err)
+	_, err = buflint.GetRulesIntroducedSinceV1Beta1("latest")
+	assert.Error(t, err)
+}
+
+func TestRunReservedNotUsed(t *testing.T) {
+	testLintModifiers(
+		t,
+		"reserved_not_used",
+		nil,
+		func(image bufimage.Image) {
+			fileDescriptorProto := image.GetFile("a.proto").Proto()
+			fooDescriptorProto := fileDescriptorProto.GetMessageType()[0]
+			// end is exclusive for message reserved ranges
+			fooDescriptorProto.ReservedRange = []*descriptorpb.DescriptorProto_ReservedRange{
+				{Start: proto.Int32(3), End: proto.Int32(5)},
+			}
+			fooDescriptorProto.ReservedName = []string{"one"}
+			barDescriptorProto := fooDescriptorProto.GetNestedType()[0]
+			barDescriptorProto.ReservedName = []string{"two"}
+			bazDescriptorProto := fileDescriptorProto.GetEnumType()[0]
+			// end is inclusive for enum reserved ranges
+			bazDescriptorProto.ReservedRange = []*descriptorpb.EnumDescriptorProto_EnumReservedRange{
+				{Start: proto.Int32(2), End: proto.Int32(2)},
+			}
+			bazDescriptorProto.ReservedName = []string{"BAZ_ONE"}
+		},
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 8, 11, 8, 14, "RESERVED_NOT_USED"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 10, 9, 10, 12, "RESERVED_NOT_USED"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 12, 17, 12, 18, "RESERVED_NOT_USED"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 17, 3, 17, 10, "RESERVED_NOT_USED"),
+		bufanalysistesting.NewFileAnnotation(t, "a.proto", 18, 13, 18, 14, "RESERVED_NOT_USED"),
+	)
+}
+
 func testLint(
 	t *testing.T,
 	relDirPath string,
 	expectedFileAnnotations ...bufanalysis.FileAnnotation,
-) {
-	testLintConfigModifier(
+) []bufanalysis.FileAnnotation {
+	return testLintConfigModifier(
 		t,
 		relDirPath,
 		nil,
@@ -849,13 +1300,60 @@ func testLintConfigModifier(
 	relDirPath string,
 	configModifier func(*bufconfig.Config),
 	expectedFileAnnotations ...bufanalysis.FileAnnotation,
-) {
+) []bufanalysis.FileAnnotation {
+	return testLintModifiers(
+		t,
+		relDirPath,
+		configModifier,
+		nil,
+		expectedFileAnnotations...,
+	)
+}
+
+// imageModifier allows modifying the built Image before linting, which is
+// needed to test descriptors that would not compile from source.
+//
+// The FileAnnotations are returned so that their messages can be checked.
+func testLintModifiers(
+	t *testing.T,
+	relDirPath string,
+	configModifier func(*bufconfig.Config),
+	imageModifier func(bufimage.Image),
+	expectedFileAnnotations ...bufanalysis.FileAnnotation,
+) []bufanalysis.FileAnnotation {
 	t.Parallel()
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	logger := zap.NewNop()
 
-	dirPath := filepath.Join("testdata", relDirPath)
+	config, image := testBuildConfigAndImage(ctx, t, filepath.Join("testdata", relDirPath), configModifier)
+	if imageModifier != nil {
+		imageModifier(image)
+	}
+
+	handler := buflint.NewHandler(logger)
+	fileAnnotations, err := handler.Check(
+		ctx,
+		config.Lint,
+		image,
+	)
+	assert.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		expectedFileAnnotations,
+		fileAnnotations,
+	)
+	return fileAnnotations
+}
+
+// testBuildConfigAndImage builds the config and Image without imports for the directory.
+func testBuildConfigAndImage(
+	ctx context.Context,
+	t *testing.T,
+	dirPath string,
+	configModifier func(*bufconfig.Config),
+) (*bufconfig.Config, bufimage.Image) {
+	logger := zap.NewNop()
 
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
 	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
@@ -890,20 +1388,7 @@ func testLintConfigModifier(
 	)
 	require.NoError(t, err)
 	require.Empty(t, fileAnnotations)
-	image = bufimage.ImageWithoutImports(image)
-
-	handler := buflint.NewHandler(logger)
-	fileAnnotations, err = handler.Check(
-		ctx,
-		config.Lint,
-		image,
-	)
-	assert.NoError(t, err)
-	bufanalysistesting.AssertFileAnnotationsEqual(
-		t,
-		expectedFileAnnotations,
-		fileAnnotations,
-	)
+	return config, bufimage.ImageWithoutImports(image)
 }
 
 func testGetConfig(
@@ -0,0 +1,575 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buflint
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufcheck/buflint/internal/buflintcheck"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/internal/pkg/protosource"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+)
+
+// protocTabStop is the tab stop protoc and protoparse use to compute columns.
+const protocTabStop = 8
+
+var (
+	// fixableIDs are the IDs of the fixable rules, in the order that fixes are applied.
+	//
+	// Fixes are applied in order as multiple rules can apply to the same name,
+	// for example an enum zero value may need to be upper-cased, prefixed, and suffixed.
+	fixableIDs = []string{
+		"ENUM_VALUE_UPPER_SNAKE_CASE",
+		"ENUM_VALUE_PREFIX",
+		"ENUM_ZERO_VALUE_SUFFIX",
+		"FIELD_LOWER_SNAKE_CASE",
+	}
+	fixableIDToFixFunc = map[string]fixFunc{
+		"ENUM_VALUE_UPPER_SNAKE_CASE": fixEnumValueUpperSnakeCase,
+		"ENUM_VALUE_PREFIX":           fixEnumValuePrefix,
+		"ENUM_ZERO_VALUE_SUFFIX":      fixEnumZeroValueSuffix,
+		"FIELD_LOWER_SNAKE_CASE":      fixFieldLowerSnakeCase,
+	}
+)
+
+// FixedFile is a file with fixes applied.
+type FixedFile interface {
+	bufanalysis.FileInfo
+
+	// OriginalData returns the data of the file before fixes were applied.
+	OriginalData() []byte
+	// FixedData returns the data of the file after fixes were applied.
+	FixedData() []byte
+}
+
+// IsFixable returns true if the rule for the ID can be fixed with Fix.
+func IsFixable(id string) bool {
+	_, ok := fixableIDToFixFunc[id]
+	return ok
+}
+
+// Fix fixes the FileAnnotations for fixable rules by renaming the
+// corresponding declarations within the source of the files.
+//
+// The FileAnnotations must be the result of a Handler check with the config and image.
+// The readFile function returns the source data for a file.
+//
+// Fixes are only applied to names that are not otherwise referenced within their
+// file or within the files of the image that can reference them, and that would
+// not collide with other names once fixed. Only the tokens
+// of the names are modified. Returns the fixed files, and the FileAnnotations
+// that were not fixed.
+func Fix(
+	ctx context.Context,
+	config *Config,
+	image bufimage.Image,
+	fileAnnotations []bufanalysis.FileAnnotation,
+	readFile func(bufanalysis.FileInfo) ([]byte, error),
+) ([]FixedFile, []bufanalysis.FileAnnotation, error) {
+	files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(image.Files())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	filePathToFile, err := protosource.FilePathToFile(files...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var unfixedFileAnnotations []bufanalysis.FileAnnotation
+	filePathToFileAnnotations := make(map[string][]bufanalysis.FileAnnotation)
+	var filePaths []string
+	for _, fileAnnotation := range fileAnnotations {
+		fileInfo := fileAnnotation.FileInfo()
+		if fileInfo == nil || !IsFixable(fileAnnotation.Type()) {
+			unfixedFileAnnotations = append(unfixedFileAnnotations, fileAnnotation)
+			continue
+		}
+		if _, ok := filePathToFile[fileInfo.Path()]; !ok {
+			unfixedFileAnnotations = append(unfixedFileAnnotations, fileAnnotation)
+			continue
+		}
+		if _, ok := filePathToFileAnnotations[fileInfo.Path()]; !ok {
+			filePaths = append(filePaths, fileInfo.Path())
+		}
+		filePathToFileAnnotations[fileInfo.Path()] = append(filePathToFileAnnotations[fileInfo.Path()], fileAnnotation)
+	}
+	sort.Strings(filePaths)
+	filePathToReferencingImageFiles := getFilePathToReferencingImageFiles(image)
+	// files may be read multiple times as they can reference multiple files
+	filePathToDataWithoutComments := make(map[string][]byte)
+	var fixedFiles []FixedFile
+	for _, filePath := range filePaths {
+		fileFileAnnotations := filePathToFileAnnotations[filePath]
+		data, err := readFile(fileFileAnnotations[0].FileInfo())
+		if err != nil {
+			return nil, nil, err
+		}
+		var referencingDatasWithoutComments [][]byte
+		for _, referencingImageFile := range filePathToReferencingImageFiles[filePath] {
+			referencingDataWithoutComments, ok := filePathToDataWithoutComments[referencingImageFile.Path()]
+			if !ok {
+				referencingData, err := readFile(referencingImageFile)
+				if err != nil {
+					return nil, nil, err
+				}
+				referencingDataWithoutComments = blankComments(referencingData)
+				filePathToDataWithoutComments[referencingImageFile.Path()] = referencingDataWithoutComments
+			}
+			referencingDatasWithoutComments = append(referencingDatasWithoutComments, referencingDataWithoutComments)
+		}
+		fixedData, fileUnfixedFileAnnotations := fixFile(
+			config,
+			filePathToFile[filePath],
+			data,
+			referencingDatasWithoutComments,
+			fileFileAnnotations,
+		)
+		unfixedFileAnnotations = append(unfixedFileAnnotations, fileUnfixedFileAnnotations...)
+		if !bytes.Equal(data, fixedData) {
+			fixedFiles = append(
+				fixedFiles,
+				newFixedFile(fileFileAnnotations[0].FileInfo(), data, fixedData),
+			)
+		}
+	}
+	bufanalysis.SortFileAnnotations(unfixedFileAnnotations)
+	return fixedFiles, unfixedFileAnnotations, nil
+}
+
+type fixFunc func(config *Config, namedDescriptor protosource.NamedDescriptor, name string) string
+
+func fixEnumValueUpperSnakeCase(_ *Config, _ protosource.NamedDescriptor, name string) string {
+	return stringutil.ToUpperSnakeCase(name)
+}
+
+func fixEnumValuePrefix(_ *Config, namedDescriptor protosource.NamedDescriptor, name string) string {
+	enumValue, ok := namedDescriptor.(protosource.EnumValue)
+	if !ok {
+		return name
+	}
+	expectedPrefix := stringutil.ToUpperSnakeCase(enumValue.Enum().Name()) + "_"
+	expectedName, ok := buflintcheck.EnumValueNameWithPrefix(name, expectedPrefix)
+	if !ok {
+		return name
+	}
+	return expectedName
+}
+
+func fixEnumZeroValueSuffix(config *Config, _ protosource.NamedDescriptor, name string) string {
+	if config.EnumZeroValueSuffix == "" || strings.HasSuffix(name, config.EnumZeroValueSuffix) {
+		return name
+	}
+	return name + config.EnumZeroValueSuffix
+}
+
+func fixFieldLowerSnakeCase(_ *Config, _ protosource.NamedDescriptor, name string) string {
+	return stringutil.ToLowerSnakeCase(name)
+}
+
+// fixEdit is a rename of a single name token.
+type fixEdit struct {
+	namedDescriptor protosource.NamedDescriptor
+	// scope is the scope the new name must be unique within.
+	scope   string
+	offset  int
+	newName string
+	// fileAnnotations are the FileAnnotations fixed by this edit.
+	fileAnnotations []bufanalysis.FileAnnotation
+}
+
+// fixFile fixes the FileAnnotations within the data of the file.
+//
+// The referencingDatasWithoutComments are the data of the other files that can
+// reference the names of the file, with comments blanked.
+func fixFile(
+	config *Config,
+	file protosource.File,
+	data []byte,
+	referencingDatasWithoutComments [][]byte,
+	fileAnnotations []bufanalysis.FileAnnotation,
+) ([]byte, []bufanalysis.FileAnnotation) {
+	nameLocationToNamedDescriptor := getNameLocationToFixableNamedDescriptor(file)
+	var unfixedFileAnnotations []bufanalysis.FileAnnotation
+	namedDescriptorToFileAnnotations := make(map[protosource.NamedDescriptor][]bufanalysis.FileAnnotation)
+	var namedDescriptors []protosource.NamedDescriptor
+	for _, fileAnnotation := range fileAnnotations {
+		namedDescriptor, ok := nameLocationToNamedDescriptor[newNameLocationKey(fileAnnotation.StartLine(), fileAnnotation.StartColumn())]
+		if !ok {
+			unfixedFileAnnotations = append(unfixedFileAnnotations, fileAnnotation)
+			continue
+		}
+		if _, ok := namedDescriptorToFileAnnotations[namedDescriptor]; !ok {
+			namedDescriptors = append(namedDescriptors, namedDescriptor)
+		}
+		namedDescriptorToFileAnnotations[namedDescriptor] = append(namedDescriptorToFileAnnotations[namedDescriptor], fileAnnotation)
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	var fixEdits []*fixEdit
+	for _, namedDescriptor := range namedDescriptors {
+		namedDescriptorFileAnnotations := namedDescriptorToFileAnnotations[namedDescriptor]
+		name := namedDescriptor.Name()
+		offset, ok := getNameOffset(lines, namedDescriptor.NameLocation(), name)
+		if !ok {
+			unfixedFileAnnotations = append(unfixedFileAnnotations, namedDescriptorFileAnnotations...)
+			continue
+		}
+		newName := name
+		for _, id := range fixableIDs {
+			for _, fileAnnotation := range namedDescriptorFileAnnotations {
+				if fileAnnotation.Type() == id {
+					newName = fixableIDToFixFunc[id](config, namedDescriptor, newName)
+				}
+			}
+		}
+		if newName == name {
+			unfixedFileAnnotations = append(unfixedFileAnnotations, namedDescriptorFileAnnotations...)
+			continue
+		}
+		fixEdits = append(
+			fixEdits,
+			&fixEdit{
+				namedDescriptor: namedDescriptor,
+				scope:           getFixScope(namedDescriptor),
+				offset:          offset,
+				newName:         newName,
+				fileAnnotations: namedDescriptorFileAnnotations,
+			},
+		)
+	}
+
+	dataWithoutComments := blankComments(data)
+	safeFixEdits := make([]*fixEdit, 0, len(fixEdits))
+	for _, fixEdit := range fixEdits {
+		if isSafeFixEdit(dataWithoutComments, referencingDatasWithoutComments, fixEdit, fixEdits) {
+			safeFixEdits = append(safeFixEdits, fixEdit)
+		} else {
+			unfixedFileAnnotations = append(unfixedFileAnnotations, fixEdit.fileAnnotations...)
+		}
+	}
+	// apply from the end of the file so that earlier offsets stay valid
+	sort.Slice(
+		safeFixEdits,
+		func(i int, j int) bool {
+			return safeFixEdits[i].offset > safeFixEdits[j].offset
+		},
+	)
+	fixedData := append([]byte(nil), data...)
+	for _, fixEdit := range safeFixEdits {
+		end := fixEdit.offset + len(fixEdit.namedDescriptor.Name())
+		fixedData = append(
+			fixedData[:fixEdit.offset],
+			append([]byte(fixEdit.newName), fixedData[end:]...)...,
+		)
+	}
+	return fixedData, unfixedFileAnnotations
+}
+
+// getNameLocationToFixableNamedDescriptor returns the fixable descriptors by
+// the start of their name locations.
+//
+// Only message fields and enum values are fixable. Extensions are not fixable
+// as their names are referenced by option usages in other files.
+func getNameLocationToFixableNamedDescriptor(file protosource.File) map[nameLocationKey]protosource.NamedDescriptor {
+	nameLocationToNamedDescriptor := make(map[nameLocationKey]protosource.NamedDescriptor)
+	add := func(namedDescriptor protosource.NamedDescriptor) {
+		if nameLocation := namedDescriptor.NameLocation(); nameLocation != nil {
+			nameLocationToNamedDescriptor[newNameLocationKey(nameLocation.StartLine(), nameLocation.StartColumn())] = namedDescriptor
+		}
+	}
+	// these never return errors as the functions do not return errors
+	_ = protosource.ForEachMessage(
+		func(message protosource.Message) error {
+			for _, field := range message.Fields() {
+				add(field)
+			}
+			return nil
+		},
+		file,
+	)
+	_ = protosource.ForEachEnum(
+		func(enum protosource.Enum) error {
+			for _, enumValue := range enum.Values() {
+				add(enumValue)
+			}
+			return nil
+		},
+		file,
+	)
+	return nameLocationToNamedDescriptor
+}
+
+// getFixScope returns the scope that the name of the descriptor must be unique within.
+//
+// Enum values are scoped to the parent of their enum, per C++ scoping rules.
+func getFixScope(namedDescriptor protosource.NamedDescriptor) string {
+	switch t := namedDescriptor.(type) {
+	case protosource.Field:
+		return t.Message().FullName()
+	case protosource.EnumValue:
+		fullName := t.Enum().FullName()
+		if index := strings.LastIndex(fullName, "."); index >= 0 {
+			return fullName[:index]
+		}
+		return ""
+	default:
+		return namedDescriptor.FullName()
+	}
+}
+
+// isSafeFixEdit returns true if the edit only changes the declaration of the name.
+//
+// The old name must only appear within the file at the declarations of names
+// being renamed to the same new name, and the new name must not already appear
+// within the file or be the result of another edit in the same scope. Neither
+// name may appear within the referencing files, as names can be referenced from
+// other files, for example by field types, option values, and default values.
+// Comments are not considered, and are left as-is.
+func isSafeFixEdit(data []byte, referencingDatas [][]byte, edit *fixEdit, fixEdits []*fixEdit) bool {
+	name := edit.namedDescriptor.Name()
+	if len(getWordOffsets(data, edit.newName)) > 0 {
+		return false
+	}
+	for _, referencingData := range referencingDatas {
+		if len(getWordOffsets(referencingData, name)) > 0 || len(getWordOffsets(referencingData, edit.newName)) > 0 {
+			return false
+		}
+	}
+	renamedOffsets := make(map[int]struct{})
+	for _, otherFixEdit := range fixEdits {
+		if otherFixEdit == edit {
+			renamedOffsets[otherFixEdit.offset] = struct{}{}
+			continue
+		}
+		if otherFixEdit.newName == edit.newName && otherFixEdit.scope == edit.scope {
+			return false
+		}
+		if otherFixEdit.namedDescriptor.Name() == name && otherFixEdit.newName == edit.newName {
+			renamedOffsets[otherFixEdit.offset] = struct{}{}
+		}
+	}
+	for _, offset := range getWordOffsets(data, name) {
+		if _, ok := renamedOffsets[offset]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// getFilePathToReferencingImageFiles returns the other files of the image that
+// can reference the names of each file.
+//
+// These are the files that import the file, directly or transitively, and the
+// files with the same package, as enum values are scoped to their package and
+// may collide across files.
+func getFilePathToReferencingImageFiles(image bufimage.Image) map[string][]bufimage.ImageFile {
+	imageFiles := image.Files()
+	filePathToImportingFilePaths := make(map[string][]string)
+	for _, imageFile := range imageFiles {
+		for _, importPath := range imageFile.ImportPaths() {
+			filePathToImportingFilePaths[importPath] = append(filePathToImportingFilePaths[importPath], imageFile.Path())
+		}
+	}
+	filePathToReferencingImageFiles := make(map[string][]bufimage.ImageFile, len(imageFiles))
+	for _, imageFile := range imageFiles {
+		referencingFilePaths := make(map[string]struct{})
+		addImportingFilePathsRec(imageFile.Path(), filePathToImportingFilePaths, referencingFilePaths)
+		for _, otherImageFile := range imageFiles {
+			if otherImageFile.Path() == imageFile.Path() {
+				continue
+			}
+			_, isReferencing := referencingFilePaths[otherImageFile.Path()]
+			if isReferencing || otherImageFile.Proto().GetPackage() == imageFile.Proto().GetPackage() {
+				filePathToReferencingImageFiles[imageFile.Path()] = append(
+					filePathToReferencingImageFiles[imageFile.Path()],
+					otherImageFile,
+				)
+			}
+		}
+	}
+	return filePathToReferencingImageFiles
+}
+
+func addImportingFilePathsRec(
+	filePath string,
+	filePathToImportingFilePaths map[string][]string,
+	importingFilePaths map[string]struct{},
+) {
+	for _, importingFilePath := range filePathToImportingFilePaths[filePath] {
+		if _, ok := importingFilePaths[importingFilePath]; ok {
+			continue
+		}
+		importingFilePaths[importingFilePath] = struct{}{}
+		addImportingFilePathsRec(importingFilePath, filePathToImportingFilePaths, importingFilePaths)
+	}
+}
+
+// getNameOffset returns the byte offset of the name within the data.
+//
+// Columns are computed with tabs expanded to the next tab stop, but we also
+// accept the column as a byte offset if the name is found there. We always
+// verify that the name is at the computed offset, so that we never edit
+// anything other than the name.
+func getNameOffset(lines [][]byte, nameLocation protosource.Location, name string) (int, bool) {
+	if nameLocation == nil {
+		return 0, false
+	}
+	lineIndex := nameLocation.StartLine() - 1
+	if lineIndex < 0 || lineIndex >= len(lines) {
+		return 0, false
+	}
+	lineOffset := 0
+	for _, line := range lines[:lineIndex] {
+		lineOffset += len(line)
+	}
+	line := lines[lineIndex]
+	column := nameLocation.StartColumn() - 1
+	candidates := []int{column}
+	if index, ok := getTabExpandedColumnIndex(line, column); ok && index != column {
+		candidates = append([]int{index}, candidates...)
+	}
+	for _, index := range candidates {
+		if index >= 0 && index+len(name) <= len(line) && string(line[index:index+len(name)]) == name {
+			return lineOffset + index, true
+		}
+	}
+	return 0, false
+}
+
+// getTabExpandedColumnIndex returns the byte index within the line for the column,
+// assuming the column was computed with tabs expanded.
+func getTabExpandedColumnIndex(line []byte, column int) (int, bool) {
+	expandedColumn := 0
+	for i, b := range line {
+		if expandedColumn == column {
+			return i, true
+		}
+		if expandedColumn > column {
+			return 0, false
+		}
+		if b == '\t' {
+			expandedColumn += protocTabStop - (expandedColumn % protocTabStop)
+		} else {
+			expandedColumn++
+		}
+	}
+	return 0, false
+}
+
+// getWordOffsets returns the offsets of the word within the data, where a word
+// is not directly adjacent to other identifier characters.
+//
+// The data should have comments blanked with blankComments.
+func getWordOffsets(data []byte, word string) []int {
+	var offsets []int
+	wordBytes := []byte(word)
+	for start := 0; start < len(data); {
+		index := bytes.Index(data[start:], wordBytes)
+		if index < 0 {
+			break
+		}
+		offset := start + index
+		end := offset + len(wordBytes)
+		if (offset == 0 || !isIdentifierByte(data[offset-1])) &&
+			(end == len(data) || !isIdentifierByte(data[end])) {
+			offsets = append(offsets, offset)
+		}
+		start = offset + 1
+	}
+	return offsets
+}
+
+// blankComments returns a copy of the data with all comments replaced with spaces.
+//
+// Offsets within the returned data are the same as within the given data.
+// Strings are not blanked, as strings within options can reference names.
+func blankComments(data []byte) []byte {
+	blanked := append([]byte(nil), data...)
+	for i := 0; i < len(blanked); i++ {
+		switch b := blanked[i]; {
+		case b == '"' || b == '\'':
+			for i++; i < len(blanked) && blanked[i] != b && blanked[i] != '\n'; i++ {
+				if blanked[i] == '\\' {
+					i++
+				}
+			}
+		case b == '/' && i+1 < len(blanked) && blanked[i+1] == '/':
+			for ; i < len(blanked) && blanked[i] != '\n'; i++ {
+				blanked[i] = ' '
+			}
+		case b == '/' && i+1 < len(blanked) && blanked[i+1] == '*':
+			end := bytes.Index(blanked[i+2:], []byte("*/"))
+			if end < 0 {
+				end = len(blanked)
+			} else {
+				end = i + 2 + end + 2
+			}
+			for ; i < end; i++ {
+				if blanked[i] != '\n' {
+					blanked[i] = ' '
+				}
+			}
+			i--
+		}
+	}
+	return blanked
+}
+
+func isIdentifierByte(b byte) bool {
+	return b == '_' ||
+		('a' <= b && b <= 'z') ||
+		('A' <= b && b <= 'Z') ||
+		('0' <= b && b <= '9')
+}
+
+type nameLocationKey struct {
+	startLine   int
+	startColumn int
+}
+
+func newNameLocationKey(startLine int, startColumn int) nameLocationKey {
+	return nameLocationKey{
+		startLine:   startLine,
+		startColumn: startColumn,
+	}
+}
+
+type fixedFile struct {
+	bufanalysis.FileInfo
+
+	originalData []byte
+	fixedData    []byte
+}
+
+func newFixedFile(fileInfo bufanalysis.FileInfo, originalData []byte, fixedData []byte) *fixedFile {
+	return &fixedFile{
+		FileInfo:     fileInfo,
+		originalData: originalData,
+		fixedData:    fixedData,
+	}
+}
+
+func (f *fixedFile) OriginalData() []byte {
+	return f.originalData
+}
+
+func (f *fixedFile) FixedData() []byte {
+	return f.fixedData
+}
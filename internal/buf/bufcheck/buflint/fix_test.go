@@ -0,0 +1,84 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buflint_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufanalysis/bufanalysistesting"
+	"github.com/bufbuild/buf/internal/buf/bufcheck/buflint"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFix(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dirPath := filepath.Join("testdata", "fix", "input")
+	config, image := testBuildConfigAndImage(ctx, t, dirPath, nil)
+	fileAnnotations, err := buflint.NewHandler(zap.NewNop()).Check(ctx, config.Lint, image)
+	require.NoError(t, err)
+	require.Len(t, fileAnnotations, 11)
+
+	fixedFiles, unfixedFileAnnotations, err := buflint.Fix(
+		ctx,
+		config.Lint,
+		image,
+		fileAnnotations,
+		func(fileInfo bufanalysis.FileInfo) ([]byte, error) {
+			return ioutil.ReadFile(filepath.Join(dirPath, fileInfo.Path()))
+		},
+	)
+	require.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			// referenced by an option value in b.proto
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 11, 3, 11, 7, "ENUM_VALUE_PREFIX"),
+			// referenced by an option value in b.proto
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 16, 10, 16, 16, "FIELD_LOWER_SNAKE_CASE"),
+			// referenced by the go_package option
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 17, 9, 17, 15, "FIELD_LOWER_SNAKE_CASE"),
+			// would collide with one_two
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 18, 9, 18, 15, "FIELD_LOWER_SNAKE_CASE"),
+			// the same name is referenced by an option value in b.proto
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 24, 10, 24, 16, "FIELD_LOWER_SNAKE_CASE"),
+			// not fixable
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 27, 9, 27, 12, "MESSAGE_PASCAL_CASE"),
+		},
+		unfixedFileAnnotations,
+	)
+	require.Len(t, fixedFiles, 1)
+	require.Equal(t, "a.proto", fixedFiles[0].Path())
+	originalData, err := ioutil.ReadFile(filepath.Join(dirPath, "a.proto"))
+	require.NoError(t, err)
+	require.Equal(t, string(originalData), string(fixedFiles[0].OriginalData()))
+	expectedData, err := ioutil.ReadFile(filepath.Join("testdata", "fix", "expected", "a.proto"))
+	require.NoError(t, err)
+	require.Equal(t, string(expectedData), string(fixedFiles[0].FixedData()))
+}
+
+func TestIsFixable(t *testing.T) {
+	t.Parallel()
+	require.True(t, buflint.IsFixable("FIELD_LOWER_SNAKE_CASE"))
+	require.False(t, buflint.IsFixable("MESSAGE_PASCAL_CASE"))
+}
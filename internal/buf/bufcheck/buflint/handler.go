@@ -22,6 +22,8 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimageutil"
 	"github.com/bufbuild/buf/internal/pkg/protosource"
+	"github.com/bufbuild/buf/internal/pkg/protoversion"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
 	"go.uber.org/zap"
 )
 
@@ -52,5 +54,73 @@ func (h *handler) Check(
 	if err != nil {
 		return nil, err
 	}
-	return h.runner.Check(ctx, configToInternalConfig(config), nil, files)
+	if config.IgnoreUnstablePackages {
+		h.logUnstablePackages(config, files)
+	}
+	fileAnnotations, err := h.runner.Check(ctx, configToInternalConfig(config), nil, files)
+	if err != nil {
+		return nil, err
+	}
+	return fileAnnotationsWithRuleSeverities(fileAnnotations, config.RuleIDToSeverity), nil
+}
+
+// fileAnnotationsWithRuleSeverities returns the FileAnnotations with the
+// severities of their rules set.
+func fileAnnotationsWithRuleSeverities(
+	fileAnnotations []bufanalysis.FileAnnotation,
+	ruleIDToSeverity map[string]bufanalysis.Severity,
+) []bufanalysis.FileAnnotation {
+	if len(ruleIDToSeverity) == 0 {
+		return fileAnnotations
+	}
+	for i, fileAnnotation := range fileAnnotations {
+		severity, ok := ruleIDToSeverity[fileAnnotation.Type()]
+		if !ok || severity == fileAnnotation.Severity() {
+			continue
+		}
+		fileAnnotations[i] = bufanalysis.NewFileAnnotationWithSeverity(
+			fileAnnotation.FileInfo(),
+			fileAnnotation.StartLine(),
+			fileAnnotation.StartColumn(),
+			fileAnnotation.EndLine(),
+			fileAnnotation.EndColumn(),
+			fileAnnotation.Type(),
+			fileAnnotation.Message(),
+			severity,
+		)
+	}
+	return fileAnnotations
+}
+
+// logUnstablePackages logs the rules that will be skipped for each unstable package.
+//
+// This is only visible with verbose logging.
+func (h *handler) logUnstablePackages(config *Config, files []protosource.File) {
+	if len(config.Rules) == 0 {
+		return
+	}
+	unstablePackages := make(map[string]struct{})
+	for _, file := range files {
+		packageVersion, ok := protoversion.NewPackageVersionForPackage(file.Package())
+		if !ok {
+			continue
+		}
+		if packageVersion.StabilityLevel() != protoversion.StabilityLevelStable {
+			unstablePackages[file.Package()] = struct{}{}
+		}
+	}
+	if len(unstablePackages) == 0 {
+		return
+	}
+	ruleIDs := make([]string, len(config.Rules))
+	for i, rule := range config.Rules {
+		ruleIDs[i] = rule.ID()
+	}
+	for _, unstablePackage := range stringutil.MapToSortedSlice(unstablePackages) {
+		h.logger.Debug(
+			"ignore_unstable_package",
+			zap.String("package", unstablePackage),
+			zap.Strings("skipped_rules", ruleIDs),
+		)
+	}
 }
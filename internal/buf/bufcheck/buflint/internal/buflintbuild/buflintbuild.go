@@ -17,6 +17,7 @@ package buflintbuild
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/buf/bufcheck/buflint/internal/buflintcheck"
@@ -61,18 +62,51 @@ var (
 		"RPCs have non-empty comments",
 		newAdapter(buflintcheck.CheckCommentRPC),
 	)
+	// CommentRPCRequestResponseRuleBuilder is a rule builder.
+	CommentRPCRequestResponseRuleBuilder = internal.NewNopRuleBuilder(
+		"COMMENT_RPC_REQUEST_RESPONSE",
+		"RPCs and their request and response messages and fields have non-empty comments",
+		newAdapter(buflintcheck.CheckCommentRPCRequestResponse),
+	)
 	// CommentServiceRuleBuilder is a rule builder.
 	CommentServiceRuleBuilder = internal.NewNopRuleBuilder(
 		"COMMENT_SERVICE",
 		"services have non-empty comments",
 		newAdapter(buflintcheck.CheckCommentService),
 	)
+	// CustomRuleBuilder is a rule builder.
+	CustomRuleBuilder = internal.NewRuleBuilder(
+		"CUSTOM",
+		func(internal.ConfigBuilder) (string, error) {
+			return "the custom constraints in the lint configuration are satisfied (constraints are configurable)", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			checkCustom, err := buflintcheck.NewCheckCustom(
+				configBuilder.CustomForbidFieldTypes,
+				configBuilder.CustomRequireFieldOptions,
+				configBuilder.CustomRequireFileOptions,
+				configBuilder.CustomForbidMessageNameRegex,
+				configBuilder.CustomAllowFieldNumberRanges,
+				configBuilder.CustomForbidFieldNumberRanges,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return newAdapter(checkCustom), nil
+		},
+	)
 	// DirectorySamePackageRuleBuilder is a rule builder.
 	DirectorySamePackageRuleBuilder = internal.NewNopRuleBuilder(
 		"DIRECTORY_SAME_PACKAGE",
 		"all files in a given directory are in the same package",
 		newAdapter(buflintcheck.CheckDirectorySamePackage),
 	)
+	// EnumAllowAliasConsistentRuleBuilder is a rule builder.
+	EnumAllowAliasConsistentRuleBuilder = internal.NewNopRuleBuilder(
+		"ENUM_ALLOW_ALIAS_CONSISTENT",
+		"enums set the allow_alias option if and only if they have values with the same number",
+		newAdapter(buflintcheck.CheckEnumAllowAliasConsistent),
+	)
 	// EnumFirstValueZeroRuleBuilder is a rule builder.
 	EnumFirstValueZeroRuleBuilder = internal.NewNopRuleBuilder(
 		"ENUM_FIRST_VALUE_ZERO",
@@ -133,6 +167,33 @@ var (
 		`field names are not name capitalization of "descriptor" with any number of prefix or suffix underscores`,
 		newAdapter(buflintcheck.CheckFieldNoDescriptor),
 	)
+	// FieldNoGroupRuleBuilder is a rule builder.
+	FieldNoGroupRuleBuilder = internal.NewNopRuleBuilder(
+		"FIELD_NO_GROUP",
+		"fields are not groups",
+		newAdapter(buflintcheck.CheckFieldNoGroup),
+	)
+	// FieldPresenceRuleBuilder is a rule builder.
+	FieldPresenceRuleBuilder = internal.NewRuleBuilder(
+		"FIELD_PRESENCE",
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			switch configBuilder.FieldPresence {
+			case buflintcheck.FieldPresenceRequireOptional:
+				return `proto3 singular scalar fields have the "optional" label (presence is configurable)`, nil
+			case buflintcheck.FieldPresenceForbidOptional:
+				return `proto3 singular scalar fields do not have the "optional" label (presence is configurable)`, nil
+			default:
+				return "", fmt.Errorf("field_presence %q is unknown", configBuilder.FieldPresence)
+			}
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			checkFieldPresence, err := buflintcheck.NewCheckFieldPresence(configBuilder.FieldPresence)
+			if err != nil {
+				return nil, err
+			}
+			return newAdapter(checkFieldPresence), nil
+		},
+	)
 	// FileLowerSnakeCaseRuleBuilder is a rule builder.
 	FileLowerSnakeCaseRuleBuilder = internal.NewNopRuleBuilder(
 		"FILE_LOWER_SNAKE_CASE",
@@ -170,10 +231,20 @@ var (
 		newAdapter(buflintcheck.CheckPackageDefined),
 	)
 	// PackageDirectoryMatchRuleBuilder is a rule builder.
-	PackageDirectoryMatchRuleBuilder = internal.NewNopRuleBuilder(
+	PackageDirectoryMatchRuleBuilder = internal.NewRuleBuilder(
 		"PACKAGE_DIRECTORY_MATCH",
-		"all files are in a directory that matches their package name",
-		newAdapter(buflintcheck.CheckPackageDirectoryMatch),
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			return "all files are in a directory that matches their package name", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			rootPrefix, err := buflintcheck.NewPackageDirectoryMatchRootPrefix(configBuilder.PackageDirectoryMatchRootPrefix)
+			if err != nil {
+				return nil, err
+			}
+			return internal.CheckFunc(func(id string, ignoreFunc internal.IgnoreFunc, _ []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+				return buflintcheck.CheckPackageDirectoryMatch(id, ignoreFunc, files, rootPrefix)
+			}), nil
+		},
 	)
 	// PackageLowerSnakeCaseRuleBuilder is a rule builder.
 	PackageLowerSnakeCaseRuleBuilder = internal.NewNopRuleBuilder(
@@ -181,6 +252,16 @@ var (
 		"packages are lower_snake.case",
 		newAdapter(buflintcheck.CheckPackageLowerSnakeCase),
 	)
+	// PackageNoStutterRuleBuilder is a rule builder.
+	PackageNoStutterRuleBuilder = internal.NewRuleBuilder(
+		"PACKAGE_NO_STUTTER",
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			return "top-level message, enum, and service names do not begin with the last non-version component of the package (names are configurable to allow)", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			return newAdapter(buflintcheck.NewCheckPackageNoStutter(configBuilder.PackageNoStutterAllow)), nil
+		},
+	)
 	// PackageSameCsharpNamespaceRuleBuilder is a rule builder.
 	PackageSameCsharpNamespaceRuleBuilder = internal.NewNopRuleBuilder(
 		"PACKAGE_SAME_CSHARP_NAMESPACE",
@@ -235,6 +316,22 @@ var (
 		`the last component of all packages is a version of the form v\d+, v\d+test.*, v\d+(alpha|beta)\d+, or v\d+p\d+(alpha|beta)\d+, where numbers are >=1`,
 		newAdapter(buflintcheck.CheckPackageVersionSuffix),
 	)
+	// ReservedNotUsedRuleBuilder is a rule builder.
+	ReservedNotUsedRuleBuilder = internal.NewNopRuleBuilder(
+		"RESERVED_NOT_USED",
+		"fields and enum values do not use reserved names or numbers",
+		newAdapter(buflintcheck.CheckReservedNotUsed),
+	)
+	// RPCHTTPAnnotationRuleBuilder is a rule builder.
+	RPCHTTPAnnotationRuleBuilder = internal.NewRuleBuilder(
+		"RPC_HTTP_ANNOTATION",
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			return "RPCs have the google.api.http option set (streaming RPCs are configurable to allow)", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			return newAdapter(buflintcheck.NewCheckRPCHTTPAnnotation(configBuilder.RPCHTTPAnnotationAllowStreaming)), nil
+		},
+	)
 	// RPCNoClientStreamingRuleBuilder is a rule builder.
 	RPCNoClientStreamingRuleBuilder = internal.NewNopRuleBuilder(
 		"RPC_NO_CLIENT_STREAMING",
@@ -306,6 +403,24 @@ var (
 			}), nil
 		},
 	)
+	// RPCStreamingSuffixRuleBuilder is a rule builder.
+	RPCStreamingSuffixRuleBuilder = internal.NewRuleBuilder(
+		"RPC_STREAMING_SUFFIX",
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			if configBuilder.RPCStreamingSuffix == "" {
+				return "", errors.New("rpc_streaming_suffix is empty")
+			}
+			return "streaming RPCs are suffixed with " + configBuilder.RPCStreamingSuffix + " and unary RPCs are not (suffix is configurable)", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			if configBuilder.RPCStreamingSuffix == "" {
+				return nil, errors.New("rpc_streaming_suffix is empty")
+			}
+			return internal.CheckFunc(func(id string, ignoreFunc internal.IgnoreFunc, _ []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+				return buflintcheck.CheckRPCStreamingSuffix(id, ignoreFunc, files, configBuilder.RPCStreamingSuffix)
+			}), nil
+		},
+	)
 	// ServicePascalCaseRuleBuilder is a rule builder.
 	ServicePascalCaseRuleBuilder = internal.NewNopRuleBuilder(
 		"SERVICE_PASCAL_CASE",
@@ -330,6 +445,12 @@ var (
 			}), nil
 		},
 	)
+	// SyntaxSpecifiedRuleBuilder is a rule builder.
+	SyntaxSpecifiedRuleBuilder = internal.NewNopRuleBuilder(
+		"SYNTAX_SPECIFIED",
+		"all files have a syntax explicitly specified",
+		newAdapter(buflintcheck.CheckSyntaxSpecified),
+	)
 )
 
 func newAdapter(
@@ -19,8 +19,12 @@ package buflintcheck
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/buf/bufcheck/internal"
@@ -75,6 +79,51 @@ func checkCommentService(add addFunc, value protosource.Service) error {
 	return checkCommentNamedDescriptor(add, value, "Service")
 }
 
+// CheckCommentRPCRequestResponse is a check function.
+var CheckCommentRPCRequestResponse = newFilesCheckFunc(checkCommentRPCRequestResponse)
+
+// checkCommentRPCRequestResponse checks that RPCs, and the messages used as the
+// request or response of an RPC along with their fields, have comments.
+//
+// Messages that are only referenced by other messages are not checked, nor are
+// request and response messages not within the files, such as google.protobuf.Empty.
+func checkCommentRPCRequestResponse(add addFunc, files []protosource.File) error {
+	fullNameToMessage, err := protosource.FullNameToMessage(files...)
+	if err != nil {
+		return err
+	}
+	checkedFullNames := make(map[string]struct{})
+	for _, file := range files {
+		for _, service := range file.Services() {
+			for _, method := range service.Methods() {
+				if err := checkCommentNamedDescriptor(add, method, "RPC"); err != nil {
+					return err
+				}
+				for _, typeName := range []string{method.InputTypeName(), method.OutputTypeName()} {
+					fullName := strings.TrimPrefix(typeName, ".")
+					if _, ok := checkedFullNames[fullName]; ok {
+						continue
+					}
+					checkedFullNames[fullName] = struct{}{}
+					message, ok := fullNameToMessage[fullName]
+					if !ok {
+						continue
+					}
+					if err := checkCommentNamedDescriptor(add, message, "Message"); err != nil {
+						return err
+					}
+					for _, field := range message.Fields() {
+						if err := checkCommentNamedDescriptor(add, field, "Field"); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func checkCommentNamedDescriptor(
 	add addFunc,
 	namedDescriptor protosource.NamedDescriptor,
@@ -91,24 +140,378 @@ func checkCommentNamedDescriptor(
 	return nil
 }
 
+// maxFieldNumber is the maximum valid field number.
+const maxFieldNumber = 536870911
+
+// NewCheckCustom returns a new check function for the custom constraints.
+//
+// forbidFieldTypes are scalar type names such as "bytes", "group", or fully-qualified
+// message or enum names such as "google.protobuf.Any". requireFieldOptions are the
+// names of field options that must be explicitly set. requireFileOptions are the
+// names of file options that must be explicitly set, optionally followed by "=VALUE"
+// to also require the option to be set to VALUE, for example "optimize_for=SPEED".
+// forbidMessageNameRegex is a regular expression that message names must not match,
+// with no constraint if empty. allowFieldNumberRanges and forbidFieldNumberRanges
+// are field number ranges of the form "N", "N-M", or "N-max". If any allowed ranges
+// are given, all field numbers must be within one of them, and no field number may
+// be within a forbidden range.
+func NewCheckCustom(
+	forbidFieldTypes []string,
+	requireFieldOptions []string,
+	requireFileOptions []string,
+	forbidMessageNameRegex string,
+	allowFieldNumberRanges []string,
+	forbidFieldNumberRanges []string,
+) (func(string, internal.IgnoreFunc, []protosource.File) ([]bufanalysis.FileAnnotation, error), error) {
+	forbidFieldTypeMap := make(map[string]struct{}, len(forbidFieldTypes))
+	for _, forbidFieldType := range forbidFieldTypes {
+		forbidFieldType = strings.TrimPrefix(forbidFieldType, ".")
+		if forbidFieldType == "" {
+			return nil, errors.New("custom forbid_field_types contains an empty type")
+		}
+		forbidFieldTypeMap[forbidFieldType] = struct{}{}
+	}
+	for _, requireFieldOption := range requireFieldOptions {
+		if _, ok := fieldOptionNameToIsSet[requireFieldOption]; !ok {
+			return nil, fmt.Errorf("custom require_field_options contains unknown option %q, must be one of %s", requireFieldOption, stringutil.SliceToString(fieldOptionNames))
+		}
+	}
+	customRequiredFileOptions := make([]*customRequiredFileOption, 0, len(requireFileOptions))
+	for _, requireFileOption := range requireFileOptions {
+		customRequiredFileOption, err := newCustomRequiredFileOption(requireFileOption)
+		if err != nil {
+			return nil, err
+		}
+		customRequiredFileOptions = append(customRequiredFileOptions, customRequiredFileOption)
+	}
+	var forbidMessageNameRegexp *regexp.Regexp
+	if forbidMessageNameRegex != "" {
+		var err error
+		forbidMessageNameRegexp, err = regexp.Compile(forbidMessageNameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("custom forbid_message_name_regex %q is invalid: %v", forbidMessageNameRegex, err)
+		}
+	}
+	allowCustomFieldNumberRanges, err := newCustomFieldNumberRanges("allow_field_number_ranges", allowFieldNumberRanges)
+	if err != nil {
+		return nil, err
+	}
+	forbidCustomFieldNumberRanges, err := newCustomFieldNumberRanges("forbid_field_number_ranges", forbidFieldNumberRanges)
+	if err != nil {
+		return nil, err
+	}
+	customFieldNumberConstraints := &customFieldNumberConstraints{
+		allowRanges:  allowCustomFieldNumberRanges,
+		forbidRanges: forbidCustomFieldNumberRanges,
+	}
+	return newFileCheckFunc(
+		func(add addFunc, file protosource.File) error {
+			return checkCustom(add, file, forbidFieldTypeMap, requireFieldOptions, customRequiredFileOptions, forbidMessageNameRegexp, customFieldNumberConstraints)
+		},
+	), nil
+}
+
+func checkCustom(
+	add addFunc,
+	file protosource.File,
+	forbidFieldTypeMap map[string]struct{},
+	requireFieldOptions []string,
+	customRequiredFileOptions []*customRequiredFileOption,
+	forbidMessageNameRegexp *regexp.Regexp,
+	customFieldNumberConstraints *customFieldNumberConstraints,
+) error {
+	for _, customRequiredFileOption := range customRequiredFileOptions {
+		checkCustomRequiredFileOption(add, file, customRequiredFileOption)
+	}
+	// map entries are always in the same file as the fields that use them
+	fullNameToMessage, err := protosource.FullNameToMessage(file)
+	if err != nil {
+		return err
+	}
+	return protosource.ForEachMessage(
+		func(message protosource.Message) error {
+			if message.IsMapEntry() {
+				// map entries are checked through the fields that use them
+				return nil
+			}
+			if forbidMessageNameRegexp != nil && forbidMessageNameRegexp.MatchString(message.Name()) {
+				add(message, message.NameLocation(), nil, "Message name %q matches the forbidden pattern %q.", message.Name(), forbidMessageNameRegexp.String())
+			}
+			for _, field := range message.Fields() {
+				checkCustomField(add, message, field, fullNameToMessage, forbidFieldTypeMap, requireFieldOptions)
+				// extensions are not checked as their numbers are within the
+				// extension ranges of the extended message
+				checkCustomFieldNumber(add, message, field, customFieldNumberConstraints)
+			}
+			for _, field := range message.Extensions() {
+				checkCustomField(add, message, field, fullNameToMessage, forbidFieldTypeMap, requireFieldOptions)
+			}
+			return nil
+		},
+		file,
+	)
+}
+
+func checkCustomField(
+	add addFunc,
+	message protosource.Message,
+	field protosource.Field,
+	fullNameToMessage map[string]protosource.Message,
+	forbidFieldTypeMap map[string]struct{},
+	requireFieldOptions []string,
+) {
+	if len(forbidFieldTypeMap) > 0 {
+		for _, fieldTypeName := range getCustomFieldTypeNames(field, fullNameToMessage) {
+			if _, ok := forbidFieldTypeMap[fieldTypeName]; ok {
+				location := field.TypeNameLocation()
+				if location == nil {
+					location = field.TypeLocation()
+				}
+				add(field, location, nil, "Field %q in message %q has forbidden type %q.", field.Name(), message.Name(), fieldTypeName)
+			}
+		}
+	}
+	for _, requireFieldOption := range requireFieldOptions {
+		if !fieldOptionNameToIsSet[requireFieldOption](field) {
+			add(field, field.NameLocation(), nil, "Field %q does not set the required option %q.", field.Name(), requireFieldOption)
+		}
+	}
+}
+
+func checkCustomFieldNumber(
+	add addFunc,
+	message protosource.Message,
+	field protosource.Field,
+	customFieldNumberConstraints *customFieldNumberConstraints,
+) {
+	number := field.Number()
+	if len(customFieldNumberConstraints.allowRanges) > 0 {
+		allowed := false
+		for _, allowRange := range customFieldNumberConstraints.allowRanges {
+			if allowRange.contains(number) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			add(field, field.NumberLocation(), nil, "Field %q in message %q has number %d which is not within an allowed range.", field.Name(), message.Name(), number)
+		}
+	}
+	for _, forbidRange := range customFieldNumberConstraints.forbidRanges {
+		if forbidRange.contains(number) {
+			add(field, field.NumberLocation(), nil, "Field %q in message %q has number %d which is within the forbidden range %q.", field.Name(), message.Name(), number, forbidRange.value)
+			// only report once per field
+			return
+		}
+	}
+}
+
+func checkCustomRequiredFileOption(
+	add addFunc,
+	file protosource.File,
+	customRequiredFileOption *customRequiredFileOption,
+) {
+	location := customRequiredFileOption.fileOption.getLocation(file)
+	if location == nil {
+		// report on the package declaration, falling back to the syntax
+		// declaration for files without a package
+		location = file.PackageLocation()
+		if location == nil {
+			location = file.SyntaxLocation()
+		}
+		if customRequiredFileOption.value != "" {
+			add(file, location, nil, "File does not set the required option %q to %q.", customRequiredFileOption.name, customRequiredFileOption.value)
+		} else {
+			add(file, location, nil, "File does not set the required option %q.", customRequiredFileOption.name)
+		}
+		return
+	}
+	if customRequiredFileOption.value == "" {
+		return
+	}
+	if value := customRequiredFileOption.fileOption.getValue(file); value != customRequiredFileOption.value {
+		add(file, location, nil, "File option %q is set to %q but must be set to %q.", customRequiredFileOption.name, value, customRequiredFileOption.value)
+	}
+}
+
+// customRequiredFileOption is a parsed entry of custom require_file_options.
+type customRequiredFileOption struct {
+	name       string
+	fileOption *fileOption
+	// value is the required value, or empty if any value is allowed.
+	value string
+}
+
+// newCustomRequiredFileOption parses an entry of custom require_file_options
+// of the form "name" or "name=VALUE".
+func newCustomRequiredFileOption(requireFileOption string) (*customRequiredFileOption, error) {
+	split := strings.SplitN(requireFileOption, "=", 2)
+	name := strings.TrimSpace(split[0])
+	fileOption, ok := fileOptionNameToFileOption[name]
+	if !ok {
+		return nil, fmt.Errorf("custom require_file_options contains unknown option %q, must be one of %s", name, stringutil.SliceToString(fileOptionNames))
+	}
+	var value string
+	if len(split) == 2 {
+		value = strings.TrimSpace(split[1])
+		if value == "" {
+			return nil, fmt.Errorf("custom require_file_options contains an empty value for option %q", name)
+		}
+		if len(fileOption.allowedValues) > 0 && !stringutil.SliceElementsContained(fileOption.allowedValues, []string{value}) {
+			return nil, fmt.Errorf("custom require_file_options contains invalid value %q for option %q, must be one of %s", value, name, stringutil.SliceToString(fileOption.allowedValues))
+		}
+	}
+	return &customRequiredFileOption{
+		name:       name,
+		fileOption: fileOption,
+		value:      value,
+	}, nil
+}
+
+// customFieldNumberConstraints are the parsed custom allow_field_number_ranges
+// and forbid_field_number_ranges.
+type customFieldNumberConstraints struct {
+	allowRanges  []*customFieldNumberRange
+	forbidRanges []*customFieldNumberRange
+}
+
+// customFieldNumberRange is a parsed entry of custom allow_field_number_ranges
+// or forbid_field_number_ranges.
+type customFieldNumberRange struct {
+	// value is the range as given in the configuration.
+	value string
+	start int
+	// end is inclusive.
+	end int
+}
+
+func (r *customFieldNumberRange) contains(number int) bool {
+	return r.start <= number && number <= r.end
+}
+
+// newCustomFieldNumberRanges parses the entries of the custom field number range
+// list with the given name.
+//
+// Each entry is of the form "N", "N-M", or "N-max", where the range is inclusive.
+func newCustomFieldNumberRanges(name string, values []string) ([]*customFieldNumberRange, error) {
+	customFieldNumberRanges := make([]*customFieldNumberRange, 0, len(values))
+	for _, value := range values {
+		split := strings.SplitN(value, "-", 2)
+		start, err := parseCustomFieldNumber(split[0])
+		if err != nil {
+			return nil, fmt.Errorf("custom %s contains invalid range %q: %v", name, value, err)
+		}
+		end := start
+		if len(split) == 2 {
+			if strings.TrimSpace(split[1]) == "max" {
+				end = maxFieldNumber
+			} else {
+				end, err = parseCustomFieldNumber(split[1])
+				if err != nil {
+					return nil, fmt.Errorf("custom %s contains invalid range %q: %v", name, value, err)
+				}
+			}
+		}
+		if start > end {
+			return nil, fmt.Errorf("custom %s contains invalid range %q: start is greater than end", name, value)
+		}
+		customFieldNumberRanges = append(
+			customFieldNumberRanges,
+			&customFieldNumberRange{
+				value: value,
+				start: start,
+				end:   end,
+			},
+		)
+	}
+	return customFieldNumberRanges, nil
+}
+
+func parseCustomFieldNumber(value string) (int, error) {
+	number, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", strings.TrimSpace(value))
+	}
+	if number < 1 || number > maxFieldNumber {
+		return 0, fmt.Errorf("%d is not a valid field number, must be between 1 and %d", number, maxFieldNumber)
+	}
+	return number, nil
+}
+
+// getCustomFieldTypeNames returns the type names of the field as used in
+// custom forbid_field_types.
+//
+// For map fields, this returns the type names of the key and value.
+func getCustomFieldTypeNames(field protosource.Field, fullNameToMessage map[string]protosource.Message) []string {
+	switch fieldType := field.Type(); fieldType {
+	case protosource.FieldDescriptorProtoTypeMessage, protosource.FieldDescriptorProtoTypeEnum:
+		typeName := strings.TrimPrefix(field.TypeName(), ".")
+		if mapEntry, ok := fullNameToMessage[typeName]; ok && mapEntry.IsMapEntry() {
+			var typeNames []string
+			for _, mapEntryField := range mapEntry.Fields() {
+				typeNames = append(typeNames, getCustomFieldTypeNames(mapEntryField, fullNameToMessage)...)
+			}
+			return typeNames
+		}
+		return []string{typeName}
+	default:
+		return []string{strings.ToLower(strings.TrimPrefix(fieldType.String(), "TYPE_"))}
+	}
+}
+
 // CheckDirectorySamePackage is a check function.
 var CheckDirectorySamePackage = newDirToFilesCheckFunc(checkDirectorySamePackage)
 
 func checkDirectorySamePackage(add addFunc, dirPath string, files []protosource.File) error {
-	pkgMap := make(map[string]struct{})
+	pkgToFilePaths := make(map[string][]string)
 	for _, file := range files {
 		// works for no package set as this will result in "" which is a valid map key
-		pkgMap[file.Package()] = struct{}{}
+		pkgToFilePaths[file.Package()] = append(pkgToFilePaths[file.Package()], file.Path())
 	}
-	if len(pkgMap) > 1 {
-		pkgs := stringutil.MapToSortedSlice(pkgMap)
+	if len(pkgToFilePaths) > 1 {
+		pkgs := make([]string, 0, len(pkgToFilePaths))
+		for pkg := range pkgToFilePaths {
+			pkgs = append(pkgs, pkg)
+		}
+		sort.Strings(pkgs)
+		// list the files of each package so that the file with the typo can be found
+		pkgFilePathsStrings := make([]string, len(pkgs))
+		for i, pkg := range pkgs {
+			filePaths := pkgToFilePaths[pkg]
+			sort.Strings(filePaths)
+			pkgFilePathsStrings[i] = fmt.Sprintf("%q in %s", pkg, strings.Join(filePaths, ", "))
+		}
 		for _, file := range files {
-			add(file, file.PackageLocation(), nil, "Multiple packages %q detected within directory %q.", strings.Join(pkgs, ","), dirPath)
+			add(file, file.PackageLocation(), nil, "Multiple packages %q detected within directory %q: %s.", strings.Join(pkgs, ","), dirPath, strings.Join(pkgFilePathsStrings, "; "))
 		}
 	}
 	return nil
 }
 
+// CheckEnumAllowAliasConsistent is a check function.
+var CheckEnumAllowAliasConsistent = newEnumCheckFunc(checkEnumAllowAliasConsistent)
+
+// Both violations are rejected by the compiler, but images may be produced by other tools.
+func checkEnumAllowAliasConsistent(add addFunc, enum protosource.Enum) error {
+	numberToFirstEnumValue := make(map[int]protosource.EnumValue)
+	hasAlias := false
+	for _, enumValue := range enum.Values() {
+		firstEnumValue, ok := numberToFirstEnumValue[enumValue.Number()]
+		if !ok {
+			numberToFirstEnumValue[enumValue.Number()] = enumValue
+			continue
+		}
+		hasAlias = true
+		if !enum.AllowAlias() {
+			add(enumValue, enumValue.NumberLocation(), nil, `Enum value %q has the same number %d as %q, which requires setting option "allow_alias = true" on enum %q.`, enumValue.Name(), enumValue.Number(), firstEnumValue.Name(), enum.Name())
+		}
+	}
+	if enum.AllowAlias() && !hasAlias {
+		add(enum, enum.AllowAliasLocation(), nil, `Enum %q sets option "allow_alias" but has no values with the same number, so the option should be removed.`, enum.Name())
+	}
+	return nil
+}
+
 // CheckEnumNoAllowAlias is a check function.
 var CheckEnumNoAllowAlias = newEnumCheckFunc(checkEnumNoAllowAlias)
 
@@ -150,24 +553,81 @@ var CheckEnumValuePrefix = newEnumValueCheckFunc(checkEnumValuePrefix)
 func checkEnumValuePrefix(add addFunc, enumValue protosource.EnumValue) error {
 	name := enumValue.Name()
 	expectedPrefix := fieldToUpperSnakeCase(enumValue.Enum().Name()) + "_"
-	if !strings.HasPrefix(name, expectedPrefix) {
+	if strings.HasPrefix(name, expectedPrefix) {
+		return nil
+	}
+	// also check the enum for this comment ignore
+	// this allows users to set this "globally" for an enum
+	// this came up in https://github.com/bufbuild/buf/issues/161
+	extraIgnoreLocations := []protosource.Location{
+		enumValue.Enum().Location(),
+	}
+	if expectedName, ok := EnumValueNameWithPrefix(name, expectedPrefix); ok {
 		add(
 			enumValue,
 			enumValue.NameLocation(),
-			// also check the enum for this comment ignore
-			// this allows users to set this "globally" for an enum
-			// this came up in https://github.com/bufbuild/buf/issues/161
-			[]protosource.Location{
-				enumValue.Enum().Location(),
-			},
-			"Enum value name %q should be prefixed with %q.",
+			extraIgnoreLocations,
+			"Enum value name %q should be prefixed with %q, i.e. %q.",
 			name,
 			expectedPrefix,
+			expectedName,
 		)
+		return nil
 	}
+	add(
+		enumValue,
+		enumValue.NameLocation(),
+		extraIgnoreLocations,
+		"Enum value name %q should be prefixed with %q.",
+		name,
+		expectedPrefix,
+	)
 	return nil
 }
 
+// EnumValueNameWithPrefix returns the enum value name with the expected prefix.
+//
+// A prefix of the name that only differs from the expected prefix by case or
+// by underscores, such as "TESt_FOO" or "TEST_1_FOO" for "TEST1_", is replaced
+// by the expected prefix. Otherwise, the expected prefix is prepended.
+//
+// Returns false if the name is only such a prefix, as there is no name to prefix.
+func EnumValueNameWithPrefix(name string, expectedPrefix string) (string, bool) {
+	if strings.HasPrefix(name, expectedPrefix) {
+		return name, true
+	}
+	nameWithoutPrefix, ok := trimSimilarPrefix(name, expectedPrefix)
+	if !ok {
+		return expectedPrefix + name, true
+	}
+	if nameWithoutPrefix == "" {
+		return "", false
+	}
+	return expectedPrefix + nameWithoutPrefix, true
+}
+
+// trimSimilarPrefix trims a prefix of the name that is equal to the upper-case
+// prefix when case and underscores are ignored, along with the following underscores.
+//
+// Returns false if the name does not have such a prefix that ends at an underscore
+// or at the end of the name.
+func trimSimilarPrefix(name string, prefix string) (string, bool) {
+	i := 0
+	for _, c := range strings.ReplaceAll(prefix, "_", "") {
+		for i < len(name) && name[i] == '_' {
+			i++
+		}
+		if i == len(name) || unicode.ToUpper(rune(name[i])) != c {
+			return "", false
+		}
+		i++
+	}
+	if i < len(name) && name[i] != '_' {
+		return "", false
+	}
+	return strings.TrimLeft(name[i:], "_"), true
+}
+
 // CheckEnumValueUpperSnakeCase is a check function.
 var CheckEnumValueUpperSnakeCase = newEnumValueCheckFunc(checkEnumValueUpperSnakeCase)
 
@@ -269,6 +729,97 @@ func checkFieldNoDescriptor(add addFunc, field protosource.Field) error {
 	return nil
 }
 
+// CheckFieldNoGroup is a check function.
+var CheckFieldNoGroup = newFieldCheckFunc(checkFieldNoGroup)
+
+func checkFieldNoGroup(add addFunc, field protosource.Field) error {
+	if field.Type() != protosource.FieldDescriptorProtoTypeGroup {
+		return nil
+	}
+	// the compiler names the synthetic message of a group after the group, and the
+	// field after the lowercased message name, so the message name is what was declared
+	messageName := field.TypeName()
+	if index := strings.LastIndex(messageName, "."); index >= 0 {
+		messageName = messageName[index+1:]
+	}
+	add(
+		field,
+		field.TypeLocation(),
+		[]protosource.Location{
+			field.Message().Location(),
+		},
+		`Field %q is a group, declare a nested message %q and a field of that type instead.`,
+		field.Name(),
+		messageName,
+	)
+	return nil
+}
+
+const (
+	// FieldPresenceRequireOptional is the field presence mode that requires
+	// proto3 singular scalar fields to have the optional label.
+	FieldPresenceRequireOptional = "require-optional"
+	// FieldPresenceForbidOptional is the field presence mode that forbids
+	// proto3 singular scalar fields from having the optional label.
+	FieldPresenceForbidOptional = "forbid-optional"
+)
+
+// FieldPresences are all the field presence modes.
+var FieldPresences = []string{
+	FieldPresenceRequireOptional,
+	FieldPresenceForbidOptional,
+}
+
+// NewCheckFieldPresence returns a new check function for the given field presence mode.
+//
+// Only proto3 singular scalar fields are checked. Repeated, map, message, and enum
+// fields, as well as fields in a oneof, are skipped.
+func NewCheckFieldPresence(
+	fieldPresence string,
+) (func(string, internal.IgnoreFunc, []protosource.File) ([]bufanalysis.FileAnnotation, error), error) {
+	var requireOptional bool
+	switch fieldPresence {
+	case FieldPresenceRequireOptional:
+		requireOptional = true
+	case FieldPresenceForbidOptional:
+		requireOptional = false
+	default:
+		return nil, fmt.Errorf("field_presence %q is unknown, must be one of %s", fieldPresence, stringutil.SliceToString(FieldPresences))
+	}
+	return newFieldCheckFunc(
+		func(add addFunc, field protosource.Field) error {
+			return checkFieldPresence(add, field, requireOptional)
+		},
+	), nil
+}
+
+func checkFieldPresence(add addFunc, field protosource.Field, requireOptional bool) error {
+	if field.File().Syntax() != protosource.SyntaxProto3 {
+		return nil
+	}
+	// the key and value fields of map entries cannot have the optional label
+	if field.Label() == protosource.FieldDescriptorProtoLabelRepeated || field.Message().IsMapEntry() {
+		return nil
+	}
+	switch field.Type() {
+	case protosource.FieldDescriptorProtoTypeMessage,
+		protosource.FieldDescriptorProtoTypeGroup,
+		protosource.FieldDescriptorProtoTypeEnum:
+		return nil
+	}
+	// proto3 optional fields are in a synthetic oneof, which we do not skip
+	if field.Oneof() != nil && !field.Proto3Optional() {
+		return nil
+	}
+	if requireOptional && !field.Proto3Optional() {
+		add(field, field.Location(), nil, `Field %q on message %q should have explicit presence with the "optional" label.`, field.Name(), field.Message().Name())
+	}
+	if !requireOptional && field.Proto3Optional() {
+		add(field, field.Location(), nil, `Field %q on message %q should not have the "optional" label.`, field.Name(), field.Message().Name())
+	}
+	return nil
+}
+
 // CheckFileLowerSnakeCase is a check function.
 var CheckFileLowerSnakeCase = newFileCheckFunc(checkFileLowerSnakeCase)
 
@@ -362,15 +913,56 @@ func checkPackageDefined(add addFunc, file protosource.File) error {
 	return nil
 }
 
+// CheckSyntaxSpecified is a check function.
+var CheckSyntaxSpecified = newFileCheckFunc(checkSyntaxSpecified)
+
+func checkSyntaxSpecified(add addFunc, file protosource.File) error {
+	if file.IsSyntaxUnspecified() {
+		add(file, nil, nil, `Files must have a syntax explicitly specified. If no syntax is specified, the file defaults to "proto2".`)
+	}
+	return nil
+}
+
+// NewPackageDirectoryMatchRootPrefix validates and normalizes the root prefix
+// for CheckPackageDirectoryMatch.
+//
+// The root prefix is a relative directory that all files are expected to be
+// within, and that is stripped from the file path before it is compared to the
+// package. The returned root prefix is empty if no prefix is set.
+func NewPackageDirectoryMatchRootPrefix(rootPrefix string) (string, error) {
+	if rootPrefix == "" {
+		return "", nil
+	}
+	normalizedRootPrefix, err := normalpath.NormalizeAndValidate(rootPrefix)
+	if err != nil {
+		return "", fmt.Errorf("invalid package_directory_match_root_prefix %q: %w", rootPrefix, err)
+	}
+	if normalizedRootPrefix == "." {
+		return "", nil
+	}
+	return normalizedRootPrefix, nil
+}
+
 // CheckPackageDirectoryMatch is a check function.
-var CheckPackageDirectoryMatch = newFileCheckFunc(checkPackageDirectoryMatch)
+var CheckPackageDirectoryMatch = func(
+	id string,
+	ignoreFunc internal.IgnoreFunc,
+	files []protosource.File,
+	rootPrefix string,
+) ([]bufanalysis.FileAnnotation, error) {
+	return newFileCheckFunc(
+		func(add addFunc, file protosource.File) error {
+			return checkPackageDirectoryMatch(add, file, rootPrefix)
+		},
+	)(id, ignoreFunc, files)
+}
 
-func checkPackageDirectoryMatch(add addFunc, file protosource.File) error {
+func checkPackageDirectoryMatch(add addFunc, file protosource.File, rootPrefix string) error {
 	pkg := file.Package()
 	if pkg == "" {
 		return nil
 	}
-	expectedDirPath := strings.ReplaceAll(pkg, ".", "/")
+	expectedDirPath := normalpath.Join(rootPrefix, strings.ReplaceAll(pkg, ".", "/"))
 	dirPath := normalpath.Dir(file.Path())
 	// need to check case where in root relative directory and no package defined
 	// this should be valid although if SENSIBLE is turned on this will be invalid
@@ -497,6 +1089,85 @@ func checkPackageSameOptionValue(
 	return nil
 }
 
+// NewCheckPackageNoStutter returns a new check function that allows the given names.
+//
+// The names can either be the simple name of the type, such as "UserService",
+// or the fully-qualified name, such as "user.v1.UserService".
+func NewCheckPackageNoStutter(
+	allowNames []string,
+) func(string, internal.IgnoreFunc, []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+	allowNameMap := stringutil.SliceToMap(allowNames)
+	return newFileCheckFunc(
+		func(add addFunc, file protosource.File) error {
+			return checkPackageNoStutter(add, file, allowNameMap)
+		},
+	)
+}
+
+func checkPackageNoStutter(add addFunc, file protosource.File, allowNameMap map[string]struct{}) error {
+	packageComponent := getPackageNoStutterComponent(file.Package())
+	if packageComponent == "" {
+		return nil
+	}
+	check := func(descriptor protosource.NamedDescriptor, descriptorType string) {
+		if _, ok := allowNameMap[descriptor.Name()]; ok {
+			return
+		}
+		if _, ok := allowNameMap[descriptor.FullName()]; ok {
+			return
+		}
+		if suggestedName := getPackageNoStutterSuggestedName(descriptor.Name(), packageComponent); suggestedName != "" {
+			add(descriptor, descriptor.NameLocation(), nil, `%s name %q should not begin with the package name %q, such as %q.`, descriptorType, descriptor.Name(), file.Package(), suggestedName)
+		}
+	}
+	for _, message := range file.Messages() {
+		check(message, "Message")
+	}
+	for _, enum := range file.Enums() {
+		check(enum, "Enum")
+	}
+	for _, service := range file.Services() {
+		check(service, "Service")
+	}
+	return nil
+}
+
+// getPackageNoStutterComponent returns the last component of the package that
+// names should not begin with, without underscores.
+//
+// If the last component is a version, such as "v1", the component before it is used.
+func getPackageNoStutterComponent(pkg string) string {
+	if pkg == "" {
+		return ""
+	}
+	components := strings.Split(pkg, ".")
+	if _, ok := protoversion.NewPackageVersionForPackage(pkg); ok {
+		components = components[:len(components)-1]
+	}
+	if len(components) == 0 {
+		return ""
+	}
+	return strings.ReplaceAll(components[len(components)-1], "_", "")
+}
+
+// getPackageNoStutterSuggestedName returns the name without the package component
+// if the name begins with the package component as a whole word, otherwise "".
+//
+// The comparison is case-insensitive, so both the package "userprofile" and
+// "user_profile" match the name "UserProfileService".
+func getPackageNoStutterSuggestedName(name string, packageComponent string) string {
+	if len(name) <= len(packageComponent) || !strings.EqualFold(name[:len(packageComponent)], packageComponent) {
+		return ""
+	}
+	// the package component must be followed by a new word, so that
+	// for i.e. package "user", "Username" does not stutter but "UserName" does
+	suggestedName := name[len(packageComponent):]
+	if !unicode.IsUpper(rune(suggestedName[0])) {
+		return ""
+	}
+	return suggestedName
+}
+
 // CheckPackageVersionSuffix is a check function.
 var CheckPackageVersionSuffix = newFileCheckFunc(checkPackageVersionSuffix)
 
@@ -511,6 +1182,84 @@ func checkPackageVersionSuffix(add addFunc, file protosource.File) error {
 	return nil
 }
 
+// CheckReservedNotUsed is a check function.
+var CheckReservedNotUsed = newFileCheckFunc(checkReservedNotUsed)
+
+func checkReservedNotUsed(add addFunc, file protosource.File) error {
+	if err := protosource.ForEachMessage(
+		func(message protosource.Message) error {
+			reservedNames := message.ReservedNames()
+			reservedTagRanges := message.ReservedTagRanges()
+			for _, field := range message.Fields() {
+				if protosource.NameInReservedNames(field.Name(), reservedNames...) {
+					add(field, field.NameLocation(), []protosource.Location{message.Location()}, `Field %q on message %q uses a reserved name.`, field.Name(), message.Name())
+				}
+				if reservedTagRange := getReservedTagRangeForNumber(field.Number(), reservedTagRanges); reservedTagRange != nil {
+					add(field, field.NumberLocation(), []protosource.Location{message.Location()}, `Field %q on message %q uses number %d which is in reserved range %s.`, field.Name(), message.Name(), field.Number(), protosource.TagRangeString(reservedTagRange))
+				}
+			}
+			return nil
+		},
+		file,
+	); err != nil {
+		return err
+	}
+	return protosource.ForEachEnum(
+		func(enum protosource.Enum) error {
+			reservedNames := enum.ReservedNames()
+			reservedTagRanges := enum.ReservedTagRanges()
+			for _, enumValue := range enum.Values() {
+				if protosource.NameInReservedNames(enumValue.Name(), reservedNames...) {
+					add(enumValue, enumValue.NameLocation(), []protosource.Location{enum.Location()}, `Enum value %q on enum %q uses a reserved name.`, enumValue.Name(), enum.Name())
+				}
+				if reservedTagRange := getReservedTagRangeForNumber(enumValue.Number(), reservedTagRanges); reservedTagRange != nil {
+					add(enumValue, enumValue.NumberLocation(), []protosource.Location{enum.Location()}, `Enum value %q on enum %q uses number %d which is in reserved range %s.`, enumValue.Name(), enum.Name(), enumValue.Number(), protosource.TagRangeString(reservedTagRange))
+				}
+			}
+			return nil
+		},
+		file,
+	)
+}
+
+// googleAPIHTTPFieldNumber is the field number of the google.api.http
+// extension of google.protobuf.MethodOptions.
+const googleAPIHTTPFieldNumber int32 = 72295728
+
+// NewCheckRPCHTTPAnnotation returns a new check function that checks that RPCs
+// have the google.api.http option set.
+//
+// If allowStreaming is true, client and server streaming RPCs are not checked.
+func NewCheckRPCHTTPAnnotation(
+	allowStreaming bool,
+) func(string, internal.IgnoreFunc, []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+	return newMethodCheckFunc(
+		func(add addFunc, method protosource.Method) error {
+			return checkRPCHTTPAnnotation(add, method, allowStreaming)
+		},
+	)
+}
+
+func checkRPCHTTPAnnotation(add addFunc, method protosource.Method, allowStreaming bool) error {
+	if allowStreaming && (method.ClientStreaming() || method.ServerStreaming()) {
+		return nil
+	}
+	if !method.HasOptionExtension(googleAPIHTTPFieldNumber) {
+		add(
+			method,
+			method.Location(),
+			// also check the service for this comment ignore
+			// this allows users to set this "globally" for a service
+			[]protosource.Location{
+				method.Service().Location(),
+			},
+			"RPC %q does not have the google.api.http option set.",
+			method.Name(),
+		)
+	}
+	return nil
+}
+
 // CheckRPCNoClientStreaming is a check function.
 var CheckRPCNoClientStreaming = newMethodCheckFunc(checkRPCNoClientStreaming)
 
@@ -825,6 +1574,35 @@ func checkServicePascalCase(add addFunc, service protosource.Service) error {
 	return nil
 }
 
+// CheckRPCStreamingSuffix is a check function.
+var CheckRPCStreamingSuffix = func(
+	id string,
+	ignoreFunc internal.IgnoreFunc,
+	files []protosource.File,
+	suffix string,
+) ([]bufanalysis.FileAnnotation, error) {
+	return newMethodCheckFunc(
+		func(add addFunc, method protosource.Method) error {
+			return checkRPCStreamingSuffix(add, method, suffix)
+		},
+	)(id, ignoreFunc, files)
+}
+
+func checkRPCStreamingSuffix(add addFunc, method protosource.Method, suffix string) error {
+	name := method.Name()
+	hasSuffix := strings.HasSuffix(name, suffix)
+	if method.ClientStreaming() || method.ServerStreaming() {
+		if !hasSuffix {
+			add(method, method.NameLocation(), nil, "RPC %q is streaming and should be suffixed with %q.", name, suffix)
+		}
+		return nil
+	}
+	if hasSuffix {
+		add(method, method.NameLocation(), nil, "RPC %q is not streaming and should not be suffixed with %q.", name, suffix)
+	}
+	return nil
+}
+
 // CheckServiceSuffix is a check function.
 var CheckServiceSuffix = func(
 	id string,
@@ -15,12 +15,135 @@
 package buflintcheck
 
 import (
+	"strconv"
+
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/buf/bufcheck/internal"
 	"github.com/bufbuild/buf/internal/pkg/protosource"
 	"github.com/bufbuild/buf/internal/pkg/stringutil"
 )
 
+var (
+	// fieldOptionNameToIsSet is the map from field option name that can be used in
+	// custom require_field_options to a function that returns whether the
+	// option is explicitly set on the field.
+	//
+	// These use the source locations, as the values in the descriptor do not
+	// distinguish between unset and set to the default, and json_name is always
+	// populated by the compiler.
+	fieldOptionNameToIsSet = map[string]func(protosource.Field) bool{
+		"ctype": func(field protosource.Field) bool {
+			return field.CTypeLocation() != nil
+		},
+		"json_name": func(field protosource.Field) bool {
+			return field.JSONNameLocation() != nil
+		},
+		"jstype": func(field protosource.Field) bool {
+			return field.JSTypeLocation() != nil
+		},
+		"packed": func(field protosource.Field) bool {
+			return field.Packed() != nil
+		},
+	}
+	// fieldOptionNames are the sorted keys of fieldOptionNameToIsSet.
+	fieldOptionNames = []string{
+		"ctype",
+		"json_name",
+		"jstype",
+		"packed",
+	}
+
+	// fileOptionNameToFileOption is the map from file option name that can be used in
+	// custom require_file_options to the corresponding fileOption.
+	fileOptionNameToFileOption = map[string]*fileOption{
+		"cc_enable_arenas":       newBoolFileOption(protosource.File.CcEnableArenasLocation, protosource.File.CcEnableArenas),
+		"cc_generic_services":    newBoolFileOption(protosource.File.CcGenericServicesLocation, protosource.File.CcGenericServices),
+		"csharp_namespace":       newStringFileOption(protosource.File.CsharpNamespaceLocation, protosource.File.CsharpNamespace),
+		"go_package":             newStringFileOption(protosource.File.GoPackageLocation, protosource.File.GoPackage),
+		"java_generic_services":  newBoolFileOption(protosource.File.JavaGenericServicesLocation, protosource.File.JavaGenericServices),
+		"java_multiple_files":    newBoolFileOption(protosource.File.JavaMultipleFilesLocation, protosource.File.JavaMultipleFiles),
+		"java_outer_classname":   newStringFileOption(protosource.File.JavaOuterClassnameLocation, protosource.File.JavaOuterClassname),
+		"java_package":           newStringFileOption(protosource.File.JavaPackageLocation, protosource.File.JavaPackage),
+		"java_string_check_utf8": newBoolFileOption(protosource.File.JavaStringCheckUtf8Location, protosource.File.JavaStringCheckUtf8),
+		"objc_class_prefix":      newStringFileOption(protosource.File.ObjcClassPrefixLocation, protosource.File.ObjcClassPrefix),
+		"optimize_for": {
+			getLocation: protosource.File.OptimizeForLocation,
+			getValue: func(file protosource.File) string {
+				return file.OptimizeFor().String()
+			},
+			allowedValues: []string{
+				protosource.FileOptionsOptimizeModeSpeed.String(),
+				protosource.FileOptionsOptimizeModeCodeSize.String(),
+				protosource.FileOptionsOptimizeModeLiteRuntime.String(),
+			},
+		},
+		"php_class_prefix":       newStringFileOption(protosource.File.PhpClassPrefixLocation, protosource.File.PhpClassPrefix),
+		"php_generic_services":   newBoolFileOption(protosource.File.PhpGenericServicesLocation, protosource.File.PhpGenericServices),
+		"php_metadata_namespace": newStringFileOption(protosource.File.PhpMetadataNamespaceLocation, protosource.File.PhpMetadataNamespace),
+		"php_namespace":          newStringFileOption(protosource.File.PhpNamespaceLocation, protosource.File.PhpNamespace),
+		"py_generic_services":    newBoolFileOption(protosource.File.PyGenericServicesLocation, protosource.File.PyGenericServices),
+		"ruby_package":           newStringFileOption(protosource.File.RubyPackageLocation, protosource.File.RubyPackage),
+		"swift_prefix":           newStringFileOption(protosource.File.SwiftPrefixLocation, protosource.File.SwiftPrefix),
+	}
+	// fileOptionNames are the sorted keys of fileOptionNameToFileOption.
+	fileOptionNames = []string{
+		"cc_enable_arenas",
+		"cc_generic_services",
+		"csharp_namespace",
+		"go_package",
+		"java_generic_services",
+		"java_multiple_files",
+		"java_outer_classname",
+		"java_package",
+		"java_string_check_utf8",
+		"objc_class_prefix",
+		"optimize_for",
+		"php_class_prefix",
+		"php_generic_services",
+		"php_metadata_namespace",
+		"php_namespace",
+		"py_generic_services",
+		"ruby_package",
+		"swift_prefix",
+	}
+)
+
+// fileOption is a file option that can be used in custom require_file_options.
+type fileOption struct {
+	// getLocation returns the location of the option, or nil if the option
+	// is not explicitly set on the file.
+	getLocation func(protosource.File) protosource.Location
+	// getValue returns the value of the option as it would be written in
+	// require_file_options.
+	getValue func(protosource.File) string
+	// allowedValues are the values that can be required, or empty if any
+	// value can be required.
+	allowedValues []string
+}
+
+func newStringFileOption(
+	getLocation func(protosource.File) protosource.Location,
+	getValue func(protosource.File) string,
+) *fileOption {
+	return &fileOption{
+		getLocation: getLocation,
+		getValue:    getValue,
+	}
+}
+
+func newBoolFileOption(
+	getLocation func(protosource.File) protosource.Location,
+	getValue func(protosource.File) bool,
+) *fileOption {
+	return &fileOption{
+		getLocation: getLocation,
+		getValue: func(file protosource.File) string {
+			return strconv.FormatBool(getValue(file))
+		},
+		allowedValues: []string{"false", "true"},
+	}
+}
+
 // addFunc adds a FileAnnotation.
 //
 // Both the Descriptor and Locations can be nil.
@@ -40,6 +163,17 @@ func fieldToUpperSnakeCase(s string) string {
 	return stringutil.ToUpperSnakeCase(s)
 }
 
+// getReservedTagRangeForNumber returns the first TagRange that contains
+// the number, or nil if no TagRange contains the number.
+func getReservedTagRangeForNumber(number int, reservedTagRanges []protosource.TagRange) protosource.TagRange {
+	for _, reservedTagRange := range reservedTagRanges {
+		if protosource.NumberInReservedRanges(number, reservedTagRange) {
+			return reservedTagRange
+		}
+	}
+	return nil
+}
+
 func newFilesCheckFunc(
 	f func(addFunc, []protosource.File) error,
 ) func(string, internal.IgnoreFunc, []protosource.File) ([]bufanalysis.FileAnnotation, error) {
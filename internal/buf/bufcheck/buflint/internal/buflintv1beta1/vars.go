@@ -28,8 +28,11 @@ var (
 		buflintbuild.CommentMessageRuleBuilder,
 		buflintbuild.CommentOneofRuleBuilder,
 		buflintbuild.CommentRPCRuleBuilder,
+		buflintbuild.CommentRPCRequestResponseRuleBuilder,
 		buflintbuild.CommentServiceRuleBuilder,
+		buflintbuild.CustomRuleBuilder,
 		buflintbuild.DirectorySamePackageRuleBuilder,
+		buflintbuild.EnumAllowAliasConsistentRuleBuilder,
 		buflintbuild.EnumFirstValueZeroRuleBuilder,
 		buflintbuild.EnumNoAllowAliasRuleBuilder,
 		buflintbuild.EnumPascalCaseRuleBuilder,
@@ -38,6 +41,8 @@ var (
 		buflintbuild.EnumZeroValueSuffixRuleBuilder,
 		buflintbuild.FieldLowerSnakeCaseRuleBuilder,
 		buflintbuild.FieldNoDescriptorRuleBuilder,
+		buflintbuild.FieldNoGroupRuleBuilder,
+		buflintbuild.FieldPresenceRuleBuilder,
 		buflintbuild.FileLowerSnakeCaseRuleBuilder,
 		buflintbuild.ImportNoPublicRuleBuilder,
 		buflintbuild.ImportNoWeakRuleBuilder,
@@ -46,6 +51,7 @@ var (
 		buflintbuild.PackageDefinedRuleBuilder,
 		buflintbuild.PackageDirectoryMatchRuleBuilder,
 		buflintbuild.PackageLowerSnakeCaseRuleBuilder,
+		buflintbuild.PackageNoStutterRuleBuilder,
 		buflintbuild.PackageSameCsharpNamespaceRuleBuilder,
 		buflintbuild.PackageSameDirectoryRuleBuilder,
 		buflintbuild.PackageSameGoPackageRuleBuilder,
@@ -55,14 +61,18 @@ var (
 		buflintbuild.PackageSameRubyPackageRuleBuilder,
 		buflintbuild.PackageSameSwiftPrefixRuleBuilder,
 		buflintbuild.PackageVersionSuffixRuleBuilder,
+		buflintbuild.ReservedNotUsedRuleBuilder,
+		buflintbuild.RPCHTTPAnnotationRuleBuilder,
 		buflintbuild.RPCNoClientStreamingRuleBuilder,
 		buflintbuild.RPCNoServerStreamingRuleBuilder,
 		buflintbuild.RPCPascalCaseRuleBuilder,
 		buflintbuild.RPCRequestResponseUniqueRuleBuilder,
 		buflintbuild.RPCRequestStandardNameRuleBuilder,
 		buflintbuild.RPCResponseStandardNameRuleBuilder,
+		buflintbuild.RPCStreamingSuffixRuleBuilder,
 		buflintbuild.ServicePascalCaseRuleBuilder,
 		buflintbuild.ServiceSuffixRuleBuilder,
+		buflintbuild.SyntaxSpecifiedRuleBuilder,
 	}
 
 	// v1beta1DefaultCategories are the default categories.
@@ -103,15 +113,24 @@ var (
 		"COMMENT_RPC": {
 			"COMMENTS",
 		},
+		"COMMENT_RPC_REQUEST_RESPONSE": {
+			"OTHER",
+		},
 		"COMMENT_SERVICE": {
 			"COMMENTS",
 		},
+		"CUSTOM": {
+			"OTHER",
+		},
 		"DIRECTORY_SAME_PACKAGE": {
 			"MINIMAL",
 			"BASIC",
 			"DEFAULT",
 			"FILE_LAYOUT",
 		},
+		"ENUM_ALLOW_ALIAS_CONSISTENT": {
+			"OTHER",
+		},
 		"ENUM_FIRST_VALUE_ZERO": {
 			"OTHER",
 		},
@@ -153,6 +172,12 @@ var (
 			"DEFAULT",
 			"SENSIBLE",
 		},
+		"FIELD_NO_GROUP": {
+			"OTHER",
+		},
+		"FIELD_PRESENCE": {
+			"OTHER",
+		},
 		"FILE_LOWER_SNAKE_CASE": {
 			"DEFAULT",
 			"STYLE_DEFAULT",
@@ -199,6 +224,9 @@ var (
 			"STYLE_BASIC",
 			"STYLE_DEFAULT",
 		},
+		"PACKAGE_NO_STUTTER": {
+			"OTHER",
+		},
 		"PACKAGE_SAME_CSHARP_NAMESPACE": {
 			"MINIMAL",
 			"BASIC",
@@ -251,6 +279,12 @@ var (
 			"DEFAULT",
 			"STYLE_DEFAULT",
 		},
+		"RESERVED_NOT_USED": {
+			"OTHER",
+		},
+		"RPC_HTTP_ANNOTATION": {
+			"OTHER",
+		},
 		"RPC_NO_CLIENT_STREAMING": {
 			"UNARY_RPC",
 		},
@@ -275,6 +309,9 @@ var (
 			"DEFAULT",
 			"STYLE_DEFAULT",
 		},
+		"RPC_STREAMING_SUFFIX": {
+			"OTHER",
+		},
 		"SERVICE_PASCAL_CASE": {
 			"BASIC",
 			"DEFAULT",
@@ -285,5 +322,22 @@ var (
 			"DEFAULT",
 			"STYLE_DEFAULT",
 		},
+		"SYNTAX_SPECIFIED": {
+			"OTHER",
+		},
+	}
+	// v1beta1IDToIntroducedVersion are the ID to the buf version that introduced
+	// the rule, for rules added after the initial release of v1beta1.
+	v1beta1IDToIntroducedVersion = map[string]string{
+		"COMMENT_RPC_REQUEST_RESPONSE": "v0.40.0",
+		"CUSTOM":                       "v0.40.0",
+		"ENUM_ALLOW_ALIAS_CONSISTENT":  "v0.40.0",
+		"FIELD_NO_GROUP":               "v0.40.0",
+		"FIELD_PRESENCE":               "v0.40.0",
+		"PACKAGE_NO_STUTTER":           "v0.40.0",
+		"RESERVED_NOT_USED":            "v0.40.0",
+		"RPC_HTTP_ANNOTATION":          "v0.40.0",
+		"RPC_STREAMING_SUFFIX":         "v0.40.0",
+		"SYNTAX_SPECIFIED":             "v0.40.0",
 	}
 )
@@ -26,6 +26,8 @@ import (
 const (
 	defaultEnumZeroValueSuffix = "_UNSPECIFIED"
 	defaultServiceSuffix       = "Service"
+	defaultRPCStreamingSuffix  = "Stream"
+	defaultFieldPresence       = "require-optional"
 )
 
 // Config is the check config.
@@ -41,6 +43,12 @@ type Config struct {
 
 	AllowCommentIgnores    bool
 	IgnoreUnstablePackages bool
+
+	// EnumZeroValueSuffix is the resolved enum zero value suffix.
+	//
+	// This is not used for checks, as the suffix is bound to the rules,
+	// but is needed for fixes.
+	EnumZeroValueSuffix string
 }
 
 // ConfigBuilder is a config builder.
@@ -58,7 +66,19 @@ type ConfigBuilder struct {
 	RPCAllowSameRequestResponse          bool
 	RPCAllowGoogleProtobufEmptyRequests  bool
 	RPCAllowGoogleProtobufEmptyResponses bool
+	RPCHTTPAnnotationAllowStreaming      bool
+	RPCStreamingSuffix                   string
 	ServiceSuffix                        string
+	FieldPresence                        string
+	PackageNoStutterAllow                []string
+	PackageDirectoryMatchRootPrefix      string
+
+	CustomForbidFieldTypes        []string
+	CustomRequireFieldOptions     []string
+	CustomRequireFileOptions      []string
+	CustomForbidMessageNameRegex  string
+	CustomAllowFieldNumberRanges  []string
+	CustomForbidFieldNumberRanges []string
 }
 
 // NewConfig returns a new Config.
@@ -79,6 +99,12 @@ func newConfig(configBuilder ConfigBuilder, versionSpec *VersionSpec) (*Config,
 	if configBuilder.ServiceSuffix == "" {
 		configBuilder.ServiceSuffix = defaultServiceSuffix
 	}
+	if configBuilder.RPCStreamingSuffix == "" {
+		configBuilder.RPCStreamingSuffix = defaultRPCStreamingSuffix
+	}
+	if configBuilder.FieldPresence == "" {
+		configBuilder.FieldPresence = defaultFieldPresence
+	}
 	return newConfigForRuleBuilders(
 		configBuilder,
 		versionSpec.RuleBuilders,
@@ -190,9 +216,64 @@ func newConfigForRuleBuilders(
 		IgnoreRootPaths:        ignoreRootPaths,
 		AllowCommentIgnores:    configBuilder.AllowCommentIgnores,
 		IgnoreUnstablePackages: configBuilder.IgnoreUnstablePackages,
+		EnumZeroValueSuffix:    configBuilder.EnumZeroValueSuffix,
 	}, nil
 }
 
+// NewConfigForRuleIDs returns a copy of the Config that only contains the rules
+// with the given onlyIDs, if any are given, and none of the rules with the given ignoreIDs.
+//
+// All IDs must be rule IDs of the VersionSpec, and all onlyIDs must be rules of the Config.
+// Categories are not allowed. The ignore paths of the Config are left as is.
+func NewConfigForRuleIDs(
+	config *Config,
+	versionSpec *VersionSpec,
+	onlyIDs []string,
+	ignoreIDs []string,
+) (*Config, error) {
+	onlyIDMap, err := getRuleIDMap(onlyIDs, versionSpec.IDToCategories)
+	if err != nil {
+		return nil, err
+	}
+	ignoreIDMap, err := getRuleIDMap(ignoreIDs, versionSpec.IDToCategories)
+	if err != nil {
+		return nil, err
+	}
+	configIDMap := make(map[string]struct{}, len(config.Rules))
+	for _, rule := range config.Rules {
+		configIDMap[rule.ID()] = struct{}{}
+	}
+	for _, id := range stringutil.MapToSortedSlice(onlyIDMap) {
+		if _, ok := configIDMap[id]; !ok {
+			return nil, fmt.Errorf("%q is not enabled by the configuration", id)
+		}
+	}
+	rules := make([]*Rule, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		if _, ok := onlyIDMap[rule.ID()]; len(onlyIDMap) > 0 && !ok {
+			continue
+		}
+		if _, ok := ignoreIDMap[rule.ID()]; ok {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	newConfig := *config
+	newConfig.Rules = rules
+	return &newConfig, nil
+}
+
+func getRuleIDMap(ids []string, idToCategories map[string][]string) (map[string]struct{}, error) {
+	idMap := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := idToCategories[id]; !ok {
+			return nil, fmt.Errorf("%q is not a known rule id", id)
+		}
+		idMap[id] = struct{}{}
+	}
+	return idMap, nil
+}
+
 func transformToIDMap(idsOrCategories []string, idToCategories map[string][]string, categoryToIDs map[string][]string) (map[string]struct{}, error) {
 	if len(idsOrCategories) == 0 {
 		return nil, nil
@@ -58,4 +58,10 @@ func runTestRuleBuilders(t *testing.T, versionSpec *internal.VersionSpec) {
 		_, ok := idsMap[id]
 		assert.True(t, ok, "id %q configured in categories is not added to ruleBuilders", id)
 	}
+	for id := range versionSpec.IDToIntroducedVersion {
+		_, ok := idsMap[id]
+		assert.True(t, ok, "id %q configured in introduced versions is not added to ruleBuilders", id)
+	}
+	_, err := internal.GetIDsIntroducedSince(versionSpec, "v0.0.0")
+	assert.NoError(t, err)
 }
@@ -16,7 +16,9 @@ package internal
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/pkg/normalpath"
@@ -30,8 +32,9 @@ import (
 
 // Runner is a runner.
 type Runner struct {
-	logger       *zap.Logger
-	ignorePrefix string
+	logger            *zap.Logger
+	ignorePrefix      string
+	warnUnusedIgnores bool
 }
 
 // NewRunner returns a new Runner.
@@ -60,6 +63,17 @@ func RunnerWithIgnorePrefix(ignorePrefix string) RunnerOption {
 	}
 }
 
+// RunnerWithWarnUnusedIgnores returns a new RunnerOption that results in a
+// FileAnnotation of SeverityWarning being returned for every comment ignore in
+// the checked files that did not ignore any failure.
+//
+// This has no effect if the ignore prefix is not set.
+func RunnerWithWarnUnusedIgnores() RunnerOption {
+	return func(runner *Runner) {
+		runner.warnUnusedIgnores = true
+	}
+}
+
 // Check runs the Rules.
 func (r *Runner) Check(ctx context.Context, config *Config, previousFiles []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
 	rules := config.Rules
@@ -73,7 +87,8 @@ func (r *Runner) Check(ctx context.Context, config *Config, previousFiles []prot
 	)
 	defer span.End()
 
-	ignoreFunc := r.newIgnoreFunc(config)
+	usedIgnores := newUsedIgnores()
+	ignoreFunc := r.newIgnoreFunc(config, usedIgnores)
 	var fileAnnotations []bufanalysis.FileAnnotation
 	resultC := make(chan *result, len(rules))
 	for _, rule := range rules {
@@ -96,20 +111,29 @@ func (r *Runner) Check(ctx context.Context, config *Config, previousFiles []prot
 	if err != nil {
 		return nil, err
 	}
+	if r.warnUnusedIgnores && r.ignorePrefix != "" && config.AllowCommentIgnores {
+		fileAnnotations = append(
+			fileAnnotations,
+			r.getUnusedIgnoreFileAnnotations(config, files, usedIgnores)...,
+		)
+	}
 	bufanalysis.SortFileAnnotations(fileAnnotations)
 	return fileAnnotations, nil
 }
 
-func (r *Runner) newIgnoreFunc(config *Config) IgnoreFunc {
+func (r *Runner) newIgnoreFunc(config *Config, usedIgnores *usedIgnores) IgnoreFunc {
 	return func(id string, descriptor protosource.Descriptor, locations []protosource.Location) bool {
 		if idIsIgnored(id, descriptor, config) {
 			return true
 		}
 		// if ignorePrefix is empty, comment ignores are not enabled for the runner
-		// this is the case with breaking changes
-		if r.ignorePrefix != "" && config.AllowCommentIgnores &&
-			locationsAreIgnored(id, r.ignorePrefix, locations, config) {
-			return true
+		if r.ignorePrefix != "" && config.AllowCommentIgnores {
+			if location := getIgnoringLocation(id, r.ignorePrefix, locations); location != nil {
+				if descriptor != nil {
+					usedIgnores.add(newIgnoreKey(id, descriptor.File().Path(), location))
+				}
+				return true
+			}
 		}
 		if config.IgnoreUnstablePackages {
 			if descriptor == nil {
@@ -143,10 +167,12 @@ func idIsIgnored(id string, descriptor protosource.Descriptor, config *Config) b
 	return normalpath.MapHasEqualOrContainingPath(ignoreRootPaths, path, normalpath.Relative)
 }
 
-func locationsAreIgnored(id string, ignorePrefix string, locations []protosource.Location, config *Config) bool {
+// getIgnoringLocation returns the first location that has a leading comment
+// ignoring the id, or nil if there is no such location.
+func getIgnoringLocation(id string, ignorePrefix string, locations []protosource.Location) protosource.Location {
 	// we already check that ignorePrefix is non-empty, but just doing here for safety
 	if id == "" || ignorePrefix == "" {
-		return false
+		return nil
 	}
 	fullIgnorePrefix := ignorePrefix + " " + id
 	for _, location := range locations {
@@ -154,13 +180,150 @@ func locationsAreIgnored(id string, ignorePrefix string, locations []protosource
 			if leadingComments := location.LeadingComments(); leadingComments != "" {
 				for _, line := range stringutil.SplitTrimLinesNoEmpty(leadingComments) {
 					if strings.HasPrefix(line, fullIgnorePrefix) {
-						return true
+						return location
 					}
 				}
 			}
 		}
 	}
-	return false
+	return nil
+}
+
+// getUnusedIgnoreFileAnnotations returns a FileAnnotation of SeverityWarning
+// for every comment ignore on a declaration in files that did not ignore any
+// failure.
+//
+// Only ids that correspond to a rule in the config are checked, as comment
+// ignores for other ids could not have been used regardless.
+func (r *Runner) getUnusedIgnoreFileAnnotations(
+	config *Config,
+	files []protosource.File,
+	usedIgnores *usedIgnores,
+) []bufanalysis.FileAnnotation {
+	ids := make(map[string]struct{}, len(config.Rules))
+	for _, rule := range config.Rules {
+		ids[rule.ID()] = struct{}{}
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, file := range files {
+		for _, namedDescriptor := range getNamedDescriptors(file) {
+			location := namedDescriptor.Location()
+			if location == nil {
+				continue
+			}
+			for _, id := range getIgnoredIDs(r.ignorePrefix, location) {
+				if _, ok := ids[id]; !ok {
+					continue
+				}
+				if usedIgnores.has(newIgnoreKey(id, file.Path(), location)) {
+					continue
+				}
+				fileAnnotations = append(
+					fileAnnotations,
+					bufanalysis.NewFileAnnotationWithSeverity(
+						file,
+						location.StartLine(),
+						location.StartColumn(),
+						location.EndLine(),
+						location.EndColumn(),
+						id,
+						fmt.Sprintf("Unused comment ignore %q on %q.", r.ignorePrefix+" "+id, namedDescriptor.FullName()),
+						bufanalysis.SeverityWarning,
+					),
+				)
+			}
+		}
+	}
+	return fileAnnotations
+}
+
+// getIgnoredIDs returns the ids ignored by the leading comments of the location.
+func getIgnoredIDs(ignorePrefix string, location protosource.Location) []string {
+	leadingComments := location.LeadingComments()
+	if leadingComments == "" {
+		return nil
+	}
+	var ids []string
+	for _, line := range stringutil.SplitTrimLinesNoEmpty(leadingComments) {
+		if !strings.HasPrefix(line, ignorePrefix+" ") {
+			continue
+		}
+		if fields := strings.Fields(strings.TrimPrefix(line, ignorePrefix+" ")); len(fields) > 0 {
+			ids = append(ids, fields[0])
+		}
+	}
+	return ids
+}
+
+// getNamedDescriptors returns all the declarations within the file.
+func getNamedDescriptors(file protosource.File) []protosource.NamedDescriptor {
+	var namedDescriptors []protosource.NamedDescriptor
+	_ = protosource.ForEachMessage(
+		func(message protosource.Message) error {
+			namedDescriptors = append(namedDescriptors, message)
+			for _, field := range message.Fields() {
+				namedDescriptors = append(namedDescriptors, field)
+			}
+			for _, extension := range message.Extensions() {
+				namedDescriptors = append(namedDescriptors, extension)
+			}
+			for _, oneof := range message.Oneofs() {
+				namedDescriptors = append(namedDescriptors, oneof)
+			}
+			return nil
+		},
+		file,
+	)
+	_ = protosource.ForEachEnum(
+		func(enum protosource.Enum) error {
+			namedDescriptors = append(namedDescriptors, enum)
+			for _, enumValue := range enum.Values() {
+				namedDescriptors = append(namedDescriptors, enumValue)
+			}
+			return nil
+		},
+		file,
+	)
+	for _, service := range file.Services() {
+		namedDescriptors = append(namedDescriptors, service)
+		for _, method := range service.Methods() {
+			namedDescriptors = append(namedDescriptors, method)
+		}
+	}
+	return namedDescriptors
+}
+
+type ignoreKey string
+
+func newIgnoreKey(id string, filePath string, location protosource.Location) ignoreKey {
+	return ignoreKey(fmt.Sprintf("%s:%s:%d:%d", id, filePath, location.StartLine(), location.StartColumn()))
+}
+
+// usedIgnores records the comment ignores that ignored a failure.
+//
+// Rules are run concurrently, so this is safe for concurrent use.
+type usedIgnores struct {
+	keys map[ignoreKey]struct{}
+	lock sync.Mutex
+}
+
+func newUsedIgnores() *usedIgnores {
+	return &usedIgnores{
+		keys: make(map[ignoreKey]struct{}),
+	}
+}
+
+func (u *usedIgnores) add(key ignoreKey) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.keys[key] = struct{}{}
+}
+
+func (u *usedIgnores) has(key ignoreKey) bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	_, ok := u.keys[key]
+	return ok
 }
 
 type result struct {
@@ -14,10 +14,78 @@
 
 package internal
 
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
 // VersionSpec specifies the rules, ids, and categories for a given version.
 type VersionSpec struct {
 	RuleBuilders      []*RuleBuilder
 	DefaultCategories []string
 	AllCategories     []string
 	IDToCategories    map[string][]string
+	// IDToIntroducedVersion is the buf version that introduced each rule
+	// that was added after the initial release of this version.
+	//
+	// Rules that are not in the map were part of the initial release.
+	IDToIntroducedVersion map[string]string
+}
+
+// GetIDsIntroducedSince gets the sorted IDs of the rules that were introduced
+// in a buf version later than the given buf version.
+func GetIDsIntroducedSince(versionSpec *VersionSpec, bufVersion string) ([]string, error) {
+	since, err := parseBufVersion(bufVersion)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for id, introducedVersion := range versionSpec.IDToIntroducedVersion {
+		introduced, err := parseBufVersion(introducedVersion)
+		if err != nil {
+			return nil, err
+		}
+		if compareBufVersions(introduced, since) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// parseBufVersion parses a buf version of the form [v]MAJOR.MINOR.PATCH.
+//
+// Any pre-release suffix such as -dev is ignored.
+func parseBufVersion(bufVersion string) ([3]int, error) {
+	var parsed [3]int
+	trimmed := strings.TrimPrefix(bufVersion, "v")
+	if index := strings.IndexByte(trimmed, '-'); index >= 0 {
+		trimmed = trimmed[:index]
+	}
+	split := strings.Split(trimmed, ".")
+	if len(split) != 3 {
+		return parsed, fmt.Errorf("invalid buf version %q, must be of the form v1.2.3", bufVersion)
+	}
+	for i, element := range split {
+		value, err := strconv.Atoi(element)
+		if err != nil || value < 0 {
+			return parsed, fmt.Errorf("invalid buf version %q, must be of the form v1.2.3", bufVersion)
+		}
+		parsed[i] = value
+	}
+	return parsed, nil
+}
+
+func compareBufVersions(one [3]int, two [3]int) int {
+	for i := range one {
+		if one[i] < two[i] {
+			return -1
+		}
+		if one[i] > two[i] {
+			return 1
+		}
+	}
+	return 0
 }
@@ -16,12 +16,15 @@ package bufcli
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/bufbuild/buf/internal/buf/bufapiclient"
 	"github.com/bufbuild/buf/internal/buf/bufapp"
@@ -29,6 +32,7 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagebuild"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulecache"
 	"github.com/bufbuild/buf/internal/buf/buffetch"
 	"github.com/bufbuild/buf/internal/buf/bufprint"
 	"github.com/bufbuild/buf/internal/buf/buftransport"
@@ -43,11 +47,14 @@ import (
 	"github.com/bufbuild/buf/internal/pkg/httpauth"
 	"github.com/bufbuild/buf/internal/pkg/netconfig"
 	"github.com/bufbuild/buf/internal/pkg/netrc"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
 	"github.com/bufbuild/buf/internal/pkg/rpc"
 	"github.com/bufbuild/buf/internal/pkg/rpc/rpcauth"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
 	"github.com/spf13/pflag"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
@@ -64,13 +71,31 @@ See https://docs.buf.build/faq for more details.`
 	inputSSHKeyFileEnvKey         = "BUF_INPUT_SSH_KEY_FILE"
 	inputSSHKnownHostsFilesEnvKey = "BUF_INPUT_SSH_KNOWN_HOSTS_FILES"
 
+	defaultRemoteEnvKey = "BUF_REMOTE"
+	timeoutEnvKey       = "BUF_TIMEOUT"
+
 	inputHashtagFlagName      = "__hashtag__"
 	inputHashtagFlagShortName = "#"
 
 	userPromptAttempts = 3
+
+	// DefaultViolationsExitCode is the default exit code when lint or breaking
+	// change detection finds violations, as opposed to failing to run.
+	DefaultViolationsExitCode = 100
+
+	// PublicVisibility is the string representation of registryv1alpha1.Visibility_VISIBILITY_PUBLIC.
+	PublicVisibility = "public"
+	// PrivateVisibility is the string representation of registryv1alpha1.Visibility_VISIBILITY_PRIVATE.
+	PrivateVisibility = "private"
 )
 
 var (
+	// AllVisibilityStrings are all visibility strings.
+	AllVisibilityStrings = []string{
+		PublicVisibility,
+		PrivateVisibility,
+	}
+
 	// defaultHTTPClient is the client we use for HTTP requests.
 	// Timeout should be set through context for calls to ImageConfigReader, not through http.Client
 	defaultHTTPClient = &http.Client{}
@@ -125,6 +150,112 @@ func BindExcludeSourceInfo(flagSet *pflag.FlagSet, addr *bool, flagName string)
 	)
 }
 
+// BindConfigOverrideFile binds the config-override-file flag.
+func BindConfigOverrideFile(flagSet *pflag.FlagSet, addr *string, flagName string, configFlagName string) {
+	flagSet.StringVar(
+		addr,
+		flagName,
+		"",
+		fmt.Sprintf(
+			`A JSON or YAML file whose lint and breaking sections are merged over the configuration
+read from --%s or the input. Lists in use replace the base values, while except, ignore, and
+ignore_only are appended to the base values. Other values replace the base values if set.`,
+			configFlagName,
+		),
+	)
+}
+
+// BindViolationsExitCode binds the exit-code flag.
+func BindViolationsExitCode(flagSet *pflag.FlagSet, addr *int, flagName string) {
+	flagSet.IntVar(
+		addr,
+		flagName,
+		DefaultViolationsExitCode,
+		`The exit code to use when violations are found. Must be between 2 and 255.
+All other failures, such as invalid configuration or build errors, exit with code 1.`,
+	)
+}
+
+// BindMaxErrors binds the max-errors flag.
+func BindMaxErrors(flagSet *pflag.FlagSet, addr *int, flagName string) {
+	flagSet.IntVar(
+		addr,
+		flagName,
+		0,
+		`The maximum number of build errors, warnings, or violations to print, after which the number of omitted ones is printed.
+This does not change the exit code. Nothing is omitted if set to 0, which is the default.
+For the json error format, the number of omitted ones is not printed.`,
+	)
+}
+
+// CheckMaxErrors checks the value of the max-errors flag.
+func CheckMaxErrors(maxErrors int, flagName string) error {
+	if maxErrors < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must not be negative but was %d", flagName, maxErrors)
+	}
+	return nil
+}
+
+// BindExperimentalEditions binds the experimental-editions flag.
+func BindExperimentalEditions(flagSet *pflag.FlagSet, addr *bool, flagName string) {
+	flagSet.BoolVar(
+		addr,
+		flagName,
+		false,
+		`Enable experimental support for files that declare edition 2023.
+Constructs that have no equivalent in proto3, such as default values, are not supported yet.`,
+	)
+}
+
+// BindOnlyAndIgnoreRuleIDs binds the only and ignore flags.
+func BindOnlyAndIgnoreRuleIDs(
+	flagSet *pflag.FlagSet,
+	onlyAddr *[]string,
+	onlyFlagName string,
+	ignoreAddr *[]string,
+	ignoreFlagName string,
+) {
+	flagSet.StringSliceVar(
+		onlyAddr,
+		onlyFlagName,
+		nil,
+		fmt.Sprintf(
+			`Only run the rules with these IDs. The rules must be enabled by the configuration.
+May be provided multiple times. Rules in --%s are not run, even if they are in --%s.`,
+			ignoreFlagName,
+			onlyFlagName,
+		),
+	)
+	flagSet.StringSliceVar(
+		ignoreAddr,
+		ignoreFlagName,
+		nil,
+		`Do not run the rules with these IDs. May be provided multiple times.
+This is applied on top of the configuration, whose "ignore" and "ignore_only" paths still apply.`,
+	)
+}
+
+// CheckViolationsExitCode checks that the value of the exit-code flag is valid.
+func CheckViolationsExitCode(exitCode int, flagName string) error {
+	if exitCode < 2 || exitCode > 255 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be between 2 and 255 but was %d", flagName, exitCode)
+	}
+	return nil
+}
+
+// BindDescriptorSetIn binds the descriptor-set-in flag.
+func BindDescriptorSetIn(flagSet *pflag.FlagSet, addr *[]string, flagName string) {
+	flagSet.StringSliceVar(
+		addr,
+		flagName,
+		nil,
+		`Paths to FileDescriptorSets or images whose files can be imported without source.
+Files on disk take precedence over files with the same path in a descriptor set.
+If a path is in multiple descriptor sets, the files must be equal.
+May be specified multiple times.`,
+	)
+}
+
 // BindPaths binds the paths flag.
 func BindPaths(
 	flagSet *pflag.FlagSet,
@@ -163,6 +294,27 @@ If specified multiple times, the union will be taken.`,
 	)
 }
 
+// BindPathsFrom binds the paths-from flag.
+func BindPathsFrom(
+	flagSet *pflag.FlagSet,
+	pathsFromAddr *string,
+	pathsFromFlagName string,
+	pathsFlagName string,
+) {
+	flagSet.StringVar(
+		pathsFromAddr,
+		pathsFromFlagName,
+		"",
+		fmt.Sprintf(
+			`A file containing a newline-separated list of paths to limit to, or "-" to read the list from stdin.
+The paths are treated the same as --%s, and the union with --%s will be taken.
+If the list is empty, no files are processed.`,
+			pathsFlagName,
+			pathsFlagName,
+		),
+	)
+}
+
 // BindInputHashtag binds the input hashtag flag.
 //
 // This needs to be added to any command that has the input as the first argument.
@@ -274,6 +426,34 @@ func GetStringSliceFlagOrDeprecatedFlag(
 	return deprecatedFlag, nil
 }
 
+// ReadPathsFrom reads the newline-separated paths for the paths-from flag.
+//
+// If pathsFrom is "-", the paths are read from stdin.
+// Blank lines and surrounding whitespace are ignored.
+func ReadPathsFrom(container app.StdinContainer, pathsFrom string) ([]string, error) {
+	var reader io.Reader
+	if pathsFrom == "-" || app.IsDevStdin(pathsFrom) {
+		reader = container.Stdin()
+	} else {
+		data, err := ioutil.ReadFile(pathsFrom)
+		if err != nil {
+			return nil, fmt.Errorf("could not read file: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	var paths []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		if path := strings.TrimSpace(scanner.Text()); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
 // NewFetchReader creates a new buffetch.Reader with the default HTTP client
 // and git cloner.
 func NewFetchReader(
@@ -330,6 +510,7 @@ func NewWireImageConfigReader(
 	configProvider bufconfig.Provider,
 	moduleResolver bufmodule.ModuleResolver,
 	moduleReader bufmodule.ModuleReader,
+	options ...bufwire.ImageConfigReaderOption,
 ) bufwire.ImageConfigReader {
 	return bufwire.NewImageConfigReader(
 		logger,
@@ -339,6 +520,7 @@ func NewWireImageConfigReader(
 		bufmodulebuild.NewModuleBucketBuilder(logger),
 		bufmodulebuild.NewModuleFileSetBuilder(logger, moduleReader),
 		bufimagebuild.NewBuilder(logger),
+		options...,
 	)
 }
 
@@ -399,6 +581,31 @@ func NewWireImageWriter(
 	)
 }
 
+// VisibilityFlagToVisibility parses the given string as a registryv1alpha1.Visibility.
+func VisibilityFlagToVisibility(visibility string) (registryv1alpha1.Visibility, error) {
+	switch visibility {
+	case PublicVisibility:
+		return registryv1alpha1.Visibility_VISIBILITY_PUBLIC, nil
+	case PrivateVisibility:
+		return registryv1alpha1.Visibility_VISIBILITY_PRIVATE, nil
+	default:
+		return 0, fmt.Errorf("invalid visibility: %s, expected one of %s", visibility, stringutil.SliceToString(AllVisibilityStrings))
+	}
+}
+
+// VisibilityToVisibilityFlag converts the visibility to its string representation,
+// as accepted by VisibilityFlagToVisibility.
+func VisibilityToVisibilityFlag(visibility registryv1alpha1.Visibility) (string, error) {
+	switch visibility {
+	case registryv1alpha1.Visibility_VISIBILITY_PUBLIC:
+		return PublicVisibility, nil
+	case registryv1alpha1.Visibility_VISIBILITY_PRIVATE:
+		return PrivateVisibility, nil
+	default:
+		return "", fmt.Errorf("unknown visibility: %v", visibility)
+	}
+}
+
 // NewConfig creates a new Config.
 func NewConfig(container appflag.Container) (*bufapp.Config, error) {
 	externalConfig := bufapp.ExternalConfig{}
@@ -408,6 +615,85 @@ func NewConfig(container appflag.Container) (*bufapp.Config, error) {
 	return bufapp.NewConfig(container, externalConfig)
 }
 
+// GetTimeout returns the default timeout for commands.
+//
+// The timeout is read from the BUF_TIMEOUT environment variable, falling back
+// to the timeout in the user configuration. Returns 0 if neither is set, in which
+// case the built-in default is used. The timeout flag takes precedence over both.
+//
+// If the user configuration is invalid, a warning is logged and 0 is returned.
+func GetTimeout(container appflag.Container) (time.Duration, error) {
+	if envTimeout := strings.TrimSpace(container.Env(timeoutEnvKey)); envTimeout != "" {
+		timeout, err := time.ParseDuration(envTimeout)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse %s: %v", timeoutEnvKey, err)
+		}
+		if timeout <= 0 {
+			return 0, fmt.Errorf("%s must be positive", timeoutEnvKey)
+		}
+		return timeout, nil
+	}
+	// we only read the external configuration here so that commands that do
+	// not use the registry do not fail on other invalid fields, such as missing
+	// root certificates
+	//
+	// every command is given the timeout, so an invalid user configuration only
+	// results in a warning here, registry commands fail when they read it
+	externalConfig := bufapp.ExternalConfig{}
+	if err := appname.ReadConfig(container, &externalConfig); err != nil {
+		container.Logger().Sugar().Warnf("using the default timeout, failed to read the user configuration: %v", err)
+		return 0, nil
+	}
+	timeout, err := bufapp.GetTimeout(container, externalConfig)
+	if err != nil {
+		container.Logger().Sugar().Warnf("using the default timeout: %v", err)
+		return 0, nil
+	}
+	return timeout, nil
+}
+
+// GetDefaultRemote returns the remote to use for commands when no remote is given.
+//
+// The remote is read from the BUF_REMOTE environment variable, falling back to
+// default_remote in the user configuration. Returns empty if neither is set.
+func GetDefaultRemote(container appflag.Container) (string, error) {
+	if remote := strings.TrimSpace(container.Env(defaultRemoteEnvKey)); remote != "" {
+		return remote, nil
+	}
+	externalConfig := bufapp.ExternalConfig{}
+	if err := appname.ReadConfig(container, &externalConfig); err != nil {
+		return "", err
+	}
+	return externalConfig.DefaultRemote, nil
+}
+
+// GetRemoteAndArgs returns the remote and the remaining arguments for commands
+// that take an optional remote followed by numArgs arguments.
+//
+// If numArgs+1 arguments were given, the first argument is the remote. Otherwise,
+// the remote returned by GetDefaultRemote is used.
+func GetRemoteAndArgs(container appflag.Container, numArgs int) (string, []string, error) {
+	args := app.Args(container)
+	if len(args) > numArgs {
+		if args[0] == "" {
+			return "", nil, appcmd.NewInvalidArgumentError("a remote must be specified")
+		}
+		return args[0], args[1:], nil
+	}
+	remote, err := GetDefaultRemote(container)
+	if err != nil {
+		return "", nil, err
+	}
+	if remote == "" {
+		return "", nil, appcmd.NewInvalidArgumentErrorf(
+			"a remote must be specified as an argument, with %s, or as default_remote in the configuration in %q",
+			defaultRemoteEnvKey,
+			container.ConfigDirPath(),
+		)
+	}
+	return remote, args, nil
+}
+
 // UpdateRemote writes the user credentials to the user configuration.
 func UpdateRemote(container appflag.Container, address string, token string) error {
 	_, err := modifyRemotes(
@@ -437,6 +723,67 @@ func NewRegistryProvider(ctx context.Context, container appflag.Container) (regi
 	if err != nil {
 		return nil, err
 	}
+	options, err := getRegistryProviderOptions(container, config)
+	if err != nil {
+		return nil, err
+	}
+	return bufapiclient.NewRegistryProvider(
+		ctx,
+		container.Logger(),
+		config.TLS,
+		options...,
+	)
+}
+
+// NewRegistryInvoker creates a new bufapiclient.RegistryInvoker, configured the
+// same way as the registry provider returned by NewRegistryProvider.
+func NewRegistryInvoker(ctx context.Context, container appflag.Container) (bufapiclient.RegistryInvoker, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
+	options, err := getRegistryProviderOptions(container, config)
+	if err != nil {
+		return nil, err
+	}
+	return bufapiclient.NewRegistryInvoker(
+		ctx,
+		container.Logger(),
+		config.TLS,
+		options...,
+	)
+}
+
+// NewRegistryProviderForToken creates a new registryv1alpha1apiclient.Provider that
+// authenticates with the given token instead of the token in the netrc file.
+//
+// This is used to validate a token before it is written to the netrc file.
+func NewRegistryProviderForToken(ctx context.Context, container appflag.Container, token string) (registryv1alpha1apiclient.Provider, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
+	options, err := getRegistryProviderOptions(container, config)
+	if err != nil {
+		return nil, err
+	}
+	options = append(
+		options,
+		bufapiclient.RegistryProviderWithContextModifierProvider(
+			func(string) (func(context.Context) context.Context, error) {
+				return newContextModifier(token), nil
+			},
+		),
+	)
+	return bufapiclient.NewRegistryProvider(
+		ctx,
+		container.Logger(),
+		config.TLS,
+		options...,
+	)
+}
+
+func getRegistryProviderOptions(container appflag.Container, config *bufapp.Config) ([]bufapiclient.RegistryProviderOption, error) {
 	useGRPC, err := buftransport.UseGRPC(container)
 	if err != nil {
 		return nil, err
@@ -450,12 +797,10 @@ func NewRegistryProvider(ctx context.Context, container appflag.Container) (regi
 	if useGRPC {
 		options = append(options, bufapiclient.RegistryProviderWithGRPC())
 	}
-	return bufapiclient.NewRegistryProvider(
-		ctx,
-		container.Logger(),
-		config.TLS,
-		options...,
-	)
+	if len(config.AddressToTLS) > 0 {
+		options = append(options, bufapiclient.RegistryProviderWithAddressToTLSConfig(config.AddressToTLS))
+	}
+	return options, nil
 }
 
 // NewContextModifierProvider returns a new context modifier provider for API providers.
@@ -473,16 +818,20 @@ func NewContextModifierProvider(
 		if machine != nil {
 			password = machine.Password()
 		}
-		return func(ctx context.Context) context.Context {
-			return rpcauth.WithToken(
-				rpc.WithOutgoingHeader(
-					ctx,
-					"buf-version",
-					Version,
-				),
-				password,
-			)
-		}, nil
+		return newContextModifier(password), nil
+	}
+}
+
+func newContextModifier(token string) func(context.Context) context.Context {
+	return func(ctx context.Context) context.Context {
+		return rpcauth.WithToken(
+			rpc.WithOutgoingHeader(
+				ctx,
+				"buf-version",
+				Version,
+			),
+			token,
+		)
 	}
 }
 
@@ -535,6 +884,47 @@ func PromptUserForDelete(container app.Container, entityType string, expectedAns
 	return nil
 }
 
+// PromptUserForTransfer is used to receive user confirmation that a specific
+// entity should be transferred to a new owner. If the user's answer does not
+// match the expected answer, an error is returned.
+func PromptUserForTransfer(container app.Container, entityType string, expectedAnswer string, newOwner string) error {
+	confirmation, err := promptUser(
+		container,
+		fmt.Sprintf(
+			"Please confirm that you want to TRANSFER this %s to %s by entering its name again."+
+				"\nWARNING: Existing references to this %s will no longer resolve!\n",
+			entityType,
+			newOwner,
+			entityType,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	if confirmation != expectedAnswer {
+		return fmt.Errorf(
+			"expected %q, but received %q",
+			expectedAnswer,
+			confirmation,
+		)
+	}
+	return nil
+}
+
+// PromptUserForToken is used to receive a token for the given remote from the user.
+//
+// Note that the token is echoed back to the terminal as it is typed.
+func PromptUserForToken(container app.Container, remote string) (string, error) {
+	token, err := promptUser(
+		container,
+		fmt.Sprintf("Enter the Buf API Key for %s: ", remote),
+	)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(token), nil
+}
+
 // ReadModule gets a module from a source ref.
 func ReadModule(
 	ctx context.Context,
@@ -669,6 +1059,191 @@ func PrintRepositoryBranches(
 	return repositoryBranchPrinter.PrintRepositoryBranches(ctx, repositoryBranches...)
 }
 
+// PrintRepositoryBranchesWithHeadCommits prints the provided repositoryBranches
+// along with the names of their head commits to the writer.
+func PrintRepositoryBranchesWithHeadCommits(
+	ctx context.Context,
+	writer io.Writer,
+	formatString string,
+	repositoryBranches []*registryv1alpha1.RepositoryBranch,
+	headCommitNames []string,
+) error {
+	format, err := bufprint.ParseFormat(formatString)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	repositoryBranchPrinter, err := bufprint.NewRepositoryBranchPrinter(writer, format)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return repositoryBranchPrinter.PrintRepositoryBranchesWithHeadCommits(ctx, repositoryBranches, headCommitNames)
+}
+
+// PrintRepositoryMirrors prints the provided repositoryMirrors to the writer.
+func PrintRepositoryMirrors(
+	ctx context.Context,
+	writer io.Writer,
+	formatString string,
+	repositoryMirrors ...*registryv1alpha1.RepositoryMirror,
+) error {
+	format, err := bufprint.ParseFormat(formatString)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	repositoryMirrorPrinter, err := bufprint.NewRepositoryMirrorPrinter(writer, format)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return repositoryMirrorPrinter.PrintRepositoryMirrors(ctx, repositoryMirrors...)
+}
+
+// PrintRepositoryStats prints the provided repositoryStats to the writer.
+func PrintRepositoryStats(
+	ctx context.Context,
+	writer io.Writer,
+	formatString string,
+	repositoryStats ...*registryv1alpha1.RepositoryStats,
+) error {
+	format, err := bufprint.ParseFormat(formatString)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	repositoryStatsPrinter, err := bufprint.NewRepositoryStatsPrinter(writer, format)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return repositoryStatsPrinter.PrintRepositoryStats(ctx, repositoryStats...)
+}
+
+// PrintRepositoryTags prints the provided repositoryTags to the writer.
+func PrintRepositoryTags(
+	ctx context.Context,
+	writer io.Writer,
+	formatString string,
+	repositoryTags ...*registryv1alpha1.RepositoryTag,
+) error {
+	format, err := bufprint.ParseFormat(formatString)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	repositoryTagPrinter, err := bufprint.NewRepositoryTagPrinter(writer, format)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return repositoryTagPrinter.PrintRepositoryTags(ctx, repositoryTags...)
+}
+
+// PrintRepositoryTagHistoryEntries prints the provided repositoryTagHistoryEntries to the writer.
+func PrintRepositoryTagHistoryEntries(
+	ctx context.Context,
+	writer io.Writer,
+	formatString string,
+	repositoryTagHistoryEntries ...*registryv1alpha1.RepositoryTagHistoryEntry,
+) error {
+	format, err := bufprint.ParseFormat(formatString)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	repositoryTagHistoryEntryPrinter, err := bufprint.NewRepositoryTagHistoryEntryPrinter(writer, format)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return repositoryTagHistoryEntryPrinter.PrintRepositoryTagHistoryEntries(ctx, repositoryTagHistoryEntries...)
+}
+
+// PrintTokens prints the provided tokens to the writer.
+func PrintTokens(
+	ctx context.Context,
+	writer io.Writer,
+	formatString string,
+	tokens ...*registryv1alpha1.Token,
+) error {
+	format, err := bufprint.ParseFormat(formatString)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	tokenPrinter, err := bufprint.NewTokenPrinter(writer, format)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return tokenPrinter.PrintTokens(ctx, tokens...)
+}
+
+// NewModuleCacheVerifier returns a new ModuleVerifier for the local module cache.
+//
+// This never makes any RPCs.
+func NewModuleCacheVerifier(container appflag.Container) (bufmodulecache.ModuleVerifier, error) {
+	_, readWriteBucket, fileLocker, err := newModuleCacheBucketAndFileLocker(container)
+	if err != nil {
+		return nil, err
+	}
+	return bufmodulecache.NewModuleVerifier(readWriteBucket, fileLocker), nil
+}
+
+// NewModuleCachePruner returns a new ModulePruner for the local module cache.
+//
+// This never makes any RPCs.
+func NewModuleCachePruner(container appflag.Container) (bufmodulecache.ModulePruner, error) {
+	modCacheDirPath, _, fileLocker, err := newModuleCacheBucketAndFileLocker(container)
+	if err != nil {
+		return nil, err
+	}
+	return bufmodulecache.NewModulePruner(modCacheDirPath, fileLocker), nil
+}
+
+// NewConfigProvider returns a new bufconfig.Provider.
+//
+// If configOverrideFilePath is set, the file at this path is read and merged over
+// every configuration returned by the Provider.
+func NewConfigProvider(logger *zap.Logger, configOverrideFilePath string) (bufconfig.Provider, error) {
+	if configOverrideFilePath == "" {
+		return bufconfig.NewProvider(logger), nil
+	}
+	data, err := ioutil.ReadFile(configOverrideFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file: %v", err)
+	}
+	return bufconfig.NewProvider(logger, bufconfig.ProviderWithOverrideData(data)), nil
+}
+
+// ReadFileDescriptorSets reads the binary FileDescriptorSets at the given paths
+// and returns all their FileDescriptorProtos in order.
+//
+// Images are wire-compatible with FileDescriptorSets and can be read as well.
+func ReadFileDescriptorSets(paths []string) ([]*descriptorpb.FileDescriptorProto, error) {
+	var fileDescriptorProtos []*descriptorpb.FileDescriptorProto
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read file: %v", err)
+		}
+		fileDescriptorSet := &descriptorpb.FileDescriptorSet{}
+		if err := protoencoding.NewWireUnmarshaler(nil).Unmarshal(data, fileDescriptorSet); err != nil {
+			return nil, fmt.Errorf("could not unmarshal %s as a FileDescriptorSet: %v", path, err)
+		}
+		fileDescriptorProtos = append(fileDescriptorProtos, fileDescriptorSet.File...)
+	}
+	return fileDescriptorProtos, nil
+}
+
+// PrintRepositoryCommits prints the provided repositoryCommits to the writer.
+func PrintRepositoryCommits(
+	ctx context.Context,
+	writer io.Writer,
+	formatString string,
+	repositoryCommits ...*registryv1alpha1.RepositoryCommit,
+) error {
+	format, err := bufprint.ParseFormat(formatString)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	repositoryCommitPrinter, err := bufprint.NewRepositoryCommitPrinter(writer, format)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return repositoryCommitPrinter.PrintRepositoryCommits(ctx, repositoryCommits...)
+}
+
 // modifyRemotes modifies the remotes based on f.
 //
 // if f returns false, this performs no update and returns false.
@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/pkg/app"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
 	"github.com/bufbuild/buf/internal/pkg/rpc"
@@ -111,6 +113,12 @@ func NewBranchNameAlreadyExistsError(name string) error {
 	return fmt.Errorf("a branch named %q already exists", name)
 }
 
+// NewTagNameAlreadyExistsError informs the user that a tag
+// with that name already exists.
+func NewTagNameAlreadyExistsError(name string) error {
+	return fmt.Errorf("a tag named %q already exists", name)
+}
+
 // NewOrganizationNotFoundError informs the user that an organization with
 // that name does not exist.
 func NewOrganizationNotFoundError(name string) error {
@@ -123,17 +131,66 @@ func NewRepositoryNotFoundError(name string) error {
 	return fmt.Errorf(`a repository named %q does not exist, use "buf beta registry repository create" to create one`, name)
 }
 
+// NewRepositoryMirrorNotFoundError informs the user that a repository
+// with that name does not have a mirror configured.
+func NewRepositoryMirrorNotFoundError(name string) error {
+	return fmt.Errorf(`repository %q does not have a mirror, use "buf beta registry repository mirror set" to configure one`, name)
+}
+
+// NewCommitNotFoundError informs the user that a commit with
+// that name does not exist.
+func NewCommitNotFoundError(name string) error {
+	return fmt.Errorf("a commit named %q does not exist", name)
+}
+
+// NewModuleReferenceNotFoundError informs the user that a module reference
+// could not be resolved.
+func NewModuleReferenceNotFoundError(moduleReference bufmodule.ModuleReference) error {
+	return fmt.Errorf("%q was not found", moduleReference.String())
+}
+
+// NewDependencyNotDeclaredError informs the user that a module is not
+// declared as a dependency in their configuration file.
+func NewDependencyNotDeclaredError(moduleIdentity string) error {
+	return fmt.Errorf(`%q is not declared in the deps of your configuration file`, moduleIdentity)
+}
+
+// NewBranchNotFoundError informs the user that a branch with
+// that name does not exist.
+func NewBranchNotFoundError(name string) error {
+	return fmt.Errorf("a branch named %q does not exist", name)
+}
+
+// NewTagNotFoundError informs the user that a tag with
+// that name does not exist.
+func NewTagNotFoundError(name string) error {
+	return fmt.Errorf("a tag named %q does not exist", name)
+}
+
+// NewTagCommitNotOnBranchError informs the user that the commit a tag
+// points to is not part of the history of the given branch.
+func NewTagCommitNotOnBranchError(tagName string, commitName string, branchName string) error {
+	return fmt.Errorf("tag %q points to commit %q, which is not in the history of branch %q", tagName, commitName, branchName)
+}
+
 // NewTokenNotFoundError informs the user that a token with
 // that identifier does not exist.
 func NewTokenNotFoundError(tokenID string) error {
 	return fmt.Errorf("a token with ID %q does not exist", tokenID)
 }
 
+// NewViolationsFoundError is used when lint or breaking change detection
+// finds violations. The violations are expected to have already been printed,
+// so the error has no message and only carries the exit code.
+func NewViolationsFoundError(exitCode int) error {
+	return app.NewError(exitCode, "")
+}
+
 // wrapError is used when a CLI command fails, regardless of its error code.
 // Note that this function will wrap the error so that the underlying error
 // can be recovered via 'errors.Is'.
 func wrapError(action string, err error) error {
-	if err == nil || (err.Error() == "" && rpc.GetErrorCode(err) == rpc.ErrorCodeUnknown) {
+	if err == nil || (err.Error() == "" && !rpc.IsError(err)) {
 		// If the error is nil or empty and not an rpc error, we return it as-is.
 		// This is especially relevant for commands like lint and breaking.
 		return err
@@ -22,10 +22,12 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufapimodule"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulecache"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
 	"github.com/bufbuild/buf/internal/gen/proto/apiclient/buf/alpha/registry/v1alpha1/registryv1alpha1apiclient"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
 	"github.com/bufbuild/buf/internal/pkg/filelock"
 	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/storage"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 )
 
@@ -45,54 +47,99 @@ func newRegistryModuleResolverReaderProvider() *registryModuleResolverReaderProv
 
 // GetModuleResolver returns a new ModuleResolver.
 func (m *registryModuleResolverReaderProvider) GetModuleResolver(ctx context.Context, container appflag.Container) (bufmodule.ModuleResolver, error) {
-	m.setup.Do(func() {
-		m.registryProvider, m.setupErr = NewRegistryProvider(ctx, container)
-	})
-	if m.setupErr != nil {
-		return nil, m.setupErr
-	}
 	return bufapimodule.NewModuleResolver(
 		container.Logger(),
-		m.registryProvider,
+		newLazyRegistryProvider(m, container),
 	), nil
 }
 
 // GetModuleReader returns a new ModuleReader.
 func (m *registryModuleResolverReaderProvider) GetModuleReader(ctx context.Context, container appflag.Container) (bufmodule.ModuleReader, error) {
+	modCacheDirPath, readWriteBucket, fileLocker, err := newModuleCacheBucketAndFileLocker(container)
+	if err != nil {
+		return nil, err
+	}
+	moduleReader := bufmodulecache.NewModuleReader(
+		container.Logger(),
+		readWriteBucket,
+		bufapimodule.NewModuleReader(
+			newLazyRegistryProvider(m, container),
+		),
+		bufmodulecache.WithMessageWriter(
+			container.Stderr(),
+		),
+		bufmodulecache.WithFileLocker(fileLocker),
+		bufmodulecache.WithAccessTimeRootDirPath(modCacheDirPath),
+	)
+	return moduleReader, nil
+}
+
+// getRegistryProvider returns the registry provider, creating it on first use.
+func (m *registryModuleResolverReaderProvider) getRegistryProvider(
+	ctx context.Context,
+	container appflag.Container,
+) (registryv1alpha1apiclient.Provider, error) {
 	m.setup.Do(func() {
 		m.registryProvider, m.setupErr = NewRegistryProvider(ctx, container)
 	})
-	if m.setupErr != nil {
-		return nil, m.setupErr
+	return m.registryProvider, m.setupErr
+}
+
+// lazyRegistryProvider only creates the registry provider when a service is
+// created, so that the user configuration is only read when a module is
+// resolved or downloaded, and not for modules in the cache or without
+// dependencies.
+type lazyRegistryProvider struct {
+	registryModuleResolverReaderProvider *registryModuleResolverReaderProvider
+	container                            appflag.Container
+}
+
+func newLazyRegistryProvider(
+	registryModuleResolverReaderProvider *registryModuleResolverReaderProvider,
+	container appflag.Container,
+) *lazyRegistryProvider {
+	return &lazyRegistryProvider{
+		registryModuleResolverReaderProvider: registryModuleResolverReaderProvider,
+		container:                            container,
 	}
+}
+
+func (l *lazyRegistryProvider) NewResolveService(ctx context.Context, address string) (registryv1alpha1api.ResolveService, error) {
+	registryProvider, err := l.registryModuleResolverReaderProvider.getRegistryProvider(ctx, l.container)
+	if err != nil {
+		return nil, err
+	}
+	return registryProvider.NewResolveService(ctx, address)
+}
+
+func (l *lazyRegistryProvider) NewDownloadService(ctx context.Context, address string) (registryv1alpha1api.DownloadService, error) {
+	registryProvider, err := l.registryModuleResolverReaderProvider.getRegistryProvider(ctx, l.container)
+	if err != nil {
+		return nil, err
+	}
+	return registryProvider.NewDownloadService(ctx, address)
+}
+
+// newModuleCacheBucketAndFileLocker returns the directory path, bucket, and file
+// locker for the module cache, creating the cache directories if they do not exist.
+func newModuleCacheBucketAndFileLocker(container appflag.Container) (string, storage.ReadWriteBucket, filelock.Locker, error) {
 	modCacheDirPath := normalpath.Join(container.CacheDirPath(), modDir)
 	if err := os.MkdirAll(normalpath.Unnormalize(modCacheDirPath), 0755); err != nil {
-		return nil, err
+		return "", nil, nil, err
 	}
 	lockCacheDirPath := normalpath.Join(container.CacheDirPath(), lockDir)
 	if err := os.MkdirAll(normalpath.Unnormalize(lockCacheDirPath), 0755); err != nil {
-		return nil, err
+		return "", nil, nil, err
 	}
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
 	// do NOT want to enable symlinks for our cache
 	readWriteBucket, err := storageosProvider.NewReadWriteBucket(modCacheDirPath)
 	if err != nil {
-		return nil, err
+		return "", nil, nil, err
 	}
 	fileLocker, err := filelock.NewLocker(lockCacheDirPath)
 	if err != nil {
-		return nil, err
+		return "", nil, nil, err
 	}
-	moduleReader := bufmodulecache.NewModuleReader(
-		container.Logger(),
-		readWriteBucket,
-		bufapimodule.NewModuleReader(
-			m.registryProvider,
-		),
-		bufmodulecache.WithMessageWriter(
-			container.Stderr(),
-		),
-		bufmodulecache.WithFileLocker(fileLocker),
-	)
-	return moduleReader, nil
+	return modCacheDirPath, readWriteBucket, fileLocker, nil
 }
@@ -50,8 +50,28 @@ type Provider interface {
 }
 
 // NewProvider returns a new Provider.
-func NewProvider(logger *zap.Logger) Provider {
-	return newProvider(logger)
+func NewProvider(logger *zap.Logger, options ...ProviderOption) Provider {
+	return newProvider(logger, options...)
+}
+
+// ProviderOption is an option for a new Provider.
+type ProviderOption func(*provider)
+
+// ProviderWithOverrideData returns a new ProviderOption that merges the lint and breaking
+// sections of the given JSON or YAML data over every Config returned by the Provider.
+//
+// The override data may only contain the version, lint, and breaking keys. Within the
+// lint and breaking sections:
+//
+//   - use replaces the base value if set.
+//   - except, ignore, and the custom lists are appended to the base values.
+//   - ignore_only is merged per rule or category, appending paths to the base values.
+//   - String values replace the base values if set.
+//   - Boolean values are enabled if set to true, they cannot be disabled by the override.
+func ProviderWithOverrideData(overrideData []byte) ProviderOption {
+	return func(provider *provider) {
+		provider.overrideData = overrideData
+	}
 }
 
 // WriteConfig writes an initial configuration file into the bucket.
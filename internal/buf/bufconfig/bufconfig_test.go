@@ -0,0 +1,94 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestProviderWithOverrideDataAllowCommentIgnores(t *testing.T) {
+	t.Parallel()
+	testProviderWithOverrideData(
+		t,
+		`version: v1beta1`,
+		`version: v1beta1`,
+		func(t *testing.T, config *Config) {
+			assert.False(t, config.Lint.AllowCommentIgnores)
+			assert.False(t, config.Breaking.AllowCommentIgnores)
+		},
+	)
+	testProviderWithOverrideData(
+		t,
+		`version: v1beta1`,
+		`
+version: v1beta1
+lint:
+  allow_comment_ignores: true
+breaking:
+  allow_comment_ignores: true
+`,
+		func(t *testing.T, config *Config) {
+			assert.True(t, config.Lint.AllowCommentIgnores)
+			assert.True(t, config.Breaking.AllowCommentIgnores)
+		},
+	)
+	// the override cannot disable a boolean set in the base
+	testProviderWithOverrideData(
+		t,
+		`
+version: v1beta1
+lint:
+  allow_comment_ignores: true
+breaking:
+  allow_comment_ignores: true
+`,
+		`version: v1beta1`,
+		func(t *testing.T, config *Config) {
+			assert.True(t, config.Lint.AllowCommentIgnores)
+			assert.True(t, config.Breaking.AllowCommentIgnores)
+		},
+	)
+}
+
+func TestProviderWithOverrideDataBuild(t *testing.T) {
+	t.Parallel()
+	provider := NewProvider(
+		zap.NewNop(),
+		ProviderWithOverrideData([]byte(`
+version: v1beta1
+build:
+  max_import_depth: 1
+`)),
+	)
+	_, err := provider.GetConfigForData(context.Background(), []byte(`version: v1beta1`))
+	require.EqualError(t, err, "Override configuration data may only contain the lint and breaking sections")
+}
+
+func testProviderWithOverrideData(
+	t *testing.T,
+	data string,
+	overrideData string,
+	check func(*testing.T, *Config),
+) {
+	provider := NewProvider(zap.NewNop(), ProviderWithOverrideData([]byte(overrideData)))
+	config, err := provider.GetConfigForData(context.Background(), []byte(data))
+	require.NoError(t, err)
+	check(t, config)
+}
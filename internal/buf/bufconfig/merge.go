@@ -0,0 +1,121 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconfig
+
+import (
+	"github.com/bufbuild/buf/internal/buf/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/internal/buf/bufcheck/buflint"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+)
+
+// mergeExternalConfigV1Beta1 merges the lint and breaking sections of override over base.
+//
+// See ProviderWithOverrideData for the merge semantics.
+func mergeExternalConfigV1Beta1(base externalConfigV1Beta1, override externalConfigV1Beta1) externalConfigV1Beta1 {
+	base.Lint = mergeLintExternalConfigV1Beta1(base.Lint, override.Lint)
+	base.Breaking = mergeBreakingExternalConfigV1Beta1(base.Breaking, override.Breaking)
+	return base
+}
+
+func mergeLintExternalConfigV1Beta1(base buflint.ExternalConfigV1Beta1, override buflint.ExternalConfigV1Beta1) buflint.ExternalConfigV1Beta1 {
+	if len(override.Use) > 0 {
+		base.Use = override.Use
+	}
+	base.Except = appendUniqueStrings(base.Except, override.Except)
+	base.Ignore = appendUniqueStrings(base.Ignore, override.Ignore)
+	base.IgnoreOnly = mergeIgnoreOnly(base.IgnoreOnly, override.IgnoreOnly)
+	if override.EnumZeroValueSuffix != "" {
+		base.EnumZeroValueSuffix = override.EnumZeroValueSuffix
+	}
+	base.RPCAllowSameRequestResponse = base.RPCAllowSameRequestResponse || override.RPCAllowSameRequestResponse
+	base.RPCAllowGoogleProtobufEmptyRequests = base.RPCAllowGoogleProtobufEmptyRequests || override.RPCAllowGoogleProtobufEmptyRequests
+	base.RPCAllowGoogleProtobufEmptyResponses = base.RPCAllowGoogleProtobufEmptyResponses || override.RPCAllowGoogleProtobufEmptyResponses
+	base.RPCHTTPAnnotationAllowStreaming = base.RPCHTTPAnnotationAllowStreaming || override.RPCHTTPAnnotationAllowStreaming
+	if override.RPCStreamingSuffix != "" {
+		base.RPCStreamingSuffix = override.RPCStreamingSuffix
+	}
+	if override.ServiceSuffix != "" {
+		base.ServiceSuffix = override.ServiceSuffix
+	}
+	if override.FieldPresence != "" {
+		base.FieldPresence = override.FieldPresence
+	}
+	base.PackageNoStutterAllow = appendUniqueStrings(base.PackageNoStutterAllow, override.PackageNoStutterAllow)
+	if override.PackageDirectoryMatchRootPrefix != "" {
+		base.PackageDirectoryMatchRootPrefix = override.PackageDirectoryMatchRootPrefix
+	}
+	base.AllowCommentIgnores = base.AllowCommentIgnores || override.AllowCommentIgnores
+	base.IgnoreUnstablePackages = base.IgnoreUnstablePackages || override.IgnoreUnstablePackages
+	base.RuleSeverities = mergeRuleSeverities(base.RuleSeverities, override.RuleSeverities)
+	base.Custom.ForbidFieldTypes = appendUniqueStrings(base.Custom.ForbidFieldTypes, override.Custom.ForbidFieldTypes)
+	base.Custom.RequireFieldOptions = appendUniqueStrings(base.Custom.RequireFieldOptions, override.Custom.RequireFieldOptions)
+	base.Custom.RequireFileOptions = appendUniqueStrings(base.Custom.RequireFileOptions, override.Custom.RequireFileOptions)
+	if override.Custom.ForbidMessageNameRegex != "" {
+		base.Custom.ForbidMessageNameRegex = override.Custom.ForbidMessageNameRegex
+	}
+	base.Custom.AllowFieldNumberRanges = appendUniqueStrings(base.Custom.AllowFieldNumberRanges, override.Custom.AllowFieldNumberRanges)
+	base.Custom.ForbidFieldNumberRanges = appendUniqueStrings(base.Custom.ForbidFieldNumberRanges, override.Custom.ForbidFieldNumberRanges)
+	return base
+}
+
+func mergeBreakingExternalConfigV1Beta1(base bufbreaking.ExternalConfigV1Beta1, override bufbreaking.ExternalConfigV1Beta1) bufbreaking.ExternalConfigV1Beta1 {
+	if len(override.Use) > 0 {
+		base.Use = override.Use
+	}
+	base.Except = appendUniqueStrings(base.Except, override.Except)
+	base.Ignore = appendUniqueStrings(base.Ignore, override.Ignore)
+	base.IgnoreOnly = mergeIgnoreOnly(base.IgnoreOnly, override.IgnoreOnly)
+	base.AllowCommentIgnores = base.AllowCommentIgnores || override.AllowCommentIgnores
+	base.IgnoreUnstablePackages = base.IgnoreUnstablePackages || override.IgnoreUnstablePackages
+	return base
+}
+
+func mergeIgnoreOnly(base map[string][]string, override map[string][]string) map[string][]string {
+	if len(override) == 0 {
+		return base
+	}
+	merged := make(map[string][]string, len(base)+len(override))
+	for id, paths := range base {
+		merged[id] = paths
+	}
+	for id, paths := range override {
+		merged[id] = appendUniqueStrings(merged[id], paths)
+	}
+	return merged
+}
+
+// mergeRuleSeverities returns the severities of base, with the severities of
+// override taking precedence.
+func mergeRuleSeverities(base map[string]string, override map[string]string) map[string]string {
+	if len(override) == 0 {
+		return base
+	}
+	merged := make(map[string]string, len(base)+len(override))
+	for id, severity := range base {
+		merged[id] = severity
+	}
+	for id, severity := range override {
+		merged[id] = severity
+	}
+	return merged
+}
+
+// appendUniqueStrings returns the unique values of base and additions.
+func appendUniqueStrings(base []string, additions []string) []string {
+	if len(additions) == 0 {
+		return base
+	}
+	return stringutil.SliceToUniqueSortedSlice(append(append([]string{}, base...), additions...))
+}
@@ -33,13 +33,18 @@ import (
 const v1beta1Version = "v1beta1"
 
 type provider struct {
-	logger *zap.Logger
+	logger       *zap.Logger
+	overrideData []byte
 }
 
-func newProvider(logger *zap.Logger) *provider {
-	return &provider{
+func newProvider(logger *zap.Logger, options ...ProviderOption) *provider {
+	provider := &provider{
 		logger: logger,
 	}
+	for _, option := range options {
+		option(provider)
+	}
+	return provider
 }
 
 func (p *provider) GetConfig(ctx context.Context, readBucket storage.ReadBucket) (_ *Config, retErr error) {
@@ -103,6 +108,13 @@ func (p *provider) getConfigForData(
 }
 
 func (p *provider) newConfigV1Beta1(externalConfig externalConfigV1Beta1) (*Config, error) {
+	if len(p.overrideData) > 0 {
+		overrideExternalConfig, err := p.getOverrideExternalConfig()
+		if err != nil {
+			return nil, err
+		}
+		externalConfig = mergeExternalConfigV1Beta1(externalConfig, overrideExternalConfig)
+	}
 	buildConfig, err := bufmodulebuild.NewConfigV1Beta1(externalConfig.Build, externalConfig.Deps...)
 	if err != nil {
 		return nil, err
@@ -130,6 +142,25 @@ func (p *provider) newConfigV1Beta1(externalConfig externalConfigV1Beta1) (*Conf
 	}, nil
 }
 
+func (p *provider) getOverrideExternalConfig() (externalConfigV1Beta1, error) {
+	id := "Override configuration data"
+	var externalConfigVersion externalConfigVersion
+	if err := encoding.UnmarshalJSONOrYAMLNonStrict(p.overrideData, &externalConfigVersion); err != nil {
+		return externalConfigV1Beta1{}, err
+	}
+	if err := p.validateExternalConfigVersion(externalConfigVersion, id); err != nil {
+		return externalConfigV1Beta1{}, err
+	}
+	var externalConfig externalConfigV1Beta1
+	if err := encoding.UnmarshalJSONOrYAMLStrict(p.overrideData, &externalConfig); err != nil {
+		return externalConfigV1Beta1{}, err
+	}
+	if externalConfig.Name != "" || len(externalConfig.Deps) > 0 || len(externalConfig.Build.Roots) > 0 || len(externalConfig.Build.Excludes) > 0 || externalConfig.Build.MaxImportDepth != 0 {
+		return externalConfigV1Beta1{}, fmt.Errorf("%s may only contain the lint and breaking sections", id)
+	}
+	return externalConfig, nil
+}
+
 func (p *provider) validateExternalConfigVersion(externalConfigVersion externalConfigVersion, id string) error {
 	switch externalConfigVersion.Version {
 	case "":
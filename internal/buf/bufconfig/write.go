@@ -102,6 +102,16 @@ build:
   {{if not .Uncomment}}#{{end}}  - foo
   {{if not .Uncomment}}#{{end}}  - bar/baz
 
+  # max_import_depth is the maximum length of any chain of imports. A file with
+  # no imports has depth 0, and a file that only imports such a file has depth 1.
+  #
+  # If any file exceeds this depth, the build fails with the deepest chain of
+  # imports. The default is 1000, which normal schemas never reach.
+  #
+  # The build stops as soon as a chain of imports longer than this is found,
+  # without parsing the rest of the chain.
+  {{if not .Uncomment}}#{{end}}max_import_depth: 1000
+
 # lint contains the options for lint rules.
 lint:
 
@@ -187,12 +197,52 @@ lint:
   # allowed in multiple RPCs.
   {{if not .Uncomment}}#{{end}}rpc_allow_google_protobuf_empty_responses: false
 
+  # rpc_http_annotation_allow_streaming affects the behavior of the
+  # RPC_HTTP_ANNOTATION rule, which is not in the default categories and must
+  # be added to use.
+  #
+  # This will result in client and server streaming RPCs not being required to
+  # have the google.api.http option set.
+  {{if not .Uncomment}}#{{end}}rpc_http_annotation_allow_streaming: false
+
+  # rpc_streaming_suffix affects the behavior of the RPC_STREAMING_SUFFIX rule,
+  # which is not in the default categories and must be added to use.
+  #
+  # This will result in this suffix being used instead of the default "Stream"
+  # suffix.
+  {{if not .Uncomment}}#{{end}}rpc_streaming_suffix: Stream
+
   # service_suffix affects the behavior of the SERVICE_SUFFIX rule.
   #
   # This will result in this suffix being used instead of the default "Service"
   # suffix.
   {{if not .Uncomment}}#{{end}}service_suffix: Service
 
+  # field_presence affects the behavior of the FIELD_PRESENCE rule, which is
+  # not in the default categories and must be added to use.
+  #
+  # This must be either "require-optional", which requires proto3 singular
+  # scalar fields to have the optional label, or "forbid-optional", which
+  # forbids it. The default is "require-optional".
+  {{if not .Uncomment}}#{{end}}field_presence: require-optional
+
+  # package_no_stutter_allow affects the behavior of the PACKAGE_NO_STUTTER
+  # rule, which is not in the default categories and must be added to use.
+  #
+  # These names are allowed to begin with the package name, and can be either
+  # the name of the type or its fully-qualified name.
+  {{if not .Uncomment}}#{{end}}package_no_stutter_allow:
+  {{if not .Uncomment}}#{{end}}  - foo.v1.FooBar
+
+  # package_directory_match_root_prefix affects the behavior of the
+  # PACKAGE_DIRECTORY_MATCH rule.
+  #
+  # This directory is expected to contain all files, and is stripped from each
+  # file path before the path is compared to the package. For example, with a
+  # root prefix of "proto", a file with package "acme.payments.v1" must be
+  # within the directory "proto/acme/payments/v1".
+  {{if not .Uncomment}}#{{end}}package_directory_match_root_prefix: proto
+
   # allow_comment_ignores allows comment-driven ignores.
   #
   # If this option is set, leading comments can be added within Protobuf files
@@ -212,6 +262,64 @@ lint:
   # make informed decisions, so we provide this as an opt-in.
   {{if not .Uncomment}}#{{end}}allow_comment_ignores: false
 
+  # ignore_unstable_packages results in ignoring packages with a last component
+  # that is one of the unstable forms recognized by the "PACKAGE_VERSION_SUFFIX"
+  # lint rule for all lint rules. This can also be set for a single invocation
+  # with "buf lint --ignore-unstable-packages".
+  #
+  # This is useful if you want your stable packages to be strictly linted, but
+  # allow your alpha and beta packages to be experimental.
+  {{if not .Uncomment}}#{{end}}ignore_unstable_packages: false
+
+  # rule_severities sets the severity of the violations of individual rules,
+  # which must be either "error" or "warning". Rules that are not listed have
+  # severity "error".
+  #
+  # Violations of rules with severity "warning" are printed, but do not fail
+  # "buf lint" unless "buf lint --fail-on-warnings" is set.
+  {{if not .Uncomment}}#{{end}}rule_severities:
+  {{if not .Uncomment}}#{{end}}  FIELD_LOWER_SNAKE_CASE: warning
+
+  # custom contains simple declarative constraints that are checked by the
+  # CUSTOM rule, which is not in the default categories and must be added
+  # to use.
+  #
+  # forbid_field_types is the list of field types that may not be used. These
+  # can be scalar types such as "bytes" and "group", or fully-qualified message
+  # or enum names such as "google.protobuf.Any". The keys and values of map
+  # fields are also checked.
+  #
+  # require_field_options is the list of field options that must be explicitly
+  # set on all fields. The supported options are "ctype", "json_name",
+  # "jstype", and "packed".
+  #
+  # require_file_options is the list of file options that must be explicitly
+  # set in all files. An option can be followed by "=VALUE" to also require
+  # that the option is set to VALUE, for example "optimize_for=SPEED".
+  #
+  # forbid_message_name_regex is a regular expression that message names may
+  # not match.
+  #
+  # allow_field_number_ranges is the list of field number ranges that message
+  # fields must be within. Ranges are inclusive and of the form "N", "N-M",
+  # or "N-max". If not set, all field numbers are allowed.
+  #
+  # forbid_field_number_ranges is the list of field number ranges that message
+  # fields may not be within, using the same form as allow_field_number_ranges.
+  {{if not .Uncomment}}#{{end}}custom:
+  {{if not .Uncomment}}#{{end}}  forbid_field_types:
+  {{if not .Uncomment}}#{{end}}    - google.protobuf.Any
+  {{if not .Uncomment}}#{{end}}  require_field_options:
+  {{if not .Uncomment}}#{{end}}    - json_name
+  {{if not .Uncomment}}#{{end}}  require_file_options:
+  {{if not .Uncomment}}#{{end}}    - go_package
+  {{if not .Uncomment}}#{{end}}    - optimize_for=SPEED
+  {{if not .Uncomment}}#{{end}}  forbid_message_name_regex: ^Legacy
+  {{if not .Uncomment}}#{{end}}  allow_field_number_ranges:
+  {{if not .Uncomment}}#{{end}}    - 1-9999
+  {{if not .Uncomment}}#{{end}}  forbid_field_number_ranges:
+  {{if not .Uncomment}}#{{end}}    - 500-599
+
 # breaking contains the options for breaking rules.
 breaking:
 
@@ -277,6 +385,22 @@ breaking:
   {{if not .Uncomment}}#{{end}}  WIRE_JSON:
   {{if not .Uncomment}}#{{end}}    - foo
 
+  # allow_comment_ignores allows comment-driven ignores.
+  #
+  # If this option is set, leading comments can be added within Protobuf files
+  # to accept an intentional breaking change for a declaration. If any line in
+  # a leading comment starts with "buf:breaking:ignore ID", then Buf will ignore
+  # breaking changes for this id on this declaration. For example:
+  #
+  #   message Foo {
+  #     // buf:breaking:ignore FIELD_SAME_TYPE
+  #     int64 bar = 1;
+  #   }
+  #
+  # Comment ignores that do not ignore any breaking change are printed as
+  # warnings.
+  {{if not .Uncomment}}#{{end}}allow_comment_ignores: false
+
   # ignore_unstable_packages results in ignoring packages with a last component
   # that is one of the unstable forms recognized by the "PACKAGE_VERSION_SUFFIX"
   # lint rule. The following forms will be ignored:
@@ -290,6 +414,13 @@ breaking:
   # - foo.bar.v1alpha1
   # - foo.bar.v1beta1
   # - foo.bar.v1test
+  #
+  # The package of a file is checked in the current image, not the previous
+  # image. Moving a file from an unstable package such as foo.bar.v1beta1 to a
+  # stable package such as foo.bar.v1 results in the file being checked against
+  # its previous definition. Failures that are not associated with a file in the
+  # current image, such as a file being deleted with the "FILE_NO_DELETE"
+  # rule, are never ignored.
   {{if not .Uncomment}}#{{end}}ignore_unstable_packages: false`
 )
 
@@ -15,6 +15,8 @@
 package bufimage
 
 import (
+	"io"
+
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	imagev1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/image/v1"
 	"github.com/bufbuild/buf/internal/pkg/normalpath"
@@ -139,6 +141,16 @@ func NewImageForProto(protoImage *imagev1.Image) (Image, error) {
 	return NewImage(imageFiles)
 }
 
+// NewFileInfosForProtoImageReader returns the FileInfos of the binary-encoded
+// proto Image read from the reader, in the order of the files in the Image.
+//
+// The Image is read file-by-file, and each FileDescriptorProto is discarded after
+// it is read, so the full Image is never held in memory. Use this instead of
+// NewImageForProto for operations that only need the FileInfos.
+func NewFileInfosForProtoImageReader(reader io.Reader) ([]bufmodule.FileInfo, error) {
+	return newFileInfosForProtoImageReader(reader)
+}
+
 // NewImageForCodeGeneratorRequest returns a new Image from a given CodeGeneratorRequest.
 //
 // The input Files are expected to be in correct DAG order!
@@ -203,6 +215,54 @@ func ImageWithOnlyPathsAllowNotExist(
 	return imageWithOnlyPaths(image, paths, true)
 }
 
+// ImageWithPathPrefixStripped returns a copy of the Image with the directory
+// prefix stripped from all file paths contained within the prefix.
+//
+// Imports of the files are rewritten to match, so that the Image stays
+// internally consistent. Files not contained within the prefix are unchanged.
+//
+// The prefix is expected to be normalized and validated.
+// If stripping the prefix results in two files with the same path, this errors.
+// The backing FileDescriptorProtos are copied.
+func ImageWithPathPrefixStripped(image Image, prefix string) (Image, error) {
+	return imageWithPathPrefixStripped(image, prefix)
+}
+
+// ImageWithoutSourceRetentionOptions returns a copy of the Image with all
+// options removed whose extension definition has retention = RETENTION_SOURCE.
+//
+// Source retention options are only meant to be available to tools operating
+// on the source, and should not appear in a built Image.
+// If the Image does not contain any such extension definitions, the Image is
+// returned unchanged. Otherwise, the backing FileDescriptorProtos are copied.
+func ImageWithoutSourceRetentionOptions(image Image) (Image, error) {
+	return imageWithoutSourceRetentionOptions(image)
+}
+
+// SortFileDescriptorProtoOptions sorts the custom options of every options
+// message in the FileDescriptorProto by field number, so that the same
+// options always serialize to the same bytes regardless of the order they
+// are declared or interpreted in.
+//
+// The FileDescriptorProto is modified in place. The values of message-typed
+// custom options are not reordered.
+func SortFileDescriptorProtoOptions(fileDescriptorProto *descriptorpb.FileDescriptorProto) error {
+	return sortFileDescriptorProtoOptions(fileDescriptorProto)
+}
+
+// ImageWithUnusedImportsPruned returns a copy of the Image with the imports
+// removed that are not needed by the non-imports.
+//
+// An import is needed if it defines a type, extendee, or custom option used by a
+// needed file, if it is on the chain of public imports through which such a
+// definition is visible, or if it extends a message within a needed file.
+// The dependency lists of the remaining files are updated to match, which
+// includes the public and weak dependency indexes and the source code info.
+// If no imports are removed, the Image is returned unchanged.
+func ImageWithUnusedImportsPruned(image Image) (Image, error) {
+	return imageWithUnusedImportsPruned(image)
+}
+
 // ImageByDir returns multiple images that have non-imports split
 // by directory.
 //
@@ -284,30 +344,55 @@ func ImageToFileDescriptorProtos(image Image) []*descriptorpb.FileDescriptorProt
 // ImageToCodeGeneratorRequest returns a new CodeGeneratorRequest for the Image.
 //
 // All non-imports are added as files to generate.
-func ImageToCodeGeneratorRequest(image Image, parameter string) *pluginpb.CodeGeneratorRequest {
-	imageFiles := image.Files()
-	request := &pluginpb.CodeGeneratorRequest{
-		ProtoFile: make([]*descriptorpb.FileDescriptorProto, len(imageFiles)),
-	}
-	if parameter != "" {
-		request.Parameter = proto.String(parameter)
-	}
-	for i, imageFile := range imageFiles {
-		request.ProtoFile[i] = imageFile.Proto()
-		if !imageFile.IsImport() {
-			request.FileToGenerate = append(request.FileToGenerate, imageFile.Path())
-		}
-	}
-	return request
+// If includeImports is set, all imports that are not well-known types are also added as files to generate.
+// If includeImports and includeWellKnownTypes are both set, well-known types are also added as files to generate.
+func ImageToCodeGeneratorRequest(
+	image Image,
+	parameter string,
+	includeImports bool,
+	includeWellKnownTypes bool,
+) *pluginpb.CodeGeneratorRequest {
+	return imageToCodeGeneratorRequest(image, parameter, includeImports, includeWellKnownTypes, nil, nil)
 }
 
 // ImagesToCodeGeneratorRequests converts the Images to CodeGeneratorRequests.
 //
 // All non-imports are added as files to generate.
-func ImagesToCodeGeneratorRequests(images []Image, parameter string) []*pluginpb.CodeGeneratorRequest {
+// If includeImports is set, all imports that are not well-known types are also added as files to generate.
+// If includeImports and includeWellKnownTypes are both set, well-known types are also added as files to generate.
+//
+// An import is only added as a file to generate to a single CodeGeneratorRequest, and is
+// never added if it is a non-import in any of the Images, so that no file is generated twice.
+func ImagesToCodeGeneratorRequests(
+	images []Image,
+	parameter string,
+	includeImports bool,
+	includeWellKnownTypes bool,
+) []*pluginpb.CodeGeneratorRequest {
 	requests := make([]*pluginpb.CodeGeneratorRequest, len(images))
+	// we only need to track these if we are including imports
+	var alreadyUsedPaths map[string]struct{}
+	var nonImportPaths map[string]struct{}
+	if includeImports {
+		alreadyUsedPaths = make(map[string]struct{})
+		nonImportPaths = make(map[string]struct{})
+		for _, image := range images {
+			for _, imageFile := range image.Files() {
+				if !imageFile.IsImport() {
+					nonImportPaths[imageFile.Path()] = struct{}{}
+				}
+			}
+		}
+	}
 	for i, image := range images {
-		requests[i] = ImageToCodeGeneratorRequest(image, parameter)
+		requests[i] = imageToCodeGeneratorRequest(
+			image,
+			parameter,
+			includeImports,
+			includeWellKnownTypes,
+			alreadyUsedPaths,
+			nonImportPaths,
+		)
 	}
 	return requests
 }
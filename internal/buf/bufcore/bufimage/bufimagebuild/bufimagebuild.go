@@ -21,6 +21,7 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // Builder builds Protobuf files into Images.
@@ -31,7 +32,9 @@ type Builder interface {
 	// they are unique relative to the roots.
 	//
 	// If an error is returned, it is a system error.
-	// Only one of Image and FileAnnotations will be returned.
+	// Only one of Image and FileAnnotations will be returned, unless WithWarnings
+	// is used, in which case an Image may be returned with FileAnnotations of
+	// SeverityWarning.
 	//
 	// FileAnnotations will use external file paths.
 	Build(
@@ -46,6 +49,11 @@ func NewBuilder(logger *zap.Logger) Builder {
 	return newBuilder(logger)
 }
 
+// DefaultMaxImportDepth is the default maximum import depth.
+//
+// This is high enough to never be reached by a normal import graph.
+const DefaultMaxImportDepth uint32 = 1000
+
 // BuildOption is an option for Build.
 type BuildOption func(*buildOptions)
 
@@ -55,3 +63,91 @@ func WithExcludeSourceCodeInfo() BuildOption {
 		buildOptions.excludeSourceCodeInfo = true
 	}
 }
+
+// WithImportFileDescriptorProtos returns a BuildOption that makes the given
+// FileDescriptorProtos available as imports, for example from a
+// FileDescriptorSet produced by another tool.
+//
+// Files in the ModuleFileSet take precedence over FileDescriptorProtos with the
+// same path. If the same path is given more than once, all FileDescriptorProtos
+// for the path must be equal.
+func WithImportFileDescriptorProtos(fileDescriptorProtos ...*descriptorpb.FileDescriptorProto) BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.importFileDescriptorProtos = append(
+			buildOptions.importFileDescriptorProtos,
+			fileDescriptorProtos...,
+		)
+	}
+}
+
+// WithMaxImportDepth returns a BuildOption that sets the maximum import depth.
+//
+// The import depth of a file is the length of the longest chain of imports
+// starting from this file, that is a file with no imports has depth 0. The
+// build fails if any file has an import depth greater than the maximum.
+//
+// The build fails as soon as a file is reached through a chain of imports
+// longer than the maximum, before the file is parsed, so an import graph that
+// is too deep is not parsed in full.
+//
+// If 0, DefaultMaxImportDepth is used.
+func WithMaxImportDepth(maxImportDepth uint32) BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.maxImportDepth = maxImportDepth
+	}
+}
+
+// WithImportOverride returns a BuildOption that resolves the given import path
+// to the given data, instead of to the file in the ModuleFileSet or to the
+// bundled well-known type with the path.
+//
+// The external path is used for the ImageFile and in FileAnnotations. The path
+// cannot be a target file of the ModuleFileSet. This is meant for forks of and
+// changes to files such as google/protobuf/descriptor.proto, and can result in
+// Images that are incompatible with other tools, so a warning is logged for
+// every override.
+func WithImportOverride(path string, externalPath string, data []byte) BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.importOverrides = append(
+			buildOptions.importOverrides,
+			&importOverride{
+				path:         path,
+				externalPath: externalPath,
+				data:         data,
+			},
+		)
+	}
+}
+
+// IsUnusedImportFileAnnotation returns true if the FileAnnotation is a warning
+// for an unused import, as returned when WithWarnings is used.
+func IsUnusedImportFileAnnotation(fileAnnotation bufanalysis.FileAnnotation) bool {
+	return isUnusedImportFileAnnotation(fileAnnotation)
+}
+
+// WithWarnings returns a BuildOption that returns compiler warnings, such as
+// unused imports, as FileAnnotations of SeverityWarning if the build succeeds.
+//
+// Warnings are otherwise dropped.
+func WithWarnings() BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.warnings = true
+	}
+}
+
+// WithExperimentalEditions returns a BuildOption that enables experimental
+// support for files that declare edition 2023.
+//
+// The FileDescriptorProtos of these files have the syntax "editions", and the
+// edition and the features are written as unknown fields, as the descriptor.proto
+// used by buf predates Protobuf Editions. Constructs of edition 2023 that have
+// no equivalent in proto3, such as default values and closed enums whose first
+// value is not zero, are not supported yet. Images with these files cannot be
+// written as JSON.
+//
+// Without this option, files that declare an edition result in an error.
+func WithExperimentalEditions() BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.experimentalEditions = true
+	}
+}
@@ -18,10 +18,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"regexp"
 	"runtime/debug"
+	"strings"
 	"sync"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufcore"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmoduleprotoparse"
@@ -32,8 +36,21 @@ import (
 	"go.opencensus.io/trace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+const (
+	// unusedImportMessagePrefix and unusedImportMessageSuffix surround the quoted
+	// path of the import in the message of unused import warnings.
+	unusedImportMessagePrefix = "Import "
+	unusedImportMessageSuffix = " is unused."
+)
+
+// editionsRegexp matches files where the first statement is an edition declaration,
+// allowing for leading whitespace and comments.
+var editionsRegexp = regexp.MustCompile(`^(?:\s|//[^\n]*(?:\n|$)|/\*(?s:.*?)\*/)*edition\s*=`)
+
 type builder struct {
 	logger *zap.Logger
 }
@@ -57,6 +74,11 @@ func (b *builder) Build(
 		ctx,
 		moduleFileSet,
 		buildOptions.excludeSourceCodeInfo,
+		buildOptions.importFileDescriptorProtos,
+		buildOptions.maxImportDepth,
+		buildOptions.warnings,
+		buildOptions.importOverrides,
+		buildOptions.experimentalEditions,
 	)
 }
 
@@ -64,11 +86,19 @@ func (b *builder) build(
 	ctx context.Context,
 	moduleFileSet bufmodule.ModuleFileSet,
 	excludeSourceCodeInfo bool,
+	importFileDescriptorProtos []*descriptorpb.FileDescriptorProto,
+	maxImportDepth uint32,
+	warnings bool,
+	importOverrides []*importOverride,
+	experimentalEditions bool,
 ) (bufimage.Image, []bufanalysis.FileAnnotation, error) {
 	ctx, span := trace.StartSpan(ctx, "build")
 	defer span.End()
 
-	parserAccessorHandler := bufmoduleprotoparse.NewParserAccessorHandler(ctx, moduleFileSet)
+	pathToImportFileDescriptorProto, err := getPathToImportFileDescriptorProto(importFileDescriptorProtos)
+	if err != nil {
+		return nil, nil, err
+	}
 	targetFileInfos, err := moduleFileSet.TargetFileInfos(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -77,15 +107,52 @@ func (b *builder) build(
 		return nil, nil, errors.New("no input files specified")
 	}
 	paths := make([]string, len(targetFileInfos))
+	pathMap := make(map[string]struct{}, len(targetFileInfos))
 	for i, targetFileInfo := range targetFileInfos {
 		paths[i] = targetFileInfo.Path()
+		pathMap[targetFileInfo.Path()] = struct{}{}
+	}
+	parserAccessorHandlerOptions := make([]bufmoduleprotoparse.ParserAccessorHandlerOption, 0, len(importOverrides))
+	for _, importOverride := range importOverrides {
+		if _, ok := pathMap[importOverride.path]; ok {
+			return nil, nil, fmt.Errorf("cannot override import %q as it is an input file", importOverride.path)
+		}
+		b.logger.Sugar().Warnf(
+			"import %q is overridden by %s, the resulting image may be incompatible with tools that use the original file",
+			importOverride.path,
+			importOverride.externalPath,
+		)
+		parserAccessorHandlerOptions = append(
+			parserAccessorHandlerOptions,
+			bufmoduleprotoparse.ParserAccessorHandlerWithOverride(
+				importOverride.path,
+				importOverride.externalPath,
+				importOverride.data,
+			),
+		)
+	}
+	parserAccessorHandler := bufmoduleprotoparse.NewParserAccessorHandler(
+		ctx,
+		moduleFileSet,
+		parserAccessorHandlerOptions...,
+	)
+	var editionsAccessor *editionsAccessor
+	if experimentalEditions {
+		editionsAccessor = newEditionsAccessor(parserAccessorHandler.Open)
+	}
+
+	if maxImportDepth == 0 {
+		maxImportDepth = DefaultMaxImportDepth
 	}
 
 	buildResults := getBuildResults(
 		ctx,
 		parserAccessorHandler,
+		editionsAccessor,
+		pathToImportFileDescriptorProto,
 		paths,
 		excludeSourceCodeInfo,
+		maxImportDepth,
 	)
 	var buildResultErr error
 	for _, buildResult := range buildResults {
@@ -98,6 +165,13 @@ func (b *builder) build(
 	for _, buildResult := range buildResults {
 		fileAnnotations = append(fileAnnotations, buildResult.FileAnnotations...)
 	}
+	if editionsAccessor != nil {
+		editionsFileAnnotations, err := editionsAccessor.getFileAnnotations(ctx, parserAccessorHandler)
+		if err != nil {
+			return nil, nil, err
+		}
+		fileAnnotations = mergeEditionsFileAnnotations(fileAnnotations, editionsFileAnnotations)
+	}
 	if len(fileAnnotations) > 0 {
 		bufanalysis.SortFileAnnotations(fileAnnotations)
 		return nil, fileAnnotations, nil
@@ -107,23 +181,43 @@ func (b *builder) build(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := checkMaxImportDepth(descFileDescriptors, maxImportDepth); err != nil {
+		return nil, nil, err
+	}
 	image, err := getImage(
 		ctx,
 		excludeSourceCodeInfo,
 		descFileDescriptors,
 		parserAccessorHandler,
+		editionsAccessor,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
-	return image, nil, nil
+	if !warnings {
+		return image, nil, nil
+	}
+	var warningFileAnnotations []bufanalysis.FileAnnotation
+	for _, buildResult := range buildResults {
+		warningFileAnnotations = append(warningFileAnnotations, buildResult.WarningFileAnnotations...)
+	}
+	unusedImportFileAnnotations, err := getUnusedImportFileAnnotations(descFileDescriptors, parserAccessorHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+	warningFileAnnotations = append(warningFileAnnotations, unusedImportFileAnnotations...)
+	bufanalysis.SortFileAnnotations(warningFileAnnotations)
+	return image, warningFileAnnotations, nil
 }
 
 func getBuildResults(
 	ctx context.Context,
 	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+	editionsAccessor *editionsAccessor,
+	pathToImportFileDescriptorProto map[string]*descriptorpb.FileDescriptorProto,
 	paths []string,
 	excludeSourceCodeInfo bool,
+	maxImportDepth uint32,
 ) []*buildResult {
 	ctx, span := trace.StartSpan(ctx, "parse")
 	defer span.End()
@@ -159,8 +253,11 @@ func getBuildResults(
 			buildResult = getBuildResult(
 				ctx,
 				parserAccessorHandler,
+				editionsAccessor,
+				pathToImportFileDescriptorProto,
 				iPaths,
 				excludeSourceCodeInfo,
+				maxImportDepth,
 			)
 		}()
 	}
@@ -178,14 +275,25 @@ func getBuildResults(
 func getBuildResult(
 	ctx context.Context,
 	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+	editionsAccessor *editionsAccessor,
+	pathToImportFileDescriptorProto map[string]*descriptorpb.FileDescriptorProto,
 	paths []string,
 	excludeSourceCodeInfo bool,
+	maxImportDepth uint32,
 ) *buildResult {
 	var errorsWithPos []protoparse.ErrorWithPos
+	var warningsWithPos []protoparse.ErrorWithPos
 	var lock sync.Mutex
+	accessor := parserAccessorHandler.Open
+	if editionsAccessor != nil {
+		accessor = editionsAccessor.Open
+	}
+	// the import depth is tracked per Parser, as every Parser opens the
+	// files of its import graph depth-first
+	importDepthAccessor := newImportDepthAccessor(accessor, maxImportDepth)
 	parser := protoparse.Parser{
 		IncludeSourceCodeInfo: !excludeSourceCodeInfo,
-		Accessor:              parserAccessorHandler.Open,
+		Accessor:              importDepthAccessor.Open,
 		ErrorReporter: func(errorWithPos protoparse.ErrorWithPos) error {
 			// protoparse isn't concurrent right now but just to be safe
 			// for the future
@@ -195,9 +303,29 @@ func getBuildResult(
 			// continue parsing
 			return nil
 		},
+		WarningReporter: func(errorWithPos protoparse.ErrorWithPos) {
+			lock.Lock()
+			warningsWithPos = append(warningsWithPos, errorWithPos)
+			lock.Unlock()
+		},
+	}
+	if len(pathToImportFileDescriptorProto) > 0 {
+		// the Accessor is consulted before LookupImportProto, so files in the
+		// ModuleFileSet take precedence over the import FileDescriptorProtos
+		parser.LookupImportProto = func(path string) (*descriptorpb.FileDescriptorProto, error) {
+			if fileDescriptorProto, ok := pathToImportFileDescriptorProto[path]; ok {
+				return fileDescriptorProto, nil
+			}
+			return nil, fmt.Errorf("%s: not found in import FileDescriptorProtos", path)
+		}
 	}
 	// fileDescriptors are in the same order as paths per the documentation
 	descFileDescriptors, err := parser.ParseFiles(paths...)
+	// the Parser reports the import depth error as an error for the import,
+	// and falls back to the well-known types, so we return it directly
+	if importDepthErr := importDepthAccessor.Err(); importDepthErr != nil {
+		return newBuildResult(nil, nil, importDepthErr)
+	}
 	if err != nil {
 		if err == protoparse.ErrInvalidSource {
 			if len(errorsWithPos) == 0 {
@@ -207,10 +335,17 @@ func getBuildResult(
 					errors.New("got invalid source error from parse but no errors reported"),
 				)
 			}
+			if editionsAccessor == nil {
+				errorsWithPos, err = replaceEditionsErrors(parserAccessorHandler, errorsWithPos)
+				if err != nil {
+					return newBuildResult(nil, nil, err)
+				}
+			}
 			fileAnnotations, err := bufmoduleprotoparse.GetFileAnnotations(
 				ctx,
 				parserAccessorHandler,
 				errorsWithPos,
+				bufanalysis.SeverityError,
 			)
 			if err != nil {
 				return newBuildResult(nil, nil, err)
@@ -246,7 +381,250 @@ func getBuildResult(
 			)
 		}
 	}
-	return newBuildResult(descFileDescriptors, nil, nil)
+	// files that are not in paths are parsed as imports, possibly by multiple
+	// parsers, so we only keep the warnings for paths to not duplicate warnings
+	pathMap := stringutil.SliceToMap(paths)
+	var pathWarningsWithPos []protoparse.ErrorWithPos
+	for _, warningWithPos := range warningsWithPos {
+		if _, ok := pathMap[getErrorWithPosFilename(warningWithPos)]; ok {
+			pathWarningsWithPos = append(pathWarningsWithPos, warningWithPos)
+		}
+	}
+	warningFileAnnotations, err := bufmoduleprotoparse.GetFileAnnotations(
+		ctx,
+		parserAccessorHandler,
+		pathWarningsWithPos,
+		bufanalysis.SeverityWarning,
+	)
+	if err != nil {
+		return newBuildResult(nil, nil, err)
+	}
+	buildResult := newBuildResult(descFileDescriptors, nil, nil)
+	buildResult.WarningFileAnnotations = warningFileAnnotations
+	return buildResult
+}
+
+func getErrorWithPosFilename(errorWithPos protoparse.ErrorWithPos) string {
+	if errorWithSourcePos, ok := errorWithPos.(protoparse.ErrorWithSourcePos); ok && errorWithSourcePos.Pos != nil {
+		return errorWithSourcePos.Pos.Filename
+	}
+	return ""
+}
+
+// getUnusedImportFileAnnotations returns warnings for the imports of the given
+// files that are not used.
+//
+// This is conservative, and only reports imports that are neither public nor weak,
+// and that do not define extensions, as these may be used by custom options.
+// An import is used if a type of the import, or of a file publicly imported by the
+// import, is referenced.
+func getUnusedImportFileAnnotations(
+	descFileDescriptors []*desc.FileDescriptor,
+	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+) ([]bufanalysis.FileAnnotation, error) {
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, descFileDescriptor := range descFileDescriptors {
+		usedPaths := getUsedPaths(descFileDescriptor)
+		fileDescriptorProto := descFileDescriptor.AsFileDescriptorProto()
+		nonRegularDependencyIndexes := make(map[int32]struct{})
+		for _, index := range fileDescriptorProto.GetPublicDependency() {
+			nonRegularDependencyIndexes[index] = struct{}{}
+		}
+		for _, index := range fileDescriptorProto.GetWeakDependency() {
+			nonRegularDependencyIndexes[index] = struct{}{}
+		}
+		for i, dependency := range descFileDescriptor.GetDependencies() {
+			if _, ok := nonRegularDependencyIndexes[int32(i)]; ok {
+				continue
+			}
+			if isImportUsed(dependency, usedPaths) {
+				continue
+			}
+			fileAnnotation, err := getUnusedImportFileAnnotation(
+				descFileDescriptor,
+				int32(i),
+				dependency.GetName(),
+				parserAccessorHandler,
+			)
+			if err != nil {
+				return nil, err
+			}
+			fileAnnotations = append(fileAnnotations, fileAnnotation)
+		}
+	}
+	return fileAnnotations, nil
+}
+
+func isImportUsed(dependency *desc.FileDescriptor, usedPaths map[string]struct{}) bool {
+	if _, ok := usedPaths[dependency.GetName()]; ok {
+		return true
+	}
+	if len(dependency.GetExtensions()) > 0 || hasNestedExtensions(dependency.GetMessageTypes()) {
+		return true
+	}
+	for _, publicDependency := range dependency.GetPublicDependencies() {
+		if isImportUsed(publicDependency, usedPaths) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasNestedExtensions(messageDescriptors []*desc.MessageDescriptor) bool {
+	for _, messageDescriptor := range messageDescriptors {
+		if len(messageDescriptor.GetNestedExtensions()) > 0 || hasNestedExtensions(messageDescriptor.GetNestedMessageTypes()) {
+			return true
+		}
+	}
+	return false
+}
+
+// getUsedPaths gets the paths of the files whose types are referenced by the file.
+func getUsedPaths(descFileDescriptor *desc.FileDescriptor) map[string]struct{} {
+	usedPaths := make(map[string]struct{})
+	addFieldDescriptors := func(fieldDescriptors []*desc.FieldDescriptor) {
+		for _, fieldDescriptor := range fieldDescriptors {
+			if messageDescriptor := fieldDescriptor.GetMessageType(); messageDescriptor != nil {
+				usedPaths[messageDescriptor.GetFile().GetName()] = struct{}{}
+			}
+			if enumDescriptor := fieldDescriptor.GetEnumType(); enumDescriptor != nil {
+				usedPaths[enumDescriptor.GetFile().GetName()] = struct{}{}
+			}
+			if fieldDescriptor.IsExtension() {
+				usedPaths[fieldDescriptor.GetOwner().GetFile().GetName()] = struct{}{}
+			}
+		}
+	}
+	var addMessageDescriptors func([]*desc.MessageDescriptor)
+	addMessageDescriptors = func(messageDescriptors []*desc.MessageDescriptor) {
+		for _, messageDescriptor := range messageDescriptors {
+			addFieldDescriptors(messageDescriptor.GetFields())
+			addFieldDescriptors(messageDescriptor.GetNestedExtensions())
+			addMessageDescriptors(messageDescriptor.GetNestedMessageTypes())
+		}
+	}
+	addMessageDescriptors(descFileDescriptor.GetMessageTypes())
+	addFieldDescriptors(descFileDescriptor.GetExtensions())
+	for _, serviceDescriptor := range descFileDescriptor.GetServices() {
+		for _, methodDescriptor := range serviceDescriptor.GetMethods() {
+			usedPaths[methodDescriptor.GetInputType().GetFile().GetName()] = struct{}{}
+			usedPaths[methodDescriptor.GetOutputType().GetFile().GetName()] = struct{}{}
+		}
+	}
+	return usedPaths
+}
+
+func getUnusedImportFileAnnotation(
+	descFileDescriptor *desc.FileDescriptor,
+	dependencyIndex int32,
+	dependencyPath string,
+	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+) (bufanalysis.FileAnnotation, error) {
+	path := descFileDescriptor.GetName()
+	fileInfo, err := bufcore.NewFileInfo(
+		path,
+		parserAccessorHandler.ExternalPath(path),
+		parserAccessorHandler.IsImport(path),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var startLine, startColumn, endLine, endColumn int
+	for _, location := range descFileDescriptor.AsFileDescriptorProto().GetSourceCodeInfo().GetLocation() {
+		// 3 is the field number of dependency in FileDescriptorProto
+		if sourcePath := location.GetPath(); len(sourcePath) == 2 && sourcePath[0] == 3 && sourcePath[1] == dependencyIndex {
+			span := location.GetSpan()
+			switch len(span) {
+			case 3:
+				startLine, startColumn, endLine, endColumn = int(span[0])+1, int(span[1])+1, int(span[0])+1, int(span[2])+1
+			case 4:
+				startLine, startColumn, endLine, endColumn = int(span[0])+1, int(span[1])+1, int(span[2])+1, int(span[3])+1
+			}
+			break
+		}
+	}
+	return bufanalysis.NewFileAnnotationWithSeverity(
+		fileInfo,
+		startLine,
+		startColumn,
+		endLine,
+		endColumn,
+		"COMPILE",
+		fmt.Sprintf(unusedImportMessagePrefix+"%q"+unusedImportMessageSuffix, dependencyPath),
+		bufanalysis.SeverityWarning,
+	), nil
+}
+
+func isUnusedImportFileAnnotation(fileAnnotation bufanalysis.FileAnnotation) bool {
+	message := fileAnnotation.Message()
+	return fileAnnotation.Type() == "COMPILE" &&
+		fileAnnotation.Severity() == bufanalysis.SeverityWarning &&
+		strings.HasPrefix(message, unusedImportMessagePrefix) &&
+		strings.HasSuffix(message, unusedImportMessageSuffix)
+}
+
+// replaceEditionsErrors replaces all the errors for files that use Protobuf
+// Editions with a single error saying that Editions are not supported
+// without WithExperimentalEditions.
+//
+// The parser does not support Editions, and the errors it reports for these
+// files are confusing, as it tries to interpret them as proto2 files.
+func replaceEditionsErrors(
+	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+	errorsWithPos []protoparse.ErrorWithPos,
+) ([]protoparse.ErrorWithPos, error) {
+	filenameToIsEditions := make(map[string]bool)
+	newErrorsWithPos := make([]protoparse.ErrorWithPos, 0, len(errorsWithPos))
+	for _, errorWithPos := range errorsWithPos {
+		sourcePos := errorWithPos.GetPosition()
+		filename := sourcePos.Filename
+		isEditions, ok := filenameToIsEditions[filename]
+		if !ok {
+			var err error
+			isEditions, err = isEditionsFile(parserAccessorHandler, filename)
+			if err != nil {
+				return nil, err
+			}
+			filenameToIsEditions[filename] = isEditions
+			if isEditions {
+				// the first error is the one for the edition declaration itself
+				newErrorsWithPos = append(
+					newErrorsWithPos,
+					protoparse.ErrorWithSourcePos{
+						Underlying: errors.New("Protobuf Editions are not supported unless experimental support is enabled with --experimental-editions."),
+						Pos:        &sourcePos,
+					},
+				)
+				continue
+			}
+		}
+		if !isEditions {
+			newErrorsWithPos = append(newErrorsWithPos, errorWithPos)
+		}
+	}
+	return newErrorsWithPos, nil
+}
+
+func isEditionsFile(
+	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+	filename string,
+) (_ bool, retErr error) {
+	if filename == "" {
+		return false, nil
+	}
+	readCloser, err := parserAccessorHandler.Open(filename)
+	if err != nil {
+		// the file will have an error reported for it by GetFileAnnotations anyways
+		return false, nil
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readCloser.Close())
+	}()
+	data, err := ioutil.ReadAll(readCloser)
+	if err != nil {
+		return false, err
+	}
+	return editionsRegexp.Match(data), nil
 }
 
 func getDescFileDescriptorsFromBuildResults(
@@ -311,6 +689,7 @@ func getImage(
 	excludeSourceCodeInfo bool,
 	sortedFileDescriptors []*desc.FileDescriptor,
 	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+	editionsAccessor *editionsAccessor,
 ) (bufimage.Image, error) {
 	ctx, span := trace.StartSpan(ctx, "get_image")
 	defer span.End()
@@ -337,6 +716,7 @@ func getImage(
 			excludeSourceCodeInfo,
 			fileDescriptor,
 			parserAccessorHandler,
+			editionsAccessor,
 			alreadySeen,
 			nonImportFilenames,
 			imageFiles,
@@ -353,6 +733,7 @@ func getImageFilesRec(
 	excludeSourceCodeInfo bool,
 	descFileDescriptor *desc.FileDescriptor,
 	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+	editionsAccessor *editionsAccessor,
 	alreadySeen map[string]struct{},
 	nonImportFilenames map[string]struct{},
 	imageFiles []bufimage.ImageFile,
@@ -373,6 +754,7 @@ func getImageFilesRec(
 			excludeSourceCodeInfo,
 			dependency,
 			parserAccessorHandler,
+			editionsAccessor,
 			alreadySeen,
 			nonImportFilenames,
 			imageFiles,
@@ -390,6 +772,18 @@ func getImageFilesRec(
 		// need to do this anyways as Parser does not respect this for FileDescriptorProtos
 		fileDescriptorProto.SourceCodeInfo = nil
 	}
+	if editionsAccessor != nil {
+		if editionsFile := editionsAccessor.getEditionsFile(path); editionsFile != nil {
+			if err := applyEditionsFile(fileDescriptorProto, editionsFile); err != nil {
+				return nil, fmt.Errorf("%s: %v", path, err)
+			}
+		}
+	}
+	// custom options are serialized in the order they are interpreted, so we sort
+	// them to get the same Image for the same options regardless of declaration order
+	if err := bufimage.SortFileDescriptorProtoOptions(fileDescriptorProto); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
 	_, isNotImport := nonImportFilenames[path]
 	imageFile, err := bufimage.NewImageFile(
 		fileDescriptorProto,
@@ -404,10 +798,63 @@ func getImageFilesRec(
 	return append(imageFiles, imageFile), nil
 }
 
+// checkMaxImportDepth returns an error containing the deepest import chain
+// if the import depth of any file is greater than maxImportDepth.
+//
+// Most import graphs that are too deep are rejected by importDepthAccessor
+// while they are parsed, this checks the chains it cannot see.
+func checkMaxImportDepth(descFileDescriptors []*desc.FileDescriptor, maxImportDepth uint32) error {
+	pathToImportDepth := make(map[string]uint32)
+	// the import of each path that is on the deepest chain, if any
+	pathToDeepestImport := make(map[string]*desc.FileDescriptor)
+	var deepest *desc.FileDescriptor
+	for _, descFileDescriptor := range descFileDescriptors {
+		importDepth := getImportDepthRec(descFileDescriptor, pathToImportDepth, pathToDeepestImport)
+		if deepest == nil || importDepth > pathToImportDepth[deepest.GetName()] {
+			deepest = descFileDescriptor
+		}
+	}
+	if deepest == nil || pathToImportDepth[deepest.GetName()] <= maxImportDepth {
+		return nil
+	}
+	var chain []string
+	for descFileDescriptor := deepest; descFileDescriptor != nil; descFileDescriptor = pathToDeepestImport[descFileDescriptor.GetName()] {
+		chain = append(chain, descFileDescriptor.GetName())
+	}
+	return fmt.Errorf(
+		"import depth of %d exceeds the maximum import depth of %d: %s",
+		pathToImportDepth[deepest.GetName()],
+		maxImportDepth,
+		strings.Join(chain, " -> "),
+	)
+}
+
+func getImportDepthRec(
+	descFileDescriptor *desc.FileDescriptor,
+	pathToImportDepth map[string]uint32,
+	pathToDeepestImport map[string]*desc.FileDescriptor,
+) uint32 {
+	path := descFileDescriptor.GetName()
+	if importDepth, ok := pathToImportDepth[path]; ok {
+		return importDepth
+	}
+	var importDepth uint32
+	for _, dependency := range descFileDescriptor.GetDependencies() {
+		if dependencyImportDepth := getImportDepthRec(dependency, pathToImportDepth, pathToDeepestImport) + 1; dependencyImportDepth > importDepth {
+			importDepth = dependencyImportDepth
+			pathToDeepestImport[path] = dependency
+		}
+	}
+	pathToImportDepth[path] = importDepth
+	return importDepth
+}
+
 type buildResult struct {
 	DescFileDescriptors []*desc.FileDescriptor
 	FileAnnotations     []bufanalysis.FileAnnotation
 	Err                 error
+	// WarningFileAnnotations are only set if the build succeeded.
+	WarningFileAnnotations []bufanalysis.FileAnnotation
 }
 
 func newBuildResult(
@@ -422,10 +869,45 @@ func newBuildResult(
 	}
 }
 
+// getPathToImportFileDescriptorProto returns a map from path to import FileDescriptorProto.
+//
+// A path may appear more than once only if all the FileDescriptorProtos for
+// the path are equal.
+func getPathToImportFileDescriptorProto(
+	importFileDescriptorProtos []*descriptorpb.FileDescriptorProto,
+) (map[string]*descriptorpb.FileDescriptorProto, error) {
+	pathToImportFileDescriptorProto := make(map[string]*descriptorpb.FileDescriptorProto, len(importFileDescriptorProtos))
+	for _, importFileDescriptorProto := range importFileDescriptorProtos {
+		path := importFileDescriptorProto.GetName()
+		if path == "" {
+			return nil, errors.New("import FileDescriptorProto has no name")
+		}
+		if existing, ok := pathToImportFileDescriptorProto[path]; ok {
+			if !proto.Equal(existing, importFileDescriptorProto) {
+				return nil, fmt.Errorf("%s was provided multiple times with different contents", path)
+			}
+			continue
+		}
+		pathToImportFileDescriptorProto[path] = importFileDescriptorProto
+	}
+	return pathToImportFileDescriptorProto, nil
+}
+
 type buildOptions struct {
-	excludeSourceCodeInfo bool
+	excludeSourceCodeInfo      bool
+	importFileDescriptorProtos []*descriptorpb.FileDescriptorProto
+	maxImportDepth             uint32
+	warnings                   bool
+	importOverrides            []*importOverride
+	experimentalEditions       bool
 }
 
 func newBuildOptions() *buildOptions {
 	return &buildOptions{}
 }
+
+type importOverride struct {
+	path         string
+	externalPath string
+	data         []byte
+}
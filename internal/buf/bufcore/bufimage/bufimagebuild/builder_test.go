@@ -17,8 +17,12 @@ package bufimagebuild
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
@@ -27,12 +31,17 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/internal/buf/internal/buftesting"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
 	"github.com/bufbuild/buf/internal/pkg/protosource"
 	"github.com/bufbuild/buf/internal/pkg/prototesting"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -211,6 +220,110 @@ func TestCustomOptionsError1(t *testing.T) {
 	)
 }
 
+func TestEditions1(t *testing.T) {
+	t.Parallel()
+	_, fileAnnotations := testBuild(t, false, filepath.Join("testdata", "editions1"))
+	require.Equal(t, 1, len(fileAnnotations), fileAnnotations)
+	require.Equal(t, "a.proto", fileAnnotations[0].FileInfo().Path())
+	require.Equal(t, 1, fileAnnotations[0].StartLine())
+	require.Equal(
+		t,
+		"Protobuf Editions are not supported unless experimental support is enabled with --experimental-editions.",
+		fileAnnotations[0].Message(),
+	)
+}
+
+func TestEditions2(t *testing.T) {
+	t.Parallel()
+	moduleFileSet := testGetModuleFileSet(t, filepath.Join("testdata", "editions2"))
+	image, fileAnnotations, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithExperimentalEditions(),
+	)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	fileDescriptorProto := image.GetFile("a.proto").Proto()
+	require.Equal(t, "editions", fileDescriptorProto.GetSyntax())
+	require.Equal(
+		t,
+		protowire.AppendVarint(protowire.AppendTag(nil, 14, protowire.VarintType), 1000),
+		[]byte(fileDescriptorProto.ProtoReflect().GetUnknown()),
+	)
+	require.Equal(t, "a", fileDescriptorProto.GetOptions().GetGoPackage())
+	require.Equal(t, testFeatures(50, 1, 2), []byte(fileDescriptorProto.GetOptions().ProtoReflect().GetUnknown()))
+	messageType := fileDescriptorProto.GetMessageType()[0]
+	require.Equal(t, testFeatures(12, 6, 2), []byte(messageType.GetOptions().ProtoReflect().GetUnknown()))
+	fields := messageType.GetField()
+	require.Equal(t, testFeatures(21, 1, 1), []byte(fields[0].GetOptions().ProtoReflect().GetUnknown()))
+	require.True(t, fields[1].GetOptions().GetDeprecated())
+	require.Equal(t, testFeatures(21, 3, 2), []byte(fields[1].GetOptions().ProtoReflect().GetUnknown()))
+	require.True(t, fields[2].GetOptions().GetDeprecated())
+	require.Equal(t, testFeatures(21, 5, 2), []byte(fields[2].GetOptions().ProtoReflect().GetUnknown()))
+	require.Nil(t, fields[3].GetOptions())
+	require.Equal(
+		t,
+		testFeatures(21, 4, 3),
+		[]byte(messageType.GetNestedType()[0].GetField()[0].GetOptions().ProtoReflect().GetUnknown()),
+	)
+	require.Equal(t, testFeatures(7, 2, 2), []byte(fileDescriptorProto.GetEnumType()[0].GetOptions().ProtoReflect().GetUnknown()))
+	// the source code info has the positions of the original file
+	var found bool
+	for _, location := range fileDescriptorProto.GetSourceCodeInfo().GetLocation() {
+		// message_type 0, field 1
+		if len(location.GetPath()) == 4 && location.GetPath()[0] == 4 && location.GetPath()[2] == 2 && location.GetPath()[3] == 1 {
+			require.Equal(t, []int32{11, 2, 90}, location.GetSpan())
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestEditions3(t *testing.T) {
+	t.Parallel()
+	moduleFileSet := testGetModuleFileSet(t, filepath.Join("testdata", "editions3"))
+	image, fileAnnotations, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithExperimentalEditions(),
+	)
+	require.NoError(t, err)
+	require.Nil(t, image)
+	messages := make([]string, len(fileAnnotations))
+	for i, fileAnnotation := range fileAnnotations {
+		messages[i] = fmt.Sprintf("%s:%d:%d:%s", fileAnnotation.FileInfo().Path(), fileAnnotation.StartLine(), fileAnnotation.StartColumn(), fileAnnotation.Message())
+	}
+	require.Equal(
+		t,
+		[]string{
+			`a.proto:6:8:feature "enum_type" is already set`,
+			`a.proto:7:17:unknown feature "unknown"`,
+			`a.proto:10:10:feature "field_presence" cannot be set on messages`,
+			`a.proto:11:3:label optional is not allowed in editions, fields have explicit presence by default`,
+			`a.proto:12:44:the value of feature "field_presence" must be one of EXPLICIT, IMPLICIT, LEGACY_REQUIRED`,
+		},
+		messages,
+	)
+}
+
+func TestEditions4(t *testing.T) {
+	t.Parallel()
+	moduleFileSet := testGetModuleFileSet(t, filepath.Join("testdata", "editions4"))
+	_, fileAnnotations, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithExperimentalEditions(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileAnnotations), fileAnnotations)
+	require.Equal(t, 1, fileAnnotations[0].StartLine())
+	require.Equal(
+		t,
+		`edition "2024" is not supported, the only supported edition is "2023"`,
+		fileAnnotations[0].Message(),
+	)
+}
+
 func TestOptionPanic(t *testing.T) {
 	t.Parallel()
 	require.NotPanics(t, func() {
@@ -223,6 +336,67 @@ func TestOptionPanic(t *testing.T) {
 	})
 }
 
+func TestMaxImportDepth(t *testing.T) {
+	t.Parallel()
+	moduleFileSet := testGetModuleFileSet(t, filepath.Join("testdata", "importdepth1"))
+	_, _, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithMaxImportDepth(1),
+	)
+	require.EqualError(t, err, "import depth of 2 exceeds the maximum import depth of 1: a.proto -> b.proto -> c.proto")
+	_, fileAnnotations, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithMaxImportDepth(2),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(fileAnnotations), fileAnnotations)
+}
+
+func TestImportDepthAccessor(t *testing.T) {
+	t.Parallel()
+	pathToData := map[string]string{
+		"a.proto": `syntax = "proto3";
+// import "d.proto";
+import "b.proto";
+import public 'c.proto';
+option go_package = "import \"d.proto\";";
+`,
+		"b.proto": `syntax = "proto3";
+import "c.proto";
+`,
+		"c.proto": `syntax = "proto3";
+import "d.proto";
+`,
+		"d.proto": `not a proto file`,
+	}
+	var openedPaths []string
+	importDepthAccessor := newImportDepthAccessor(
+		func(path string) (io.ReadCloser, error) {
+			openedPaths = append(openedPaths, path)
+			data, ok := pathToData[path]
+			if !ok {
+				return nil, fmt.Errorf("%s: not found", path)
+			}
+			return ioutil.NopCloser(strings.NewReader(data)), nil
+		},
+		1,
+	)
+	parser := protoparse.Parser{
+		Accessor: importDepthAccessor.Open,
+	}
+	_, err := parser.ParseFiles("a.proto")
+	require.Error(t, err)
+	// the longest chain to c.proto is through b.proto, and d.proto is never opened
+	require.EqualError(
+		t,
+		importDepthAccessor.Err(),
+		"import depth of 2 exceeds the maximum import depth of 1: a.proto -> b.proto -> c.proto",
+	)
+	assert.Equal(t, []string{"a.proto", "b.proto"}, openedPaths)
+}
+
 func TestCompareSemicolons(t *testing.T) {
 	t.Parallel()
 	testCompare(t, "semicolons")
@@ -316,3 +490,106 @@ func testGetImageImportPaths(image bufimage.Image) []string {
 	sort.Strings(importNames)
 	return importNames
 }
+
+func TestCustomOptionsSorted(t *testing.T) {
+	t.Parallel()
+	dirPath := filepath.Join("testdata", "customoptions2")
+	image, fileAnnotations := testBuild(t, false, dirPath)
+	require.Equal(t, 0, len(fileAnnotations), fileAnnotations)
+	fileDescriptorProto := image.GetFile("a.proto").Proto()
+	messageDescriptorProto := fileDescriptorProto.GetMessageType()[1]
+	for _, options := range []proto.Message{
+		fileDescriptorProto.GetOptions(),
+		messageDescriptorProto.GetOptions(),
+		messageDescriptorProto.GetField()[0].GetOptions(),
+	} {
+		unknownFieldNumbers := testGetUnknownFieldNumbers(t, options)
+		require.NotEmpty(t, unknownFieldNumbers)
+		require.True(t, sort.SliceIsSorted(unknownFieldNumbers, func(i int, j int) bool { return unknownFieldNumbers[i] < unknownFieldNumbers[j] }), unknownFieldNumbers)
+	}
+	require.Equal(
+		t,
+		[]protowire.Number{50001, 50002, 50003},
+		testGetUnknownFieldNumbers(t, messageDescriptorProto.GetOptions()),
+	)
+	data, err := protoencoding.NewWireMarshaler().Marshal(bufimage.ImageToProtoImage(image))
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		otherImage, fileAnnotations := testBuild(t, false, dirPath)
+		require.Equal(t, 0, len(fileAnnotations), fileAnnotations)
+		otherData, err := protoencoding.NewWireMarshaler().Marshal(bufimage.ImageToProtoImage(otherImage))
+		require.NoError(t, err)
+		require.Equal(t, data, otherData)
+	}
+}
+
+func testGetUnknownFieldNumbers(t *testing.T, message proto.Message) []protowire.Number {
+	var fieldNumbers []protowire.Number
+	unknown := message.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		fieldNumber, _, length := protowire.ConsumeField(unknown)
+		require.True(t, length > 0)
+		fieldNumbers = append(fieldNumbers, fieldNumber)
+		unknown = unknown[length:]
+	}
+	return fieldNumbers
+}
+
+// testFeatures returns a FeatureSet with the feature in the field with the number.
+func testFeatures(number protowire.Number, featureNumber protowire.Number, value uint64) []byte {
+	featureSet := protowire.AppendVarint(protowire.AppendTag(nil, featureNumber, protowire.VarintType), value)
+	return protowire.AppendBytes(protowire.AppendTag(nil, number, protowire.BytesType), featureSet)
+}
+
+func TestImportOverride(t *testing.T) {
+	t.Parallel()
+	moduleFileSet := testGetModuleFileSet(t, filepath.Join("testdata", "importoverride1"))
+	// b.proto only exists as an override
+	image, fileAnnotations, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithImportOverride(
+			"b.proto",
+			"override/b.proto",
+			[]byte(`syntax = "proto3"; package b; message B { string overridden = 1; }`),
+		),
+	)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	imageFile := image.GetFile("b.proto")
+	require.NotNil(t, imageFile)
+	require.Equal(t, "override/b.proto", imageFile.ExternalPath())
+	require.True(t, imageFile.IsImport())
+	require.Equal(t, "overridden", imageFile.Proto().GetMessageType()[0].GetField()[0].GetName())
+	_, _, err = NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithImportOverride("a.proto", "override/a.proto", []byte(`syntax = "proto3";`)),
+	)
+	require.EqualError(t, err, `cannot override import "a.proto" as it is an input file`)
+	// a well-known type can be patched, and this is warned about
+	core, observedLogs := observer.New(zap.WarnLevel)
+	image, fileAnnotations, err = NewBuilder(zap.New(core)).Build(
+		context.Background(),
+		testGetModuleFileSet(t, filepath.Join("testdata", "importoverride2")),
+		WithImportOverride(
+			"google/protobuf/descriptor.proto",
+			"override/descriptor.proto",
+			[]byte(`syntax = "proto2"; package google.protobuf; message FileOptions { optional string patched = 1; }`),
+		),
+	)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	imageFile = image.GetFile("google/protobuf/descriptor.proto")
+	require.NotNil(t, imageFile)
+	require.Equal(t, "override/descriptor.proto", imageFile.ExternalPath())
+	require.True(t, imageFile.IsImport())
+	require.Len(t, imageFile.Proto().GetMessageType(), 1)
+	require.Equal(t, "patched", imageFile.Proto().GetMessageType()[0].GetField()[0].GetName())
+	require.Len(t, observedLogs.All(), 1)
+	assert.Equal(
+		t,
+		`import "google/protobuf/descriptor.proto" is overridden by override/descriptor.proto, the resulting image may be incompatible with tools that use the original file`,
+		observedLogs.All()[0].Message,
+	)
+}
@@ -0,0 +1,873 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimagebuild
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmoduleprotoparse"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/desc/protoparse/ast"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// editionsSyntax is the value of the syntax field of FileDescriptorProtos
+	// of files that declare an edition.
+	editionsSyntax = "editions"
+	// supportedEdition is the only edition that can be built.
+	supportedEdition = "2023"
+	// edition2023 is the value of EDITION_2023 in the google.protobuf.Edition enum.
+	edition2023 = 1000
+	// fileDescriptorProtoEditionNumber is the number of the edition field of
+	// google.protobuf.FileDescriptorProto. The descriptor.proto vendored in this
+	// tree predates editions, so the field is written as an unknown field.
+	fileDescriptorProtoEditionNumber protowire.Number = 14
+)
+
+// editionDeclarationRegexp matches the edition declaration of files where the
+// first statement is an edition declaration, allowing for leading whitespace
+// and comments.
+//
+// The first submatch is the declaration, and the second or third submatch is
+// the edition.
+var editionDeclarationRegexp = regexp.MustCompile(`^(?:\s|//[^\n]*(?:\n|$)|/\*(?s:.*?)\*/)*(edition\s*=\s*(?:"([^"]*)"|'([^']*)')\s*;)`)
+
+// editionsElementType is the type of a descriptor that features can be set on.
+type editionsElementType int
+
+const (
+	editionsElementTypeFile editionsElementType = iota + 1
+	editionsElementTypeMessage
+	editionsElementTypeField
+	editionsElementTypeOneof
+	editionsElementTypeEnum
+	editionsElementTypeEnumValue
+	editionsElementTypeService
+	editionsElementTypeMethod
+)
+
+// String returns the plural name of the type, as used in error messages.
+func (t editionsElementType) String() string {
+	switch t {
+	case editionsElementTypeFile:
+		return "files"
+	case editionsElementTypeMessage:
+		return "messages"
+	case editionsElementTypeField:
+		return "fields"
+	case editionsElementTypeOneof:
+		return "oneofs"
+	case editionsElementTypeEnum:
+		return "enums"
+	case editionsElementTypeEnumValue:
+		return "enum values"
+	case editionsElementTypeService:
+		return "services"
+	case editionsElementTypeMethod:
+		return "methods"
+	default:
+		return fmt.Sprintf("%d", int(t))
+	}
+}
+
+// featuresNumber returns the number of the features field of the options
+// message of the type.
+func (t editionsElementType) featuresNumber() protowire.Number {
+	switch t {
+	case editionsElementTypeFile:
+		return 50
+	case editionsElementTypeMessage:
+		return 12
+	case editionsElementTypeField:
+		return 21
+	case editionsElementTypeOneof:
+		return 1
+	case editionsElementTypeEnum:
+		return 7
+	case editionsElementTypeEnumValue:
+		return 2
+	case editionsElementTypeService:
+		return 34
+	case editionsElementTypeMethod:
+		return 35
+	default:
+		return 0
+	}
+}
+
+// editionsFeature is a field of google.protobuf.FeatureSet.
+type editionsFeature struct {
+	number           protowire.Number
+	valueNameToValue map[string]uint64
+	targets          []editionsElementType
+}
+
+// nameToEditionsFeature contains the features of edition 2023.
+var nameToEditionsFeature = map[string]*editionsFeature{
+	"field_presence": {
+		number:           1,
+		valueNameToValue: map[string]uint64{"EXPLICIT": 1, "IMPLICIT": 2, "LEGACY_REQUIRED": 3},
+		targets:          []editionsElementType{editionsElementTypeFile, editionsElementTypeField},
+	},
+	"enum_type": {
+		number:           2,
+		valueNameToValue: map[string]uint64{"OPEN": 1, "CLOSED": 2},
+		targets:          []editionsElementType{editionsElementTypeFile, editionsElementTypeEnum},
+	},
+	"repeated_field_encoding": {
+		number:           3,
+		valueNameToValue: map[string]uint64{"PACKED": 1, "EXPANDED": 2},
+		targets:          []editionsElementType{editionsElementTypeFile, editionsElementTypeField},
+	},
+	"utf8_validation": {
+		number:           4,
+		valueNameToValue: map[string]uint64{"VERIFY": 2, "NONE": 3},
+		targets:          []editionsElementType{editionsElementTypeFile, editionsElementTypeField},
+	},
+	"message_encoding": {
+		number:           5,
+		valueNameToValue: map[string]uint64{"LENGTH_PREFIXED": 1, "DELIMITED": 2},
+		targets:          []editionsElementType{editionsElementTypeFile, editionsElementTypeField},
+	},
+	"json_format": {
+		number:           6,
+		valueNameToValue: map[string]uint64{"ALLOW": 1, "LEGACY_BEST_EFFORT": 2},
+		targets: []editionsElementType{
+			editionsElementTypeFile,
+			editionsElementTypeMessage,
+			editionsElementTypeEnum,
+		},
+	},
+}
+
+// editionsFile is a file that declares an edition.
+type editionsFile struct {
+	// data is the rewritten data of the file.
+	data []byte
+	// elementKeyToFeatures maps the keys of elements, as returned by
+	// getEditionsElementKey, to the features set on them.
+	elementKeyToFeatures map[string]map[protowire.Number]uint64
+	errorsWithPos        []protoparse.ErrorWithPos
+}
+
+// editionsAccessor is an Accessor for the Parser that rewrites files that
+// declare an edition so that the Parser can parse them.
+//
+// The Parser only supports proto2 and proto3. Files that declare edition 2023
+// are parsed as proto3 files, which have the same syntax except for the
+// edition declaration, labels, and features. The edition declaration is
+// replaced by a proto3 syntax declaration. The features options, and the
+// optional and required labels, are validated and removed. Everything else
+// keeps its position, so that the source code info and the errors of the
+// Parser match the original file.
+//
+// After the files are linked, applyEditionsFile records the edition and the
+// features in the FileDescriptorProtos.
+//
+// Constructs that are valid in edition 2023 but not in proto3, such as default
+// values, extension declarations, and closed enums whose first value is not
+// zero, are rejected by the Parser.
+type editionsAccessor struct {
+	open func(string) (io.ReadCloser, error)
+
+	// files may be opened concurrently and more than once, so we rewrite
+	// them once and cache the result
+	lock               sync.Mutex
+	pathToEditionsFile map[string]*editionsFile
+}
+
+func newEditionsAccessor(open func(string) (io.ReadCloser, error)) *editionsAccessor {
+	return &editionsAccessor{
+		open:               open,
+		pathToEditionsFile: make(map[string]*editionsFile),
+	}
+}
+
+// Open opens the file, rewriting it if it declares an edition.
+func (a *editionsAccessor) Open(path string) (_ io.ReadCloser, retErr error) {
+	a.lock.Lock()
+	editionsFile, ok := a.pathToEditionsFile[path]
+	a.lock.Unlock()
+	if ok {
+		return ioutil.NopCloser(bytes.NewReader(editionsFile.data)), nil
+	}
+	readCloser, err := a.open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readCloser.Close())
+	}()
+	data, err := ioutil.ReadAll(readCloser)
+	if err != nil {
+		return nil, err
+	}
+	if !editionsRegexp.Match(data) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+	editionsFile = newEditionsFile(path, data)
+	a.lock.Lock()
+	a.pathToEditionsFile[path] = editionsFile
+	a.lock.Unlock()
+	return ioutil.NopCloser(bytes.NewReader(editionsFile.data)), nil
+}
+
+// getEditionsFile returns the editionsFile for the path, or nil if the file
+// does not declare an edition.
+func (a *editionsAccessor) getEditionsFile(path string) *editionsFile {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.pathToEditionsFile[path]
+}
+
+// getFileAnnotations returns the FileAnnotations for the errors found while
+// rewriting the files.
+func (a *editionsAccessor) getFileAnnotations(
+	ctx context.Context,
+	parserAccessorHandler bufmoduleprotoparse.ParserAccessorHandler,
+) ([]bufanalysis.FileAnnotation, error) {
+	a.lock.Lock()
+	var errorsWithPos []protoparse.ErrorWithPos
+	for _, editionsFile := range a.pathToEditionsFile {
+		errorsWithPos = append(errorsWithPos, editionsFile.errorsWithPos...)
+	}
+	a.lock.Unlock()
+	if len(errorsWithPos) == 0 {
+		return nil, nil
+	}
+	return bufmoduleprotoparse.GetFileAnnotations(
+		ctx,
+		parserAccessorHandler,
+		errorsWithPos,
+		bufanalysis.SeverityError,
+	)
+}
+
+// mergeEditionsFileAnnotations merges the FileAnnotations of the Parser with
+// the FileAnnotations of editionsAccessor.getFileAnnotations.
+//
+// The FileAnnotations for editions take precedence over the FileAnnotations
+// of the Parser on the same line, as the Parser reports errors for proto3.
+func mergeEditionsFileAnnotations(
+	fileAnnotations []bufanalysis.FileAnnotation,
+	editionsFileAnnotations []bufanalysis.FileAnnotation,
+) []bufanalysis.FileAnnotation {
+	if len(editionsFileAnnotations) == 0 {
+		return fileAnnotations
+	}
+	editionsLines := make(map[string]struct{}, len(editionsFileAnnotations))
+	for _, editionsFileAnnotation := range editionsFileAnnotations {
+		editionsLines[getFileAnnotationLineKey(editionsFileAnnotation)] = struct{}{}
+	}
+	mergedFileAnnotations := editionsFileAnnotations
+	for _, fileAnnotation := range fileAnnotations {
+		if _, ok := editionsLines[getFileAnnotationLineKey(fileAnnotation)]; !ok {
+			mergedFileAnnotations = append(mergedFileAnnotations, fileAnnotation)
+		}
+	}
+	return mergedFileAnnotations
+}
+
+func getFileAnnotationLineKey(fileAnnotation bufanalysis.FileAnnotation) string {
+	var path string
+	if fileInfo := fileAnnotation.FileInfo(); fileInfo != nil {
+		path = fileInfo.Path()
+	}
+	return fmt.Sprintf("%s:%d", path, fileAnnotation.StartLine())
+}
+
+// newEditionsFile rewrites the data of a file that declares an edition.
+func newEditionsFile(path string, data []byte) *editionsFile {
+	editionsFile := &editionsFile{
+		data:                 data,
+		elementKeyToFeatures: make(map[string]map[protowire.Number]uint64),
+	}
+	walker := newEditionsWalker(path, editionsFile)
+	match := editionDeclarationRegexp.FindSubmatchIndex(data)
+	if match == nil {
+		walker.addErrorAtOffset(
+			data,
+			bytes.Index(data, []byte("edition")),
+			`invalid edition declaration, expected edition = "%s";`,
+			supportedEdition,
+		)
+		return editionsFile
+	}
+	declarationStart, declarationEnd := match[2], match[3]
+	var edition string
+	if match[4] >= 0 {
+		edition = string(data[match[4]:match[5]])
+	} else {
+		edition = string(data[match[6]:match[7]])
+	}
+	if edition != supportedEdition {
+		walker.addErrorAtOffset(
+			data,
+			declarationStart,
+			`edition %q is not supported, the only supported edition is %q`,
+			edition,
+			supportedEdition,
+		)
+	}
+	// keep the newlines so that the lines of everything after the declaration
+	// do not change
+	replacement := `syntax = "proto3";` + strings.Repeat("\n", bytes.Count(data[declarationStart:declarationEnd], []byte("\n")))
+	rewritten := make([]byte, 0, len(data)-(declarationEnd-declarationStart)+len(replacement))
+	rewritten = append(rewritten, data[:declarationStart]...)
+	rewritten = append(rewritten, replacement...)
+	rewritten = append(rewritten, data[declarationEnd:]...)
+	editionsFile.data = rewritten
+
+	parser := protoparse.Parser{
+		Accessor: func(string) (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(rewritten)), nil
+		},
+	}
+	fileNodes, err := parser.ParseToAST(path)
+	if err != nil || len(fileNodes) != 1 {
+		// the Parser reports the syntax errors when the file is parsed again
+		return editionsFile
+	}
+	walker.walkFile(fileNodes[0])
+	editionsFile.data = walker.blank(rewritten)
+	return editionsFile
+}
+
+// applyEditionsFile records the edition and the features of the editionsFile
+// in the FileDescriptorProto.
+func applyEditionsFile(fileDescriptorProto *descriptorpb.FileDescriptorProto, editionsFile *editionsFile) error {
+	fileDescriptorProto.Syntax = proto.String(editionsSyntax)
+	fileDescriptorProto.ProtoReflect().SetUnknown(
+		protowire.AppendVarint(
+			protowire.AppendTag(
+				fileDescriptorProto.ProtoReflect().GetUnknown(),
+				fileDescriptorProtoEditionNumber,
+				protowire.VarintType,
+			),
+			edition2023,
+		),
+	)
+	if len(editionsFile.elementKeyToFeatures) == 0 {
+		return nil
+	}
+	applied := make(map[string]struct{}, len(editionsFile.elementKeyToFeatures))
+	apply := func(elementType editionsElementType, name string, getOptions func() proto.Message) {
+		key := getEditionsElementKey(elementType, name)
+		features, ok := editionsFile.elementKeyToFeatures[key]
+		if !ok {
+			return
+		}
+		applied[key] = struct{}{}
+		options := getOptions().ProtoReflect()
+		options.SetUnknown(appendFeatures(options.GetUnknown(), elementType.featuresNumber(), features))
+	}
+	pkg := fileDescriptorProto.GetPackage()
+	if fileDescriptorProto.Options == nil {
+		fileDescriptorProto.Options = &descriptorpb.FileOptions{}
+	}
+	apply(editionsElementTypeFile, "", func() proto.Message { return fileDescriptorProto.Options })
+	if len(fileDescriptorProto.Options.ProtoReflect().GetUnknown()) == 0 && isEmptyMessage(fileDescriptorProto.Options) {
+		fileDescriptorProto.Options = nil
+	}
+	for _, descriptorProto := range fileDescriptorProto.GetMessageType() {
+		applyEditionsMessage(apply, pkg, descriptorProto)
+	}
+	for _, enumDescriptorProto := range fileDescriptorProto.GetEnumType() {
+		applyEditionsEnum(apply, pkg, enumDescriptorProto)
+	}
+	for _, fieldDescriptorProto := range fileDescriptorProto.GetExtension() {
+		applyEditionsField(apply, pkg, fieldDescriptorProto)
+	}
+	for _, serviceDescriptorProto := range fileDescriptorProto.GetService() {
+		serviceName := joinEditionsName(pkg, serviceDescriptorProto.GetName())
+		apply(editionsElementTypeService, serviceName, func() proto.Message {
+			if serviceDescriptorProto.Options == nil {
+				serviceDescriptorProto.Options = &descriptorpb.ServiceOptions{}
+			}
+			return serviceDescriptorProto.Options
+		})
+		for _, methodDescriptorProto := range serviceDescriptorProto.GetMethod() {
+			methodDescriptorProto := methodDescriptorProto
+			apply(editionsElementTypeMethod, joinEditionsName(serviceName, methodDescriptorProto.GetName()), func() proto.Message {
+				if methodDescriptorProto.Options == nil {
+					methodDescriptorProto.Options = &descriptorpb.MethodOptions{}
+				}
+				return methodDescriptorProto.Options
+			})
+		}
+	}
+	if len(applied) != len(editionsFile.elementKeyToFeatures) {
+		var unapplied []string
+		for key := range editionsFile.elementKeyToFeatures {
+			if _, ok := applied[key]; !ok {
+				unapplied = append(unapplied, key)
+			}
+		}
+		sort.Strings(unapplied)
+		return fmt.Errorf("features of %s were not found in the FileDescriptorProto", strings.Join(unapplied, ", "))
+	}
+	return nil
+}
+
+func applyEditionsMessage(
+	apply func(editionsElementType, string, func() proto.Message),
+	scope string,
+	descriptorProto *descriptorpb.DescriptorProto,
+) {
+	messageName := joinEditionsName(scope, descriptorProto.GetName())
+	apply(editionsElementTypeMessage, messageName, func() proto.Message {
+		if descriptorProto.Options == nil {
+			descriptorProto.Options = &descriptorpb.MessageOptions{}
+		}
+		return descriptorProto.Options
+	})
+	for _, fieldDescriptorProto := range descriptorProto.GetField() {
+		applyEditionsField(apply, messageName, fieldDescriptorProto)
+	}
+	for _, fieldDescriptorProto := range descriptorProto.GetExtension() {
+		applyEditionsField(apply, messageName, fieldDescriptorProto)
+	}
+	for _, oneofDescriptorProto := range descriptorProto.GetOneofDecl() {
+		oneofDescriptorProto := oneofDescriptorProto
+		apply(editionsElementTypeOneof, joinEditionsName(messageName, oneofDescriptorProto.GetName()), func() proto.Message {
+			if oneofDescriptorProto.Options == nil {
+				oneofDescriptorProto.Options = &descriptorpb.OneofOptions{}
+			}
+			return oneofDescriptorProto.Options
+		})
+	}
+	for _, nestedDescriptorProto := range descriptorProto.GetNestedType() {
+		applyEditionsMessage(apply, messageName, nestedDescriptorProto)
+	}
+	for _, enumDescriptorProto := range descriptorProto.GetEnumType() {
+		applyEditionsEnum(apply, messageName, enumDescriptorProto)
+	}
+}
+
+func applyEditionsField(
+	apply func(editionsElementType, string, func() proto.Message),
+	scope string,
+	fieldDescriptorProto *descriptorpb.FieldDescriptorProto,
+) {
+	apply(editionsElementTypeField, joinEditionsName(scope, fieldDescriptorProto.GetName()), func() proto.Message {
+		if fieldDescriptorProto.Options == nil {
+			fieldDescriptorProto.Options = &descriptorpb.FieldOptions{}
+		}
+		return fieldDescriptorProto.Options
+	})
+}
+
+func applyEditionsEnum(
+	apply func(editionsElementType, string, func() proto.Message),
+	scope string,
+	enumDescriptorProto *descriptorpb.EnumDescriptorProto,
+) {
+	enumName := joinEditionsName(scope, enumDescriptorProto.GetName())
+	apply(editionsElementTypeEnum, enumName, func() proto.Message {
+		if enumDescriptorProto.Options == nil {
+			enumDescriptorProto.Options = &descriptorpb.EnumOptions{}
+		}
+		return enumDescriptorProto.Options
+	})
+	for _, enumValueDescriptorProto := range enumDescriptorProto.GetValue() {
+		enumValueDescriptorProto := enumValueDescriptorProto
+		apply(editionsElementTypeEnumValue, joinEditionsName(enumName, enumValueDescriptorProto.GetName()), func() proto.Message {
+			if enumValueDescriptorProto.Options == nil {
+				enumValueDescriptorProto.Options = &descriptorpb.EnumValueOptions{}
+			}
+			return enumValueDescriptorProto.Options
+		})
+	}
+}
+
+// appendFeatures appends the features as a FeatureSet in the field with the number.
+func appendFeatures(b []byte, number protowire.Number, features map[protowire.Number]uint64) []byte {
+	featureNumbers := make([]protowire.Number, 0, len(features))
+	for featureNumber := range features {
+		featureNumbers = append(featureNumbers, featureNumber)
+	}
+	sort.Slice(featureNumbers, func(i int, j int) bool { return featureNumbers[i] < featureNumbers[j] })
+	var featureSet []byte
+	for _, featureNumber := range featureNumbers {
+		featureSet = protowire.AppendTag(featureSet, featureNumber, protowire.VarintType)
+		featureSet = protowire.AppendVarint(featureSet, features[featureNumber])
+	}
+	b = protowire.AppendTag(b, number, protowire.BytesType)
+	return protowire.AppendBytes(b, featureSet)
+}
+
+func isEmptyMessage(message proto.Message) bool {
+	empty := true
+	message.ProtoReflect().Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		empty = false
+		return false
+	})
+	return empty
+}
+
+// getEditionsElementKey returns the key of the element of the type with the
+// fully-qualified name.
+//
+// Enum values are keyed by the name of their enum and their own name.
+func getEditionsElementKey(elementType editionsElementType, name string) string {
+	return fmt.Sprintf("%s %s", elementType.String(), name)
+}
+
+func joinEditionsName(scope string, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// editionsWalker walks the AST of a file that declares an edition.
+type editionsWalker struct {
+	path         string
+	editionsFile *editionsFile
+	// ranges are the start and end positions of the ranges to blank
+	ranges [][2]ast.SourcePos
+}
+
+func newEditionsWalker(path string, editionsFile *editionsFile) *editionsWalker {
+	return &editionsWalker{
+		path:         path,
+		editionsFile: editionsFile,
+	}
+}
+
+func (w *editionsWalker) walkFile(fileNode *ast.FileNode) {
+	var pkg string
+	for _, decl := range fileNode.Decls {
+		if packageNode, ok := decl.(*ast.PackageNode); ok {
+			pkg = string(packageNode.Name.AsIdentifier())
+		}
+	}
+	for _, decl := range fileNode.Decls {
+		switch node := decl.(type) {
+		case *ast.OptionNode:
+			w.walkOption(node, editionsElementTypeFile, "")
+		case *ast.MessageNode:
+			w.walkMessageBody(&node.MessageBody, joinEditionsName(pkg, node.Name.Val))
+			w.checkMessageOptions(&node.MessageBody, joinEditionsName(pkg, node.Name.Val))
+		case *ast.EnumNode:
+			w.walkEnum(node, pkg)
+		case *ast.ExtendNode:
+			w.walkExtend(node, pkg)
+		case *ast.ServiceNode:
+			serviceName := joinEditionsName(pkg, node.Name.Val)
+			for _, serviceDecl := range node.Decls {
+				switch serviceNode := serviceDecl.(type) {
+				case *ast.OptionNode:
+					w.walkOption(serviceNode, editionsElementTypeService, serviceName)
+				case *ast.RPCNode:
+					for _, rpcDecl := range serviceNode.Decls {
+						if optionNode, ok := rpcDecl.(*ast.OptionNode); ok {
+							w.walkOption(optionNode, editionsElementTypeMethod, joinEditionsName(serviceName, serviceNode.Name.Val))
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkMessageOptions walks the options of the message.
+//
+// This is separate from walkMessageBody so that the options are walked with
+// the name of the message.
+func (w *editionsWalker) checkMessageOptions(messageBody *ast.MessageBody, messageName string) {
+	for _, decl := range messageBody.Decls {
+		if optionNode, ok := decl.(*ast.OptionNode); ok {
+			w.walkOption(optionNode, editionsElementTypeMessage, messageName)
+		}
+	}
+}
+
+func (w *editionsWalker) walkMessageBody(messageBody *ast.MessageBody, messageName string) {
+	for _, decl := range messageBody.Decls {
+		switch node := decl.(type) {
+		case *ast.FieldNode:
+			w.walkField(node, messageName)
+		case *ast.MapFieldNode:
+			w.walkCompactOptions(node.Options, editionsElementTypeField, joinEditionsName(messageName, node.Name.Val))
+		case *ast.GroupNode:
+			w.addGroupError(node)
+		case *ast.OneOfNode:
+			oneofName := joinEditionsName(messageName, node.Name.Val)
+			for _, oneofDecl := range node.Decls {
+				switch oneofNode := oneofDecl.(type) {
+				case *ast.OptionNode:
+					w.walkOption(oneofNode, editionsElementTypeOneof, oneofName)
+				case *ast.FieldNode:
+					w.walkField(oneofNode, messageName)
+				case *ast.GroupNode:
+					w.addGroupError(oneofNode)
+				}
+			}
+		case *ast.MessageNode:
+			nestedMessageName := joinEditionsName(messageName, node.Name.Val)
+			w.walkMessageBody(&node.MessageBody, nestedMessageName)
+			w.checkMessageOptions(&node.MessageBody, nestedMessageName)
+		case *ast.EnumNode:
+			w.walkEnum(node, messageName)
+		case *ast.ExtendNode:
+			w.walkExtend(node, messageName)
+		}
+	}
+}
+
+func (w *editionsWalker) walkExtend(extendNode *ast.ExtendNode, scope string) {
+	for _, decl := range extendNode.Decls {
+		switch node := decl.(type) {
+		case *ast.FieldNode:
+			w.walkField(node, scope)
+		case *ast.GroupNode:
+			w.addGroupError(node)
+		}
+	}
+}
+
+func (w *editionsWalker) walkEnum(enumNode *ast.EnumNode, scope string) {
+	enumName := joinEditionsName(scope, enumNode.Name.Val)
+	for _, decl := range enumNode.Decls {
+		switch node := decl.(type) {
+		case *ast.OptionNode:
+			w.walkOption(node, editionsElementTypeEnum, enumName)
+		case *ast.EnumValueNode:
+			w.walkCompactOptions(node.Options, editionsElementTypeEnumValue, joinEditionsName(enumName, node.Name.Val))
+		}
+	}
+}
+
+func (w *editionsWalker) walkField(fieldNode *ast.FieldNode, scope string) {
+	if label := fieldNode.Label.KeywordNode; label != nil {
+		switch label.Val {
+		case "optional":
+			w.addError(label.Start(), "label optional is not allowed in editions, fields have explicit presence by default")
+			w.addRange(label)
+		case "required":
+			w.addError(label.Start(), "label required is not allowed in editions, use features.field_presence = LEGACY_REQUIRED instead")
+			w.addRange(label)
+		}
+	}
+	w.walkCompactOptions(fieldNode.Options, editionsElementTypeField, joinEditionsName(scope, fieldNode.Name.Val))
+}
+
+func (w *editionsWalker) addGroupError(groupNode *ast.GroupNode) {
+	w.addError(groupNode.Start(), "groups are not allowed in editions, use a message field with features.message_encoding = DELIMITED instead")
+}
+
+// walkOption walks an option declared with the option keyword, removing it if
+// it is a features option.
+func (w *editionsWalker) walkOption(optionNode *ast.OptionNode, elementType editionsElementType, name string) {
+	if w.recordFeature(optionNode, elementType, name) {
+		w.addRange(optionNode)
+	}
+}
+
+// walkCompactOptions walks the options in brackets, removing the features
+// options and the commas that separate them.
+func (w *editionsWalker) walkCompactOptions(compactOptionsNode *ast.CompactOptionsNode, elementType editionsElementType, name string) {
+	if compactOptionsNode == nil {
+		return
+	}
+	isFeature := make([]bool, len(compactOptionsNode.Options))
+	numFeatures := 0
+	for i, optionNode := range compactOptionsNode.Options {
+		if w.recordFeature(optionNode, elementType, name) {
+			isFeature[i] = true
+			numFeatures++
+		}
+	}
+	if numFeatures == 0 {
+		return
+	}
+	if numFeatures == len(compactOptionsNode.Options) {
+		// empty brackets are not valid, so remove the brackets as well
+		w.addRange(compactOptionsNode)
+		return
+	}
+	for i, optionNode := range compactOptionsNode.Options {
+		if isFeature[i] {
+			w.addRange(optionNode)
+		}
+	}
+	// the comma after an option is kept if the option is kept and another
+	// option is kept after it
+	for i, comma := range compactOptionsNode.Commas {
+		keepComma := false
+		if !isFeature[i] {
+			for j := i + 1; j < len(isFeature); j++ {
+				if !isFeature[j] {
+					keepComma = true
+					break
+				}
+			}
+		}
+		if !keepComma {
+			w.addRange(comma)
+		}
+	}
+}
+
+// recordFeature records the feature if the option is a features option.
+//
+// Returns true if the option is a features option, even if it is invalid, in
+// which case an error is added.
+func (w *editionsWalker) recordFeature(optionNode *ast.OptionNode, elementType editionsElementType, name string) bool {
+	parts := optionNode.Name.Parts
+	if len(parts) == 0 || parts[0].IsExtension() || string(parts[0].Name.AsIdentifier()) != "features" {
+		return false
+	}
+	if len(parts) != 2 || parts[1].IsExtension() {
+		w.addError(
+			optionNode.Name.Start(),
+			"features must be set one at a time with a feature of google.protobuf.FeatureSet, such as features.field_presence",
+		)
+		return true
+	}
+	featureName := string(parts[1].Name.AsIdentifier())
+	feature, ok := nameToEditionsFeature[featureName]
+	if !ok {
+		w.addError(parts[1].Start(), "unknown feature %q", featureName)
+		return true
+	}
+	if !isEditionsElementTypeIn(elementType, feature.targets) {
+		w.addError(optionNode.Name.Start(), "feature %q cannot be set on %s", featureName, elementType.String())
+		return true
+	}
+	identValueNode, ok := optionNode.Val.(ast.IdentValueNode)
+	if !ok {
+		w.addError(optionNode.Val.Start(), "the value of feature %q must be one of %s", featureName, getFeatureValueNames(feature))
+		return true
+	}
+	value, ok := feature.valueNameToValue[string(identValueNode.AsIdentifier())]
+	if !ok {
+		w.addError(optionNode.Val.Start(), "the value of feature %q must be one of %s", featureName, getFeatureValueNames(feature))
+		return true
+	}
+	key := getEditionsElementKey(elementType, name)
+	features, ok := w.editionsFile.elementKeyToFeatures[key]
+	if !ok {
+		features = make(map[protowire.Number]uint64)
+		w.editionsFile.elementKeyToFeatures[key] = features
+	}
+	if _, ok := features[feature.number]; ok {
+		w.addError(optionNode.Name.Start(), "feature %q is already set", featureName)
+		return true
+	}
+	features[feature.number] = value
+	return true
+}
+
+func (w *editionsWalker) addRange(node ast.Node) {
+	w.ranges = append(w.ranges, [2]ast.SourcePos{*node.Start(), *node.End()})
+}
+
+func (w *editionsWalker) addError(sourcePos *ast.SourcePos, format string, args ...interface{}) {
+	pos := *sourcePos
+	pos.Filename = w.path
+	w.editionsFile.errorsWithPos = append(
+		w.editionsFile.errorsWithPos,
+		protoparse.ErrorWithSourcePos{
+			Underlying: fmt.Errorf(format, args...),
+			Pos:        &pos,
+		},
+	)
+}
+
+func (w *editionsWalker) addErrorAtOffset(data []byte, offset int, format string, args ...interface{}) {
+	if offset < 0 {
+		offset = 0
+	}
+	line := 1 + bytes.Count(data[:offset], []byte("\n"))
+	col := 1 + utf8.RuneCount(data[bytes.LastIndexByte(data[:offset], '\n')+1:offset])
+	w.addError(&ast.SourcePos{Line: line, Col: col}, format, args...)
+}
+
+// blank replaces the ranges in the data with spaces, keeping the newlines.
+func (w *editionsWalker) blank(data []byte) []byte {
+	if len(w.ranges) == 0 {
+		return data
+	}
+	// the offsets of the starts of the lines, lines are 1-indexed
+	lineOffsets := []int{0, 0}
+	for i, b := range data {
+		if b == '\n' {
+			lineOffsets = append(lineOffsets, i+1)
+		}
+	}
+	blanked := make([]byte, len(data))
+	copy(blanked, data)
+	for _, r := range w.ranges {
+		start := getEditionsOffset(data, lineOffsets, r[0])
+		end := getEditionsOffset(data, lineOffsets, r[1])
+		for i := start; i < end; i++ {
+			if blanked[i] != '\n' {
+				blanked[i] = ' '
+			}
+		}
+	}
+	return blanked
+}
+
+// getEditionsOffset returns the offset in the data of the position, where
+// columns count runes.
+func getEditionsOffset(data []byte, lineOffsets []int, sourcePos ast.SourcePos) int {
+	if sourcePos.Line <= 0 || sourcePos.Line >= len(lineOffsets) {
+		return len(data)
+	}
+	offset := lineOffsets[sourcePos.Line]
+	for col := 1; col < sourcePos.Col && offset < len(data); col++ {
+		_, size := utf8.DecodeRune(data[offset:])
+		offset += size
+	}
+	return offset
+}
+
+func isEditionsElementTypeIn(elementType editionsElementType, elementTypes []editionsElementType) bool {
+	for _, candidate := range elementTypes {
+		if candidate == elementType {
+			return true
+		}
+	}
+	return false
+}
+
+func getFeatureValueNames(feature *editionsFeature) string {
+	valueNames := make([]string, 0, len(feature.valueNameToValue))
+	for valueName := range feature.valueNameToValue {
+		valueNames = append(valueNames, valueName)
+	}
+	sort.Slice(valueNames, func(i int, j int) bool {
+		return feature.valueNameToValue[valueNames[i]] < feature.valueNameToValue[valueNames[j]]
+	})
+	return strings.Join(valueNames, ", ")
+}
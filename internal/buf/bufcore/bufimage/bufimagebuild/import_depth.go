@@ -0,0 +1,167 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimagebuild
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/multierr"
+)
+
+// importRegexp matches comments, string literals, and import statements, so
+// that comments and strings that contain import statements are skipped.
+//
+// The first or second submatch is the path of an import statement.
+var importRegexp = regexp.MustCompile(
+	`//[^\n]*|/\*(?s:.*?)\*/|\bimport\s*(?:(?:public|weak)\s+)?(?:"((?:[^"\\\n]|\\.)*)"|'((?:[^'\\\n]|\\.)*)')|"(?:[^"\\\n]|\\.)*"|'(?:[^'\\\n]|\\.)*'`,
+)
+
+// importDepthAccessor is an Accessor for a single Parser that fails as soon as
+// a file is opened at an import depth greater than the maximum.
+//
+// The Parser opens the files of an import graph depth-first, and opens the
+// imports of a file right after the file. When a file is opened, its imports
+// are read from its data, and every import that is not opened yet records the
+// file as its importer if this is the longest chain of imports to it so far.
+// The recorded chain of a file is always a chain of imports from a file given
+// to the Parser, so a file is not opened if this chain is longer than the
+// maximum.
+//
+// The Parser opens every file once, so a longer chain to a file that is found
+// after the file was opened is not seen here. checkMaxImportDepth checks all
+// chains after the files are linked.
+type importDepthAccessor struct {
+	open           func(string) (io.ReadCloser, error)
+	maxImportDepth uint32
+
+	// protoparse isn't concurrent right now but just to be safe
+	// for the future
+	lock               sync.Mutex
+	openedPaths        map[string]struct{}
+	pathToImportDepth  map[string]uint32
+	pathToImporterPath map[string]string
+	err                error
+}
+
+func newImportDepthAccessor(open func(string) (io.ReadCloser, error), maxImportDepth uint32) *importDepthAccessor {
+	return &importDepthAccessor{
+		open:               open,
+		maxImportDepth:     maxImportDepth,
+		openedPaths:        make(map[string]struct{}),
+		pathToImportDepth:  make(map[string]uint32),
+		pathToImporterPath: make(map[string]string),
+	}
+}
+
+// Open opens the file, or returns an error if the import depth of the file
+// is greater than the maximum.
+//
+// Once an error is returned for the import depth, every call returns this error.
+func (a *importDepthAccessor) Open(path string) (_ io.ReadCloser, retErr error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.err != nil {
+		return nil, a.err
+	}
+	if importDepth := a.pathToImportDepth[path]; importDepth > a.maxImportDepth {
+		a.err = fmt.Errorf(
+			"import depth of %d exceeds the maximum import depth of %d: %s",
+			importDepth,
+			a.maxImportDepth,
+			strings.Join(a.getImportChain(path), " -> "),
+		)
+		return nil, a.err
+	}
+	readCloser, err := a.open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readCloser.Close())
+	}()
+	data, err := ioutil.ReadAll(readCloser)
+	if err != nil {
+		return nil, err
+	}
+	a.openedPaths[path] = struct{}{}
+	importDepth := a.pathToImportDepth[path] + 1
+	for _, importPath := range getImportPaths(data) {
+		if _, ok := a.openedPaths[importPath]; ok {
+			continue
+		}
+		if existingImportDepth, ok := a.pathToImportDepth[importPath]; ok && existingImportDepth >= importDepth {
+			continue
+		}
+		a.pathToImportDepth[importPath] = importDepth
+		a.pathToImporterPath[importPath] = path
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Err returns the import depth error returned by Open, if any.
+func (a *importDepthAccessor) Err() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.err
+}
+
+// getImportChain returns the recorded chain of imports to the path.
+//
+// The importer of a path is always opened before the path, and the import
+// depth of a path does not change once it is opened, so the import depths
+// along the chain strictly decrease and the chain ends.
+func (a *importDepthAccessor) getImportChain(path string) []string {
+	chain := []string{path}
+	for importerPath, ok := a.pathToImporterPath[path]; ok; importerPath, ok = a.pathToImporterPath[importerPath] {
+		chain = append(chain, importerPath)
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain
+}
+
+// getImportPaths returns the paths of the import statements in the data.
+//
+// Paths with escapes that cannot be unquoted are skipped, the Parser reports
+// these when the file is parsed.
+func getImportPaths(data []byte) []string {
+	var importPaths []string
+	for _, match := range importRegexp.FindAllSubmatch(data, -1) {
+		var quoted string
+		switch {
+		case match[1] != nil:
+			quoted = `"` + string(match[1]) + `"`
+		case match[2] != nil:
+			// strconv.Unquote only accepts a single character in single quotes
+			quoted = `"` + strings.ReplaceAll(string(match[2]), `"`, `\"`) + `"`
+		default:
+			continue
+		}
+		importPath, err := strconv.Unquote(quoted)
+		if err != nil {
+			continue
+		}
+		importPaths = append(importPaths, importPath)
+	}
+	return importPaths
+}
@@ -0,0 +1,102 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufimagediff computes the structural difference between two Images.
+package bufimagediff
+
+import (
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+)
+
+const (
+	// ChangeTypeAdded says the type was added.
+	ChangeTypeAdded ChangeType = iota + 1
+	// ChangeTypeRemoved says the type was removed.
+	ChangeTypeRemoved
+	// ChangeTypeChanged says the definition of the type changed.
+	ChangeTypeChanged
+)
+
+const (
+	// KindMessage is a message.
+	KindMessage Kind = iota + 1
+	// KindEnum is an enum.
+	KindEnum
+	// KindService is a service.
+	KindService
+)
+
+var (
+	changeTypeToString = map[ChangeType]string{
+		ChangeTypeAdded:   "added",
+		ChangeTypeRemoved: "removed",
+		ChangeTypeChanged: "changed",
+	}
+	kindToString = map[Kind]string{
+		KindMessage: "message",
+		KindEnum:    "enum",
+		KindService: "service",
+	}
+)
+
+// ChangeType is the type of a Change.
+type ChangeType int
+
+// String implements fmt.Stringer.
+func (c ChangeType) String() string {
+	s, ok := changeTypeToString[c]
+	if !ok {
+		return "unknown"
+	}
+	return s
+}
+
+// Kind is the kind of type that a Change is for.
+type Kind int
+
+// String implements fmt.Stringer.
+func (k Kind) String() string {
+	s, ok := kindToString[k]
+	if !ok {
+		return "unknown"
+	}
+	return s
+}
+
+// Change is a change to a single message, enum, or service.
+type Change interface {
+	ChangeType() ChangeType
+	Kind() Kind
+	// FullName is the fully-qualified name of the type, without a leading dot.
+	FullName() string
+	// Path is the path of the file that contains the type.
+	//
+	// This is the path in the previous Image for removed types, and the path
+	// in the new Image otherwise.
+	Path() string
+}
+
+// Diff returns the changes to the messages, enums, and services, including
+// nested messages and enums, of the non-import files from previousImage to image.
+//
+// Types are matched by their full name, so a type that moved to another file
+// without otherwise changing is not reported. Changes to nested messages and
+// enums are only reported for the nested type and not for the containing
+// message. Source code info is not compared, so changes to comments and
+// formatting are not reported.
+//
+// The Changes are sorted by full name.
+func Diff(previousImage bufimage.Image, image bufimage.Image) []Change {
+	return diff(previousImage, image)
+}
@@ -0,0 +1,177 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimagediff
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagetesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	newField := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			JsonName: proto.String(name),
+		}
+	}
+	newEnum := func(name string, valueNames ...string) *descriptorpb.EnumDescriptorProto {
+		enumDescriptorProto := &descriptorpb.EnumDescriptorProto{
+			Name: proto.String(name),
+		}
+		for i, valueName := range valueNames {
+			enumDescriptorProto.Value = append(
+				enumDescriptorProto.Value,
+				&descriptorpb.EnumValueDescriptorProto{
+					Name:   proto.String(valueName),
+					Number: proto.Int32(int32(i)),
+				},
+			)
+		}
+		return enumDescriptorProto
+	}
+	newImage := func(
+		fileDescriptorProtoA *descriptorpb.FileDescriptorProto,
+		fileDescriptorProtoImport *descriptorpb.FileDescriptorProto,
+	) bufimage.Image {
+		image, err := bufimage.NewImage(
+			[]bufimage.ImageFile{
+				bufimagetesting.NewImageFile(t, fileDescriptorProtoImport, nil, fileDescriptorProtoImport.GetName(), true),
+				bufimagetesting.NewImageFile(t, fileDescriptorProtoA, nil, fileDescriptorProtoA.GetName(), false),
+			},
+		)
+		require.NoError(t, err)
+		return image
+	}
+
+	previousFileDescriptorProtoImport := bufimagetesting.NewFileDescriptorProto(t, "import.proto")
+	previousFileDescriptorProtoImport.MessageType = []*descriptorpb.DescriptorProto{
+		{Name: proto.String("Import")},
+	}
+	previousFileDescriptorProtoA := bufimagetesting.NewFileDescriptorProto(t, "a.proto", "import.proto")
+	previousFileDescriptorProtoA.Package = proto.String("a")
+	previousFileDescriptorProtoA.MessageType = []*descriptorpb.DescriptorProto{
+		{
+			Name:  proto.String("Same"),
+			Field: []*descriptorpb.FieldDescriptorProto{newField("one", 1)},
+			NestedType: []*descriptorpb.DescriptorProto{
+				{
+					Name:  proto.String("Nested"),
+					Field: []*descriptorpb.FieldDescriptorProto{newField("one", 1)},
+				},
+			},
+		},
+		{
+			Name:  proto.String("Changed"),
+			Field: []*descriptorpb.FieldDescriptorProto{newField("one", 1)},
+		},
+		{Name: proto.String("Removed")},
+	}
+	previousFileDescriptorProtoA.EnumType = []*descriptorpb.EnumDescriptorProto{
+		newEnum("Enum", "ENUM_UNSPECIFIED"),
+		newEnum("BecameMessage", "BECAME_MESSAGE_UNSPECIFIED"),
+	}
+	previousFileDescriptorProtoA.Service = []*descriptorpb.ServiceDescriptorProto{
+		{Name: proto.String("Service")},
+	}
+	// changes to comments are not reported
+	previousFileDescriptorProtoA.SourceCodeInfo = &descriptorpb.SourceCodeInfo{
+		Location: []*descriptorpb.SourceCodeInfo_Location{
+			{Path: []int32{4, 0}, LeadingComments: proto.String(" previous\n")},
+		},
+	}
+
+	fileDescriptorProtoImport := bufimagetesting.NewFileDescriptorProto(t, "import.proto")
+	// changes to imports are not reported
+	fileDescriptorProtoImport.MessageType = []*descriptorpb.DescriptorProto{
+		{Name: proto.String("ImportAdded")},
+	}
+	fileDescriptorProtoA := bufimagetesting.NewFileDescriptorProto(t, "a.proto", "import.proto")
+	fileDescriptorProtoA.Package = proto.String("a")
+	fileDescriptorProtoA.MessageType = []*descriptorpb.DescriptorProto{
+		{
+			Name:  proto.String("Same"),
+			Field: []*descriptorpb.FieldDescriptorProto{newField("one", 1)},
+			NestedType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Nested"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						newField("one", 1),
+						newField("two", 2),
+					},
+				},
+			},
+		},
+		{
+			Name:  proto.String("Changed"),
+			Field: []*descriptorpb.FieldDescriptorProto{newField("one", 2)},
+		},
+		{Name: proto.String("Added")},
+		{Name: proto.String("BecameMessage")},
+	}
+	fileDescriptorProtoA.EnumType = []*descriptorpb.EnumDescriptorProto{
+		newEnum("Enum", "ENUM_UNSPECIFIED", "ENUM_ONE"),
+	}
+	fileDescriptorProtoA.Service = []*descriptorpb.ServiceDescriptorProto{
+		{Name: proto.String("Service")},
+	}
+	fileDescriptorProtoA.SourceCodeInfo = &descriptorpb.SourceCodeInfo{
+		Location: []*descriptorpb.SourceCodeInfo_Location{
+			{Path: []int32{4, 0}, LeadingComments: proto.String(" current\n")},
+		},
+	}
+
+	changes := Diff(
+		newImage(previousFileDescriptorProtoA, previousFileDescriptorProtoImport),
+		newImage(fileDescriptorProtoA, fileDescriptorProtoImport),
+	)
+	assert.Equal(
+		t,
+		[]string{
+			"added message a.Added a.proto",
+			"removed enum a.BecameMessage a.proto",
+			"added message a.BecameMessage a.proto",
+			"changed message a.Changed a.proto",
+			"changed enum a.Enum a.proto",
+			"removed message a.Removed a.proto",
+			"changed message a.Same.Nested a.proto",
+		},
+		changesToStrings(changes),
+	)
+	assert.Empty(
+		t,
+		Diff(
+			newImage(previousFileDescriptorProtoA, previousFileDescriptorProtoImport),
+			newImage(previousFileDescriptorProtoA, fileDescriptorProtoImport),
+		),
+	)
+}
+
+func changesToStrings(changes []Change) []string {
+	strings := make([]string, len(changes))
+	for i, change := range changes {
+		strings[i] = change.ChangeType().String() + " " + change.Kind().String() + " " + change.FullName() + " " + change.Path()
+	}
+	return strings
+}
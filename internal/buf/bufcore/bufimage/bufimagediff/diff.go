@@ -0,0 +1,165 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimagediff
+
+import (
+	"sort"
+
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type change struct {
+	changeType ChangeType
+	kind       Kind
+	fullName   string
+	path       string
+}
+
+func newChange(changeType ChangeType, kind Kind, fullName string, path string) *change {
+	return &change{
+		changeType: changeType,
+		kind:       kind,
+		fullName:   fullName,
+		path:       path,
+	}
+}
+
+func (c *change) ChangeType() ChangeType {
+	return c.changeType
+}
+
+func (c *change) Kind() Kind {
+	return c.kind
+}
+
+func (c *change) FullName() string {
+	return c.fullName
+}
+
+func (c *change) Path() string {
+	return c.path
+}
+
+// namedType is a message, enum, or service within an Image.
+type namedType struct {
+	kind Kind
+	path string
+	// the descriptor proto, with nested messages and enums cleared for messages
+	descriptor proto.Message
+}
+
+func diff(previousImage bufimage.Image, image bufimage.Image) []Change {
+	previousFullNameToNamedType := getFullNameToNamedType(previousImage)
+	fullNameToNamedType := getFullNameToNamedType(image)
+	var changes []Change
+	for fullName, previousNamedType := range previousFullNameToNamedType {
+		namedType, ok := fullNameToNamedType[fullName]
+		if !ok {
+			changes = append(changes, newChange(ChangeTypeRemoved, previousNamedType.kind, fullName, previousNamedType.path))
+			continue
+		}
+		if namedType.kind != previousNamedType.kind {
+			// a message became an enum or similar, this is a removal and an addition
+			changes = append(
+				changes,
+				newChange(ChangeTypeRemoved, previousNamedType.kind, fullName, previousNamedType.path),
+				newChange(ChangeTypeAdded, namedType.kind, fullName, namedType.path),
+			)
+			continue
+		}
+		if !proto.Equal(previousNamedType.descriptor, namedType.descriptor) {
+			changes = append(changes, newChange(ChangeTypeChanged, namedType.kind, fullName, namedType.path))
+		}
+	}
+	for fullName, namedType := range fullNameToNamedType {
+		if _, ok := previousFullNameToNamedType[fullName]; !ok {
+			changes = append(changes, newChange(ChangeTypeAdded, namedType.kind, fullName, namedType.path))
+		}
+	}
+	sort.Slice(
+		changes,
+		func(i int, j int) bool {
+			if changes[i].FullName() == changes[j].FullName() {
+				return changes[i].ChangeType() > changes[j].ChangeType()
+			}
+			return changes[i].FullName() < changes[j].FullName()
+		},
+	)
+	return changes
+}
+
+func getFullNameToNamedType(image bufimage.Image) map[string]*namedType {
+	fullNameToNamedType := make(map[string]*namedType)
+	for _, imageFile := range image.Files() {
+		if imageFile.IsImport() {
+			continue
+		}
+		fileDescriptorProto := imageFile.Proto()
+		path := imageFile.Path()
+		prefix := ""
+		if pkg := fileDescriptorProto.GetPackage(); pkg != "" {
+			prefix = pkg + "."
+		}
+		addMessages(fullNameToNamedType, path, prefix, fileDescriptorProto.GetMessageType())
+		addEnums(fullNameToNamedType, path, prefix, fileDescriptorProto.GetEnumType())
+		for _, serviceDescriptorProto := range fileDescriptorProto.GetService() {
+			fullNameToNamedType[prefix+serviceDescriptorProto.GetName()] = &namedType{
+				kind:       KindService,
+				path:       path,
+				descriptor: serviceDescriptorProto,
+			}
+		}
+	}
+	return fullNameToNamedType
+}
+
+func addMessages(
+	fullNameToNamedType map[string]*namedType,
+	path string,
+	prefix string,
+	descriptorProtos []*descriptorpb.DescriptorProto,
+) {
+	for _, descriptorProto := range descriptorProtos {
+		fullName := prefix + descriptorProto.GetName()
+		// nested messages and enums are compared separately
+		shallowDescriptorProto := proto.Clone(descriptorProto).(*descriptorpb.DescriptorProto)
+		shallowDescriptorProto.NestedType = nil
+		shallowDescriptorProto.EnumType = nil
+		fullNameToNamedType[fullName] = &namedType{
+			kind:       KindMessage,
+			path:       path,
+			descriptor: shallowDescriptorProto,
+		}
+		addMessages(fullNameToNamedType, path, fullName+".", descriptorProto.GetNestedType())
+		addEnums(fullNameToNamedType, path, fullName+".", descriptorProto.GetEnumType())
+	}
+}
+
+func addEnums(
+	fullNameToNamedType map[string]*namedType,
+	path string,
+	prefix string,
+	enumDescriptorProtos []*descriptorpb.EnumDescriptorProto,
+) {
+	for _, enumDescriptorProto := range enumDescriptorProtos {
+		fullNameToNamedType[prefix+enumDescriptorProto.GetName()] = &namedType{
+			kind:       KindEnum,
+			path:       path,
+			descriptor: enumDescriptorProto,
+		}
+	}
+}
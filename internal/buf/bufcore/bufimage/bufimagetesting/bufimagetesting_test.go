@@ -15,12 +15,20 @@
 package bufimagetesting
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	imagev1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/image/v1"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
@@ -437,7 +445,7 @@ func TestBasic(t *testing.T) {
 	require.Equal(
 		t,
 		codeGeneratorRequest,
-		bufimage.ImageToCodeGeneratorRequest(image, "foo"),
+		bufimage.ImageToCodeGeneratorRequest(image, "foo", false, false),
 	)
 	newImage, err = bufimage.NewImageForCodeGeneratorRequest(codeGeneratorRequest)
 	require.NoError(t, err)
@@ -454,3 +462,493 @@ func TestBasic(t *testing.T) {
 		newImage.Files(),
 	)
 }
+
+func TestImagesToCodeGeneratorRequestsIncludeImports(t *testing.T) {
+	fileDescriptorProtoA := NewFileDescriptorProto(t, "a/a.proto", "import/import.proto", "google/protobuf/timestamp.proto")
+	fileDescriptorProtoB := NewFileDescriptorProto(t, "b/b.proto", "import/import.proto", "a/a.proto")
+	fileDescriptorProtoImport := NewFileDescriptorProto(t, "import/import.proto")
+	fileDescriptorProtoTimestamp := NewFileDescriptorProto(t, "google/protobuf/timestamp.proto")
+	imageA, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, fileDescriptorProtoImport, nil, "import/import.proto", true),
+			NewImageFile(t, fileDescriptorProtoTimestamp, nil, "google/protobuf/timestamp.proto", true),
+			NewImageFile(t, fileDescriptorProtoA, nil, "a/a.proto", false),
+		},
+	)
+	require.NoError(t, err)
+	imageB, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, fileDescriptorProtoImport, nil, "import/import.proto", true),
+			NewImageFile(t, fileDescriptorProtoTimestamp, nil, "google/protobuf/timestamp.proto", true),
+			NewImageFile(t, fileDescriptorProtoA, nil, "a/a.proto", true),
+			NewImageFile(t, fileDescriptorProtoB, nil, "b/b.proto", false),
+		},
+	)
+	require.NoError(t, err)
+	images := []bufimage.Image{imageA, imageB}
+
+	requests := bufimage.ImagesToCodeGeneratorRequests(images, "", false, false)
+	require.Len(t, requests, 2)
+	require.Equal(t, []string{"a/a.proto"}, requests[0].GetFileToGenerate())
+	require.Equal(t, []string{"b/b.proto"}, requests[1].GetFileToGenerate())
+
+	// imports are only generated once, and a/a.proto is not generated as an import of b/b.proto
+	requests = bufimage.ImagesToCodeGeneratorRequests(images, "", true, false)
+	require.Len(t, requests, 2)
+	require.Equal(t, []string{"import/import.proto", "a/a.proto"}, requests[0].GetFileToGenerate())
+	require.Equal(t, []string{"b/b.proto"}, requests[1].GetFileToGenerate())
+
+	requests = bufimage.ImagesToCodeGeneratorRequests(images, "", true, true)
+	require.Len(t, requests, 2)
+	require.Equal(t, []string{"import/import.proto", "google/protobuf/timestamp.proto", "a/a.proto"}, requests[0].GetFileToGenerate())
+	require.Equal(t, []string{"b/b.proto"}, requests[1].GetFileToGenerate())
+
+	// well-known types are only included if imports are included
+	requests = bufimage.ImagesToCodeGeneratorRequests(images, "", false, true)
+	require.Len(t, requests, 2)
+	require.Equal(t, []string{"a/a.proto"}, requests[0].GetFileToGenerate())
+	require.Equal(t, []string{"b/b.proto"}, requests[1].GetFileToGenerate())
+
+	request := bufimage.ImageToCodeGeneratorRequest(imageB, "foo", true, false)
+	require.Equal(t, "foo", request.GetParameter())
+	require.Equal(t, []string{"import/import.proto", "a/a.proto", "b/b.proto"}, request.GetFileToGenerate())
+}
+
+// The below benchmarks read the image from a file, and report peak-B/op, which is
+// the maximum increase of the heap memory in use while the image is read, as B/op
+// only reports total allocations.
+
+func BenchmarkNewImageForProto(b *testing.B) {
+	imageFilePath := newLargeProtoImageFile(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	var peakBytes uint64
+	for n := 0; n < b.N; n++ {
+		var image bufimage.Image
+		peakBytes += getPeakHeapInuse(
+			func() {
+				file, err := os.Open(imageFilePath)
+				require.NoError(b, err)
+				defer file.Close()
+				data, err := ioutil.ReadAll(file)
+				require.NoError(b, err)
+				protoImage := &imagev1.Image{}
+				require.NoError(b, proto.Unmarshal(data, protoImage))
+				image, err = bufimage.NewImageForProto(protoImage)
+				require.NoError(b, err)
+			},
+		)
+		require.Equal(b, 3000, len(image.Files()))
+	}
+	b.ReportMetric(float64(peakBytes)/float64(b.N), "peak-B/op")
+}
+
+func BenchmarkNewFileInfosForProtoImageReader(b *testing.B) {
+	imageFilePath := newLargeProtoImageFile(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	var peakBytes uint64
+	for n := 0; n < b.N; n++ {
+		var fileInfos []bufmodule.FileInfo
+		peakBytes += getPeakHeapInuse(
+			func() {
+				file, err := os.Open(imageFilePath)
+				require.NoError(b, err)
+				defer file.Close()
+				fileInfos, err = bufimage.NewFileInfosForProtoImageReader(file)
+				require.NoError(b, err)
+			},
+		)
+		require.Equal(b, 3000, len(fileInfos))
+	}
+	b.ReportMetric(float64(peakBytes)/float64(b.N), "peak-B/op")
+}
+
+func TestImageWithPathPrefixStripped(t *testing.T) {
+	t.Parallel()
+
+	fileDescriptorProtoImport := NewFileDescriptorProto(
+		t,
+		"google/protobuf/empty.proto",
+	)
+	fileDescriptorProtoA := NewFileDescriptorProto(
+		t,
+		"proto/a/a.proto",
+		"google/protobuf/empty.proto",
+	)
+	fileDescriptorProtoB := NewFileDescriptorProto(
+		t,
+		"proto/b/b.proto",
+		"proto/a/a.proto",
+	)
+	image, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, fileDescriptorProtoImport, nil, "google/protobuf/empty.proto", true),
+			NewImageFile(t, fileDescriptorProtoA, nil, "foo/proto/a/a.proto", false),
+			NewImageFile(t, fileDescriptorProtoB, nil, "foo/proto/b/b.proto", false),
+		},
+	)
+	require.NoError(t, err)
+	strippedImage, err := bufimage.ImageWithPathPrefixStripped(image, "proto")
+	require.NoError(t, err)
+	AssertImageFilesEqual(
+		t,
+		[]bufimage.ImageFile{
+			NewImageFile(t, fileDescriptorProtoImport, nil, "google/protobuf/empty.proto", true),
+			NewImageFile(
+				t,
+				NewFileDescriptorProto(t, "a/a.proto", "google/protobuf/empty.proto"),
+				nil,
+				"foo/proto/a/a.proto",
+				false,
+			),
+			NewImageFile(
+				t,
+				NewFileDescriptorProto(t, "b/b.proto", "a/a.proto"),
+				nil,
+				"foo/proto/b/b.proto",
+				false,
+			),
+		},
+		strippedImage.Files(),
+	)
+	// the original image is not modified
+	require.Equal(t, "proto/b/b.proto", fileDescriptorProtoB.GetName())
+	require.Equal(t, []string{"proto/a/a.proto"}, fileDescriptorProtoB.GetDependency())
+
+	collidingImage, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, NewFileDescriptorProto(t, "a/a.proto"), nil, "a/a.proto", false),
+			NewImageFile(t, NewFileDescriptorProto(t, "proto/a/a.proto"), nil, "proto/a/a.proto", false),
+		},
+	)
+	require.NoError(t, err)
+	_, err = bufimage.ImageWithPathPrefixStripped(collidingImage, "proto")
+	require.Error(t, err)
+}
+
+func TestImageWithoutSourceRetentionOptions(t *testing.T) {
+	t.Parallel()
+
+	newExtension := func(name string, number int32, extendee string, retention uint64) *descriptorpb.FieldDescriptorProto {
+		fieldOptions := &descriptorpb.FieldOptions{}
+		if retention != 0 {
+			// retention is field 17 of FieldOptions
+			fieldOptions.ProtoReflect().SetUnknown(newUnknownVarint(17, retention))
+		}
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum(),
+			Extendee: proto.String(extendee),
+			Options:  fieldOptions,
+		}
+	}
+	fileDescriptorProtoOptions := NewFileDescriptorProto(t, "options.proto")
+	fileDescriptorProtoOptions.Extension = []*descriptorpb.FieldDescriptorProto{
+		// RETENTION_SOURCE
+		newExtension("file_source", 50000, ".google.protobuf.FileOptions", 2),
+		// RETENTION_RUNTIME
+		newExtension("field_runtime", 50001, ".google.protobuf.FieldOptions", 1),
+	}
+	fileDescriptorProtoOptions.MessageType = []*descriptorpb.DescriptorProto{
+		{
+			Name: proto.String("Nested"),
+			Extension: []*descriptorpb.FieldDescriptorProto{
+				newExtension("message_source", 50002, ".google.protobuf.MessageOptions", 2),
+			},
+		},
+	}
+	fileOptions := &descriptorpb.FileOptions{GoPackage: proto.String("foo")}
+	fileOptions.ProtoReflect().SetUnknown(append(newUnknownVarint(50000, 1), newUnknownVarint(50003, 1)...))
+	messageOptions := &descriptorpb.MessageOptions{}
+	messageOptions.ProtoReflect().SetUnknown(newUnknownVarint(50002, 1))
+	fieldOptions := &descriptorpb.FieldOptions{}
+	fieldOptions.ProtoReflect().SetUnknown(newUnknownVarint(50001, 1))
+	fileDescriptorProtoA := NewFileDescriptorProto(t, "a.proto", "options.proto")
+	fileDescriptorProtoA.Options = fileOptions
+	fileDescriptorProtoA.MessageType = []*descriptorpb.DescriptorProto{
+		{
+			Name:    proto.String("A"),
+			Options: messageOptions,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("a"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					JsonName: proto.String("a"),
+					Options:  fieldOptions,
+				},
+			},
+		},
+	}
+	image, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, fileDescriptorProtoOptions, nil, "options.proto", true),
+			NewImageFile(t, fileDescriptorProtoA, nil, "a.proto", false),
+		},
+	)
+	require.NoError(t, err)
+	strippedImage, err := bufimage.ImageWithoutSourceRetentionOptions(image)
+	require.NoError(t, err)
+	strippedFileDescriptorProtoA := strippedImage.GetFile("a.proto").Proto()
+	require.Equal(t, "foo", strippedFileDescriptorProtoA.GetOptions().GetGoPackage())
+	require.Equal(
+		t,
+		newUnknownVarint(50003, 1),
+		[]byte(strippedFileDescriptorProtoA.GetOptions().ProtoReflect().GetUnknown()),
+	)
+	require.Empty(t, strippedFileDescriptorProtoA.GetMessageType()[0].GetOptions().ProtoReflect().GetUnknown())
+	require.Equal(
+		t,
+		newUnknownVarint(50001, 1),
+		[]byte(strippedFileDescriptorProtoA.GetMessageType()[0].GetField()[0].GetOptions().ProtoReflect().GetUnknown()),
+	)
+	// the original image is not modified
+	require.Equal(
+		t,
+		append(newUnknownVarint(50000, 1), newUnknownVarint(50003, 1)...),
+		[]byte(fileDescriptorProtoA.GetOptions().ProtoReflect().GetUnknown()),
+	)
+
+	// an image without source retention extensions is returned unchanged
+	imageWithoutExtensions, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, NewFileDescriptorProto(t, "b.proto"), nil, "b.proto", false),
+		},
+	)
+	require.NoError(t, err)
+	unchangedImage, err := bufimage.ImageWithoutSourceRetentionOptions(imageWithoutExtensions)
+	require.NoError(t, err)
+	require.True(t, imageWithoutExtensions == unchangedImage)
+}
+
+func TestImageWithUnusedImportsPruned(t *testing.T) {
+	t.Parallel()
+
+	newMessageFileDescriptorProto := func(path string, pkg string, messageName string, importPaths ...string) *descriptorpb.FileDescriptorProto {
+		fileDescriptorProto := NewFileDescriptorProto(t, path, importPaths...)
+		fileDescriptorProto.Package = proto.String(pkg)
+		fileDescriptorProto.MessageType = []*descriptorpb.DescriptorProto{{Name: proto.String(messageName)}}
+		return fileDescriptorProto
+	}
+	newField := func(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(typeName),
+			JsonName: proto.String(name),
+		}
+	}
+	fileDescriptorProtoUsed := newMessageFileDescriptorProto("used.proto", "used", "Used")
+	fileDescriptorProtoUnused := newMessageFileDescriptorProto("unused.proto", "unused", "Unused")
+	fileDescriptorProtoViaPublic := newMessageFileDescriptorProto("via_public.proto", "viapublic", "ViaPublic")
+	fileDescriptorProtoPublic := NewFileDescriptorProto(t, "public.proto", "via_public.proto")
+	fileDescriptorProtoPublic.PublicDependency = []int32{0}
+	fileDescriptorProtoOptions := NewFileDescriptorProto(t, "options.proto")
+	fileDescriptorProtoOptions.Extension = []*descriptorpb.FieldDescriptorProto{
+		{
+			Name:     proto.String("file_option"),
+			Number:   proto.Int32(50000),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum(),
+			Extendee: proto.String(".google.protobuf.FileOptions"),
+		},
+	}
+	fileDescriptorProtoExtension := NewFileDescriptorProto(t, "extension.proto", "a.proto", "used.proto")
+	fileDescriptorProtoExtension.Extension = []*descriptorpb.FieldDescriptorProto{
+		newField("extension", 100, ".used.Used"),
+	}
+	fileDescriptorProtoExtension.Extension[0].Extendee = proto.String(".a.A")
+	fileOptions := &descriptorpb.FileOptions{}
+	fileOptions.ProtoReflect().SetUnknown(newUnknownVarint(50000, 1))
+	fileDescriptorProtoA := NewFileDescriptorProto(t, "a.proto", "used.proto", "unused.proto", "public.proto", "options.proto")
+	fileDescriptorProtoA.Package = proto.String("a")
+	fileDescriptorProtoA.PublicDependency = []int32{2}
+	fileDescriptorProtoA.Options = fileOptions
+	fileDescriptorProtoA.MessageType = []*descriptorpb.DescriptorProto{
+		{
+			Name: proto.String("A"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				newField("used", 1, ".used.Used"),
+				newField("via_public", 2, ".viapublic.ViaPublic"),
+			},
+			ExtensionRange: []*descriptorpb.DescriptorProto_ExtensionRange{
+				{Start: proto.Int32(100), End: proto.Int32(200)},
+			},
+		},
+	}
+	fileDescriptorProtoA.SourceCodeInfo = &descriptorpb.SourceCodeInfo{
+		Location: []*descriptorpb.SourceCodeInfo_Location{
+			{Path: []int32{3, 0}},
+			{Path: []int32{3, 1}},
+			{Path: []int32{3, 2}},
+			{Path: []int32{3, 3}},
+			{Path: []int32{10, 0}},
+			{Path: []int32{4, 0}},
+		},
+	}
+	image, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, fileDescriptorProtoUsed, nil, "used.proto", true),
+			NewImageFile(t, fileDescriptorProtoUnused, nil, "unused.proto", true),
+			NewImageFile(t, fileDescriptorProtoViaPublic, nil, "via_public.proto", true),
+			NewImageFile(t, fileDescriptorProtoPublic, nil, "public.proto", true),
+			NewImageFile(t, fileDescriptorProtoOptions, nil, "options.proto", true),
+			NewImageFile(t, fileDescriptorProtoA, nil, "a.proto", false),
+			NewImageFile(t, fileDescriptorProtoExtension, nil, "extension.proto", true),
+		},
+	)
+	require.NoError(t, err)
+	prunedImage, err := bufimage.ImageWithUnusedImportsPruned(image)
+	require.NoError(t, err)
+	AssertImageFilesEqual(
+		t,
+		[]bufimage.ImageFile{
+			NewImageFile(t, fileDescriptorProtoUsed, nil, "used.proto", true),
+			NewImageFile(t, fileDescriptorProtoViaPublic, nil, "via_public.proto", true),
+			NewImageFile(t, fileDescriptorProtoPublic, nil, "public.proto", true),
+			NewImageFile(t, fileDescriptorProtoOptions, nil, "options.proto", true),
+			NewImageFile(
+				t,
+				NewFileDescriptorProto(t, "a.proto", "used.proto", "public.proto", "options.proto"),
+				nil,
+				"a.proto",
+				false,
+			),
+			NewImageFile(t, fileDescriptorProtoExtension, nil, "extension.proto", true),
+		},
+		prunedImage.Files(),
+	)
+	prunedFileDescriptorProtoA := prunedImage.GetFile("a.proto").Proto()
+	require.Equal(t, []int32{1}, prunedFileDescriptorProtoA.GetPublicDependency())
+	var locationPaths [][]int32
+	for _, location := range prunedFileDescriptorProtoA.GetSourceCodeInfo().GetLocation() {
+		locationPaths = append(locationPaths, location.GetPath())
+	}
+	require.Equal(t, [][]int32{{3, 0}, {3, 1}, {3, 2}, {10, 0}, {4, 0}}, locationPaths)
+	// the original image is not modified
+	require.Equal(t, []string{"used.proto", "unused.proto", "public.proto", "options.proto"}, fileDescriptorProtoA.GetDependency())
+
+	// pruning again does nothing
+	unchangedImage, err := bufimage.ImageWithUnusedImportsPruned(prunedImage)
+	require.NoError(t, err)
+	require.True(t, prunedImage == unchangedImage)
+}
+
+func TestNewFileInfosForProtoImageReader(t *testing.T) {
+	t.Parallel()
+	moduleReference, err := bufmodule.NewModuleReference("foo.com", "barr", "bazz", "main")
+	require.NoError(t, err)
+	image, err := bufimage.NewImage(
+		[]bufimage.ImageFile{
+			NewImageFile(t, NewFileDescriptorProto(t, "import.proto"), moduleReference, "import.proto", true),
+			NewImageFile(t, NewFileDescriptorProto(t, "a/a.proto", "import.proto"), nil, "a/a.proto", false),
+			NewImageFile(t, NewFileDescriptorProto(t, "b/b.proto", "a/a.proto"), nil, "b/b.proto", false),
+		},
+	)
+	require.NoError(t, err)
+	data, err := proto.Marshal(bufimage.ImageToProtoImage(image))
+	require.NoError(t, err)
+	fileInfos, err := bufimage.NewFileInfosForProtoImageReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, fileInfos, 3)
+	for i, imageFile := range image.Files() {
+		require.Equal(t, imageFile.Path(), fileInfos[i].Path())
+		require.Equal(t, imageFile.ExternalPath(), fileInfos[i].ExternalPath())
+		require.Equal(t, imageFile.IsImport(), fileInfos[i].IsImport())
+	}
+	require.NotNil(t, fileInfos[0].ModuleReference())
+	require.Equal(t, moduleReference.String(), fileInfos[0].ModuleReference().String())
+	require.Nil(t, fileInfos[1].ModuleReference())
+
+	_, err = bufimage.NewFileInfosForProtoImageReader(bytes.NewReader(data[:len(data)-1]))
+	require.Error(t, err)
+	_, err = bufimage.NewFileInfosForProtoImageReader(bytes.NewReader(nil))
+	require.Error(t, err)
+
+	protoImage := bufimage.ImageToProtoImage(image)
+	protoImage.File = append(protoImage.File, protoImage.File[1])
+	data, err = proto.Marshal(protoImage)
+	require.NoError(t, err)
+	_, err = bufimage.NewFileInfosForProtoImageReader(bytes.NewReader(data))
+	require.EqualError(t, err, "duplicate file: a/a.proto")
+}
+
+// newLargeProtoImageFile writes a binary-encoded proto Image with 3000 files
+// of 100 messages each to a temporary file, and returns the path of the file.
+func newLargeProtoImageFile(b *testing.B) string {
+	var imageFiles []bufimage.ImageFile
+	for i := 0; i < 3000; i++ {
+		fileDescriptorProto := NewFileDescriptorProto(b, fmt.Sprintf("a%d/a%d.proto", i, i))
+		for j := 0; j < 100; j++ {
+			fileDescriptorProto.MessageType = append(
+				fileDescriptorProto.MessageType,
+				&descriptorpb.DescriptorProto{
+					Name: proto.String(fmt.Sprintf("Message%d", j)),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:   proto.String("value"),
+							Number: proto.Int32(1),
+							Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+						},
+					},
+				},
+			)
+		}
+		imageFiles = append(imageFiles, NewImageFile(b, fileDescriptorProto, nil, fileDescriptorProto.GetName(), false))
+	}
+	image, err := bufimage.NewImage(imageFiles)
+	require.NoError(b, err)
+	data, err := proto.Marshal(bufimage.ImageToProtoImage(image))
+	require.NoError(b, err)
+	imageFilePath := filepath.Join(b.TempDir(), "image.bin")
+	require.NoError(b, ioutil.WriteFile(imageFilePath, data, 0600))
+	return imageFilePath
+}
+
+func newUnknownVarint(fieldNumber protowire.Number, value uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, fieldNumber, protowire.VarintType), value)
+}
+
+// getPeakHeapInuse calls f, and returns the maximum increase of HeapInuse over
+// its value before f was called, sampled every millisecond while f runs.
+func getPeakHeapInuse(f func()) uint64 {
+	runtime.GC()
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	before := memStats.HeapInuse
+	peak := before
+	done := make(chan struct{})
+	sampled := make(chan struct{})
+	go func() {
+		defer close(sampled)
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		var memStats runtime.MemStats
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				runtime.ReadMemStats(&memStats)
+				if memStats.HeapInuse > peak {
+					peak = memStats.HeapInuse
+				}
+			}
+		}
+	}()
+	f()
+	close(done)
+	<-sampled
+	runtime.ReadMemStats(&memStats)
+	if memStats.HeapInuse > peak {
+		peak = memStats.HeapInuse
+	}
+	return peak - before
+}
@@ -0,0 +1,94 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimage
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func sortFileDescriptorProtoOptions(fileDescriptorProto *descriptorpb.FileDescriptorProto) error {
+	sorter := &optionsSorter{}
+	forEachOptions(fileDescriptorProto, sorter.sort)
+	return sorter.err
+}
+
+type optionsSorter struct {
+	err error
+}
+
+// sort sorts the unknown fields of the options message by field number.
+//
+// Custom options whose extensions are not registered with the Go runtime
+// are stored as unknown fields, and are serialized in the order they were
+// added. Registered extensions are already sorted by the deterministic
+// marshaler. The sort is stable, so that the values of repeated fields, and
+// the last value of non-repeated fields, are unchanged.
+func (s *optionsSorter) sort(options proto.Message) {
+	if s.err != nil {
+		return
+	}
+	message := options.ProtoReflect()
+	if !message.IsValid() {
+		// typed nil, i.e. the options are not set
+		return
+	}
+	unknown := message.GetUnknown()
+	if len(unknown) == 0 {
+		return
+	}
+	var unknownFields []unknownField
+	for len(unknown) > 0 {
+		fieldNumber, wireType, length := protowire.ConsumeTag(unknown)
+		if length < 0 {
+			s.err = protowire.ParseError(length)
+			return
+		}
+		valueLength := protowire.ConsumeFieldValue(fieldNumber, wireType, unknown[length:])
+		if valueLength < 0 {
+			s.err = protowire.ParseError(valueLength)
+			return
+		}
+		unknownFields = append(
+			unknownFields,
+			unknownField{
+				number: fieldNumber,
+				data:   unknown[:length+valueLength],
+			},
+		)
+		unknown = unknown[length+valueLength:]
+	}
+	less := func(i int, j int) bool {
+		return unknownFields[i].number < unknownFields[j].number
+	}
+	if sort.SliceIsSorted(unknownFields, less) {
+		return
+	}
+	sort.SliceStable(unknownFields, less)
+	newUnknown := make(protoreflect.RawFields, 0, len(message.GetUnknown()))
+	for _, unknownField := range unknownFields {
+		newUnknown = append(newUnknown, unknownField.data...)
+	}
+	message.SetUnknown(newUnknown)
+}
+
+type unknownField struct {
+	number protowire.Number
+	data   []byte
+}
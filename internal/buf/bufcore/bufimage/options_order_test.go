@@ -0,0 +1,66 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestSortFileDescriptorProtoOptions(t *testing.T) {
+	t.Parallel()
+	var unknown []byte
+	for _, fieldNumberAndValue := range [][2]uint64{
+		{50003, 3},
+		{50001, 1},
+		{50002, 2},
+		{50001, 4},
+	} {
+		unknown = protowire.AppendTag(unknown, protowire.Number(fieldNumberAndValue[0]), protowire.VarintType)
+		unknown = protowire.AppendVarint(unknown, fieldNumberAndValue[1])
+	}
+	var expectedUnknown []byte
+	// the sort is stable, so 1 is still before 4
+	for _, fieldNumberAndValue := range [][2]uint64{
+		{50001, 1},
+		{50001, 4},
+		{50002, 2},
+		{50003, 3},
+	} {
+		expectedUnknown = protowire.AppendTag(expectedUnknown, protowire.Number(fieldNumberAndValue[0]), protowire.VarintType)
+		expectedUnknown = protowire.AppendVarint(expectedUnknown, fieldNumberAndValue[1])
+	}
+	fieldOptions := &descriptorpb.FieldOptions{}
+	fieldOptions.ProtoReflect().SetUnknown(unknown)
+	fileDescriptorProto := &descriptorpb.FileDescriptorProto{
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Foo"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:    proto.String("bar"),
+						Options: fieldOptions,
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, SortFileDescriptorProtoOptions(fileDescriptorProto))
+	require.Equal(t, expectedUnknown, []byte(fieldOptions.ProtoReflect().GetUnknown()))
+}
@@ -0,0 +1,190 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimage
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/bufbuild/buf/internal/buf/bufcore"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	imagev1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/image/v1"
+	"github.com/bufbuild/buf/internal/pkg/protodescriptor"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// the field numbers of file and bufbuild_image_extension in Image
+	protoImageFileFieldNumber           protowire.Number = 1
+	protoImageImageExtensionFieldNumber protowire.Number = 8042
+)
+
+func newFileInfosForProtoImageReader(reader io.Reader) ([]bufmodule.FileInfo, error) {
+	var coreFileInfos []bufcore.FileInfo
+	paths := make(map[string]struct{})
+	imageExtension, err := walkProtoImage(
+		reader,
+		func(fileDescriptorProto *descriptorpb.FileDescriptorProto) error {
+			if err := protodescriptor.ValidateFileDescriptorProto(fileDescriptorProto); err != nil {
+				return err
+			}
+			coreFileInfo, err := bufcore.NewFileInfo(
+				fileDescriptorProto.GetName(),
+				fileDescriptorProto.GetName(),
+				false,
+			)
+			if err != nil {
+				return err
+			}
+			// this is the same validation as in NewImage
+			if _, ok := paths[coreFileInfo.Path()]; ok {
+				return fmt.Errorf("duplicate file: %s", coreFileInfo.Path())
+			}
+			paths[coreFileInfo.Path()] = struct{}{}
+			coreFileInfos = append(coreFileInfos, coreFileInfo)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(coreFileInfos) == 0 {
+		return nil, errors.New("image contains no files")
+	}
+	// the file indexes are only known once the entire image has been read,
+	// as the image extension is serialized after the files
+	if err := validateProtoImageExtension(imageExtension, uint32(len(coreFileInfos))); err != nil {
+		return nil, err
+	}
+	protoImage := &imagev1.Image{
+		BufbuildImageExtension: imageExtension,
+	}
+	importFileIndexes, err := getImportFileIndexes(protoImage)
+	if err != nil {
+		return nil, err
+	}
+	moduleReferenceRefs, err := getModuleReferenceRefs(protoImage)
+	if err != nil {
+		return nil, err
+	}
+	fileInfos := make([]bufmodule.FileInfo, len(coreFileInfos))
+	for i, coreFileInfo := range coreFileInfos {
+		if _, isImport := importFileIndexes[i]; isImport {
+			coreFileInfo = coreFileInfo.WithIsImport(true)
+		}
+		fileInfos[i] = bufmodule.NewFileInfo(coreFileInfo, moduleReferenceRefs[i])
+	}
+	return fileInfos, nil
+}
+
+// walkProtoImage reads the binary-encoded proto Image from the reader, calling
+// fileFunc for every file in order, and returns the ImageExtension.
+//
+// Only one FileDescriptorProto is unmarshalled at a time.
+func walkProtoImage(
+	reader io.Reader,
+	fileFunc func(*descriptorpb.FileDescriptorProto) error,
+) (*imagev1.ImageExtension, error) {
+	bufioReader := bufio.NewReader(reader)
+	imageExtension := &imagev1.ImageExtension{}
+	var data []byte
+	for {
+		tag, err := binary.ReadUvarint(bufioReader)
+		if err != nil {
+			if err == io.EOF {
+				return imageExtension, nil
+			}
+			return nil, newCouldNotUnmarshalImageError(err)
+		}
+		fieldNumber, wireType := protowire.DecodeTag(tag)
+		switch wireType {
+		case protowire.BytesType:
+			length, err := binary.ReadUvarint(bufioReader)
+			if err != nil {
+				return nil, newCouldNotUnmarshalImageError(toUnexpectedEOF(err))
+			}
+			if length > math.MaxInt32 {
+				return nil, newCouldNotUnmarshalImageError(fmt.Errorf("field %d has invalid length %d", fieldNumber, length))
+			}
+			switch fieldNumber {
+			case protoImageFileFieldNumber, protoImageImageExtensionFieldNumber:
+				// the buffer is reused across files as proto.Unmarshal copies
+				if uint64(cap(data)) < length {
+					data = make([]byte, length)
+				}
+				data = data[:length]
+				if _, err := io.ReadFull(bufioReader, data); err != nil {
+					return nil, newCouldNotUnmarshalImageError(toUnexpectedEOF(err))
+				}
+				if fieldNumber == protoImageFileFieldNumber {
+					fileDescriptorProto := &descriptorpb.FileDescriptorProto{}
+					if err := proto.Unmarshal(data, fileDescriptorProto); err != nil {
+						return nil, newCouldNotUnmarshalImageError(err)
+					}
+					if err := fileFunc(fileDescriptorProto); err != nil {
+						return nil, err
+					}
+				} else {
+					// a message field that appears multiple times is merged
+					if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(data, imageExtension); err != nil {
+						return nil, newCouldNotUnmarshalImageError(err)
+					}
+				}
+			default:
+				if err := discard(bufioReader, int(length)); err != nil {
+					return nil, newCouldNotUnmarshalImageError(err)
+				}
+			}
+		case protowire.VarintType:
+			if _, err := binary.ReadUvarint(bufioReader); err != nil {
+				return nil, newCouldNotUnmarshalImageError(toUnexpectedEOF(err))
+			}
+		case protowire.Fixed32Type:
+			if err := discard(bufioReader, 4); err != nil {
+				return nil, newCouldNotUnmarshalImageError(err)
+			}
+		case protowire.Fixed64Type:
+			if err := discard(bufioReader, 8); err != nil {
+				return nil, newCouldNotUnmarshalImageError(err)
+			}
+		default:
+			return nil, newCouldNotUnmarshalImageError(fmt.Errorf("field %d has unsupported wire type %d", fieldNumber, wireType))
+		}
+	}
+}
+
+func discard(bufioReader *bufio.Reader, n int) error {
+	if _, err := bufioReader.Discard(n); err != nil {
+		return toUnexpectedEOF(err)
+	}
+	return nil
+}
+
+func toUnexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func newCouldNotUnmarshalImageError(err error) error {
+	return fmt.Errorf("could not unmarshal image: %v", err)
+}
@@ -0,0 +1,407 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimage
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	fileDescriptorProtoDependencyTag       = 3
+	fileDescriptorProtoPublicDependencyTag = 10
+	fileDescriptorProtoWeakDependencyTag   = 11
+)
+
+func imageWithUnusedImportsPruned(image Image) (Image, error) {
+	imageFiles := image.Files()
+	index := newPruneIndex(imageFiles)
+	keptPaths := make(map[string]struct{}, len(imageFiles))
+	var queue []ImageFile
+	keep := func(imageFile ImageFile) {
+		if _, ok := keptPaths[imageFile.Path()]; !ok {
+			keptPaths[imageFile.Path()] = struct{}{}
+			queue = append(queue, imageFile)
+		}
+	}
+	for _, imageFile := range imageFiles {
+		if !imageFile.IsImport() {
+			keep(imageFile)
+		}
+	}
+	for len(queue) > 0 {
+		for len(queue) > 0 {
+			imageFile := queue[0]
+			queue = queue[1:]
+			for _, referencedPath := range index.getReferencedPaths(imageFile) {
+				for _, path := range index.getDependencyChain(imageFile, referencedPath) {
+					keep(index.pathToImageFile[path])
+				}
+			}
+		}
+		// Extensions are not referenced by the files they extend, so we keep any
+		// import that extends a message within a kept file, along with its
+		// references on the next iteration.
+		for _, imageFile := range imageFiles {
+			if _, ok := keptPaths[imageFile.Path()]; ok {
+				continue
+			}
+			for _, extendeePath := range index.pathToExtendeePaths[imageFile.Path()] {
+				if _, ok := keptPaths[extendeePath]; ok {
+					keep(imageFile)
+					break
+				}
+			}
+		}
+	}
+	if len(keptPaths) == len(imageFiles) {
+		return image, nil
+	}
+	newImageFiles := make([]ImageFile, 0, len(keptPaths))
+	for _, imageFile := range imageFiles {
+		if _, ok := keptPaths[imageFile.Path()]; !ok {
+			continue
+		}
+		removedDependencyIndexes := make(map[int]struct{})
+		for i, dependency := range imageFile.Proto().GetDependency() {
+			if _, ok := index.pathToImageFile[dependency]; !ok {
+				// not within the image, so we cannot say it is unused
+				continue
+			}
+			if _, ok := keptPaths[dependency]; !ok {
+				removedDependencyIndexes[i] = struct{}{}
+			}
+		}
+		if len(removedDependencyIndexes) == 0 {
+			newImageFiles = append(newImageFiles, imageFile)
+			continue
+		}
+		fileDescriptorProto := proto.Clone(imageFile.Proto()).(*descriptorpb.FileDescriptorProto)
+		removeDependencies(fileDescriptorProto, removedDependencyIndexes)
+		newImageFile, err := NewImageFile(
+			fileDescriptorProto,
+			imageFile.ModuleReference(),
+			imageFile.ExternalPath(),
+			imageFile.IsImport(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		newImageFiles = append(newImageFiles, newImageFile)
+	}
+	return NewImage(newImageFiles)
+}
+
+type extensionKey struct {
+	extendee string
+	number   protowire.Number
+}
+
+type pruneIndex struct {
+	pathToImageFile map[string]ImageFile
+	// fully-qualified name without the leading dot to the path of the
+	// file that defines the message, enum, or extension
+	nameToPath map[string]string
+	// the extendee and field number to the path of the file that
+	// defines the extension, used for custom options
+	extensionKeyToPath map[extensionKey]string
+	// the path to the paths of the files that contain the messages
+	// extended by extensions within the file at the path
+	pathToExtendeePaths map[string][]string
+}
+
+func newPruneIndex(imageFiles []ImageFile) *pruneIndex {
+	index := &pruneIndex{
+		pathToImageFile:     make(map[string]ImageFile, len(imageFiles)),
+		nameToPath:          make(map[string]string),
+		extensionKeyToPath:  make(map[extensionKey]string),
+		pathToExtendeePaths: make(map[string][]string),
+	}
+	for _, imageFile := range imageFiles {
+		index.pathToImageFile[imageFile.Path()] = imageFile
+		fileDescriptorProto := imageFile.Proto()
+		prefix := fileDescriptorProto.GetPackage()
+		if prefix != "" {
+			prefix += "."
+		}
+		index.addExtensions(imageFile.Path(), prefix, fileDescriptorProto.GetExtension())
+		index.addMessages(imageFile.Path(), prefix, fileDescriptorProto.GetMessageType())
+		index.addEnums(imageFile.Path(), prefix, fileDescriptorProto.GetEnumType())
+	}
+	// now that all names are indexed, resolve the files of the extendees
+	for _, imageFile := range imageFiles {
+		for _, extendee := range getExtendees(imageFile.Proto()) {
+			if extendeePath, ok := index.nameToPath[extendee]; ok && extendeePath != imageFile.Path() {
+				index.pathToExtendeePaths[imageFile.Path()] = append(index.pathToExtendeePaths[imageFile.Path()], extendeePath)
+			}
+		}
+	}
+	return index
+}
+
+func (p *pruneIndex) addMessages(path string, prefix string, descriptorProtos []*descriptorpb.DescriptorProto) {
+	for _, descriptorProto := range descriptorProtos {
+		name := prefix + descriptorProto.GetName()
+		p.nameToPath[name] = path
+		p.addExtensions(path, name+".", descriptorProto.GetExtension())
+		p.addMessages(path, name+".", descriptorProto.GetNestedType())
+		p.addEnums(path, name+".", descriptorProto.GetEnumType())
+	}
+}
+
+func (p *pruneIndex) addEnums(path string, prefix string, enumDescriptorProtos []*descriptorpb.EnumDescriptorProto) {
+	for _, enumDescriptorProto := range enumDescriptorProtos {
+		p.nameToPath[prefix+enumDescriptorProto.GetName()] = path
+	}
+}
+
+func (p *pruneIndex) addExtensions(path string, prefix string, fieldDescriptorProtos []*descriptorpb.FieldDescriptorProto) {
+	for _, fieldDescriptorProto := range fieldDescriptorProtos {
+		p.nameToPath[prefix+fieldDescriptorProto.GetName()] = path
+		p.extensionKeyToPath[extensionKey{
+			extendee: strings.TrimPrefix(fieldDescriptorProto.GetExtendee(), "."),
+			number:   protowire.Number(fieldDescriptorProto.GetNumber()),
+		}] = path
+	}
+}
+
+// getReferencedPaths returns the paths of the other files within the image
+// that define the types, extendees, and custom options used by the file.
+func (p *pruneIndex) getReferencedPaths(imageFile ImageFile) []string {
+	fileDescriptorProto := imageFile.Proto()
+	var referencedPaths []string
+	seen := make(map[string]struct{})
+	addPath := func(path string) {
+		if path == imageFile.Path() {
+			return
+		}
+		if _, ok := seen[path]; !ok {
+			seen[path] = struct{}{}
+			referencedPaths = append(referencedPaths, path)
+		}
+	}
+	addName := func(name string) {
+		if name == "" {
+			return
+		}
+		if path, ok := p.nameToPath[strings.TrimPrefix(name, ".")]; ok {
+			addPath(path)
+		}
+	}
+	addFields := func(fieldDescriptorProtos []*descriptorpb.FieldDescriptorProto) {
+		for _, fieldDescriptorProto := range fieldDescriptorProtos {
+			addName(fieldDescriptorProto.GetTypeName())
+			addName(fieldDescriptorProto.GetExtendee())
+		}
+	}
+	var addMessages func([]*descriptorpb.DescriptorProto)
+	addMessages = func(descriptorProtos []*descriptorpb.DescriptorProto) {
+		for _, descriptorProto := range descriptorProtos {
+			addFields(descriptorProto.GetField())
+			addFields(descriptorProto.GetExtension())
+			addMessages(descriptorProto.GetNestedType())
+		}
+	}
+	addFields(fileDescriptorProto.GetExtension())
+	addMessages(fileDescriptorProto.GetMessageType())
+	for _, serviceDescriptorProto := range fileDescriptorProto.GetService() {
+		for _, methodDescriptorProto := range serviceDescriptorProto.GetMethod() {
+			addName(methodDescriptorProto.GetInputType())
+			addName(methodDescriptorProto.GetOutputType())
+		}
+	}
+	forEachOptions(
+		fileDescriptorProto,
+		func(options proto.Message) {
+			for _, path := range p.getOptionsExtensionPaths(options) {
+				addPath(path)
+			}
+		},
+	)
+	return referencedPaths
+}
+
+// getOptionsExtensionPaths returns the paths of the files that define the
+// custom options set on the options message.
+func (p *pruneIndex) getOptionsExtensionPaths(options proto.Message) []string {
+	message := options.ProtoReflect()
+	if !message.IsValid() {
+		return nil
+	}
+	extendee := string(message.Descriptor().FullName())
+	var paths []string
+	addNumber := func(number protowire.Number) {
+		if path, ok := p.extensionKeyToPath[extensionKey{extendee: extendee, number: number}]; ok {
+			paths = append(paths, path)
+		}
+	}
+	message.Range(
+		func(fieldDescriptor protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if fieldDescriptor.IsExtension() {
+				addNumber(fieldDescriptor.Number())
+			}
+			return true
+		},
+	)
+	unknown := message.GetUnknown()
+	for len(unknown) > 0 {
+		number, wireType, length := protowire.ConsumeTag(unknown)
+		if length < 0 {
+			// malformed unknown fields, nothing more can be read
+			break
+		}
+		valueLength := protowire.ConsumeFieldValue(number, wireType, unknown[length:])
+		if valueLength < 0 {
+			break
+		}
+		addNumber(number)
+		unknown = unknown[length+valueLength:]
+	}
+	return paths
+}
+
+// getDependencyChain returns the paths of the files that the file must keep
+// as imports to be able to see the referenced path.
+//
+// This is the referenced path itself, and if the referenced path is only
+// visible through public imports, the files along the chain of public imports.
+func (p *pruneIndex) getDependencyChain(imageFile ImageFile, referencedPath string) []string {
+	for _, dependency := range imageFile.Proto().GetDependency() {
+		if chain := p.getPublicDependencyChain(dependency, referencedPath, make(map[string]struct{})); chain != nil {
+			return chain
+		}
+	}
+	// the file did not import the referenced file, which is invalid, but we
+	// keep the referenced file to be safe
+	if _, ok := p.pathToImageFile[referencedPath]; ok {
+		return []string{referencedPath}
+	}
+	return nil
+}
+
+func (p *pruneIndex) getPublicDependencyChain(path string, referencedPath string, seen map[string]struct{}) []string {
+	if _, ok := p.pathToImageFile[path]; !ok {
+		return nil
+	}
+	if path == referencedPath {
+		return []string{path}
+	}
+	if _, ok := seen[path]; ok {
+		return nil
+	}
+	seen[path] = struct{}{}
+	fileDescriptorProto := p.pathToImageFile[path].Proto()
+	for _, publicDependencyIndex := range fileDescriptorProto.GetPublicDependency() {
+		if int(publicDependencyIndex) >= len(fileDescriptorProto.GetDependency()) {
+			continue
+		}
+		publicDependency := fileDescriptorProto.GetDependency()[publicDependencyIndex]
+		if chain := p.getPublicDependencyChain(publicDependency, referencedPath, seen); chain != nil {
+			return append([]string{path}, chain...)
+		}
+	}
+	return nil
+}
+
+// getExtendees returns the fully-qualified names without the leading dot
+// of the messages extended within the file.
+func getExtendees(fileDescriptorProto *descriptorpb.FileDescriptorProto) []string {
+	var extendees []string
+	addFields := func(fieldDescriptorProtos []*descriptorpb.FieldDescriptorProto) {
+		for _, fieldDescriptorProto := range fieldDescriptorProtos {
+			extendees = append(extendees, strings.TrimPrefix(fieldDescriptorProto.GetExtendee(), "."))
+		}
+	}
+	var addMessages func([]*descriptorpb.DescriptorProto)
+	addMessages = func(descriptorProtos []*descriptorpb.DescriptorProto) {
+		for _, descriptorProto := range descriptorProtos {
+			addFields(descriptorProto.GetExtension())
+			addMessages(descriptorProto.GetNestedType())
+		}
+	}
+	addFields(fileDescriptorProto.GetExtension())
+	addMessages(fileDescriptorProto.GetMessageType())
+	return extendees
+}
+
+// removeDependencies removes the dependencies at the given indexes, fixing up
+// the public and weak dependency indexes and the source code info to match.
+func removeDependencies(fileDescriptorProto *descriptorpb.FileDescriptorProto, removedIndexes map[int]struct{}) {
+	oldIndexToNewIndex := make(map[int32]int32, len(fileDescriptorProto.Dependency))
+	newDependencies := make([]string, 0, len(fileDescriptorProto.Dependency)-len(removedIndexes))
+	for i, dependency := range fileDescriptorProto.Dependency {
+		if _, ok := removedIndexes[i]; ok {
+			continue
+		}
+		oldIndexToNewIndex[int32(i)] = int32(len(newDependencies))
+		newDependencies = append(newDependencies, dependency)
+	}
+	fileDescriptorProto.Dependency = newDependencies
+	publicDependencyOldIndexToNewIndex, newPublicDependencies := remapDependencyIndexes(fileDescriptorProto.PublicDependency, oldIndexToNewIndex)
+	fileDescriptorProto.PublicDependency = newPublicDependencies
+	weakDependencyOldIndexToNewIndex, newWeakDependencies := remapDependencyIndexes(fileDescriptorProto.WeakDependency, oldIndexToNewIndex)
+	fileDescriptorProto.WeakDependency = newWeakDependencies
+	if fileDescriptorProto.SourceCodeInfo == nil {
+		return
+	}
+	tagToOldIndexToNewIndex := map[int32]map[int32]int32{
+		fileDescriptorProtoDependencyTag:       oldIndexToNewIndex,
+		fileDescriptorProtoPublicDependencyTag: publicDependencyOldIndexToNewIndex,
+		fileDescriptorProtoWeakDependencyTag:   weakDependencyOldIndexToNewIndex,
+	}
+	newLocations := make([]*descriptorpb.SourceCodeInfo_Location, 0, len(fileDescriptorProto.SourceCodeInfo.Location))
+	for _, location := range fileDescriptorProto.SourceCodeInfo.Location {
+		if len(location.Path) < 2 {
+			newLocations = append(newLocations, location)
+			continue
+		}
+		sourceOldIndexToNewIndex, ok := tagToOldIndexToNewIndex[location.Path[0]]
+		if !ok {
+			newLocations = append(newLocations, location)
+			continue
+		}
+		newIndex, ok := sourceOldIndexToNewIndex[location.Path[1]]
+		if !ok {
+			// the location is for a removed dependency
+			continue
+		}
+		location.Path[1] = newIndex
+		newLocations = append(newLocations, location)
+	}
+	fileDescriptorProto.SourceCodeInfo.Location = newLocations
+}
+
+// remapDependencyIndexes remaps the indexes into the dependency list, dropping
+// any indexes that were removed.
+//
+// This returns the mapping from the old position within indexes to the new
+// position, as well as the new indexes.
+func remapDependencyIndexes(indexes []int32, oldIndexToNewIndex map[int32]int32) (map[int32]int32, []int32) {
+	oldPositionToNewPosition := make(map[int32]int32, len(indexes))
+	var newIndexes []int32
+	for i, index := range indexes {
+		newIndex, ok := oldIndexToNewIndex[index]
+		if !ok {
+			continue
+		}
+		oldPositionToNewPosition[int32(i)] = int32(len(newIndexes))
+		newIndexes = append(newIndexes, newIndex)
+	}
+	return oldPositionToNewPosition, newIndexes
+}
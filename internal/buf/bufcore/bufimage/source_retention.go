@@ -0,0 +1,212 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimage
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// fieldOptionsRetentionFieldNumber is the field number of retention in
+	// google.protobuf.FieldOptions.
+	//
+	// The version of descriptor.proto we compile against predates retention,
+	// so we read it from the unknown fields. This is populated when an Image
+	// is read from a source that understands retention, such as an Image
+	// produced by a newer version of protoc.
+	fieldOptionsRetentionFieldNumber protowire.Number = 17
+	// optionRetentionSource is the value of RETENTION_SOURCE in
+	// google.protobuf.FieldOptions.OptionRetention.
+	optionRetentionSource uint64 = 2
+)
+
+func imageWithoutSourceRetentionOptions(image Image) (Image, error) {
+	optionsNameToFieldNumbers, err := getSourceRetentionOptionsNameToFieldNumbers(image)
+	if err != nil {
+		return nil, err
+	}
+	if len(optionsNameToFieldNumbers) == 0 {
+		return image, nil
+	}
+	imageFiles := image.Files()
+	newImageFiles := make([]ImageFile, 0, len(imageFiles))
+	for _, imageFile := range imageFiles {
+		fileDescriptorProto := proto.Clone(imageFile.Proto()).(*descriptorpb.FileDescriptorProto)
+		if err := stripSourceRetentionOptionsFromFile(fileDescriptorProto, optionsNameToFieldNumbers); err != nil {
+			return nil, fmt.Errorf("%s: %v", imageFile.Path(), err)
+		}
+		newImageFile, err := NewImageFile(
+			fileDescriptorProto,
+			imageFile.ModuleReference(),
+			imageFile.ExternalPath(),
+			imageFile.IsImport(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		newImageFiles = append(newImageFiles, newImageFile)
+	}
+	return NewImage(newImageFiles)
+}
+
+// getSourceRetentionOptionsNameToFieldNumbers returns a map from the fully-qualified
+// name of an options message, such as google.protobuf.FieldOptions, to the field numbers
+// of the extensions of that message that have retention = RETENTION_SOURCE.
+func getSourceRetentionOptionsNameToFieldNumbers(image Image) (map[string]map[protowire.Number]struct{}, error) {
+	optionsNameToFieldNumbers := make(map[string]map[protowire.Number]struct{})
+	addExtensions := func(fieldDescriptorProtos []*descriptorpb.FieldDescriptorProto) error {
+		for _, fieldDescriptorProto := range fieldDescriptorProtos {
+			isSourceRetention, err := isSourceRetention(fieldDescriptorProto.GetOptions())
+			if err != nil {
+				return fmt.Errorf("extension %q: %v", fieldDescriptorProto.GetName(), err)
+			}
+			if !isSourceRetention {
+				continue
+			}
+			optionsName := strings.TrimPrefix(fieldDescriptorProto.GetExtendee(), ".")
+			fieldNumbers, ok := optionsNameToFieldNumbers[optionsName]
+			if !ok {
+				fieldNumbers = make(map[protowire.Number]struct{})
+				optionsNameToFieldNumbers[optionsName] = fieldNumbers
+			}
+			fieldNumbers[protowire.Number(fieldDescriptorProto.GetNumber())] = struct{}{}
+		}
+		return nil
+	}
+	var addMessageExtensions func([]*descriptorpb.DescriptorProto) error
+	addMessageExtensions = func(descriptorProtos []*descriptorpb.DescriptorProto) error {
+		for _, descriptorProto := range descriptorProtos {
+			if err := addExtensions(descriptorProto.GetExtension()); err != nil {
+				return err
+			}
+			if err := addMessageExtensions(descriptorProto.GetNestedType()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, imageFile := range image.Files() {
+		fileDescriptorProto := imageFile.Proto()
+		if err := addExtensions(fileDescriptorProto.GetExtension()); err != nil {
+			return nil, fmt.Errorf("%s: %v", imageFile.Path(), err)
+		}
+		if err := addMessageExtensions(fileDescriptorProto.GetMessageType()); err != nil {
+			return nil, fmt.Errorf("%s: %v", imageFile.Path(), err)
+		}
+	}
+	return optionsNameToFieldNumbers, nil
+}
+
+// isSourceRetention returns true if the FieldOptions have retention = RETENTION_SOURCE.
+func isSourceRetention(fieldOptions *descriptorpb.FieldOptions) (bool, error) {
+	if fieldOptions == nil {
+		return false, nil
+	}
+	isSourceRetention := false
+	unknown := fieldOptions.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		fieldNumber, wireType, length := protowire.ConsumeTag(unknown)
+		if length < 0 {
+			return false, protowire.ParseError(length)
+		}
+		unknown = unknown[length:]
+		if fieldNumber == fieldOptionsRetentionFieldNumber && wireType == protowire.VarintType {
+			value, length := protowire.ConsumeVarint(unknown)
+			if length < 0 {
+				return false, protowire.ParseError(length)
+			}
+			// the last value wins, as with any non-repeated field
+			isSourceRetention = value == optionRetentionSource
+		}
+		length = protowire.ConsumeFieldValue(fieldNumber, wireType, unknown)
+		if length < 0 {
+			return false, protowire.ParseError(length)
+		}
+		unknown = unknown[length:]
+	}
+	return isSourceRetention, nil
+}
+
+func stripSourceRetentionOptionsFromFile(
+	fileDescriptorProto *descriptorpb.FileDescriptorProto,
+	optionsNameToFieldNumbers map[string]map[protowire.Number]struct{},
+) error {
+	stripper := &sourceRetentionOptionsStripper{
+		optionsNameToFieldNumbers: optionsNameToFieldNumbers,
+	}
+	forEachOptions(fileDescriptorProto, stripper.strip)
+	return stripper.err
+}
+
+type sourceRetentionOptionsStripper struct {
+	optionsNameToFieldNumbers map[string]map[protowire.Number]struct{}
+	err                       error
+}
+
+// strip removes the source retention extensions from the options message.
+//
+// Extensions that are not registered with the Go runtime are stored as
+// unknown fields, which is the common case, but registered extensions
+// are handled as well.
+func (s *sourceRetentionOptionsStripper) strip(options proto.Message) {
+	if s.err != nil {
+		return
+	}
+	message := options.ProtoReflect()
+	if !message.IsValid() {
+		// typed nil, i.e. the options are not set
+		return
+	}
+	fieldNumbers, ok := s.optionsNameToFieldNumbers[string(message.Descriptor().FullName())]
+	if !ok {
+		return
+	}
+	message.Range(
+		func(fieldDescriptor protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if _, ok := fieldNumbers[fieldDescriptor.Number()]; ok && fieldDescriptor.IsExtension() {
+				message.Clear(fieldDescriptor)
+			}
+			return true
+		},
+	)
+	unknown := message.GetUnknown()
+	if len(unknown) == 0 {
+		return
+	}
+	var newUnknown protoreflect.RawFields
+	for len(unknown) > 0 {
+		fieldNumber, wireType, length := protowire.ConsumeTag(unknown)
+		if length < 0 {
+			s.err = protowire.ParseError(length)
+			return
+		}
+		valueLength := protowire.ConsumeFieldValue(fieldNumber, wireType, unknown[length:])
+		if valueLength < 0 {
+			s.err = protowire.ParseError(valueLength)
+			return
+		}
+		if _, ok := fieldNumbers[fieldNumber]; !ok {
+			newUnknown = append(newUnknown, unknown[:length+valueLength]...)
+		}
+		unknown = unknown[length+valueLength:]
+	}
+	message.SetUnknown(newUnknown)
+}
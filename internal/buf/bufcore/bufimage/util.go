@@ -15,14 +15,20 @@
 package bufimage
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/bufcore/internal/bufcorevalidate"
+	"github.com/bufbuild/buf/internal/gen/data/datawkt"
 	imagev1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/image/v1"
 	"github.com/bufbuild/buf/internal/pkg/normalpath"
 	"github.com/bufbuild/buf/internal/pkg/stringutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 func getImportFileIndexes(protoImage *imagev1.Image) (map[int]struct{}, error) {
@@ -154,6 +160,57 @@ func imageWithOnlyPaths(image Image, fileOrDirPaths []string, allowNotExist bool
 	return getImageWithImports(image, nonImportPaths, nonImportImageFiles)
 }
 
+// imageWithPathPrefixStripped returns a copy of the Image with the prefix
+// directory stripped from the paths of the files within this directory, and
+// from the imports that refer to these files.
+//
+// Returns an error if stripping the prefix results in two files with the
+// same path.
+func imageWithPathPrefixStripped(image Image, prefix string) (Image, error) {
+	imageFiles := image.Files()
+	newPathToPath := make(map[string]string, len(imageFiles))
+	newImageFiles := make([]ImageFile, 0, len(imageFiles))
+	for _, imageFile := range imageFiles {
+		path := imageFile.Path()
+		newPath := stripPathPrefix(path, prefix)
+		if existingPath, ok := newPathToPath[newPath]; ok {
+			return nil, fmt.Errorf(
+				"stripping path prefix %q results in both %q and %q having path %q",
+				prefix,
+				existingPath,
+				path,
+				newPath,
+			)
+		}
+		newPathToPath[newPath] = path
+		fileDescriptorProto := proto.Clone(imageFile.Proto()).(*descriptorpb.FileDescriptorProto)
+		fileDescriptorProto.Name = proto.String(newPath)
+		for i, dependency := range fileDescriptorProto.GetDependency() {
+			fileDescriptorProto.Dependency[i] = stripPathPrefix(dependency, prefix)
+		}
+		newImageFile, err := NewImageFile(
+			fileDescriptorProto,
+			imageFile.ModuleReference(),
+			imageFile.ExternalPath(),
+			imageFile.IsImport(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		newImageFiles = append(newImageFiles, newImageFile)
+	}
+	return NewImage(newImageFiles)
+}
+
+// stripPathPrefix strips the directory prefix from the path if
+// the path is contained within the prefix.
+func stripPathPrefix(path string, prefix string) string {
+	if normalpath.ContainsPath(prefix, path, normalpath.Relative) {
+		return strings.TrimPrefix(path, prefix+"/")
+	}
+	return path
+}
+
 func getImageWithImports(
 	image Image,
 	nonImportPaths map[string]struct{},
@@ -210,3 +267,119 @@ func addFileWithImports(
 	)
 	return accumulator
 }
+
+func imageToCodeGeneratorRequest(
+	image Image,
+	parameter string,
+	includeImports bool,
+	includeWellKnownTypes bool,
+	alreadyUsedPaths map[string]struct{},
+	nonImportPaths map[string]struct{},
+) *pluginpb.CodeGeneratorRequest {
+	imageFiles := image.Files()
+	request := &pluginpb.CodeGeneratorRequest{
+		ProtoFile: make([]*descriptorpb.FileDescriptorProto, len(imageFiles)),
+	}
+	if parameter != "" {
+		request.Parameter = proto.String(parameter)
+	}
+	for i, imageFile := range imageFiles {
+		request.ProtoFile[i] = imageFile.Proto()
+		if isFileToGenerate(
+			imageFile,
+			includeImports,
+			includeWellKnownTypes,
+			alreadyUsedPaths,
+			nonImportPaths,
+		) {
+			request.FileToGenerate = append(request.FileToGenerate, imageFile.Path())
+		}
+	}
+	return request
+}
+
+func isFileToGenerate(
+	imageFile ImageFile,
+	includeImports bool,
+	includeWellKnownTypes bool,
+	alreadyUsedPaths map[string]struct{},
+	nonImportPaths map[string]struct{},
+) bool {
+	path := imageFile.Path()
+	if !imageFile.IsImport() {
+		return true
+	}
+	if !includeImports {
+		return false
+	}
+	if !includeWellKnownTypes && isWellKnownTypePath(path) {
+		return false
+	}
+	if nonImportPaths != nil {
+		// this import will be generated as a non-import in another request
+		if _, ok := nonImportPaths[path]; ok {
+			return false
+		}
+	}
+	if alreadyUsedPaths != nil {
+		if _, ok := alreadyUsedPaths[path]; ok {
+			return false
+		}
+		alreadyUsedPaths[path] = struct{}{}
+	}
+	return true
+}
+
+func isWellKnownTypePath(path string) bool {
+	// the storagemem ReadBucket does not use the context
+	_, err := datawkt.ReadBucket.Stat(context.Background(), path)
+	return err == nil
+}
+
+// forEachOptions calls f for every options message within the file,
+// including the options of the file itself.
+//
+// The options messages may be typed nils if the options are not set.
+func forEachOptions(fileDescriptorProto *descriptorpb.FileDescriptorProto, f func(proto.Message)) {
+	f(fileDescriptorProto.Options)
+	forEachMessageOptions(fileDescriptorProto.GetMessageType(), f)
+	forEachEnumOptions(fileDescriptorProto.GetEnumType(), f)
+	forEachFieldOptions(fileDescriptorProto.GetExtension(), f)
+	for _, serviceDescriptorProto := range fileDescriptorProto.GetService() {
+		f(serviceDescriptorProto.Options)
+		for _, methodDescriptorProto := range serviceDescriptorProto.GetMethod() {
+			f(methodDescriptorProto.Options)
+		}
+	}
+}
+
+func forEachMessageOptions(descriptorProtos []*descriptorpb.DescriptorProto, f func(proto.Message)) {
+	for _, descriptorProto := range descriptorProtos {
+		f(descriptorProto.Options)
+		forEachFieldOptions(descriptorProto.GetField(), f)
+		forEachFieldOptions(descriptorProto.GetExtension(), f)
+		for _, oneofDescriptorProto := range descriptorProto.GetOneofDecl() {
+			f(oneofDescriptorProto.Options)
+		}
+		for _, extensionRange := range descriptorProto.GetExtensionRange() {
+			f(extensionRange.Options)
+		}
+		forEachMessageOptions(descriptorProto.GetNestedType(), f)
+		forEachEnumOptions(descriptorProto.GetEnumType(), f)
+	}
+}
+
+func forEachEnumOptions(enumDescriptorProtos []*descriptorpb.EnumDescriptorProto, f func(proto.Message)) {
+	for _, enumDescriptorProto := range enumDescriptorProtos {
+		f(enumDescriptorProto.Options)
+		for _, enumValueDescriptorProto := range enumDescriptorProto.GetValue() {
+			f(enumValueDescriptorProto.Options)
+		}
+	}
+}
+
+func forEachFieldOptions(fieldDescriptorProtos []*descriptorpb.FieldDescriptorProto, f func(proto.Message)) {
+	for _, fieldDescriptorProto := range fieldDescriptorProtos {
+		f(fieldDescriptorProto.Options)
+	}
+}
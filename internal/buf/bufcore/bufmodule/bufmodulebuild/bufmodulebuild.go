@@ -139,6 +139,10 @@ type Config struct {
 	// If RootToExcludes is empty, the default is "." with no excludes.
 	RootToExcludes             map[string][]string
 	DependencyModuleReferences []bufmodule.ModuleReference
+	// MaxImportDepth is the maximum import depth of any file.
+	//
+	// If 0, the default of the image builder is used.
+	MaxImportDepth uint32
 }
 
 // NewConfigV1Beta1 returns a new, validated Config for the ExternalConfig.
@@ -148,6 +152,7 @@ func NewConfigV1Beta1(externalConfig ExternalConfigV1Beta1, deps ...string) (*Co
 
 // ExternalConfigV1Beta1 is an external config.
 type ExternalConfigV1Beta1 struct {
-	Roots    []string `json:"roots,omitempty" yaml:"roots,omitempty"`
-	Excludes []string `json:"excludes,omitempty" yaml:"excludes,omitempty"`
+	Roots          []string `json:"roots,omitempty" yaml:"roots,omitempty"`
+	Excludes       []string `json:"excludes,omitempty" yaml:"excludes,omitempty"`
+	MaxImportDepth uint32   `json:"max_import_depth,omitempty" yaml:"max_import_depth,omitempty"`
 }
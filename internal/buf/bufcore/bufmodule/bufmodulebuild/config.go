@@ -55,6 +55,7 @@ func newConfigV1Beta1(externalConfig ExternalConfigV1Beta1, deps ...string) (*Co
 		return &Config{
 			RootToExcludes:             rootToExcludes,
 			DependencyModuleReferences: dependencyModuleReferences,
+			MaxImportDepth:             externalConfig.MaxImportDepth,
 		}, nil
 	}
 
@@ -109,6 +110,7 @@ func newConfigV1Beta1(externalConfig ExternalConfigV1Beta1, deps ...string) (*Co
 	return &Config{
 		RootToExcludes:             rootToExcludes,
 		DependencyModuleReferences: dependencyModuleReferences,
+		MaxImportDepth:             externalConfig.MaxImportDepth,
 	}, nil
 }
 
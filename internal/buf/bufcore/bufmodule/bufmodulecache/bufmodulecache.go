@@ -15,7 +15,9 @@
 package bufmodulecache
 
 import (
+	"context"
 	"io"
+	"time"
 
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/pkg/filelock"
@@ -34,6 +36,87 @@ func NewModuleReader(
 	return newModuleReader(logger, readWriteBucket, delegate, options...)
 }
 
+// ModuleVerifier verifies modules in a cache.
+type ModuleVerifier interface {
+	// VerifyModule verifies that the module for the ModulePin exists in the cache
+	// and that its digest matches the digest of the ModulePin.
+	//
+	// Returns an error that fulfills storage.IsNotExist if the module is not in the cache.
+	// Modules that do not match their digest are not deleted.
+	VerifyModule(ctx context.Context, modulePin bufmodule.ModulePin) error
+}
+
+// NewModuleVerifier returns a new ModuleVerifier for the cache in readWriteBucket.
+//
+// This never reads from any source other than the cache.
+func NewModuleVerifier(
+	readWriteBucket storage.ReadWriteBucket,
+	fileLocker filelock.Locker,
+) ModuleVerifier {
+	return newModuleCacher(readWriteBucket, fileLocker)
+}
+
+// ModulePruner prunes modules from a cache.
+type ModulePruner interface {
+	// PruneModules deletes the modules in the cache that are not kept by the
+	// given options, and returns the deleted modules sorted by path.
+	//
+	// Each module is deleted while holding its write lock.
+	// With no options, all modules are deleted.
+	PruneModules(ctx context.Context, options ...PruneOption) ([]PrunedModule, error)
+}
+
+// NewModulePruner returns a new ModulePruner for the cache in the directory at rootDirPath.
+//
+// The last access time of a module is the modification time of its directory,
+// which is updated on cache hits by ModuleReaders created with WithAccessTimeRootDirPath.
+func NewModulePruner(
+	rootDirPath string,
+	fileLocker filelock.Locker,
+) ModulePruner {
+	return newModulePruner(rootDirPath, fileLocker)
+}
+
+// PrunedModule is a module that was deleted from a cache.
+type PrunedModule interface {
+	// Path is the path of the module within the cache, of the form
+	// remote/owner/repository/commit.
+	Path() string
+	// SizeBytes is the total size of the files of the module.
+	SizeBytes() int64
+	// LastAccessTime is the time the module was last accessed.
+	LastAccessTime() time.Time
+}
+
+// PruneOption is an option for PruneModules.
+type PruneOption func(*pruneOptions)
+
+// PruneWithOlderThan returns a new PruneOption that keeps modules that were
+// accessed within the given duration.
+func PruneWithOlderThan(olderThan time.Duration) PruneOption {
+	return func(pruneOptions *pruneOptions) {
+		pruneOptions.olderThan = olderThan
+	}
+}
+
+// PruneWithKeepLatest returns a new PruneOption that keeps the given number of
+// most recently accessed commits of each module.
+func PruneWithKeepLatest(keepLatest int) PruneOption {
+	return func(pruneOptions *pruneOptions) {
+		pruneOptions.keepLatest = keepLatest
+	}
+}
+
+// PruneWithKeepModulePins returns a new PruneOption that keeps the modules
+// for the given ModulePins.
+func PruneWithKeepModulePins(modulePins ...bufmodule.ModulePin) PruneOption {
+	return func(pruneOptions *pruneOptions) {
+		for _, modulePin := range modulePins {
+			pruneOptions.keepPaths[newCacheKey(modulePin)] = struct{}{}
+		}
+	}
+}
+
 // ModuleReaderOption is an option for a new ModuleReader.
 type ModuleReaderOption func(*moduleReader)
 
@@ -55,3 +138,14 @@ func WithFileLocker(fileLocker filelock.Locker) ModuleReaderOption {
 		moduleReader.fileLocker = fileLocker
 	}
 }
+
+// WithAccessTimeRootDirPath records the last access time of modules on cache
+// hits, for use by a ModulePruner.
+//
+// The rootDirPath must be the directory of the cache bucket.
+// The default is to not record access times.
+func WithAccessTimeRootDirPath(rootDirPath string) ModuleReaderOption {
+	return func(moduleReader *moduleReader) {
+		moduleReader.accessTimeRootDirPath = rootDirPath
+	}
+}
@@ -16,6 +16,7 @@ package bufmodulecache
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/bufbuild/buf/internal/pkg/storage"
 	"github.com/bufbuild/buf/internal/pkg/storage/storagemem"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/bufbuild/buf/internal/pkg/uuidutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -128,6 +130,124 @@ func TestCacherBasic(t *testing.T) {
 	require.True(t, exists)
 }
 
+func TestVerifierBasic(t *testing.T) {
+	ctx := context.Background()
+
+	modulePin, err := bufmodule.NewModulePin(
+		"buf.build",
+		"foob",
+		"bar",
+		"v1",
+		bufmoduletesting.TestCommit,
+		bufmoduletesting.TestDigest,
+		time.Now(),
+	)
+	require.NoError(t, err)
+	readBucket, err := storagemem.NewReadBucket(bufmoduletesting.TestData)
+	require.NoError(t, err)
+	module, err := bufmodule.NewModuleForBucket(ctx, readBucket)
+	require.NoError(t, err)
+
+	readWriteBucket, fileLocker := newTestBucketAndLocker(t)
+	moduleVerifier := NewModuleVerifier(readWriteBucket, fileLocker)
+	err = moduleVerifier.VerifyModule(ctx, modulePin)
+	require.True(t, storage.IsNotExist(err))
+
+	err = newModuleCacher(readWriteBucket, fileLocker).PutModule(ctx, modulePin, module)
+	require.NoError(t, err)
+	require.NoError(t, moduleVerifier.VerifyModule(ctx, modulePin))
+
+	// Corrupt a file in the cache.
+	cachedFilePaths, err := storage.AllPaths(ctx, storage.MapReadBucket(readWriteBucket, storage.MatchPathExt(".proto")), "")
+	require.NoError(t, err)
+	require.NotEmpty(t, cachedFilePaths)
+	err = storage.PutPath(ctx, readWriteBucket, cachedFilePaths[0], []byte("syntax = \"proto3\";\n"))
+	require.NoError(t, err)
+	err = moduleVerifier.VerifyModule(ctx, modulePin)
+	require.Error(t, err)
+	require.False(t, storage.IsNotExist(err))
+	// The corrupted module is not deleted.
+	exists, err := storage.Exists(ctx, readWriteBucket, cachedFilePaths[0])
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestPrunerBasic(t *testing.T) {
+	ctx := context.Background()
+
+	readBucket, err := storagemem.NewReadBucket(bufmoduletesting.TestData)
+	require.NoError(t, err)
+	module, err := bufmodule.NewModuleForBucket(ctx, readBucket)
+	require.NoError(t, err)
+
+	rootDirPath := t.TempDir()
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(rootDirPath)
+	require.NoError(t, err)
+	fileLocker, err := filelock.NewLocker(t.TempDir())
+	require.NoError(t, err)
+	moduleCacher := newModuleCacher(readWriteBucket, fileLocker)
+	moduleCacher.accessTimeRootDirPath = rootDirPath
+
+	now := time.Now()
+	newTestModulePin := func(repository string, age time.Duration) bufmodule.ModulePin {
+		id, err := uuidutil.New()
+		require.NoError(t, err)
+		commit, err := uuidutil.ToDashless(id)
+		require.NoError(t, err)
+		modulePin, err := bufmodule.NewModulePin(
+			"buf.build",
+			"foob",
+			repository,
+			"v1",
+			commit,
+			bufmoduletesting.TestDigest,
+			now,
+		)
+		require.NoError(t, err)
+		require.NoError(t, moduleCacher.PutModule(ctx, modulePin, module))
+		require.NoError(t, touchCacheEntry(rootDirPath, newCacheKey(modulePin), now.Add(-age)))
+		return modulePin
+	}
+	oldestModulePin := newTestModulePin("bar", 3*time.Hour)
+	olderModulePin := newTestModulePin("bar", 2*time.Hour)
+	latestModulePin := newTestModulePin("bar", 2*time.Minute)
+	lockedModulePin := newTestModulePin("baz", 3*time.Hour)
+
+	prunedModules, err := NewModulePruner(rootDirPath, fileLocker).PruneModules(
+		ctx,
+		PruneWithOlderThan(time.Hour),
+		PruneWithKeepLatest(1),
+		PruneWithKeepModulePins(lockedModulePin),
+	)
+	require.NoError(t, err)
+	expectedPaths := []string{newCacheKey(oldestModulePin), newCacheKey(olderModulePin)}
+	sort.Strings(expectedPaths)
+	prunedPaths := make([]string, len(prunedModules))
+	for i, prunedModule := range prunedModules {
+		prunedPaths[i] = prunedModule.Path()
+		require.True(t, prunedModule.SizeBytes() > 0)
+	}
+	require.Equal(t, expectedPaths, prunedPaths)
+
+	_, err = moduleCacher.GetModule(ctx, oldestModulePin)
+	require.True(t, storage.IsNotExist(err))
+	_, err = moduleCacher.GetModule(ctx, olderModulePin)
+	require.True(t, storage.IsNotExist(err))
+	_, err = moduleCacher.GetModule(ctx, latestModulePin)
+	require.NoError(t, err)
+	_, err = moduleCacher.GetModule(ctx, lockedModulePin)
+	require.NoError(t, err)
+
+	// The cache hit above updated the last access time.
+	prunedModules, err = NewModulePruner(rootDirPath, fileLocker).PruneModules(
+		ctx,
+		PruneWithOlderThan(time.Hour),
+	)
+	require.NoError(t, err)
+	require.Empty(t, prunedModules)
+}
+
 func newTestBucketAndLocker(t *testing.T) (storage.ReadWriteBucket, filelock.Locker) {
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
 	readWriteBucket, err := storageosProvider.NewReadWriteBucket(t.TempDir())
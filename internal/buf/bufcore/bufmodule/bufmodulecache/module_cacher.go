@@ -16,6 +16,7 @@ package bufmodulecache
 
 import (
 	"context"
+	"time"
 
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/pkg/filelock"
@@ -27,6 +28,8 @@ import (
 type moduleCacher struct {
 	readWriteBucket storage.ReadWriteBucket
 	fileLocker      filelock.Locker
+	// empty if access times are not recorded
+	accessTimeRootDirPath string
 }
 
 func newModuleCacher(
@@ -71,6 +74,11 @@ func (m *moduleCacher) GetModule(
 	if err != nil {
 		return nil, multierr.Append(err, unlocker.Unlock())
 	}
+	if m.accessTimeRootDirPath != "" {
+		if err := touchCacheEntry(m.accessTimeRootDirPath, modulePath, time.Now()); err != nil {
+			return nil, multierr.Append(err, unlocker.Unlock())
+		}
+	}
 	if err := unlocker.Unlock(); err != nil {
 		return nil, err
 	}
@@ -88,6 +96,33 @@ func (m *moduleCacher) GetModule(
 	return module, nil
 }
 
+func (m *moduleCacher) VerifyModule(
+	ctx context.Context,
+	modulePin bufmodule.ModulePin,
+) (retErr error) {
+	modulePath := newCacheKey(modulePin)
+	unlocker, err := m.fileLocker.RLock(ctx, modulePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, unlocker.Unlock())
+	}()
+	readWriteBucket := storage.MapReadWriteBucket(m.readWriteBucket, storage.MapOnPrefix(modulePath))
+	exists, err := storage.Exists(ctx, readWriteBucket, bufmodule.LockFilePath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return storage.NewErrNotExist(modulePath)
+	}
+	module, err := bufmodule.NewModuleForBucket(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	return bufmodule.ValidateModuleMatchesDigest(ctx, module, modulePin)
+}
+
 func (m *moduleCacher) PutModule(
 	ctx context.Context,
 	modulePin bufmodule.ModulePin,
@@ -0,0 +1,243 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodulecache
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/buf/internal/pkg/filelock"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"go.uber.org/multierr"
+)
+
+// cacheKeyDepth is the number of path components in a cache key.
+const cacheKeyDepth = 4
+
+type modulePruner struct {
+	rootDirPath string
+	fileLocker  filelock.Locker
+	now         func() time.Time
+}
+
+func newModulePruner(
+	rootDirPath string,
+	fileLocker filelock.Locker,
+) *modulePruner {
+	return &modulePruner{
+		rootDirPath: rootDirPath,
+		fileLocker:  fileLocker,
+		now:         time.Now,
+	}
+}
+
+func (m *modulePruner) PruneModules(
+	ctx context.Context,
+	options ...PruneOption,
+) ([]PrunedModule, error) {
+	pruneOptions := newPruneOptions()
+	for _, option := range options {
+		option(pruneOptions)
+	}
+	cacheEntries, err := m.getCacheEntries()
+	if err != nil {
+		return nil, err
+	}
+	// group by remote/owner/repository so that keepLatest applies per module
+	identityPathToCacheEntries := make(map[string][]*cacheEntry)
+	for _, cacheEntry := range cacheEntries {
+		identityPath := normalpath.Dir(cacheEntry.path)
+		identityPathToCacheEntries[identityPath] = append(identityPathToCacheEntries[identityPath], cacheEntry)
+	}
+	var toPrune []*cacheEntry
+	for _, identityCacheEntries := range identityPathToCacheEntries {
+		sort.SliceStable(
+			identityCacheEntries,
+			func(i int, j int) bool {
+				return identityCacheEntries[i].lastAccessTime.After(identityCacheEntries[j].lastAccessTime)
+			},
+		)
+		for i, cacheEntry := range identityCacheEntries {
+			if i < pruneOptions.keepLatest {
+				continue
+			}
+			if pruneOptions.olderThan > 0 && m.now().Sub(cacheEntry.lastAccessTime) <= pruneOptions.olderThan {
+				continue
+			}
+			if _, ok := pruneOptions.keepPaths[cacheEntry.path]; ok {
+				continue
+			}
+			toPrune = append(toPrune, cacheEntry)
+		}
+	}
+	sort.Slice(
+		toPrune,
+		func(i int, j int) bool {
+			return toPrune[i].path < toPrune[j].path
+		},
+	)
+	prunedModules := make([]PrunedModule, 0, len(toPrune))
+	for _, cacheEntry := range toPrune {
+		prunedModule, err := m.pruneModule(ctx, cacheEntry.path)
+		if err != nil {
+			return prunedModules, err
+		}
+		if prunedModule != nil {
+			prunedModules = append(prunedModules, prunedModule)
+		}
+	}
+	return prunedModules, nil
+}
+
+// pruneModule deletes the module at the cache path.
+//
+// Returns nil if the module was deleted by another process before the lock was acquired.
+func (m *modulePruner) pruneModule(ctx context.Context, path string) (_ PrunedModule, retErr error) {
+	unlocker, err := m.fileLocker.Lock(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, unlocker.Unlock())
+	}()
+	dirPath := normalpath.Unnormalize(normalpath.Join(m.rootDirPath, path))
+	fileInfo, err := os.Stat(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	sizeBytes, err := getDirSizeBytes(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(dirPath); err != nil {
+		return nil, err
+	}
+	return newPrunedModule(path, sizeBytes, fileInfo.ModTime()), nil
+}
+
+// getCacheEntries returns the entries of the cache, which are the directories
+// at the depth of the cache key of the form remote/owner/repository/commit.
+func (m *modulePruner) getCacheEntries() ([]*cacheEntry, error) {
+	var cacheEntries []*cacheEntry
+	if err := m.walkCacheEntries("", 0, &cacheEntries); err != nil {
+		return nil, err
+	}
+	return cacheEntries, nil
+}
+
+func (m *modulePruner) walkCacheEntries(path string, depth int, cacheEntries *[]*cacheEntry) error {
+	fileInfos, err := ioutil.ReadDir(normalpath.Unnormalize(normalpath.Join(m.rootDirPath, path)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, fileInfo := range fileInfos {
+		if !fileInfo.IsDir() {
+			continue
+		}
+		subPath := normalpath.Join(path, fileInfo.Name())
+		if depth+1 == cacheKeyDepth {
+			*cacheEntries = append(
+				*cacheEntries,
+				&cacheEntry{
+					path:           subPath,
+					lastAccessTime: fileInfo.ModTime(),
+				},
+			)
+			continue
+		}
+		if err := m.walkCacheEntries(subPath, depth+1, cacheEntries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type cacheEntry struct {
+	path           string
+	lastAccessTime time.Time
+}
+
+type prunedModule struct {
+	path           string
+	sizeBytes      int64
+	lastAccessTime time.Time
+}
+
+func newPrunedModule(path string, sizeBytes int64, lastAccessTime time.Time) *prunedModule {
+	return &prunedModule{
+		path:           path,
+		sizeBytes:      sizeBytes,
+		lastAccessTime: lastAccessTime,
+	}
+}
+
+func (p *prunedModule) Path() string {
+	return p.path
+}
+
+func (p *prunedModule) SizeBytes() int64 {
+	return p.sizeBytes
+}
+
+func (p *prunedModule) LastAccessTime() time.Time {
+	return p.lastAccessTime
+}
+
+type pruneOptions struct {
+	olderThan  time.Duration
+	keepLatest int
+	keepPaths  map[string]struct{}
+}
+
+func newPruneOptions() *pruneOptions {
+	return &pruneOptions{
+		keepPaths: make(map[string]struct{}),
+	}
+}
+
+func getDirSizeBytes(dirPath string) (int64, error) {
+	var sizeBytes int64
+	if err := filepath.Walk(
+		dirPath,
+		func(_ string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !fileInfo.IsDir() {
+				sizeBytes += fileInfo.Size()
+			}
+			return nil
+		},
+	); err != nil {
+		return 0, err
+	}
+	return sizeBytes, nil
+}
+
+// touchCacheEntry records an access of the module at the cache path by updating
+// the modification time of its directory.
+func touchCacheEntry(rootDirPath string, path string, now time.Time) error {
+	return os.Chtimes(normalpath.Unnormalize(normalpath.Join(rootDirPath, path)), now, now)
+}
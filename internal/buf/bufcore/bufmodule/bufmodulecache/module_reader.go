@@ -31,6 +31,8 @@ type moduleReader struct {
 	delegate      bufmodule.ModuleReader
 	messageWriter io.Writer
 	fileLocker    filelock.Locker
+	// empty if access times are not recorded
+	accessTimeRootDirPath string
 
 	count     int
 	cacheHits int
@@ -52,6 +54,7 @@ func newModuleReader(
 		option(moduleReader)
 	}
 	moduleReader.cache = newModuleCacher(readWriteBucket, moduleReader.fileLocker)
+	moduleReader.cache.accessTimeRootDirPath = moduleReader.accessTimeRootDirPath
 	return moduleReader
 }
 
@@ -47,15 +47,40 @@ type ParserAccessorHandler interface {
 // access to not just the target files, but all dependency files as well.
 //
 // For AST building, this can just be a bufmodule.Module.
-func NewParserAccessorHandler(ctx context.Context, module bufmodule.Module) ParserAccessorHandler {
-	return newParserAccessorHandler(ctx, module)
+func NewParserAccessorHandler(
+	ctx context.Context,
+	module bufmodule.Module,
+	options ...ParserAccessorHandlerOption,
+) ParserAccessorHandler {
+	return newParserAccessorHandler(ctx, module, options...)
+}
+
+// ParserAccessorHandlerOption is an option for a new ParserAccessorHandler.
+type ParserAccessorHandlerOption func(*parserAccessorHandler)
+
+// ParserAccessorHandlerWithOverride returns a new ParserAccessorHandlerOption
+// that opens the given data for the path instead of the file in the module or
+// the well-known type with the path.
+//
+// The path is tracked as an import with the given external path.
+func ParserAccessorHandlerWithOverride(path string, externalPath string, data []byte) ParserAccessorHandlerOption {
+	return func(parserAccessorHandler *parserAccessorHandler) {
+		parserAccessorHandler.pathToOverride[path] = &override{
+			externalPath: externalPath,
+			data:         data,
+		}
+	}
 }
 
 // GetFileAnnotations gets the FileAnnotations for the ErrorWithPos errors.
+//
+// The FileAnnotations will have the given Severity, as protoparse uses
+// ErrorWithPos for both errors and warnings.
 func GetFileAnnotations(
 	ctx context.Context,
 	parserAccessorHandler ParserAccessorHandler,
 	errorsWithPos []protoparse.ErrorWithPos,
+	severity bufanalysis.Severity,
 ) ([]bufanalysis.FileAnnotation, error) {
 	fileAnnotations := make([]bufanalysis.FileAnnotation, 0, len(errorsWithPos))
 	for _, errorWithPos := range errorsWithPos {
@@ -63,6 +88,7 @@ func GetFileAnnotations(
 			ctx,
 			parserAccessorHandler,
 			errorWithPos,
+			severity,
 		)
 		if err != nil {
 			return nil, err
@@ -72,11 +98,12 @@ func GetFileAnnotations(
 	return fileAnnotations, nil
 }
 
-// GetFileAnnotation gets the FileAnnotation for the ErrorWithPos error.
+// GetFileAnnotation gets the FileAnnotation for the ErrorWithPos error with the given Severity.
 func GetFileAnnotation(
 	ctx context.Context,
 	parserAccessorHandler ParserAccessorHandler,
 	errorWithPos protoparse.ErrorWithPos,
+	severity bufanalysis.Severity,
 ) (bufanalysis.FileAnnotation, error) {
 	var fileInfo bufcore.FileInfo
 	var startLine int
@@ -118,7 +145,7 @@ func GetFileAnnotation(
 		startColumn = sourcePos.Col
 		endColumn = sourcePos.Col
 	}
-	return bufanalysis.NewFileAnnotation(
+	return bufanalysis.NewFileAnnotationWithSeverity(
 		fileInfo,
 		startLine,
 		startColumn,
@@ -126,5 +153,6 @@ func GetFileAnnotation(
 		endColumn,
 		typeString,
 		message,
+		severity,
 	), nil
 }
@@ -15,9 +15,11 @@
 package bufmoduleprotoparse
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"sync"
 
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
@@ -32,23 +34,37 @@ type parserAccessorHandler struct {
 	pathToExternalPath      map[string]string
 	nonImportPaths          map[string]struct{}
 	pathsToModuleReferences map[string]bufmodule.ModuleReference
+	pathToOverride          map[string]*override
 	lock                    sync.RWMutex
 }
 
 func newParserAccessorHandler(
 	ctx context.Context,
 	module bufmodule.Module,
+	options ...ParserAccessorHandlerOption,
 ) *parserAccessorHandler {
-	return &parserAccessorHandler{
+	parserAccessorHandler := &parserAccessorHandler{
 		ctx:                     ctx,
 		module:                  module,
 		pathToExternalPath:      make(map[string]string),
 		nonImportPaths:          make(map[string]struct{}),
 		pathsToModuleReferences: make(map[string]bufmodule.ModuleReference),
+		pathToOverride:          make(map[string]*override),
 	}
+	for _, option := range options {
+		option(parserAccessorHandler)
+	}
+	return parserAccessorHandler
 }
 
 func (p *parserAccessorHandler) Open(path string) (_ io.ReadCloser, retErr error) {
+	// overrides take precedence over both the module and the well-known types
+	if override, ok := p.pathToOverride[path]; ok {
+		if err := p.addPath(path, override.externalPath, true, nil); err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(bytes.NewReader(override.data)), nil
+	}
 	moduleFile, moduleErr := p.module.GetModuleFile(p.ctx, path)
 	if moduleErr != nil {
 		if !storage.IsNotExist(moduleErr) {
@@ -132,3 +148,8 @@ func (p *parserAccessorHandler) addPath(
 	}
 	return nil
 }
+
+type override struct {
+	externalPath string
+	data         []byte
+}
@@ -0,0 +1,112 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodule
+
+import (
+	"context"
+	"testing"
+
+	modulev1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/module/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleFileSetDuplicatePath(t *testing.T) {
+	ctx := context.Background()
+	module, err := NewModuleForProto(
+		ctx,
+		&modulev1alpha1.Module{
+			Files: []*modulev1alpha1.ModuleFile{
+				{
+					Path:    "a/a.proto",
+					Content: []byte(`syntax = "proto3"; package a;`),
+				},
+				{
+					Path:    "b/b.proto",
+					Content: []byte(`syntax = "proto3"; package b; import "a/a.proto";`),
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	moduleReference, err := NewModuleReference("buf.build", "foob", "dep", "main")
+	require.NoError(t, err)
+	dependency, err := NewModuleForProto(
+		ctx,
+		&modulev1alpha1.Module{
+			Files: []*modulev1alpha1.ModuleFile{
+				{
+					Path:    "a/a.proto",
+					Content: []byte(`syntax = "proto3"; package a;`),
+				},
+			},
+		},
+		ModuleWithModuleReference(moduleReference),
+	)
+	require.NoError(t, err)
+
+	moduleFileSet := NewModuleFileSet(module, []Module{dependency})
+	_, err = moduleFileSet.AllFileInfos(ctx)
+	require.Error(t, err)
+	assert.True(t, storage.IsExistsMultipleLocations(err))
+	assert.Equal(t, "a/a.proto exists in multiple locations: a/a.proto a/a.proto (buf.build/foob/dep:main)", err.Error())
+	_, err = moduleFileSet.GetModuleFile(ctx, "a/a.proto")
+	require.Error(t, err)
+	assert.True(t, storage.IsExistsMultipleLocations(err))
+	moduleFile, err := moduleFileSet.GetModuleFile(ctx, "b/b.proto")
+	require.NoError(t, err)
+	require.NoError(t, moduleFile.Close())
+}
+
+func TestModuleFileSetNoDuplicatePath(t *testing.T) {
+	ctx := context.Background()
+	module, err := NewModuleForProto(
+		ctx,
+		&modulev1alpha1.Module{
+			Files: []*modulev1alpha1.ModuleFile{
+				{
+					Path:    "b/b.proto",
+					Content: []byte(`syntax = "proto3"; package b; import "a/a.proto";`),
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	moduleReference, err := NewModuleReference("buf.build", "foob", "dep", "main")
+	require.NoError(t, err)
+	dependency, err := NewModuleForProto(
+		ctx,
+		&modulev1alpha1.Module{
+			Files: []*modulev1alpha1.ModuleFile{
+				{
+					Path:    "a/a.proto",
+					Content: []byte(`syntax = "proto3"; package a;`),
+				},
+			},
+		},
+		ModuleWithModuleReference(moduleReference),
+	)
+	require.NoError(t, err)
+
+	fileInfos, err := NewModuleFileSet(module, []Module{dependency}).AllFileInfos(ctx)
+	require.NoError(t, err)
+	require.Len(t, fileInfos, 2)
+	assert.Equal(t, "a/a.proto", fileInfos[0].Path())
+	assert.True(t, fileInfos[0].IsImport())
+	assert.Equal(t, moduleReference, fileInfos[0].ModuleReference())
+	assert.Equal(t, "b/b.proto", fileInfos[1].Path())
+	assert.False(t, fileInfos[1].IsImport())
+}
@@ -40,6 +40,7 @@ func newMultiReadBucket(
 }
 
 func (m *multiReadBucket) StatModuleFile(ctx context.Context, path string) (ObjectInfo, error) {
+	var objectInfos []ObjectInfo
 	for _, delegate := range m.delegates {
 		objectInfo, err := delegate.StatModuleFile(ctx, path)
 		if err != nil {
@@ -48,16 +49,53 @@ func (m *multiReadBucket) StatModuleFile(ctx context.Context, path string) (Obje
 			}
 			return nil, err
 		}
-		return objectInfo, nil
+		objectInfos = append(objectInfos, objectInfo)
+	}
+	switch len(objectInfos) {
+	case 0:
+		return nil, storage.NewErrNotExist(path)
+	case 1:
+		return objectInfos[0], nil
+	default:
+		locations := make([]string, len(objectInfos))
+		for i, objectInfo := range objectInfos {
+			locations[i] = getObjectInfoLocation(objectInfo)
+		}
+		return nil, storage.NewErrExistsMultipleLocations(path, locations...)
 	}
-	return nil, storage.NewErrNotExist(path)
 }
 
 func (m *multiReadBucket) WalkModuleFiles(ctx context.Context, prefix string, f func(ObjectInfo) error) error {
+	seenPathToLocation := make(map[string]string)
 	for _, delegate := range m.delegates {
-		if err := delegate.WalkModuleFiles(ctx, prefix, f); err != nil {
+		if err := delegate.WalkModuleFiles(
+			ctx,
+			prefix,
+			func(objectInfo ObjectInfo) error {
+				path := objectInfo.Path()
+				location := getObjectInfoLocation(objectInfo)
+				if existingLocation, ok := seenPathToLocation[path]; ok {
+					// we do not continue iterating, as callers expect a single call per path
+					return storage.NewErrExistsMultipleLocations(path, existingLocation, location)
+				}
+				seenPathToLocation[path] = location
+				return f(objectInfo)
+			},
+		); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// getObjectInfoLocation returns a description of where the object came from.
+//
+// The external path of a file within a dependency is not enough to tell it
+// apart from the same file in the main module, so the module reference is
+// included if it is present.
+func getObjectInfoLocation(objectInfo ObjectInfo) string {
+	if moduleReference := objectInfo.ModuleReference(); moduleReference != nil {
+		return objectInfo.ExternalPath() + " (" + moduleReference.String() + ")"
+	}
+	return objectInfo.ExternalPath()
+}
@@ -30,6 +30,15 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// ImageMmapEnvKey is the environment variable that memory-maps local
+	// uncompressed image files instead of reading them if set to "true".
+	//
+	// This avoids copying the whole file into memory before it is unmarshalled.
+	// Files are read as usual on platforms that do not support memory-mapping.
+	ImageMmapEnvKey = "BUF_IMAGE_MMAP"
+)
+
 const (
 	// ImageEncodingBin is the binary image encoding.
 	ImageEncodingBin ImageEncoding = iota + 1
@@ -108,6 +117,10 @@ type SourceOrModuleRef interface {
 // SourceRef is a source bucket reference.
 type SourceRef interface {
 	SourceOrModuleRef
+	// IsLocalDir returns true if the source is a local directory.
+	//
+	// If true, the external paths of files within the source are local file paths.
+	IsLocalDir() bool
 	internalBucketRef() internal.BucketRef
 }
 
@@ -120,7 +133,22 @@ type ModuleRef interface {
 // ImageRefParser is an image ref parser for Buf.
 type ImageRefParser interface {
 	// GetImageRef gets the reference for the image file.
-	GetImageRef(ctx context.Context, value string) (ImageRef, error)
+	GetImageRef(ctx context.Context, value string, options ...GetImageRefOption) (ImageRef, error)
+}
+
+// GetImageRefOption is an option for GetImageRef.
+type GetImageRefOption func(*getImageRefOptions)
+
+// GetImageRefWithCompression returns a new GetImageRefOption that sets the
+// compression of the image file, which must be one of none, gzip, or zstd.
+//
+// This is the same as specifying the compression option in the value, and is
+// an error if the value already specifies the compression option, or if the
+// compression conflicts with the extension of the path.
+func GetImageRefWithCompression(compression string) GetImageRefOption {
+	return func(getImageRefOptions *getImageRefOptions) {
+		getImageRefOptions.compression = compression
+	}
 }
 
 // SourceRefParser is a source ref parser for Buf.
@@ -193,6 +221,8 @@ type ImageReader interface {
 	// GetImageFile gets the image file.
 	//
 	// The returned file will be uncompressed.
+	// If ImageMmapEnvKey is set to "true" and the image is a local uncompressed
+	// file, the returned file is a mmap.File.
 	GetImageFile(
 		ctx context.Context,
 		container app.EnvStdinContainer,
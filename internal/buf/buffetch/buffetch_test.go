@@ -15,13 +15,22 @@
 package buffetch
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"testing"
 
 	"github.com/bufbuild/buf/internal/buf/buffetch/internal"
 	"github.com/bufbuild/buf/internal/pkg/app"
+	"github.com/bufbuild/buf/internal/pkg/httpauth"
+	"github.com/bufbuild/buf/internal/pkg/mmap"
+	"github.com/bufbuild/buf/internal/pkg/storage"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -57,6 +66,230 @@ func TestRoundTripBinZst(t *testing.T) {
 	)
 }
 
+func TestGetBucketTar(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.tar",
+		testNewTarData(t),
+		nil,
+	)
+}
+
+func TestGetBucketTarGz(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.tar.gz",
+		testNewGzipData(t, testNewTarData(t)),
+		nil,
+	)
+}
+
+func TestGetBucketZip(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.zip",
+		testNewZipData(t),
+		nil,
+	)
+}
+
+func TestGetBucketTarIsZip(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.tar",
+		testNewZipData(t),
+		internal.NewInputTypeMismatchError("a tar archive", "a zip archive", "format=zip"),
+	)
+}
+
+func TestGetBucketTarIsTarGz(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.tar",
+		testNewGzipData(t, testNewTarData(t)),
+		internal.NewInputTypeMismatchError("a tar archive", "gzip-compressed data", "format=tar,compression=gzip"),
+	)
+}
+
+func TestGetBucketTarGzIsTar(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.tar.gz",
+		testNewTarData(t),
+		internal.NewInputTypeMismatchError("gzip-compressed data", "a tar archive", "format=tar,compression=none"),
+	)
+}
+
+func TestGetBucketTarGzIsZip(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.tar.gz",
+		testNewZipData(t),
+		internal.NewInputTypeMismatchError("gzip-compressed data", "a zip archive", "format=zip"),
+	)
+}
+
+func TestGetBucketZipIsTar(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.zip",
+		testNewTarData(t),
+		internal.NewInputTypeMismatchError("a zip archive", "a tar archive", "format=tar,compression=none"),
+	)
+}
+
+func TestGetBucketZipIsTarGz(t *testing.T) {
+	testGetBucketLocalArchive(
+		t,
+		"file.zip",
+		testNewGzipData(t, testNewTarData(t)),
+		internal.NewInputTypeMismatchError("a zip archive", "gzip-compressed data", "format=tar,compression=gzip"),
+	)
+}
+
+func TestGetFileBinGzNotCompressed(t *testing.T) {
+	t.Parallel()
+
+	logger := zap.NewNop()
+	refParser := newRefParser(logger)
+	reader := testNewFetchReader(logger)
+
+	ctx := context.Background()
+	container := app.NewContainer(nil, nil, nil, nil)
+
+	filePath := filepath.Join(t.TempDir(), "file.bin.gz")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("one"), 0600))
+
+	parsedRef, err := refParser.getParsedRef(ctx, filePath, allFormats)
+	require.NoError(t, err)
+	fileRef, ok := parsedRef.(internal.FileRef)
+	require.True(t, ok)
+
+	_, err = reader.GetFile(ctx, container, fileRef)
+	require.Equal(t, internal.NewInputNotCompressedError("gzip-compressed data"), err)
+}
+
+func TestGetFileHTTPHeader(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(responseWriter http.ResponseWriter, request *http.Request) {
+				if request.Header.Get("Authorization") != "Bearer abc" {
+					responseWriter.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = responseWriter.Write([]byte("one"))
+			},
+		),
+	)
+	defer server.Close()
+
+	logger := zap.NewNop()
+	refParser := newRefParser(logger)
+	reader := internal.NewReader(
+		logger,
+		storageos.NewProvider(),
+		internal.WithReaderHTTP(server.Client(), httpauth.NewNopAuthenticator()),
+	)
+
+	ctx := context.Background()
+	container := app.NewContainer(nil, nil, nil, nil)
+
+	parsedRef, err := refParser.getParsedRef(ctx, server.URL+"/file.bin#header=Authorization:Bearer%20abc", allFormats)
+	require.NoError(t, err)
+	fileRef, ok := parsedRef.(internal.FileRef)
+	require.True(t, ok)
+	readCloser, err := reader.GetFile(ctx, container, fileRef)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(readCloser)
+	require.NoError(t, err)
+	require.NoError(t, readCloser.Close())
+	require.Equal(t, "one", string(data))
+
+	parsedRef, err = refParser.getParsedRef(ctx, server.URL+"/file.bin", allFormats)
+	require.NoError(t, err)
+	fileRef, ok = parsedRef.(internal.FileRef)
+	require.True(t, ok)
+	_, err = reader.GetFile(ctx, container, fileRef)
+	require.Error(t, err)
+}
+
+func TestGetFileMmap(t *testing.T) {
+	t.Parallel()
+
+	logger := zap.NewNop()
+	refParser := newRefParser(logger)
+	reader := testNewFetchReader(logger)
+
+	ctx := context.Background()
+	container := app.NewContainer(nil, nil, nil, nil)
+
+	filePath := filepath.Join(t.TempDir(), "file.bin")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("one"), 0600))
+	parsedRef, err := refParser.getParsedRef(ctx, filePath, allFormats)
+	require.NoError(t, err)
+	fileRef, ok := parsedRef.(internal.FileRef)
+	require.True(t, ok)
+	readCloser, err := reader.GetFile(ctx, container, fileRef, internal.WithGetFileMmap())
+	require.NoError(t, err)
+	mmapFile, ok := readCloser.(mmap.File)
+	require.True(t, ok)
+	require.Equal(t, "one", string(mmapFile.Bytes()))
+	require.NoError(t, readCloser.Close())
+
+	// compressed files are decompressed as usual
+	filePath = filepath.Join(t.TempDir(), "file.bin.gz")
+	require.NoError(t, ioutil.WriteFile(filePath, testNewGzipData(t, []byte("one")), 0600))
+	parsedRef, err = refParser.getParsedRef(ctx, filePath, allFormats)
+	require.NoError(t, err)
+	fileRef, ok = parsedRef.(internal.FileRef)
+	require.True(t, ok)
+	readCloser, err = reader.GetFile(ctx, container, fileRef, internal.WithGetFileMmap())
+	require.NoError(t, err)
+	_, ok = readCloser.(mmap.File)
+	require.False(t, ok)
+	data, err := ioutil.ReadAll(readCloser)
+	require.NoError(t, err)
+	require.NoError(t, readCloser.Close())
+	require.Equal(t, "one", string(data))
+}
+
+func testGetBucketLocalArchive(
+	t *testing.T,
+	filename string,
+	data []byte,
+	expectedErr error,
+) {
+	t.Parallel()
+
+	logger := zap.NewNop()
+	refParser := newRefParser(logger)
+	reader := testNewFetchReader(logger)
+
+	ctx := context.Background()
+	container := app.NewContainer(nil, nil, nil, nil)
+
+	filePath := filepath.Join(t.TempDir(), filename)
+	require.NoError(t, ioutil.WriteFile(filePath, data, 0600))
+
+	parsedRef, err := refParser.getParsedRef(ctx, filePath, allFormats)
+	require.NoError(t, err)
+	archiveRef, ok := parsedRef.(internal.ArchiveRef)
+	require.True(t, ok)
+
+	readBucketCloser, err := reader.GetBucket(ctx, container, archiveRef)
+	if expectedErr != nil {
+		require.Equal(t, expectedErr, err)
+		return
+	}
+	require.NoError(t, err)
+	actualData, err := storage.ReadPath(ctx, readBucketCloser, "a.proto")
+	require.NoError(t, err)
+	require.NoError(t, readBucketCloser.Close())
+	require.Equal(t, testArchiveFileData, string(actualData))
+}
+
 func testRoundTripLocalFile(
 	t *testing.T,
 	filename string,
@@ -99,6 +332,47 @@ func testRoundTripLocalFile(
 	require.Equal(t, string(expectedData), string(actualData))
 }
 
+const testArchiveFileData = "syntax = \"proto3\";\n"
+
+func testNewTarData(t *testing.T) []byte {
+	buffer := bytes.NewBuffer(nil)
+	tarWriter := tar.NewWriter(buffer)
+	require.NoError(
+		t,
+		tarWriter.WriteHeader(
+			&tar.Header{
+				Name: "a.proto",
+				Mode: 0600,
+				Size: int64(len(testArchiveFileData)),
+			},
+		),
+	)
+	_, err := tarWriter.Write([]byte(testArchiveFileData))
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+	return buffer.Bytes()
+}
+
+func testNewZipData(t *testing.T) []byte {
+	buffer := bytes.NewBuffer(nil)
+	zipWriter := zip.NewWriter(buffer)
+	writer, err := zipWriter.Create("a.proto")
+	require.NoError(t, err)
+	_, err = writer.Write([]byte(testArchiveFileData))
+	require.NoError(t, err)
+	require.NoError(t, zipWriter.Close())
+	return buffer.Bytes()
+}
+
+func testNewGzipData(t *testing.T, data []byte) []byte {
+	buffer := bytes.NewBuffer(nil)
+	gzipWriter := gzip.NewWriter(buffer)
+	_, err := gzipWriter.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+	return buffer.Bytes()
+}
+
 func testNewFetchReader(logger *zap.Logger) internal.Reader {
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
 	return internal.NewReader(
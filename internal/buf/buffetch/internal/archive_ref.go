@@ -28,6 +28,7 @@ type archiveRef struct {
 	compressionType CompressionType
 	stripComponents uint32
 	subDirPath      string
+	httpHeaders     map[string]string
 }
 
 func newArchiveRef(
@@ -37,6 +38,7 @@ func newArchiveRef(
 	compressionType CompressionType,
 	stripComponents uint32,
 	subDirPath string,
+	httpHeaders map[string]string,
 ) (*archiveRef, error) {
 	if archiveType == ArchiveTypeZip && compressionType != CompressionTypeNone {
 		return nil, NewCannotSpecifyCompressionForZipError()
@@ -45,6 +47,7 @@ func newArchiveRef(
 		format,
 		path,
 		compressionType,
+		httpHeaders,
 	)
 	if err != nil {
 		return nil, err
@@ -64,6 +67,7 @@ func newArchiveRef(
 		singleRef.CompressionType(),
 		stripComponents,
 		subDirPath,
+		singleRef.HTTPHeaders(),
 	), nil
 }
 
@@ -75,6 +79,7 @@ func newDirectArchiveRef(
 	compressionType CompressionType,
 	stripComponents uint32,
 	subDirPath string,
+	httpHeaders map[string]string,
 ) *archiveRef {
 	return &archiveRef{
 		format:          format,
@@ -84,6 +89,7 @@ func newDirectArchiveRef(
 		compressionType: compressionType,
 		stripComponents: stripComponents,
 		subDirPath:      subDirPath,
+		httpHeaders:     httpHeaders,
 	}
 }
 
@@ -115,6 +121,10 @@ func (r *archiveRef) SubDirPath() string {
 	return r.subDirPath
 }
 
+func (r *archiveRef) HTTPHeaders() map[string]string {
+	return r.httpHeaders
+}
+
 func (*archiveRef) ref()        {}
 func (*archiveRef) fileRef()    {}
 func (*archiveRef) bucketRef()  {}
@@ -88,11 +88,36 @@ func NewCompressionUnknownError(compression string) error {
 	return fmt.Errorf("unknown compression: %q (valid values are %q)", compression, strings.Join(knownCompressionTypeStrings, ","))
 }
 
+// NewCompressionSpecifiedByOptionError is a fetch error.
+func NewCompressionSpecifiedByOptionError(compression string) error {
+	return fmt.Errorf("cannot set compression %q as the compression option is already specified", compression)
+}
+
+// NewCompressionConflictsWithPathError is a fetch error.
+func NewCompressionConflictsWithPathError(compression string, path string) error {
+	return fmt.Errorf("compression %q conflicts with the extension of path %q", compression, path)
+}
+
 // NewCannotSpecifyCompressionForZipError is a fetch error.
 func NewCannotSpecifyCompressionForZipError() error {
 	return errors.New("cannot specify compression type for zip files")
 }
 
+// NewInputTypeMismatchError is a fetch error.
+//
+// The hint is the format and options to suggest, and is ignored if empty.
+func NewInputTypeMismatchError(expected string, detected string, hint string) error {
+	if hint == "" {
+		return fmt.Errorf("input was expected to be %s but appears to be %s", expected, detected)
+	}
+	return fmt.Errorf("input was expected to be %s but appears to be %s, use \"#%s\" to read it as %s", expected, detected, hint, detected)
+}
+
+// NewInputNotCompressedError is a fetch error.
+func NewInputNotCompressedError(expected string) error {
+	return fmt.Errorf("input was expected to be %s but is not", expected)
+}
+
 // NewNoPathError is a fetch error.
 func NewNoPathError() error {
 	return errors.New("value has no path once processed")
@@ -128,6 +153,23 @@ func NewOptionsCouldNotParseRecurseSubmodulesError(s string) error {
 	return fmt.Errorf("could not parse recurse_submodules value %q", s)
 }
 
+// NewOptionsCouldNotParseHeaderError is a fetch error.
+//
+// The header value is never included as it may contain credentials.
+func NewOptionsCouldNotParseHeaderError() error {
+	return errors.New(`could not parse header value, must be of the form "name:value"`)
+}
+
+// NewOptionsDuplicateHTTPHeaderError is a fetch error.
+func NewOptionsDuplicateHTTPHeaderError(name string) error {
+	return fmt.Errorf("duplicate header: %q", name)
+}
+
+// NewHTTPHeadersNotAllowedError is a fetch error.
+func NewHTTPHeadersNotAllowedError(path string) error {
+	return fmt.Errorf("header can only be set for http or https paths: %q", path)
+}
+
 // NewFormatOverrideNotAllowedForDevNullError is a fetch error.
 func NewFormatOverrideNotAllowedForDevNullError(devNull string) error {
 	return fmt.Errorf("not allowed if path is %s", devNull)
@@ -99,6 +99,10 @@ type FileRef interface {
 	Path() string
 	FileScheme() FileScheme
 	CompressionType() CompressionType
+	// HTTPHeaders are the headers to set on the request.
+	//
+	// This will only be non-empty for http and https files.
+	HTTPHeaders() map[string]string
 	fileRef()
 }
 
@@ -116,7 +120,7 @@ type SingleRef interface {
 
 // NewSingleRef returns a new SingleRef.
 func NewSingleRef(path string, compressionType CompressionType) (SingleRef, error) {
-	return newSingleRef("", path, compressionType)
+	return newSingleRef("", path, compressionType, nil)
 }
 
 // ArchiveRef is an archive reference.
@@ -142,7 +146,7 @@ func NewArchiveRef(
 	stripComponents uint32,
 	subDirPath string,
 ) (ArchiveRef, error) {
-	return newArchiveRef("", path, archiveType, compressionType, stripComponents, subDirPath)
+	return newArchiveRef("", path, archiveType, compressionType, stripComponents, subDirPath, nil)
 }
 
 // DirRef is a local directory reference.
@@ -241,12 +245,14 @@ func NewDirectParsedSingleRef(
 	path string,
 	fileScheme FileScheme,
 	compressionType CompressionType,
+	httpHeaders map[string]string,
 ) ParsedSingleRef {
 	return newDirectSingleRef(
 		format,
 		path,
 		fileScheme,
 		compressionType,
+		httpHeaders,
 	)
 }
 
@@ -267,6 +273,7 @@ func NewDirectParsedArchiveRef(
 	compressionType CompressionType,
 	stripComponents uint32,
 	subDirPath string,
+	httpHeaders map[string]string,
 ) ParsedArchiveRef {
 	return newDirectArchiveRef(
 		format,
@@ -276,6 +283,7 @@ func NewDirectParsedArchiveRef(
 		compressionType,
 		stripComponents,
 		subDirPath,
+		httpHeaders,
 	)
 }
 
@@ -454,6 +462,9 @@ type RawRef struct {
 	GitDepth uint32
 	// Only set for archive formats
 	ArchiveStripComponents uint32
+	// Only set for single, archive formats
+	// Only allowed for http and https paths
+	HTTPHeaders map[string]string
 }
 
 // RefParserOption is an RefParser option.
@@ -660,6 +671,18 @@ func WithAllowedFormats(formats ...string) GetParsedRefOption {
 	}
 }
 
+// WithCompression sets the compression of the ref, as if the compression
+// option was specified in the value.
+//
+// This is an error if the compression option is also specified in the value,
+// or if the compression is different than the compression inferred from the
+// path, for example zstd for a path ending in .gz.
+func WithCompression(compression string) GetParsedRefOption {
+	return func(getParsedRefOptions *getParsedRefOptions) {
+		getParsedRefOptions.compression = compression
+	}
+}
+
 // GetFileOption is a GetFile option.
 type GetFileOption func(*getFileOptions)
 
@@ -670,6 +693,15 @@ func WithGetFileKeepFileCompression() GetFileOption {
 	}
 }
 
+// WithGetFileMmap says to memory-map uncompressed local single files.
+//
+// The returned io.ReadCloser is a mmap.File in this case.
+func WithGetFileMmap() GetFileOption {
+	return func(getFileOptions *getFileOptions) {
+		getFileOptions.mmap = true
+	}
+}
+
 // GetBucketOption is a GetBucket option.
 type GetBucketOption func(*getBucketOptions)
 
@@ -15,6 +15,7 @@
 package internal
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
@@ -30,6 +31,7 @@ import (
 	"github.com/bufbuild/buf/internal/pkg/git"
 	"github.com/bufbuild/buf/internal/pkg/httpauth"
 	"github.com/bufbuild/buf/internal/pkg/ioutilextended"
+	"github.com/bufbuild/buf/internal/pkg/mmap"
 	"github.com/bufbuild/buf/internal/pkg/normalpath"
 	"github.com/bufbuild/buf/internal/pkg/storage"
 	"github.com/bufbuild/buf/internal/pkg/storage/storagearchive"
@@ -93,6 +95,7 @@ func (r *reader) GetFile(
 			container,
 			t,
 			getFileOptions.keepFileCompression,
+			getFileOptions.mmap,
 		)
 	case ArchiveRef:
 		return r.getArchiveFile(
@@ -163,7 +166,14 @@ func (r *reader) getSingle(
 	container app.EnvStdinContainer,
 	singleRef SingleRef,
 	keepFileCompression bool,
+	mmapLocal bool,
 ) (io.ReadCloser, error) {
+	if mmapLocal &&
+		r.localEnabled &&
+		singleRef.FileScheme() == FileSchemeLocal &&
+		(keepFileCompression || singleRef.CompressionType() == CompressionTypeNone) {
+		return mmap.Open(singleRef.Path())
+	}
 	readCloser, _, err := r.getFileReadCloserAndSize(ctx, container, singleRef, keepFileCompression)
 	return readCloser, err
 }
@@ -199,9 +209,18 @@ func (r *reader) getArchiveBucket(
 	defer span.End()
 	switch archiveType := archiveRef.ArchiveType(); archiveType {
 	case ArchiveTypeTar:
+		bufferedReader := bufio.NewReaderSize(readCloser, magicPeekSize)
+		data, err := peek(bufferedReader)
+		if err != nil {
+			return nil, err
+		}
+		switch detectedMagicType := detectMagicType(data); detectedMagicType {
+		case magicTypeGzip, magicTypeZstd, magicTypeZip:
+			return nil, newMagicTypeMismatchError(magicTypeTar, detectedMagicType, true)
+		}
 		if err := storagearchive.Untar(
 			ctx,
-			readCloser,
+			bufferedReader,
 			readBucketBuilder,
 			mapper,
 			archiveRef.StripComponents(),
@@ -213,7 +232,7 @@ func (r *reader) getArchiveBucket(
 		if size < 0 {
 			data, err := ioutil.ReadAll(readCloser)
 			if err != nil {
-				return
+				return nil, err
 			}
 			readerAt = bytes.NewReader(data)
 			size = int64(len(data))
@@ -223,6 +242,15 @@ func (r *reader) getArchiveBucket(
 				return nil, err
 			}
 		}
+		data := make([]byte, magicPeekSize)
+		n, err := readerAt.ReadAt(data, 0)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		switch detectedMagicType := detectMagicType(data[:n]); detectedMagicType {
+		case magicTypeGzip, magicTypeZstd, magicTypeTar:
+			return nil, newMagicTypeMismatchError(magicTypeZip, detectedMagicType, true)
+		}
 		if err := storagearchive.Unzip(
 			ctx,
 			readerAt,
@@ -341,11 +369,16 @@ func (r *reader) getFileReadCloserAndSize(
 	if keepFileCompression {
 		return readCloser, size, nil
 	}
+	_, isArchive := fileRef.(ArchiveRef)
 	switch compressionType := fileRef.CompressionType(); compressionType {
 	case CompressionTypeNone:
 		return readCloser, size, nil
 	case CompressionTypeGzip:
-		gzipReadCloser, err := pgzip.NewReader(readCloser)
+		bufferedReader, err := checkMagicType(readCloser, magicTypeGzip, isArchive)
+		if err != nil {
+			return nil, -1, err
+		}
+		gzipReadCloser, err := pgzip.NewReader(bufferedReader)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -357,7 +390,11 @@ func (r *reader) getFileReadCloserAndSize(
 			),
 		), -1, nil
 	case CompressionTypeZstd:
-		zstdDecoder, err := zstd.NewReader(readCloser)
+		bufferedReader, err := checkMagicType(readCloser, magicTypeZstd, isArchive)
+		if err != nil {
+			return nil, -1, err
+		}
+		zstdDecoder, err := zstd.NewReader(bufferedReader)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -385,12 +422,12 @@ func (r *reader) getFileReadCloserAndSizePotentiallyCompressed(
 		if !r.httpEnabled {
 			return nil, -1, NewReadHTTPDisabledError()
 		}
-		return r.getFileReadCloserAndSizePotentiallyCompressedHTTP(ctx, container, "http://"+fileRef.Path())
+		return r.getFileReadCloserAndSizePotentiallyCompressedHTTP(ctx, container, "http://"+fileRef.Path(), fileRef.HTTPHeaders())
 	case FileSchemeHTTPS:
 		if !r.httpEnabled {
 			return nil, -1, NewReadHTTPDisabledError()
 		}
-		return r.getFileReadCloserAndSizePotentiallyCompressedHTTP(ctx, container, "https://"+fileRef.Path())
+		return r.getFileReadCloserAndSizePotentiallyCompressedHTTP(ctx, container, "https://"+fileRef.Path(), fileRef.HTTPHeaders())
 	case FileSchemeLocal:
 		if !r.localEnabled {
 			return nil, -1, NewReadLocalDisabledError()
@@ -423,6 +460,7 @@ func (r *reader) getFileReadCloserAndSizePotentiallyCompressedHTTP(
 	ctx context.Context,
 	container app.EnvStdinContainer,
 	httpPath string,
+	httpHeaders map[string]string,
 ) (io.ReadCloser, int64, error) {
 	if r.httpClient == nil {
 		return nil, 0, errors.New("http client is nil")
@@ -437,6 +475,11 @@ func (r *reader) getFileReadCloserAndSizePotentiallyCompressedHTTP(
 	if _, err := r.httpAuthenticator.SetAuth(container, request); err != nil {
 		return nil, -1, err
 	}
+	// Headers given on the ref take precedence over any authentication
+	// set by the authenticator.
+	for name, value := range httpHeaders {
+		request.Header.Set(name, value)
+	}
 	response, err := r.httpClient.Do(request)
 	if err != nil {
 		return nil, -1, err
@@ -452,6 +495,51 @@ func (r *reader) getFileReadCloserAndSizePotentiallyCompressedHTTP(
 	return response.Body, response.ContentLength, nil
 }
 
+// checkMagicType returns a buffered reader for the reader if the data
+// of the reader is of the expected magic type, and a mismatch error otherwise.
+//
+// Empty data is not checked, so that empty input results in the same
+// errors as before.
+func checkMagicType(reader io.Reader, expected magicType, isArchive bool) (io.Reader, error) {
+	bufferedReader := bufio.NewReaderSize(reader, magicPeekSize)
+	data, err := peek(bufferedReader)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return bufferedReader, nil
+	}
+	if detectedMagicType := detectMagicType(data); detectedMagicType != expected {
+		return nil, newMagicTypeMismatchError(expected, detectedMagicType, isArchive)
+	}
+	return bufferedReader, nil
+}
+
+// peek peeks up to magicPeekSize bytes, returning fewer bytes
+// if the reader has less data.
+func peek(bufferedReader *bufio.Reader) ([]byte, error) {
+	data, err := bufferedReader.Peek(magicPeekSize)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return data, nil
+}
+
+// newMagicTypeMismatchError returns a new error for a mismatched magic type.
+//
+// Hints are only given for archives, as single references have no
+// alternative format to suggest.
+func newMagicTypeMismatchError(expected magicType, detected magicType, isArchive bool) error {
+	if detected == magicTypeUnknown {
+		return NewInputNotCompressedError(expected.String())
+	}
+	var hint string
+	if isArchive {
+		hint = magicTypeToHint[detected]
+	}
+	return NewInputTypeMismatchError(expected.String(), detected.String(), hint)
+}
+
 func getGitURL(gitRef GitRef) (string, error) {
 	switch gitScheme := gitRef.GitScheme(); gitScheme {
 	case GitSchemeHTTP:
@@ -475,6 +563,7 @@ func getGitURL(gitRef GitRef) (string, error) {
 
 type getFileOptions struct {
 	keepFileCompression bool
+	mmap                bool
 }
 
 func newGetFileOptions() *getFileOptions {
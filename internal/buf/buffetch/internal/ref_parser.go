@@ -16,6 +16,9 @@ package internal
 
 import (
 	"context"
+	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -25,6 +28,14 @@ import (
 	"go.uber.org/zap"
 )
 
+// httpHeaderOptionValueRegexp matches a header option, capturing everything
+// up to and including the first ":" so that the header value can be redacted.
+var httpHeaderOptionValueRegexp = regexp.MustCompile(`(header=[^:,#]*:)[^,#]*`)
+
+// httpHeaderOptionKey is the only options key that can be given more than once,
+// so that multiple headers can be set.
+const httpHeaderOptionKey = "header"
+
 type refParser struct {
 	logger              *zap.Logger
 	rawRefProcessor     func(*RawRef) error
@@ -59,18 +70,19 @@ func (a *refParser) GetParsedRef(
 	for _, option := range options {
 		option(getParsedRefOptions)
 	}
-	return a.getParsedRef(ctx, value, getParsedRefOptions.allowedFormats)
+	return a.getParsedRef(ctx, value, getParsedRefOptions)
 }
 
 func (a *refParser) getParsedRef(
 	ctx context.Context,
 	value string,
-	allowedFormats map[string]struct{},
+	getParsedRefOptions *getParsedRefOptions,
 ) (ParsedRef, error) {
-	rawRef, err := a.getRawRef(value)
+	rawRef, err := a.getRawRef(value, getParsedRefOptions.compression)
 	if err != nil {
 		return nil, err
 	}
+	allowedFormats := getParsedRefOptions.allowedFormats
 	singleFormatInfo, singleOK := a.singleFormatToInfo[rawRef.Format]
 	archiveFormatInfo, archiveOK := a.archiveFormatToInfo[rawRef.Format]
 	_, dirOK := a.dirFormatToInfo[rawRef.Format]
@@ -103,9 +115,11 @@ func (a *refParser) getParsedRef(
 }
 
 // validated per rules on rawRef
-func (a *refParser) getRawRef(value string) (*RawRef, error) {
+//
+// compression is the value of WithCompression, if set.
+func (a *refParser) getRawRef(value string, compression string) (*RawRef, error) {
 	// path is never empty after returning from this function
-	path, options, err := getRawPathAndOptions(value)
+	path, options, httpHeaderOptionValues, err := getRawPathAndOptions(value)
 	if err != nil {
 		return nil, err
 	}
@@ -125,16 +139,11 @@ func (a *refParser) getRawRef(value string) (*RawRef, error) {
 			}
 			rawRef.Format = value
 		case "compression":
-			switch value {
-			case "none":
-				rawRef.CompressionType = CompressionTypeNone
-			case "gzip":
-				rawRef.CompressionType = CompressionTypeGzip
-			case "zstd":
-				rawRef.CompressionType = CompressionTypeZstd
-			default:
-				return nil, NewCompressionUnknownError(value)
+			compressionType, err := parseCompressionType(value)
+			if err != nil {
+				return nil, err
 			}
+			rawRef.CompressionType = compressionType
 		case "branch":
 			if rawRef.GitBranch != "" || rawRef.GitTag != "" {
 				return nil, NewCannotSpecifyGitBranchAndTagError()
@@ -186,9 +195,36 @@ func (a *refParser) getRawRef(value string) (*RawRef, error) {
 			return nil, NewOptionsInvalidKeyError(key)
 		}
 	}
+	for _, httpHeaderOptionValue := range httpHeaderOptionValues {
+		httpHeaderName, httpHeaderValue, err := getHTTPHeaderNameAndValue(httpHeaderOptionValue)
+		if err != nil {
+			return nil, err
+		}
+		if rawRef.HTTPHeaders == nil {
+			rawRef.HTTPHeaders = make(map[string]string)
+		}
+		if _, ok := rawRef.HTTPHeaders[httpHeaderName]; ok {
+			return nil, NewOptionsDuplicateHTTPHeaderError(httpHeaderName)
+		}
+		rawRef.HTTPHeaders[httpHeaderName] = httpHeaderValue
+	}
+	if compression != "" {
+		if _, ok := options["compression"]; ok {
+			return nil, NewCompressionSpecifiedByOptionError(compression)
+		}
+		compressionType, err := parseCompressionType(compression)
+		if err != nil {
+			return nil, err
+		}
+		// the compression type set by the rawRefProcessor is inferred from the path
+		if rawRef.CompressionType != 0 && rawRef.CompressionType != compressionType {
+			return nil, NewCompressionConflictsWithPathError(compression, path)
+		}
+		rawRef.CompressionType = compressionType
+	}
 
 	if rawRef.Format == "" {
-		return nil, NewFormatCannotBeDeterminedError(value)
+		return nil, NewFormatCannotBeDeterminedError(redactValue(value))
 	}
 
 	_, gitOK := a.gitFormatToInfo[rawRef.Format]
@@ -208,13 +244,13 @@ func (a *refParser) getRawRef(value string) (*RawRef, error) {
 		}
 	} else {
 		if rawRef.GitBranch != "" || rawRef.GitTag != "" || rawRef.GitRef != "" || rawRef.GitRecurseSubmodules || rawRef.GitDepth > 0 {
-			return nil, NewOptionsInvalidForFormatError(rawRef.Format, value)
+			return nil, NewOptionsInvalidForFormatError(rawRef.Format, redactValue(value))
 		}
 	}
 	// not an archive format
 	if !archiveOK {
 		if rawRef.ArchiveStripComponents > 0 {
-			return nil, NewOptionsInvalidForFormatError(rawRef.Format, value)
+			return nil, NewOptionsInvalidForFormatError(rawRef.Format, redactValue(value))
 		}
 	} else {
 		if archiveFormatInfo.archiveType == ArchiveTypeZip && rawRef.CompressionType != 0 {
@@ -222,59 +258,105 @@ func (a *refParser) getRawRef(value string) (*RawRef, error) {
 		}
 	}
 	if !singleOK && !archiveOK {
-		if rawRef.CompressionType != 0 {
-			return nil, NewOptionsInvalidForFormatError(rawRef.Format, value)
+		if rawRef.CompressionType != 0 || len(rawRef.HTTPHeaders) > 0 {
+			return nil, NewOptionsInvalidForFormatError(rawRef.Format, redactValue(value))
 		}
 	}
 	if !archiveOK && !gitOK {
 		if rawRef.SubDirPath != "" {
-			return nil, NewOptionsInvalidForFormatError(rawRef.Format, value)
+			return nil, NewOptionsInvalidForFormatError(rawRef.Format, redactValue(value))
 		}
 	}
 	return rawRef, nil
 }
 
+func parseCompressionType(value string) (CompressionType, error) {
+	switch value {
+	case "none":
+		return CompressionTypeNone, nil
+	case "gzip":
+		return CompressionTypeGzip, nil
+	case "zstd":
+		return CompressionTypeZstd, nil
+	default:
+		return 0, NewCompressionUnknownError(value)
+	}
+}
+
 // rawPath will be non-empty
-func getRawPathAndOptions(value string) (string, map[string]string, error) {
+//
+// The values of the header options are returned separately in order, as the
+// header option can be given more than once.
+func getRawPathAndOptions(value string) (string, map[string]string, []string, error) {
 	value = strings.TrimSpace(value)
 	if value == "" {
-		return "", nil, NewValueEmptyError()
+		return "", nil, nil, NewValueEmptyError()
 	}
 
 	switch splitValue := strings.Split(value, "#"); len(splitValue) {
 	case 1:
-		return value, nil, nil
+		return value, nil, nil, nil
 	case 2:
 		path := strings.TrimSpace(splitValue[0])
 		optionsString := strings.TrimSpace(splitValue[1])
 		if path == "" {
-			return "", nil, NewValueStartsWithHashtagError(value)
+			return "", nil, nil, NewValueStartsWithHashtagError(redactValue(value))
 		}
 		if optionsString == "" {
-			return "", nil, NewValueEndsWithHashtagError(value)
+			return "", nil, nil, NewValueEndsWithHashtagError(redactValue(value))
 		}
 		options := make(map[string]string)
+		var httpHeaderOptionValues []string
 		for _, pair := range strings.Split(optionsString, ",") {
 			split := strings.Split(pair, "=")
 			if len(split) != 2 {
-				return "", nil, NewOptionsInvalidError(optionsString)
+				return "", nil, nil, NewOptionsInvalidError(redactValue(optionsString))
 			}
 			key := strings.TrimSpace(split[0])
 			value := strings.TrimSpace(split[1])
 			if key == "" || value == "" {
-				return "", nil, NewOptionsInvalidError(optionsString)
+				return "", nil, nil, NewOptionsInvalidError(redactValue(optionsString))
+			}
+			if key == httpHeaderOptionKey {
+				httpHeaderOptionValues = append(httpHeaderOptionValues, value)
+				continue
 			}
 			if _, ok := options[key]; ok {
-				return "", nil, NewOptionsDuplicateKeyError(key)
+				return "", nil, nil, NewOptionsDuplicateKeyError(key)
 			}
 			options[key] = value
 		}
-		return path, options, nil
+		return path, options, httpHeaderOptionValues, nil
 	default:
-		return "", nil, NewValueMultipleHashtagsError(value)
+		return "", nil, nil, NewValueMultipleHashtagsError(redactValue(value))
 	}
 }
 
+// getHTTPHeaderNameAndValue parses a header option value of the form "name:value".
+//
+// The value is unescaped so that characters such as spaces, "," and "=" can be
+// given as %20, %2C and %3D.
+func getHTTPHeaderNameAndValue(value string) (string, string, error) {
+	split := strings.SplitN(value, ":", 2)
+	if len(split) != 2 {
+		return "", "", NewOptionsCouldNotParseHeaderError()
+	}
+	name := http.CanonicalHeaderKey(strings.TrimSpace(split[0]))
+	headerValue, err := url.PathUnescape(strings.TrimSpace(split[1]))
+	if err != nil {
+		return "", "", NewOptionsCouldNotParseHeaderError()
+	}
+	if name == "" || headerValue == "" {
+		return "", "", NewOptionsCouldNotParseHeaderError()
+	}
+	return name, headerValue, nil
+}
+
+// redactValue redacts the values of header options, as these may contain credentials.
+func redactValue(value string) string {
+	return httpHeaderOptionValueRegexp.ReplaceAllString(value, "${1}<redacted>")
+}
+
 func getSingleRef(
 	rawRef *RawRef,
 	defaultCompressionType CompressionType,
@@ -287,6 +369,7 @@ func getSingleRef(
 		rawRef.Format,
 		rawRef.Path,
 		compressionType,
+		rawRef.HTTPHeaders,
 	)
 }
 
@@ -306,6 +389,7 @@ func getArchiveRef(
 		compressionType,
 		rawRef.ArchiveStripComponents,
 		rawRef.SubDirPath,
+		rawRef.HTTPHeaders,
 	)
 }
 
@@ -412,6 +496,7 @@ func newModuleFormatInfo() *moduleFormatInfo {
 
 type getParsedRefOptions struct {
 	allowedFormats map[string]struct{}
+	compression    string
 }
 
 func newGetParsedRefOptions() *getParsedRefOptions {
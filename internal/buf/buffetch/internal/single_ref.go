@@ -36,16 +36,21 @@ type singleRef struct {
 	path            string
 	fileScheme      FileScheme
 	compressionType CompressionType
+	httpHeaders     map[string]string
 }
 
 func newSingleRef(
 	format string,
 	path string,
 	compressionType CompressionType,
+	httpHeaders map[string]string,
 ) (*singleRef, error) {
 	if path == "" {
 		return nil, NewNoPathError()
 	}
+	if len(httpHeaders) > 0 && !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		return nil, NewHTTPHeadersNotAllowedError(path)
+	}
 	if app.IsDevStderr(path) {
 		return nil, NewInvalidPathError(format, path)
 	}
@@ -55,6 +60,7 @@ func newSingleRef(
 			"",
 			FileSchemeStdio,
 			compressionType,
+			nil,
 		), nil
 	}
 	if app.IsDevStdin(path) {
@@ -63,6 +69,7 @@ func newSingleRef(
 			"",
 			FileSchemeStdin,
 			compressionType,
+			nil,
 		), nil
 	}
 	if app.IsDevStdout(path) {
@@ -71,6 +78,7 @@ func newSingleRef(
 			"",
 			FileSchemeStdout,
 			compressionType,
+			nil,
 		), nil
 	}
 	if app.IsDevNull(path) {
@@ -79,6 +87,7 @@ func newSingleRef(
 			"",
 			FileSchemeNull,
 			compressionType,
+			nil,
 		), nil
 	}
 	for prefix, fileScheme := range fileSchemePrefixToFileScheme {
@@ -95,6 +104,7 @@ func newSingleRef(
 				path,
 				fileScheme,
 				compressionType,
+				httpHeaders,
 			), nil
 		}
 	}
@@ -106,6 +116,7 @@ func newSingleRef(
 		normalpath.Normalize(path),
 		FileSchemeLocal,
 		compressionType,
+		nil,
 	), nil
 }
 
@@ -114,12 +125,14 @@ func newDirectSingleRef(
 	path string,
 	fileScheme FileScheme,
 	compressionType CompressionType,
+	httpHeaders map[string]string,
 ) *singleRef {
 	return &singleRef{
 		format:          format,
 		path:            path,
 		fileScheme:      fileScheme,
 		compressionType: compressionType,
+		httpHeaders:     httpHeaders,
 	}
 }
 
@@ -139,6 +152,10 @@ func (r *singleRef) CompressionType() CompressionType {
 	return r.compressionType
 }
 
+func (r *singleRef) HTTPHeaders() map[string]string {
+	return r.httpHeaders
+}
+
 func (*singleRef) ref()       {}
 func (*singleRef) fileRef()   {}
 func (*singleRef) singleRef() {}
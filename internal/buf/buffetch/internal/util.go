@@ -15,10 +15,79 @@
 package internal
 
 import (
+	"bytes"
 	"sort"
 	"strings"
 )
 
+const (
+	// magicPeekSize is the number of bytes needed to detect every magicType.
+	//
+	// The tar magic ends at offset 262.
+	magicPeekSize = 262
+	// tarMagicOffset is the offset of the tar magic.
+	tarMagicOffset = 257
+)
+
+const (
+	magicTypeUnknown magicType = iota
+	magicTypeGzip
+	magicTypeZstd
+	magicTypeZip
+	magicTypeTar
+)
+
+var (
+	gzipMagic       = []byte{0x1f, 0x8b}
+	zstdMagic       = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	zipMagic        = []byte{'P', 'K', 0x03, 0x04}
+	zipEmptyMagic   = []byte{'P', 'K', 0x05, 0x06}
+	tarMagic        = []byte("ustar")
+	magicTypeToHint = map[magicType]string{
+		magicTypeGzip: "format=tar,compression=gzip",
+		magicTypeZstd: "format=tar,compression=zstd",
+		magicTypeZip:  "format=zip",
+		magicTypeTar:  "format=tar,compression=none",
+	}
+	magicTypeToString = map[magicType]string{
+		magicTypeGzip: "gzip-compressed data",
+		magicTypeZstd: "zstd-compressed data",
+		magicTypeZip:  "a zip archive",
+		magicTypeTar:  "a tar archive",
+	}
+)
+
+// magicType is a type of data detected from its leading bytes.
+type magicType int
+
+// String implements fmt.Stringer.
+func (m magicType) String() string {
+	if s, ok := magicTypeToString[m]; ok {
+		return s
+	}
+	return "unknown data"
+}
+
+// detectMagicType detects the magicType of the given leading bytes.
+//
+// data should be at least magicPeekSize bytes to detect all types, but
+// can be shorter.
+func detectMagicType(data []byte) magicType {
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		return magicTypeGzip
+	case bytes.HasPrefix(data, zstdMagic):
+		return magicTypeZstd
+	case bytes.HasPrefix(data, zipMagic), bytes.HasPrefix(data, zipEmptyMagic):
+		return magicTypeZip
+	case len(data) >= tarMagicOffset+len(tarMagic) &&
+		bytes.Equal(data[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic):
+		return magicTypeTar
+	default:
+		return magicTypeUnknown
+	}
+}
+
 func normalizeFormat(format string) string {
 	return strings.ToLower(strings.TrimSpace(format))
 }
@@ -131,7 +131,11 @@ func (a *reader) GetImageFile(
 	container app.EnvStdinContainer,
 	imageRef ImageRef,
 ) (io.ReadCloser, error) {
-	return a.internalReader.GetFile(ctx, container, imageRef.internalFileRef())
+	var getFileOptions []internal.GetFileOption
+	if container.Env(ImageMmapEnvKey) == "true" {
+		getFileOptions = append(getFileOptions, internal.WithGetFileMmap())
+	}
+	return a.internalReader.GetFile(ctx, container, imageRef.internalFileRef(), getFileOptions...)
 }
 
 func (a *reader) GetSourceBucket(
@@ -226,10 +226,22 @@ func (a *refParser) GetSourceOrModuleRef(
 func (a *refParser) GetImageRef(
 	ctx context.Context,
 	value string,
+	options ...GetImageRefOption,
 ) (ImageRef, error) {
 	ctx, span := trace.StartSpan(ctx, "get_image_ref")
 	defer span.End()
-	parsedRef, err := a.getParsedRef(ctx, value, imageFormats)
+	getImageRefOptions := newGetImageRefOptions()
+	for _, option := range options {
+		option(getImageRefOptions)
+	}
+	var getParsedRefOptions []internal.GetParsedRefOption
+	if getImageRefOptions.compression != "" {
+		getParsedRefOptions = append(
+			getParsedRefOptions,
+			internal.WithCompression(getImageRefOptions.compression),
+		)
+	}
+	parsedRef, err := a.getParsedRef(ctx, value, imageFormats, getParsedRefOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -285,11 +297,12 @@ func (a *refParser) getParsedRef(
 	ctx context.Context,
 	value string,
 	allowedFormats []string,
+	options ...internal.GetParsedRefOption,
 ) (internal.ParsedRef, error) {
 	parsedRef, err := a.fetchRefParser.GetParsedRef(
 		ctx,
 		value,
-		internal.WithAllowedFormats(allowedFormats...),
+		append(options, internal.WithAllowedFormats(allowedFormats...))...,
 	)
 	if err != nil {
 		return nil, err
@@ -524,3 +537,11 @@ func assumeModuleOrDir(path string) (string, error) {
 	// cannot be parsed into a module, assume dir for here
 	return formatDir, nil
 }
+
+type getImageRefOptions struct {
+	compression string
+}
+
+func newGetImageRefOptions() *getImageRefOptions {
+	return &getImageRefOptions{}
+}
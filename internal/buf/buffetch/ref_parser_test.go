@@ -88,6 +88,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			0,
 			"",
+			nil,
 		),
 		"path/to/file.tar",
 	)
@@ -101,6 +102,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			0,
 			"",
+			nil,
 		),
 		"file:///path/to/file.tar",
 	)
@@ -114,6 +116,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file.tar#strip_components=1",
 	)
@@ -127,6 +130,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeGzip,
 			0,
 			"",
+			nil,
 		),
 		"path/to/file.tar.gz",
 	)
@@ -140,6 +144,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeGzip,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file.tar.gz#strip_components=1",
 	)
@@ -153,6 +158,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeGzip,
 			0,
 			"",
+			nil,
 		),
 		"path/to/file.tgz",
 	)
@@ -166,6 +172,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeGzip,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file.tgz#strip_components=1",
 	)
@@ -179,6 +186,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			0,
 			"",
+			nil,
 		),
 		"http://path/to/file.tar",
 	)
@@ -192,6 +200,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			0,
 			"",
+			nil,
 		),
 		"https://path/to/file.tar",
 	)
@@ -205,6 +214,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			0,
 			"",
+			nil,
 		),
 		"path/to/file.zip",
 	)
@@ -218,6 +228,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			0,
 			"",
+			nil,
 		),
 		"file:///path/to/file.zip",
 	)
@@ -231,6 +242,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file.zip#strip_components=1",
 	)
@@ -462,6 +474,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file.bin",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"path/to/file.bin",
 	)
@@ -472,6 +485,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file.bin.gz",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeGzip,
+			nil,
 		),
 		"path/to/file.bin.gz",
 	)
@@ -482,6 +496,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file.json",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"path/to/file.json",
 	)
@@ -492,6 +507,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file.json.gz",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeGzip,
+			nil,
 		),
 		"path/to/file.json.gz",
 	)
@@ -502,6 +518,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file.json.gz",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"path/to/file.json.gz#compression=none",
 	)
@@ -512,6 +529,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file.json.gz",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeGzip,
+			nil,
 		),
 		"path/to/file.json.gz#compression=gzip",
 	)
@@ -522,6 +540,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"",
 			internal.FileSchemeStdio,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"-",
 	)
@@ -532,6 +551,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"",
 			internal.FileSchemeStdio,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"-#format=json",
 	)
@@ -542,6 +562,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"",
 			internal.FileSchemeNull,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		app.DevNullFilePath,
 	)
@@ -553,6 +574,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"",
 			internal.FileSchemeStdin,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		app.DevStdinFilePath,
 	)
@@ -564,6 +586,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"",
 			internal.FileSchemeStdout,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		app.DevStdoutFilePath,
 	)
@@ -574,6 +597,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/dir",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"path/to/dir#format=bin",
 	)
@@ -584,6 +608,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/dir",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"path/to/dir#format=bin,compression=none",
 	)
@@ -594,6 +619,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/dir",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeGzip,
+			nil,
 		),
 		"path/to/dir#format=bin,compression=gzip",
 	)
@@ -711,6 +737,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeGzip,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file#format=targz,strip_components=1",
 	)
@@ -724,6 +751,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file#format=tar,strip_components=1",
 	)
@@ -737,6 +765,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file#format=tar,strip_components=1,compression=none",
 	)
@@ -750,6 +779,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeGzip,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file#format=tar,strip_components=1,compression=gzip",
 	)
@@ -763,6 +793,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file#format=zip,strip_components=1",
 	)
@@ -776,6 +807,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeZstd,
 			0,
 			"",
+			nil,
 		),
 		"path/to/file.tar.zst",
 	)
@@ -789,6 +821,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeZstd,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file.tar.zst#strip_components=1",
 	)
@@ -802,6 +835,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeNone,
 			1,
 			"",
+			nil,
 		),
 		"path/to/file#format=zip,strip_components=1",
 	)
@@ -815,6 +849,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeZstd,
 			0,
 			"foo/bar",
+			nil,
 		),
 		"path/to/file.tar.zst#subdir=foo/bar",
 	)
@@ -828,6 +863,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			internal.CompressionTypeZstd,
 			1,
 			"foo/bar",
+			nil,
 		),
 		"path/to/file#format=tar,strip_components=1,compression=zstd,subdir=foo/bar",
 	)
@@ -838,6 +874,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeZstd,
+			nil,
 		),
 		"path/to/file#format=bin,compression=zstd",
 	)
@@ -848,6 +885,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"path/to/file.bin.zst",
 			internal.FileSchemeLocal,
 			internal.CompressionTypeZstd,
+			nil,
 		),
 		"path/to/file.bin.zst",
 	)
@@ -886,6 +924,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"github.com/path/to/file.bin",
 			internal.FileSchemeHTTPS,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"https://github.com/path/to/file.bin",
 	)
@@ -896,6 +935,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"github.com/path/to/file.ext",
 			internal.FileSchemeHTTPS,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"https://github.com/path/to/file.ext#format=bin",
 	)
@@ -906,9 +946,53 @@ func TestGetParsedRefSuccess(t *testing.T) {
 			"gitlab.com/api/v4/projects/foo/packages/generic/proto/0.0.1/proto.bin?private_token=bar",
 			internal.FileSchemeHTTPS,
 			internal.CompressionTypeNone,
+			nil,
 		),
 		"https://gitlab.com/api/v4/projects/foo/packages/generic/proto/0.0.1/proto.bin?private_token=bar#format=bin",
 	)
+	testGetParsedRefSuccess(
+		t,
+		internal.NewDirectParsedSingleRef(
+			formatBin,
+			"example.com/path/to/file.bin",
+			internal.FileSchemeHTTPS,
+			internal.CompressionTypeNone,
+			map[string]string{
+				"Authorization": "Bearer abc+def=",
+			},
+		),
+		"https://example.com/path/to/file.bin#header=Authorization:Bearer%20abc+def%3D",
+	)
+	testGetParsedRefSuccess(
+		t,
+		internal.NewDirectParsedArchiveRef(
+			formatTar,
+			"example.com/path/to/file.tar.gz",
+			internal.FileSchemeHTTP,
+			internal.ArchiveTypeTar,
+			internal.CompressionTypeGzip,
+			1,
+			"",
+			map[string]string{
+				"X-Api-Key": "foo",
+			},
+		),
+		"http://example.com/path/to/file.tar.gz#strip_components=1,header=X-Api-Key:foo",
+	)
+	testGetParsedRefSuccess(
+		t,
+		internal.NewDirectParsedSingleRef(
+			formatBin,
+			"example.com/path/to/file.bin",
+			internal.FileSchemeHTTPS,
+			internal.CompressionTypeNone,
+			map[string]string{
+				"Authorization": "Bearer abc",
+				"X-Api-Key":     "foo",
+			},
+		),
+		"https://example.com/path/to/file.bin#header=Authorization:Bearer%20abc,header=x-api-key:foo",
+	)
 }
 
 func TestGetParsedRefError(t *testing.T) {
@@ -1072,6 +1156,69 @@ func TestGetParsedRefError(t *testing.T) {
 		internal.NewCannotSpecifyCompressionForZipError(),
 		"path/to/foo#format=zip,compression=gzip",
 	)
+	testGetParsedRefError(
+		t,
+		internal.NewOptionsCouldNotParseHeaderError(),
+		"https://example.com/foo.bin#header=Authorization",
+	)
+	testGetParsedRefError(
+		t,
+		internal.NewOptionsCouldNotParseHeaderError(),
+		"https://example.com/foo.bin#header=Authorization:",
+	)
+	testGetParsedRefError(
+		t,
+		internal.NewOptionsCouldNotParseHeaderError(),
+		"https://example.com/foo.bin#header=:secret",
+	)
+	testGetParsedRefError(
+		t,
+		internal.NewHTTPHeadersNotAllowedError("path/to/foo.bin"),
+		"path/to/foo.bin#header=Authorization:secret",
+	)
+	testGetParsedRefError(
+		t,
+		internal.NewOptionsInvalidForFormatError(formatGit, "https://example.com/foo.git#header=Authorization:<redacted>"),
+		"https://example.com/foo.git#header=Authorization:secret",
+	)
+	testGetParsedRefError(
+		t,
+		internal.NewOptionsInvalidError("header=Authorization:<redacted>,foo"),
+		"https://example.com/foo.bin#header=Authorization:secret,foo",
+	)
+	testGetParsedRefError(
+		t,
+		internal.NewOptionsDuplicateHTTPHeaderError("Authorization"),
+		"https://example.com/foo.bin#header=Authorization:secret,header=authorization:other",
+	)
+}
+
+func TestGetImageRefWithCompression(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	imageRefParser := newImageRefParser(zap.NewNop())
+
+	imageRef, err := imageRefParser.GetImageRef(ctx, "path/to/file", GetImageRefWithCompression("zstd"))
+	require.NoError(t, err)
+	assert.Equal(t, ImageEncodingBin, imageRef.ImageEncoding())
+	assert.Equal(t, internal.CompressionTypeZstd, imageRef.internalFileRef().CompressionType())
+
+	// other options are kept
+	imageRef, err = imageRefParser.GetImageRef(ctx, "path/to/file#format=json", GetImageRefWithCompression("gzip"))
+	require.NoError(t, err)
+	assert.Equal(t, ImageEncodingJSON, imageRef.ImageEncoding())
+	assert.Equal(t, internal.CompressionTypeGzip, imageRef.internalFileRef().CompressionType())
+
+	imageRef, err = imageRefParser.GetImageRef(ctx, "path/to/file.bin.gz", GetImageRefWithCompression("gzip"))
+	require.NoError(t, err)
+	assert.Equal(t, internal.CompressionTypeGzip, imageRef.internalFileRef().CompressionType())
+
+	_, err = imageRefParser.GetImageRef(ctx, "path/to/file.bin.gz", GetImageRefWithCompression("zstd"))
+	assert.Equal(t, internal.NewCompressionConflictsWithPathError("zstd", "path/to/file.bin.gz"), err)
+	_, err = imageRefParser.GetImageRef(ctx, "path/to/file#compression=gzip", GetImageRefWithCompression("zstd"))
+	assert.Equal(t, internal.NewCompressionSpecifiedByOptionError("zstd"), err)
+	_, err = imageRefParser.GetImageRef(ctx, "path/to/file", GetImageRefWithCompression("lz4"))
+	assert.Equal(t, internal.NewCompressionUnknownError("lz4"), err)
 }
 
 func testGetParsedRefSuccess(
@@ -59,6 +59,10 @@ func (r *sourceRef) PathForExternalPath(externalPath string) (string, error) {
 	return normalpath.NormalizeAndValidate(path)
 }
 
+func (r *sourceRef) IsLocalDir() bool {
+	return r.dirPath != ""
+}
+
 func (r *sourceRef) internalRef() internal.Ref {
 	return r.bucketRef
 }
@@ -105,10 +105,139 @@ func GenerateWithBaseOutDirPath(baseOutDirPath string) GenerateOption {
 	}
 }
 
+// GenerateWithOutputFilePathFunc returns a new GenerateOption that calls
+// the given function with the path of every file written during generation.
+//
+// Paths include the base output directory if one is set. The same path
+// may be given more than once.
+func GenerateWithOutputFilePathFunc(outputFilePathFunc func(string)) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.outputFilePathFunc = outputFilePathFunc
+	}
+}
+
+// GenerateWithIncludeImports returns a new GenerateOption that includes imports
+// as files to generate for plugins that do not set IncludeImports.
+//
+// The default is to only generate for non-imports.
+func GenerateWithIncludeImports() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.includeImports = true
+	}
+}
+
+// GenerateWithIncludeWellKnownTypes returns a new GenerateOption that includes
+// the well-known types as files to generate for plugins that do not set
+// IncludeWellKnownTypes.
+//
+// This has no effect for plugins that do not include imports.
+func GenerateWithIncludeWellKnownTypes() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.includeWellKnownTypes = true
+	}
+}
+
+// GenerateWithStrictPluginVersions returns a new GenerateOption that results in
+// an error if a plugin with a Version does not report a matching version.
+//
+// The default is to log a warning.
+func GenerateWithStrictPluginVersions() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.strictPluginVersions = true
+	}
+}
+
+// GenerateWithPluginSearchDirPaths returns a new GenerateOption that looks for
+// plugins without a Path in the given directories.
+//
+// The directories are checked in order for a binary named "protoc-gen-" + Name,
+// and take precedence over the PATH. Plugins with a Path are not affected.
+func GenerateWithPluginSearchDirPaths(pluginSearchDirPaths ...string) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.pluginSearchDirPaths = pluginSearchDirPaths
+	}
+}
+
+// GenerateWithoutFailFast returns a new GenerateOption that results in every
+// plugin being executed even if a previous plugin failed.
+//
+// The failures of all plugins are returned together, and nothing is written
+// if any plugin failed. The default is to return the first failure, keeping the
+// output of the plugins that were executed before it.
+func GenerateWithoutFailFast() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.failFast = false
+	}
+}
+
+// GenerateWithIncrementalStateFilePath returns a new GenerateOption that results
+// in plugins being skipped if nothing they depend on changed since the last
+// generation that used the state file at the given path.
+//
+// The state file records the given version, a digest of the config, and for
+// every plugin a digest of its configuration, of the binary executed for it,
+// and of the requests sent to it. If the version or the config changed, every
+// plugin is executed. The state file is written after every plugin succeeded.
+//
+// The binary is identified by its path, size, and modification time, not by
+// its content. Plugins are skipped regardless of whether their output still
+// exists, use GenerateWithIncrementalForce to execute every plugin.
+func GenerateWithIncrementalStateFilePath(stateFilePath string, version string) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.incrementalStateFilePath = stateFilePath
+		generateOptions.incrementalVersion = version
+	}
+}
+
+// GenerateWithIncrementalForce returns a new GenerateOption that results in
+// every plugin being executed even if nothing changed since the last generation.
+//
+// The state file is still written. This has no effect if
+// GenerateWithIncrementalStateFilePath is not set.
+func GenerateWithIncrementalForce() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.incrementalForce = true
+	}
+}
+
+// GenerateWithAllowOverwrite returns a new GenerateOption that allows plugins
+// to generate the same file with different content, in which case the file
+// generated by the last plugin is written.
+//
+// The default is to return an error naming both plugins. Files for insertion
+// points never conflict, and plugins skipped by incremental generation are not
+// checked.
+func GenerateWithAllowOverwrite() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.allowOverwrite = true
+	}
+}
+
+// GenerateWithDumpRequestDirPath returns a new GenerateOption that writes the
+// serialized CodeGeneratorRequests for the plugins with the given name to the
+// given directory before any plugin is executed.
+//
+// Each request is written to its own file named request-N.bin, numbered in
+// plugin and then request order, so that a plugin can be run on a file with
+// protoc-gen-NAME < request-0.bin. The plugins are still executed, including
+// plugins skipped by incremental generation. May be given multiple times.
+func GenerateWithDumpRequestDirPath(pluginName string, dirPath string) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.pluginNameToDumpRequestDirPath[pluginName] = dirPath
+	}
+}
+
 // Config is a configuration.
 type Config struct {
 	// Required
 	PluginConfigs []*PluginConfig
+	// Optional
+	//
+	// The shell commands to run after every plugin has succeeded and all
+	// files are written, in order. These are excluded from the template
+	// digest used for incremental generation, as they do not affect the
+	// output of the plugins.
+	PostGenerateCommands []string `json:"-"`
 }
 
 // PluginConfig is a plugin configuration.
@@ -123,6 +252,30 @@ type PluginConfig struct {
 	Path string
 	// Required
 	Strategy Strategy
+	// Optional
+	//
+	// If set, this overrides whether imports are included as files to
+	// generate for this plugin. If nil, the value given to Generate is used.
+	IncludeImports *bool
+	// Optional
+	//
+	// If set, this overrides whether the well-known types are included as
+	// files to generate for this plugin. If nil, the value given to Generate
+	// is used. This has no effect if imports are not included.
+	IncludeWellKnownTypes *bool
+	// Optional
+	//
+	// If set, the version the plugin is expected to report when invoked with
+	// --version. This is set by specifying the plugin name as name@version.
+	Version string
+	// Optional
+	//
+	// If set, the names of the files generated by the plugin are computed
+	// from this template, relative to Out. The placeholders {package}, {dir},
+	// {name}, and {ext} are replaced by the package of the files to generate
+	// with dots replaced by slashes, and the directory, base name without
+	// extension, and extension of the name returned by the plugin.
+	OutTemplate string
 }
 
 // ReadConfig reads the configuration from the OS.
@@ -132,27 +285,78 @@ type PluginConfig struct {
 // data in either JSON or YAML format, and unmarshals it.
 //
 // Only use in CLI tools.
-func ReadConfig(fileOrData string) (*Config, error) {
-	return readConfig(fileOrData)
+func ReadConfig(fileOrData string, options ...ReadConfigOption) (*Config, error) {
+	return readConfig(fileOrData, options...)
+}
+
+// ReadConfigOption is an option for ReadConfig.
+type ReadConfigOption func(*readConfigOptions)
+
+// ReadConfigWithEnvContainer returns a new ReadConfigOption that expands
+// environment variables in the out, opt, and path fields of plugins using
+// the given EnvContainer.
+//
+// Both $VAR and ${VAR} are expanded, and ${VAR:-default} expands to default
+// if VAR is not set or is empty. Use $$ for a literal $.
+//
+// Note that this allows the environment to control which plugin binaries are
+// executed via path, so only use this if the environment is trusted.
+func ReadConfigWithEnvContainer(envContainer app.EnvContainer) ReadConfigOption {
+	return func(readConfigOptions *readConfigOptions) {
+		readConfigOptions.envContainer = envContainer
+	}
+}
+
+// ReadConfigWithStrictEnv returns a new ReadConfigOption that results in an
+// error if an environment variable without a default is not set or is empty,
+// instead of expanding it to the empty string.
+//
+// This has no effect if ReadConfigWithEnvContainer is not set.
+func ReadConfigWithStrictEnv() ReadConfigOption {
+	return func(readConfigOptions *readConfigOptions) {
+		readConfigOptions.strictEnv = true
+	}
+}
+
+// ConfigWithPluginPath returns a copy of the config where the path of every
+// plugin with the given name is set to path.
+//
+// If no plugin has the given name, a plugin with the name and path is added
+// that outputs to the base output directory with the directory strategy.
+// The given config is not modified.
+func ConfigWithPluginPath(config *Config, name string, path string) *Config {
+	return configWithPluginPath(config, name, path)
 }
 
 // ExternalConfigV1Beta1 is an external configuration.
 //
 // Only use outside of this package for testing.
 type ExternalConfigV1Beta1 struct {
-	Version string                        `json:"version,omitempty" yaml:"version,omitempty"`
-	Plugins []ExternalPluginConfigV1Beta1 `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	Version        string                              `json:"version,omitempty" yaml:"version,omitempty"`
+	PluginDefaults ExternalPluginDefaultsConfigV1Beta1 `json:"plugin_defaults,omitempty" yaml:"plugin_defaults,omitempty"`
+	Plugins        []ExternalPluginConfigV1Beta1       `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	PostGenerate   []string                            `json:"post_generate,omitempty" yaml:"post_generate,omitempty"`
+}
+
+// ExternalPluginDefaultsConfigV1Beta1 is an external configuration of defaults for all plugins.
+//
+// Only use outside of this package for testing.
+type ExternalPluginDefaultsConfigV1Beta1 struct {
+	Opt interface{} `json:"opt,omitempty" yaml:"opt,omitempty"`
 }
 
 // ExternalPluginConfigV1Beta1 is an external plugin configuration.
 //
 // Only use outside of this package for testing.
 type ExternalPluginConfigV1Beta1 struct {
-	Name     string      `json:"name,omitempty" yaml:"name,omitempty"`
-	Out      string      `json:"out,omitempty" yaml:"out,omitempty"`
-	Opt      interface{} `json:"opt,omitempty" yaml:"opt,omitempty"`
-	Path     string      `json:"path,omitempty" yaml:"path,omitempty"`
-	Strategy string      `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	Name           string      `json:"name,omitempty" yaml:"name,omitempty"`
+	Out            string      `json:"out,omitempty" yaml:"out,omitempty"`
+	Opt            interface{} `json:"opt,omitempty" yaml:"opt,omitempty"`
+	Path           string      `json:"path,omitempty" yaml:"path,omitempty"`
+	Strategy       string      `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	IncludeImports *bool       `json:"include_imports,omitempty" yaml:"include_imports,omitempty"`
+	IncludeWKT     *bool       `json:"include_wkt,omitempty" yaml:"include_wkt,omitempty"`
+	OutTemplate    string      `json:"out_template,omitempty" yaml:"out_template,omitempty"`
 }
 
 type externalConfigVersion struct {
@@ -20,23 +20,28 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/bufbuild/buf/internal/pkg/app"
 	"github.com/bufbuild/buf/internal/pkg/encoding"
 )
 
 const v1beta1Version = "v1beta1"
 
-func readConfig(fileOrData string) (*Config, error) {
+func readConfig(fileOrData string, options ...ReadConfigOption) (*Config, error) {
+	readConfigOptions := newReadConfigOptions()
+	for _, option := range options {
+		option(readConfigOptions)
+	}
 	switch filepath.Ext(fileOrData) {
 	case ".json":
-		return getConfigJSONFile(fileOrData)
+		return getConfigJSONFile(fileOrData, readConfigOptions)
 	case ".yaml":
-		return getConfigYAMLFile(fileOrData)
+		return getConfigYAMLFile(fileOrData, readConfigOptions)
 	default:
-		return getConfigJSONOrYAMLData(fileOrData)
+		return getConfigJSONOrYAMLData(fileOrData, readConfigOptions)
 	}
 }
 
-func getConfigJSONFile(file string) (*Config, error) {
+func getConfigJSONFile(file string, readConfigOptions *readConfigOptions) (*Config, error) {
 	data, err := ioutil.ReadFile(file)
 	if err != nil {
 		return nil, fmt.Errorf("could not read file %s: %v", file, err)
@@ -46,10 +51,11 @@ func getConfigJSONFile(file string) (*Config, error) {
 		encoding.UnmarshalJSONStrict,
 		data,
 		file,
+		readConfigOptions,
 	)
 }
 
-func getConfigYAMLFile(file string) (*Config, error) {
+func getConfigYAMLFile(file string, readConfigOptions *readConfigOptions) (*Config, error) {
 	data, err := ioutil.ReadFile(file)
 	if err != nil {
 		return nil, fmt.Errorf("could not read file %s: %v", file, err)
@@ -59,15 +65,17 @@ func getConfigYAMLFile(file string) (*Config, error) {
 		encoding.UnmarshalYAMLStrict,
 		data,
 		file,
+		readConfigOptions,
 	)
 }
 
-func getConfigJSONOrYAMLData(data string) (*Config, error) {
+func getConfigJSONOrYAMLData(data string, readConfigOptions *readConfigOptions) (*Config, error) {
 	return getConfig(
 		encoding.UnmarshalJSONOrYAMLNonStrict,
 		encoding.UnmarshalJSONOrYAMLStrict,
 		[]byte(data),
 		"Generate configuration data",
+		readConfigOptions,
 	)
 }
 
@@ -76,6 +84,7 @@ func getConfig(
 	unmarshalStrict func([]byte, interface{}) error,
 	data []byte,
 	id string,
+	readConfigOptions *readConfigOptions,
 ) (*Config, error) {
 	var externalConfigVersion externalConfigVersion
 	if err := unmarshalNonStrict(data, &externalConfigVersion); err != nil {
@@ -90,6 +99,16 @@ func getConfig(
 	if err := unmarshalStrict(data, &externalConfigV1Beta1); err != nil {
 		return nil, err
 	}
+	if readConfigOptions.envContainer != nil {
+		if err := expandExternalConfigV1Beta1Env(
+			&externalConfigV1Beta1,
+			id,
+			readConfigOptions.envContainer,
+			readConfigOptions.strictEnv,
+		); err != nil {
+			return nil, err
+		}
+	}
 	if err := validateExternalConfigV1Beta1(externalConfigV1Beta1, id); err != nil {
 		return nil, err
 	}
@@ -104,49 +123,252 @@ func validateExternalConfigV1Beta1(externalConfig ExternalConfigV1Beta1, id stri
 		if plugin.Name == "" {
 			return fmt.Errorf("%s: plugin name is required", id)
 		}
+		if _, _, err := splitPluginNameAndVersion(plugin.Name); err != nil {
+			return fmt.Errorf("%s: %v", id, err)
+		}
 		if plugin.Out == "" {
 			return fmt.Errorf("%s: plugin %s out is required", id, plugin.Name)
 		}
+		if plugin.IncludeImports != nil && !*plugin.IncludeImports && plugin.IncludeWKT != nil && *plugin.IncludeWKT {
+			return fmt.Errorf("%s: plugin %s cannot set include_wkt without include_imports", id, plugin.Name)
+		}
+		if err := validateOutTemplate(plugin.OutTemplate); err != nil {
+			return fmt.Errorf("%s: plugin %s %v", id, plugin.Name, err)
+		}
 	}
 	return nil
 }
 
-func newConfigV1Beta1(externalConfig ExternalConfigV1Beta1, id string) (*Config, error) {
-	config := &Config{}
-	for _, plugin := range externalConfig.Plugins {
-		strategy, err := ParseStrategy(plugin.Strategy)
+// expandExternalConfigV1Beta1Env expands environment variables in the out, opt, and path fields.
+//
+// This is done before validation, so that fields that expand to empty values are validated.
+func expandExternalConfigV1Beta1Env(
+	externalConfig *ExternalConfigV1Beta1,
+	id string,
+	envContainer app.EnvContainer,
+	strict bool,
+) error {
+	expand := func(pluginName string, fieldName string, value string) (string, error) {
+		expanded, err := expandEnv(value, envContainer.Env, strict)
 		if err != nil {
-			return nil, err
+			if pluginName == "" {
+				return "", fmt.Errorf("%s: plugin_defaults %s: %v", id, fieldName, err)
+			}
+			return "", fmt.Errorf("%s: plugin %s %s: %v", id, pluginName, fieldName, err)
 		}
-		var opt string
-		switch t := plugin.Opt.(type) {
+		return expanded, nil
+	}
+	expandOpt := func(pluginName string, opt interface{}) (interface{}, error) {
+		switch t := opt.(type) {
 		case string:
-			opt = t
+			return expand(pluginName, "opt", t)
 		case []interface{}:
-			opts := make([]string, len(t))
-			for i, elem := range t {
-				s, ok := elem.(string)
-				if !ok {
-					return nil, fmt.Errorf("%s: could not convert opt element %T to a string", id, elem)
+			opts := make([]interface{}, len(t))
+			for j, elem := range t {
+				// non-string elements are reported by newConfigV1Beta1
+				if s, ok := elem.(string); ok {
+					expanded, err := expand(pluginName, "opt", s)
+					if err != nil {
+						return nil, err
+					}
+					elem = expanded
 				}
-				opts[i] = s
+				opts[j] = elem
 			}
-			opt = strings.Join(opts, ",")
-		case nil:
-			// If opt is omitted, plugin.Opt is nil
+			return opts, nil
 		default:
-			return nil, fmt.Errorf("%s: unknown type %T for opt", id, t)
+			return opt, nil
+		}
+	}
+	defaultOpt, err := expandOpt("", externalConfig.PluginDefaults.Opt)
+	if err != nil {
+		return err
+	}
+	externalConfig.PluginDefaults.Opt = defaultOpt
+	for i, plugin := range externalConfig.Plugins {
+		out, err := expand(plugin.Name, "out", plugin.Out)
+		if err != nil {
+			return err
+		}
+		path, err := expand(plugin.Name, "path", plugin.Path)
+		if err != nil {
+			return err
+		}
+		opt, err := expandOpt(plugin.Name, plugin.Opt)
+		if err != nil {
+			return err
+		}
+		plugin.Out = out
+		plugin.Path = path
+		plugin.Opt = opt
+		externalConfig.Plugins[i] = plugin
+	}
+	return nil
+}
+
+func newConfigV1Beta1(externalConfig ExternalConfigV1Beta1, id string) (*Config, error) {
+	defaultOpts, err := getOpts(externalConfig.PluginDefaults.Opt, id)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	for _, plugin := range externalConfig.Plugins {
+		strategy, err := ParseStrategy(plugin.Strategy)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := getOpts(plugin.Opt, id)
+		if err != nil {
+			return nil, err
+		}
+		opt := strings.Join(mergeOpts(defaultOpts, opts), ",")
+		name, version, err := splitPluginNameAndVersion(plugin.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", id, err)
 		}
 		config.PluginConfigs = append(
 			config.PluginConfigs,
 			&PluginConfig{
-				Name:     plugin.Name,
-				Out:      plugin.Out,
-				Opt:      opt,
-				Path:     plugin.Path,
-				Strategy: strategy,
+				Name:                  name,
+				Out:                   plugin.Out,
+				Opt:                   opt,
+				Path:                  plugin.Path,
+				Strategy:              strategy,
+				IncludeImports:        plugin.IncludeImports,
+				IncludeWellKnownTypes: plugin.IncludeWKT,
+				Version:               version,
+				OutTemplate:           plugin.OutTemplate,
 			},
 		)
 	}
+	for _, command := range externalConfig.PostGenerate {
+		if strings.TrimSpace(command) == "" {
+			return nil, fmt.Errorf("%s: post_generate commands must not be empty", id)
+		}
+		config.PostGenerateCommands = append(config.PostGenerateCommands, command)
+	}
 	return config, nil
 }
+
+// getOpts returns the opt values for the given opt field, which is either a single string or a list of strings.
+func getOpts(opt interface{}, id string) ([]string, error) {
+	switch t := opt.(type) {
+	case string:
+		if t == "" {
+			return nil, nil
+		}
+		return []string{t}, nil
+	case []interface{}:
+		opts := make([]string, len(t))
+		for i, elem := range t {
+			s, ok := elem.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: could not convert opt element %T to a string", id, elem)
+			}
+			opts[i] = s
+		}
+		return opts, nil
+	case nil:
+		// If opt is omitted, opt is nil
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%s: unknown type %T for opt", id, t)
+	}
+}
+
+// mergeOpts prepends the default opts to the plugin opts.
+//
+// Each opt is split on commas, and a default opt is dropped if the plugin opts contain
+// an opt with the same key, that is the part before any "=". The order of both the
+// remaining default opts and the plugin opts is preserved.
+func mergeOpts(defaultOpts []string, opts []string) []string {
+	if len(defaultOpts) == 0 {
+		return opts
+	}
+	splitDefaultOpts := splitOpts(defaultOpts)
+	splitPluginOpts := splitOpts(opts)
+	pluginKeys := make(map[string]struct{}, len(splitPluginOpts))
+	for _, opt := range splitPluginOpts {
+		pluginKeys[getOptKey(opt)] = struct{}{}
+	}
+	merged := make([]string, 0, len(splitDefaultOpts)+len(splitPluginOpts))
+	for _, opt := range splitDefaultOpts {
+		if _, ok := pluginKeys[getOptKey(opt)]; !ok {
+			merged = append(merged, opt)
+		}
+	}
+	return append(merged, splitPluginOpts...)
+}
+
+func splitOpts(opts []string) []string {
+	var split []string
+	for _, opt := range opts {
+		for _, elem := range strings.Split(opt, ",") {
+			if elem != "" {
+				split = append(split, elem)
+			}
+		}
+	}
+	return split
+}
+
+func getOptKey(opt string) string {
+	if index := strings.Index(opt, "="); index >= 0 {
+		return opt[:index]
+	}
+	return opt
+}
+
+// splitPluginNameAndVersion splits a plugin name of the form name@version.
+//
+// The version is empty if the name does not contain a version.
+func splitPluginNameAndVersion(pluginName string) (string, string, error) {
+	split := strings.Split(pluginName, "@")
+	switch len(split) {
+	case 1:
+		return pluginName, "", nil
+	case 2:
+		if split[0] != "" && split[1] != "" {
+			return split[0], split[1], nil
+		}
+	}
+	return "", "", fmt.Errorf("plugin %s must be of the form name or name@version", pluginName)
+}
+
+type readConfigOptions struct {
+	envContainer app.EnvContainer
+	strictEnv    bool
+}
+
+func configWithPluginPath(config *Config, name string, path string) *Config {
+	pluginConfigs := make([]*PluginConfig, len(config.PluginConfigs))
+	found := false
+	for i, pluginConfig := range config.PluginConfigs {
+		if pluginConfig.Name == name {
+			pluginConfigCopy := *pluginConfig
+			pluginConfigCopy.Path = path
+			pluginConfig = &pluginConfigCopy
+			found = true
+		}
+		pluginConfigs[i] = pluginConfig
+	}
+	if !found {
+		pluginConfigs = append(
+			pluginConfigs,
+			&PluginConfig{
+				Name:     name,
+				Out:      ".",
+				Path:     path,
+				Strategy: StrategyDirectory,
+			},
+		)
+	}
+	return &Config{
+		PluginConfigs:        pluginConfigs,
+		PostGenerateCommands: config.PostGenerateCommands,
+	}
+}
+
+func newReadConfigOptions() *readConfigOptions {
+	return &readConfigOptions{}
+}
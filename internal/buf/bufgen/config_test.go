@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/bufbuild/buf/internal/pkg/app"
 	"github.com/stretchr/testify/require"
 )
 
@@ -104,3 +105,339 @@ func TestReadConfig(t *testing.T) {
 	_, err = ReadConfig(string(data))
 	require.Error(t, err)
 }
+
+func TestReadConfigIncludeImports(t *testing.T) {
+	config, err := ReadConfig(filepath.Join("testdata", "gen_success4.yaml"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				{
+					Name:                  "doc",
+					Out:                   "gen/doc",
+					Strategy:              StrategyDirectory,
+					IncludeImports:        boolPointer(true),
+					IncludeWellKnownTypes: boolPointer(true),
+				},
+				{
+					Name:           "go",
+					Out:            "gen/go",
+					Strategy:       StrategyDirectory,
+					IncludeImports: boolPointer(false),
+				},
+			},
+		},
+		config,
+	)
+	_, err = ReadConfig(filepath.Join("testdata", "gen_error2.yaml"))
+	require.EqualError(t, err, filepath.Join("testdata", "gen_error2.yaml")+": plugin doc cannot set include_wkt without include_imports")
+}
+
+func TestReadConfigPluginVersion(t *testing.T) {
+	config, err := ReadConfig(filepath.Join("testdata", "gen_success5.yaml"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				{
+					Name:     "go",
+					Out:      "gen/go",
+					Strategy: StrategyDirectory,
+					Version:  "v1.28",
+				},
+				{
+					Name:     "java",
+					Out:      "gen/java",
+					Strategy: StrategyDirectory,
+				},
+			},
+		},
+		config,
+	)
+	_, err = ReadConfig(filepath.Join("testdata", "gen_error3.yaml"))
+	require.EqualError(t, err, filepath.Join("testdata", "gen_error3.yaml")+": plugin go@ must be of the form name or name@version")
+}
+
+func TestReadConfigOutTemplate(t *testing.T) {
+	config, err := ReadConfig(filepath.Join("testdata", "gen_success8.yaml"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				{
+					Name:        "go",
+					Out:         "gen/go",
+					Strategy:    StrategyDirectory,
+					OutTemplate: "{package}/{name}{ext}",
+				},
+			},
+		},
+		config,
+	)
+	_, err = ReadConfig(filepath.Join("testdata", "gen_error4.yaml"))
+	require.EqualError(t, err, filepath.Join("testdata", "gen_error4.yaml")+`: plugin go out_template "{pkg}/{name}{ext}" contains unknown placeholder "{pkg}", must be one of [dir,ext,name,package]`)
+}
+
+func TestReadConfigPostGenerate(t *testing.T) {
+	config, err := ReadConfig(filepath.Join("testdata", "gen_success9.yaml"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				{
+					Name:     "go",
+					Out:      "gen/go",
+					Strategy: StrategyDirectory,
+				},
+			},
+			PostGenerateCommands: []string{
+				"gofmt -s -w .",
+				`echo "$BUF_GENERATED_FILES"`,
+			},
+		},
+		config,
+	)
+	_, err = ReadConfig(filepath.Join("testdata", "gen_error5.yaml"))
+	require.EqualError(t, err, filepath.Join("testdata", "gen_error5.yaml")+": post_generate commands must not be empty")
+}
+
+func TestReadConfigPluginDefaults(t *testing.T) {
+	config, err := ReadConfig(filepath.Join("testdata", "gen_success6.yaml"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				{
+					Name:     "go",
+					Out:      "gen/go",
+					Opt:      "paths=source_relative,Mfoo/foo.proto=example.com/foo",
+					Strategy: StrategyDirectory,
+				},
+				{
+					Name:     "go-grpc",
+					Out:      "gen/go",
+					Opt:      "Mfoo/foo.proto=example.com/foo,require_unimplemented_servers=false,paths=import",
+					Strategy: StrategyDirectory,
+				},
+				{
+					Name:     "java",
+					Out:      "gen/java",
+					Opt:      "Mfoo/foo.proto=example.com/foo,lite,paths=source_relative",
+					Strategy: StrategyDirectory,
+				},
+			},
+		},
+		config,
+	)
+}
+
+func TestMergeOpts(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, []string{"a,b"}, mergeOpts(nil, []string{"a,b"}))
+	require.Equal(t, []string{"a", "b"}, mergeOpts([]string{"a,b"}, nil))
+	require.Equal(t, []string{"a=1", "c", "b=3", "d"}, mergeOpts([]string{"a=1", "b=2", "c"}, []string{"b=3,d"}))
+	require.Equal(t, []string{"b", "a=2"}, mergeOpts([]string{"a", "b"}, []string{"", "a=2"}))
+}
+
+func TestConfigWithPluginPath(t *testing.T) {
+	t.Parallel()
+	config := &Config{
+		PluginConfigs: []*PluginConfig{
+			{
+				Name:     "go",
+				Out:      "gen/go",
+				Opt:      "paths=source_relative",
+				Strategy: StrategyDirectory,
+			},
+			{
+				Name:     "java",
+				Out:      "gen/java",
+				Path:     "protoc-gen-java",
+				Strategy: StrategyAll,
+			},
+		},
+	}
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				{
+					Name:     "go",
+					Out:      "gen/go",
+					Opt:      "paths=source_relative",
+					Strategy: StrategyDirectory,
+				},
+				{
+					Name:     "java",
+					Out:      "gen/java",
+					Path:     "bin/protoc-gen-java",
+					Strategy: StrategyAll,
+				},
+			},
+		},
+		ConfigWithPluginPath(config, "java", "bin/protoc-gen-java"),
+	)
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				config.PluginConfigs[0],
+				config.PluginConfigs[1],
+				{
+					Name:     "foo",
+					Out:      ".",
+					Path:     "bin/protoc-gen-foo",
+					Strategy: StrategyDirectory,
+				},
+			},
+		},
+		ConfigWithPluginPath(config, "foo", "bin/protoc-gen-foo"),
+	)
+	// the original config is not modified
+	require.Equal(t, "protoc-gen-java", config.PluginConfigs[1].Path)
+}
+
+func TestReadConfigYAMLAnchors(t *testing.T) {
+	config, err := ReadConfig(filepath.Join("testdata", "gen_success7.yaml"))
+	require.NoError(t, err)
+	require.Len(t, config.PluginConfigs, 2)
+	for _, pluginConfig := range config.PluginConfigs {
+		require.Equal(t, "paths=source_relative", pluginConfig.Opt)
+	}
+}
+
+func TestPluginVersionMatches(t *testing.T) {
+	t.Parallel()
+	require.True(t, pluginVersionMatches("v1.28", "protoc-gen-go v1.28.0"))
+	require.True(t, pluginVersionMatches("v1.28", "1.28.1"))
+	require.True(t, pluginVersionMatches("1.28.0", "protoc-gen-go v1.28.0"))
+	require.True(t, pluginVersionMatches("3.15", "libprotoc 3.15.2"))
+	require.True(t, pluginVersionMatches("v1.0.0", "v1.0.0-rc1"))
+	require.False(t, pluginVersionMatches("v1.28", "protoc-gen-go v1.280.0"))
+	require.False(t, pluginVersionMatches("v1.28", "protoc-gen-go v1.27.1"))
+	require.False(t, pluginVersionMatches("v1.28", ""))
+}
+
+func TestReadConfigEnv(t *testing.T) {
+	envContainer := app.NewEnvContainer(
+		map[string]string{
+			"GEN_DIR": "gen",
+			"BIN_DIR": "/path/to",
+		},
+	)
+	config, err := ReadConfig(
+		filepath.Join("testdata", "gen_env1.yaml"),
+		ReadConfigWithEnvContainer(envContainer),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&Config{
+			PluginConfigs: []*PluginConfig{
+				{
+					Name:     "go",
+					Out:      "gen/go",
+					Opt:      "plugins=grpc,cost=$5",
+					Path:     "/path/to/protoc-gen-go",
+					Strategy: StrategyAll,
+				},
+			},
+		},
+		config,
+	)
+	config, err = ReadConfig(
+		filepath.Join("testdata", "gen_env1.yaml"),
+		ReadConfigWithEnvContainer(envContainer),
+		ReadConfigWithStrictEnv(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "gen/go", config.PluginConfigs[0].Out)
+
+	// without an EnvContainer, nothing is expanded
+	config, err = ReadConfig(filepath.Join("testdata", "gen_env1.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "$GEN_DIR/go", config.PluginConfigs[0].Out)
+
+	// BIN_DIR is not set
+	envContainer = app.NewEnvContainer(
+		map[string]string{
+			"GEN_DIR": "gen",
+		},
+	)
+	config, err = ReadConfig(
+		filepath.Join("testdata", "gen_env1.yaml"),
+		ReadConfigWithEnvContainer(envContainer),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "/protoc-gen-go", config.PluginConfigs[0].Path)
+	_, err = ReadConfig(
+		filepath.Join("testdata", "gen_env1.yaml"),
+		ReadConfigWithEnvContainer(envContainer),
+		ReadConfigWithStrictEnv(),
+	)
+	require.EqualError(t, err, filepath.Join("testdata", "gen_env1.yaml")+": plugin go path: environment variable BIN_DIR is not set")
+
+	// out is required after expansion
+	_, err = ReadConfig(
+		`{"version":"v1beta1","plugins":[{"name":"go","out":"$GEN_DIR"}]}`,
+		ReadConfigWithEnvContainer(app.NewEnvContainer(nil)),
+	)
+	require.Error(t, err)
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Parallel()
+	getenv := app.NewEnvContainer(
+		map[string]string{
+			"FOO":     "foo",
+			"FOO_BAR": "foobar",
+		},
+	).Env
+	for _, testCase := range []struct {
+		value       string
+		expected    string
+		expectedErr bool
+		strict      bool
+	}{
+		{value: "foo", expected: "foo"},
+		{value: "$FOO", expected: "foo"},
+		{value: "${FOO}", expected: "foo"},
+		{value: "$FOO_BAR/baz", expected: "foobar/baz"},
+		{value: "${FOO}_BAR", expected: "foo_BAR"},
+		{value: "a/$FOO/b", expected: "a/foo/b"},
+		{value: "$$FOO", expected: "$FOO"},
+		{value: "$", expected: "$"},
+		{value: "$1", expected: "$1"},
+		{value: "$BAZ", expected: ""},
+		{value: "${BAZ:-baz}", expected: "baz"},
+		{value: "${FOO:-baz}", expected: "foo"},
+		{value: "${BAZ:-}", expected: ""},
+		{value: "${BAZ:-}", expected: "", strict: true},
+		{value: "$BAZ", expectedErr: true, strict: true},
+		{value: "${BAZ}", expectedErr: true, strict: true},
+		{value: "${FOO", expectedErr: true},
+		{value: "${}", expectedErr: true},
+		{value: "${1FOO}", expectedErr: true},
+	} {
+		testCase := testCase
+		t.Run(testCase.value, func(t *testing.T) {
+			t.Parallel()
+			actual, err := expandEnv(testCase.value, getenv, testCase.strict)
+			if testCase.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func boolPointer(value bool) *bool {
+	return &value
+}
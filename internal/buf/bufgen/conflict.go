@@ -0,0 +1,101 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// outputFileTracker tracks the files generated by every plugin, to detect
+// plugins that generate the same file with different content.
+type outputFileTracker struct {
+	pathToOutputFile map[string]*outputFile
+}
+
+// outputFile is a file generated by a plugin.
+type outputFile struct {
+	pluginIndex int
+	pluginName  string
+	digest      string
+}
+
+func newOutputFileTracker() *outputFileTracker {
+	return &outputFileTracker{
+		pathToOutputFile: make(map[string]*outputFile),
+	}
+}
+
+// add adds the file digests of the plugin at the given index to the tracker.
+//
+// An error is returned if a file was already generated by a different plugin
+// with different content.
+func (t *outputFileTracker) add(
+	pluginIndex int,
+	pluginName string,
+	outputFileDigests []*outputFileDigest,
+) error {
+	for _, outputFileDigest := range outputFileDigests {
+		existing, ok := t.pathToOutputFile[outputFileDigest.Path]
+		if ok && existing.pluginIndex != pluginIndex && existing.digest != outputFileDigest.Digest {
+			return fmt.Errorf(
+				"plugins %s and %s both generated %s with different content",
+				existing.pluginName,
+				pluginName,
+				outputFileDigest.Path,
+			)
+		}
+		t.pathToOutputFile[outputFileDigest.Path] = &outputFile{
+			pluginIndex: pluginIndex,
+			pluginName:  pluginName,
+			digest:      outputFileDigest.Digest,
+		}
+	}
+	return nil
+}
+
+// outputFileDigest is the digest of the content of a file generated by a plugin.
+//
+// These are recorded in the incremental state, so that the files of skipped
+// plugins are still checked for conflicts.
+type outputFileDigest struct {
+	// Path is the path of the file, including the output directory of the plugin.
+	Path   string `json:"path,omitempty"`
+	Digest string `json:"digest,omitempty"`
+}
+
+// getOutputFileDigests returns the digests of the files generated by a plugin
+// with the given output directory.
+//
+// Files for insertion points are ignored, as they append to files instead of
+// replacing them.
+func getOutputFileDigests(out string, files []*pluginpb.CodeGeneratorResponse_File) []*outputFileDigest {
+	var outputFileDigests []*outputFileDigest
+	for _, file := range files {
+		if file.GetInsertionPoint() != "" {
+			continue
+		}
+		outputFileDigests = append(
+			outputFileDigests,
+			&outputFileDigest{
+				Path:   normalpath.Join(normalpath.Normalize(out), file.GetName()),
+				Digest: getDigest([]byte(file.GetContent())),
+			},
+		)
+	}
+	return outputFileDigests
+}
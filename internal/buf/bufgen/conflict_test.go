@@ -0,0 +1,119 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestOutputFileTracker(t *testing.T) {
+	t.Parallel()
+	newFile := func(name string, insertionPoint string, content string) *pluginpb.CodeGeneratorResponse_File {
+		file := &pluginpb.CodeGeneratorResponse_File{
+			Name:    proto.String(name),
+			Content: proto.String(content),
+		}
+		if insertionPoint != "" {
+			file.InsertionPoint = proto.String(insertionPoint)
+		}
+		return file
+	}
+	tracker := newOutputFileTracker()
+	require.NoError(
+		t,
+		tracker.add(0, "go", getOutputFileDigests("gen", []*pluginpb.CodeGeneratorResponse_File{newFile("a/a.pb.go", "", "foo")})),
+	)
+	// same content
+	require.NoError(
+		t,
+		tracker.add(1, "go2", getOutputFileDigests("gen/", []*pluginpb.CodeGeneratorResponse_File{newFile("a/a.pb.go", "", "foo")})),
+	)
+	// insertion point
+	require.NoError(
+		t,
+		tracker.add(2, "go3", getOutputFileDigests("gen", []*pluginpb.CodeGeneratorResponse_File{newFile("a/a.pb.go", "imports", "bar")})),
+	)
+	// different out
+	require.NoError(
+		t,
+		tracker.add(3, "go4", getOutputFileDigests("gen2", []*pluginpb.CodeGeneratorResponse_File{newFile("a/a.pb.go", "", "bar")})),
+	)
+	// same plugin
+	require.NoError(
+		t,
+		tracker.add(3, "go4", getOutputFileDigests("gen2", []*pluginpb.CodeGeneratorResponse_File{newFile("a/a.pb.go", "", "baz")})),
+	)
+	require.EqualError(
+		t,
+		tracker.add(4, "go5", getOutputFileDigests("gen/a", []*pluginpb.CodeGeneratorResponse_File{newFile("a.pb.go", "", "bar")})),
+		"plugins go2 and go5 both generated gen/a/a.pb.go with different content",
+	)
+}
+
+func TestOutputFileTrackerSkippedPlugin(t *testing.T) {
+	t.Parallel()
+	tracker := newOutputFileTracker()
+	// the digests recorded in the incremental state for a skipped plugin
+	require.NoError(
+		t,
+		tracker.add(
+			0,
+			"go",
+			[]*outputFileDigest{
+				{
+					Path:   "gen/a/a.pb.go",
+					Digest: getDigest([]byte("foo")),
+				},
+			},
+		),
+	)
+	require.NoError(
+		t,
+		tracker.add(
+			1,
+			"go2",
+			getOutputFileDigests(
+				"gen",
+				[]*pluginpb.CodeGeneratorResponse_File{
+					{
+						Name:    proto.String("a/a.pb.go"),
+						Content: proto.String("foo"),
+					},
+				},
+			),
+		),
+	)
+	require.EqualError(
+		t,
+		tracker.add(
+			2,
+			"go3",
+			getOutputFileDigests(
+				"gen",
+				[]*pluginpb.CodeGeneratorResponse_File{
+					{
+						Name:    proto.String("a/a.pb.go"),
+						Content: proto.String("bar"),
+					},
+				},
+			),
+		),
+		"plugins go2 and go3 both generated gen/a/a.pb.go with different content",
+	)
+}
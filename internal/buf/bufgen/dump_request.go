@@ -0,0 +1,63 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// dumpRequests writes the requests of the plugins with a dump directory.
+func dumpRequests(
+	config *Config,
+	pluginRequests [][]*pluginpb.CodeGeneratorRequest,
+	pluginNameToDumpRequestDirPath map[string]string,
+) error {
+	if len(pluginNameToDumpRequestDirPath) == 0 {
+		return nil
+	}
+	marshaler := protoencoding.NewWireMarshaler()
+	// the requests of plugins with the same name are numbered together
+	pluginNameToNumRequests := make(map[string]int)
+	for i, pluginConfig := range config.PluginConfigs {
+		dirPath, ok := pluginNameToDumpRequestDirPath[pluginConfig.Name]
+		if !ok {
+			continue
+		}
+		if err := os.MkdirAll(dirPath, 0755); err != nil {
+			return fmt.Errorf("plugin %s: %v", pluginConfig.Name, err)
+		}
+		for _, request := range pluginRequests[i] {
+			data, err := marshaler.Marshal(request)
+			if err != nil {
+				return fmt.Errorf("plugin %s: %v", pluginConfig.Name, err)
+			}
+			filePath := filepath.Join(
+				dirPath,
+				fmt.Sprintf("request-%d.bin", pluginNameToNumRequests[pluginConfig.Name]),
+			)
+			if err := ioutil.WriteFile(filePath, data, 0644); err != nil {
+				return fmt.Errorf("plugin %s: %v", pluginConfig.Name, err)
+			}
+			pluginNameToNumRequests[pluginConfig.Name]++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// expandEnv expands $VAR, ${VAR}, and ${VAR:-default} within the value.
+//
+// $$ expands to a literal $, and a $ that is not followed by a variable name
+// or a brace is left as-is. Defaults are not expanded themselves.
+// If strict is set, variables without a default that are not set or are empty
+// result in an error.
+func expandEnv(value string, getenv func(string) string, strict bool) (string, error) {
+	if !strings.Contains(value, "$") {
+		return value, nil
+	}
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '$' || i+1 == len(value) {
+			_ = builder.WriteByte(value[i])
+			continue
+		}
+		switch next := value[i+1]; {
+		case next == '$':
+			_ = builder.WriteByte('$')
+			i++
+		case next == '{':
+			end := strings.IndexByte(value[i+2:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated variable reference in %q", value)
+			}
+			expression := value[i+2 : i+2+end]
+			name := expression
+			defaultValue, hasDefault := "", false
+			if index := strings.Index(expression, ":-"); index >= 0 {
+				name = expression[:index]
+				defaultValue = expression[index+2:]
+				hasDefault = true
+			}
+			if !isEnvName(name) {
+				return "", fmt.Errorf("invalid variable reference %q", "${"+expression+"}")
+			}
+			expanded, err := expandEnvName(name, getenv, strict, defaultValue, hasDefault)
+			if err != nil {
+				return "", err
+			}
+			_, _ = builder.WriteString(expanded)
+			i += 2 + end
+		case isEnvNameStartByte(next):
+			end := i + 2
+			for end < len(value) && isEnvNameByte(value[end]) {
+				end++
+			}
+			expanded, err := expandEnvName(value[i+1:end], getenv, strict, "", false)
+			if err != nil {
+				return "", err
+			}
+			_, _ = builder.WriteString(expanded)
+			i = end - 1
+		default:
+			_ = builder.WriteByte('$')
+		}
+	}
+	return builder.String(), nil
+}
+
+func expandEnvName(
+	name string,
+	getenv func(string) string,
+	strict bool,
+	defaultValue string,
+	hasDefault bool,
+) (string, error) {
+	if value := getenv(name); value != "" {
+		return value, nil
+	}
+	if hasDefault {
+		return defaultValue, nil
+	}
+	if strict {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return "", nil
+}
+
+func isEnvName(s string) bool {
+	if s == "" || !isEnvNameStartByte(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if !isEnvNameByte(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isEnvNameStartByte(b byte) bool {
+	return b == '_' || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
+
+func isEnvNameByte(b byte) bool {
+	return isEnvNameStartByte(b) || ('0' <= b && b <= '9')
+}
@@ -16,14 +16,18 @@ package bufgen
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
 	"github.com/bufbuild/buf/internal/pkg/app"
+	"github.com/bufbuild/buf/internal/pkg/app/appproto/appprotoexec"
 	"github.com/bufbuild/buf/internal/pkg/app/appproto/appprotoos"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 type generator struct {
@@ -57,7 +61,7 @@ func (g *generator) Generate(
 		container,
 		config,
 		image,
-		generateOptions.baseOutDirPath,
+		generateOptions,
 	)
 }
 
@@ -66,16 +70,18 @@ func (g *generator) generate(
 	container app.EnvStdioContainer,
 	config *Config,
 	image bufimage.Image,
-	baseOutDirPath string,
+	generateOptions *generateOptions,
 ) error {
+	if err := g.checkPluginVersions(ctx, container, config, generateOptions.pluginSearchDirPaths, generateOptions.strictPluginVersions); err != nil {
+		return err
+	}
 	// we keep this as a variable so we can cache it if we hit StrategyDirectory
 	var imagesByDir []bufimage.Image
 	var err error
-	for _, pluginConfig := range config.PluginConfigs {
-		out := pluginConfig.Out
-		if baseOutDirPath != "" && baseOutDirPath != "." {
-			out = filepath.Join(baseOutDirPath, out)
-		}
+	// the requests are computed before any plugin is executed, so that the
+	// digests of the inputs of every plugin are known for incremental generation
+	pluginRequests := make([][]*pluginpb.CodeGeneratorRequest, len(config.PluginConfigs))
+	for i, pluginConfig := range config.PluginConfigs {
 		var pluginImages []bufimage.Image
 		switch pluginConfig.Strategy {
 		case StrategyAll:
@@ -92,25 +98,312 @@ func (g *generator) generate(
 		default:
 			return fmt.Errorf("unknown strategy: %v", pluginConfig.Strategy)
 		}
-		if err := g.appprotoosGenerator.Generate(
-			ctx,
-			container,
-			pluginConfig.Name,
-			out,
-			bufimage.ImagesToCodeGeneratorRequests(pluginImages, pluginConfig.Opt),
+		pluginIncludeImports := generateOptions.includeImports
+		if pluginConfig.IncludeImports != nil {
+			pluginIncludeImports = *pluginConfig.IncludeImports
+		}
+		pluginIncludeWellKnownTypes := generateOptions.includeWellKnownTypes
+		if pluginConfig.IncludeWellKnownTypes != nil {
+			pluginIncludeWellKnownTypes = *pluginConfig.IncludeWellKnownTypes
+		}
+		pluginRequests[i] = bufimage.ImagesToCodeGeneratorRequests(
+			pluginImages,
+			pluginConfig.Opt,
+			pluginIncludeImports,
+			pluginIncludeWellKnownTypes,
+		)
+	}
+	if err := dumpRequests(config, pluginRequests, generateOptions.pluginNameToDumpRequestDirPath); err != nil {
+		return err
+	}
+	var state *incrementalState
+	var previousState *incrementalState
+	var unchangedPluginIndexes map[int]struct{}
+	if generateOptions.incrementalStateFilePath != "" {
+		state, err = newIncrementalState(
+			config,
+			pluginRequests,
+			generateOptions.baseOutDirPath,
+			generateOptions.includeImports,
+			generateOptions.includeWellKnownTypes,
+			generateOptions.pluginSearchDirPaths,
+			generateOptions.incrementalVersion,
+		)
+		if err != nil {
+			return err
+		}
+		if !generateOptions.incrementalForce {
+			previousState, err = readIncrementalState(generateOptions.incrementalStateFilePath)
+			if err != nil {
+				// the state is only an optimization, so we execute every plugin
+				// instead of failing
+				g.logger.Sugar().Warnf("%v, executing all plugins", err)
+			}
+			unchangedPluginIndexes = getUnchangedPluginIndexes(previousState, state)
+			if len(unchangedPluginIndexes) > 0 {
+				state.InsertionPoints = previousState.InsertionPoints
+			}
+		}
+	}
+	// nil if allowOverwrite is set
+	var tracker *outputFileTracker
+	if !generateOptions.allowOverwrite {
+		tracker = newOutputFileTracker()
+	}
+	// the paths of the written files are collected for the post_generate commands
+	var generatedFilePaths []string
+	outputFilePathFunc := generateOptions.outputFilePathFunc
+	if len(config.PostGenerateCommands) > 0 {
+		userOutputFilePathFunc := outputFilePathFunc
+		outputFilePathFunc = func(filePath string) {
+			generatedFilePaths = append(generatedFilePaths, filePath)
+			if userOutputFilePathFunc != nil {
+				userOutputFilePathFunc(filePath)
+			}
+		}
+	}
+	// only used if failFast is false
+	var pluginErrorMessages []string
+	var pluginResults []*pluginResult
+	for i, pluginConfig := range config.PluginConfigs {
+		if _, ok := unchangedPluginIndexes[i]; ok {
+			g.logger.Sugar().Debugf("plugin %s: inputs unchanged, skipping", pluginConfig.Name)
+			// the files of the plugin are unchanged, but can still conflict
+			// with the files of the plugins that are executed
+			outputFileDigests := previousState.Plugins[i].OutputFiles
+			if tracker != nil {
+				if err := tracker.add(i, pluginConfig.Name, outputFileDigests); err != nil {
+					return err
+				}
+			}
+			state.Plugins[i].OutputFiles = outputFileDigests
+			state.Plugins[i].OutputPaths = previousState.Plugins[i].OutputPaths
+			continue
+		}
+		out := pluginConfig.Out
+		if generateOptions.baseOutDirPath != "" && generateOptions.baseOutDirPath != "." {
+			out = filepath.Join(generateOptions.baseOutDirPath, out)
+		}
+		appprotoosGenerateOptions := []appprotoos.GenerateOption{
 			appprotoos.GenerateWithPluginPath(pluginConfig.Path),
+			appprotoos.GenerateWithPluginSearchDirPaths(generateOptions.pluginSearchDirPaths),
 			appprotoos.GenerateWithCreateOutDirIfNotExists(),
+		}
+		if outputFilePathFunc != nil {
+			appprotoosGenerateOptions = append(
+				appprotoosGenerateOptions,
+				appprotoos.GenerateWithOutputFilePathFunc(outputFilePathFunc),
+			)
+		}
+		files, err := g.execute(
+			ctx,
+			container,
+			pluginConfig,
+			pluginRequests[i],
+			appprotoosGenerateOptions,
+		)
+		if err != nil {
+			if generateOptions.failFast {
+				return fmt.Errorf("plugin %s: %v", pluginConfig.Name, err)
+			}
+			pluginErrorMessages = append(pluginErrorMessages, fmt.Sprintf("plugin %s: %v", pluginConfig.Name, err))
+			continue
+		}
+		outputFileDigests := getOutputFileDigests(out, files)
+		if tracker != nil {
+			// conflicts are always returned immediately, as they are errors
+			// in the template and not in the plugin
+			if err := tracker.add(i, pluginConfig.Name, outputFileDigests); err != nil {
+				return err
+			}
+		}
+		if state != nil {
+			state.Plugins[i].OutputFiles = outputFileDigests
+			state.Plugins[i].OutputPaths = getOutputPaths(out, files)
+			if hasInsertionPoint(files) {
+				state.InsertionPoints = true
+			}
+		}
+		if generateOptions.failFast {
+			if err := g.appprotoosGenerator.Write(
+				ctx,
+				out,
+				files,
+				appprotoosGenerateOptions...,
+			); err != nil {
+				return fmt.Errorf("plugin %s: %v", pluginConfig.Name, err)
+			}
+			continue
+		}
+		// without failFast, we execute every plugin before writing anything,
+		// so that nothing is written if any plugin fails
+		pluginResults = append(
+			pluginResults,
+			&pluginResult{
+				pluginName:                pluginConfig.Name,
+				out:                       out,
+				files:                     files,
+				appprotoosGenerateOptions: appprotoosGenerateOptions,
+			},
+		)
+	}
+	if len(pluginErrorMessages) > 0 {
+		return errors.New(strings.Join(pluginErrorMessages, "\n"))
+	}
+	// results are written in plugin order, so that insertion points can
+	// apply to files written by previous plugins
+	for _, pluginResult := range pluginResults {
+		if err := g.appprotoosGenerator.Write(
+			ctx,
+			pluginResult.out,
+			pluginResult.files,
+			pluginResult.appprotoosGenerateOptions...,
 		); err != nil {
-			return fmt.Errorf("plugin %s: %v", pluginConfig.Name, err)
+			return fmt.Errorf("plugin %s: %v", pluginResult.pluginName, err)
+		}
+	}
+	if state != nil {
+		if err := writeIncrementalState(generateOptions.incrementalStateFilePath, state); err != nil {
+			return err
 		}
 	}
+	return runPostGenerateCommands(
+		ctx,
+		container,
+		config.PostGenerateCommands,
+		generateOptions.baseOutDirPath,
+		generatedFilePaths,
+	)
+}
+
+// execute executes the plugin, applying the OutTemplate of the plugin if set.
+func (g *generator) execute(
+	ctx context.Context,
+	container app.EnvStdioContainer,
+	pluginConfig *PluginConfig,
+	requests []*pluginpb.CodeGeneratorRequest,
+	appprotoosGenerateOptions []appprotoos.GenerateOption,
+) ([]*pluginpb.CodeGeneratorResponse_File, error) {
+	if pluginConfig.OutTemplate == "" {
+		return g.appprotoosGenerator.Execute(
+			ctx,
+			container,
+			pluginConfig.Name,
+			requests,
+			appprotoosGenerateOptions...,
+		)
+	}
+	// each request is executed separately so that we know the request
+	// that every file was generated for
+	var files []*pluginpb.CodeGeneratorResponse_File
+	for _, request := range requests {
+		requestFiles, err := g.appprotoosGenerator.Execute(
+			ctx,
+			container,
+			pluginConfig.Name,
+			[]*pluginpb.CodeGeneratorRequest{request},
+			appprotoosGenerateOptions...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		requestFiles, err = applyOutTemplate(pluginConfig.OutTemplate, request, requestFiles)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, requestFiles...)
+	}
+	if err := validateOutTemplateFileNamesUnique(pluginConfig.OutTemplate, files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// checkPluginVersions checks that every plugin with a Version reports a matching
+// version, logging a warning for each mismatch unless strict is set.
+func (g *generator) checkPluginVersions(
+	ctx context.Context,
+	container app.EnvContainer,
+	config *Config,
+	pluginSearchDirPaths []string,
+	strict bool,
+) error {
+	for _, pluginConfig := range config.PluginConfigs {
+		if pluginConfig.Version == "" {
+			continue
+		}
+		var message string
+		actualVersion, err := appprotoexec.GetPluginVersion(
+			ctx,
+			container,
+			pluginConfig.Name,
+			appprotoexec.HandlerWithPluginPath(pluginConfig.Path),
+			appprotoexec.HandlerWithPluginSearchDirPaths(pluginSearchDirPaths),
+		)
+		if err != nil {
+			message = fmt.Sprintf("plugin %s: could not determine version, expected %s: %v", pluginConfig.Name, pluginConfig.Version, err)
+		} else if !pluginVersionMatches(pluginConfig.Version, actualVersion) {
+			message = fmt.Sprintf("plugin %s: expected version %s but plugin reported %q", pluginConfig.Name, pluginConfig.Version, actualVersion)
+		}
+		if message == "" {
+			continue
+		}
+		if strict {
+			return errors.New(message)
+		}
+		g.logger.Sugar().Warn(message)
+	}
 	return nil
 }
 
+// pluginVersionMatches returns true if the version reported by a plugin matches
+// the expected version.
+//
+// The last whitespace-separated field of the reported version is compared, so
+// that output such as "protoc-gen-go v1.28.0" is supported. A leading "v" is
+// ignored on both versions, and the expected version may omit trailing components,
+// so that "v1.28" matches "1.28.0" but not "1.280.0".
+func pluginVersionMatches(expectedVersion string, reportedVersion string) bool {
+	fields := strings.Fields(reportedVersion)
+	if len(fields) == 0 {
+		return false
+	}
+	expectedVersion = strings.TrimPrefix(expectedVersion, "v")
+	actualVersion := strings.TrimPrefix(fields[len(fields)-1], "v")
+	return actualVersion == expectedVersion ||
+		strings.HasPrefix(actualVersion, expectedVersion+".") ||
+		strings.HasPrefix(actualVersion, expectedVersion+"-")
+}
+
 type generateOptions struct {
-	baseOutDirPath string
+	baseOutDirPath        string
+	outputFilePathFunc    func(string)
+	includeImports        bool
+	includeWellKnownTypes bool
+	strictPluginVersions  bool
+	pluginSearchDirPaths  []string
+	failFast              bool
+
+	incrementalStateFilePath string
+	incrementalVersion       string
+	incrementalForce         bool
+
+	allowOverwrite bool
+
+	pluginNameToDumpRequestDirPath map[string]string
 }
 
 func newGenerateOptions() *generateOptions {
-	return &generateOptions{}
+	return &generateOptions{
+		failFast:                       true,
+		pluginNameToDumpRequestDirPath: make(map[string]string),
+	}
+}
+
+// pluginResult is the result of executing a plugin that has not been written yet.
+type pluginResult struct {
+	pluginName                string
+	out                       string
+	files                     []*pluginpb.CodeGeneratorResponse_File
+	appprotoosGenerateOptions []appprotoos.GenerateOption
 }
@@ -0,0 +1,309 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/bufbuild/buf/internal/pkg/app/appproto/appprotoexec"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// incrementalState is the state recorded by an incremental generation.
+type incrementalState struct {
+	// Version is the version of the generator that recorded the state.
+	Version string `json:"version,omitempty"`
+	// TemplateDigest is the digest of the template and the generation options
+	// that apply to every plugin.
+	TemplateDigest string `json:"template_digest,omitempty"`
+	// InsertionPoints is true if any plugin returned a file with an insertion
+	// point. Plugins are only skipped if every plugin is skipped in this case,
+	// as an insertion point would otherwise be applied again to the output of
+	// a skipped plugin.
+	InsertionPoints bool `json:"insertion_points,omitempty"`
+	// Plugins are the states of the plugins, in the order of the template.
+	Plugins []*incrementalStatePlugin `json:"plugins,omitempty"`
+}
+
+// incrementalStatePlugin is the state recorded for a single plugin.
+type incrementalStatePlugin struct {
+	Name string `json:"name,omitempty"`
+	// ConfigDigest is the digest of the PluginConfig.
+	ConfigDigest string `json:"config_digest,omitempty"`
+	// BinaryDigest is the digest of the path, size, and modification time of
+	// the binary executed for the plugin.
+	BinaryDigest string `json:"binary_digest,omitempty"`
+	// InputDigest is the digest of the CodeGeneratorRequests sent to the plugin.
+	InputDigest string `json:"input_digest,omitempty"`
+	// OutputFiles are the digests of the files generated by the plugin, so that
+	// conflicts with the files of a plugin that is skipped are detected.
+	OutputFiles []*outputFileDigest `json:"output_files,omitempty"`
+	// OutputPaths are the paths on disk written by the plugin, so that the
+	// plugin is executed if any of them was deleted.
+	//
+	// This is the archive for plugins with a .jar or .zip output.
+	OutputPaths []string `json:"output_paths,omitempty"`
+}
+
+// newIncrementalState returns a new incrementalState for the config and the
+// requests of every plugin, in the order of the config.
+func newIncrementalState(
+	config *Config,
+	pluginRequests [][]*pluginpb.CodeGeneratorRequest,
+	baseOutDirPath string,
+	includeImports bool,
+	includeWellKnownTypes bool,
+	pluginSearchDirPaths []string,
+	version string,
+) (*incrementalState, error) {
+	templateDigest, err := getTemplateDigest(config, baseOutDirPath, includeImports, includeWellKnownTypes)
+	if err != nil {
+		return nil, err
+	}
+	state := &incrementalState{
+		Version:        version,
+		TemplateDigest: templateDigest,
+	}
+	for i, pluginConfig := range config.PluginConfigs {
+		configDigest, err := getPluginConfigDigest(pluginConfig)
+		if err != nil {
+			return nil, err
+		}
+		binaryDigest, err := getPluginBinaryDigest(pluginConfig, pluginSearchDirPaths)
+		if err != nil {
+			return nil, err
+		}
+		inputDigest, err := getRequestsDigest(pluginRequests[i])
+		if err != nil {
+			return nil, err
+		}
+		state.Plugins = append(
+			state.Plugins,
+			&incrementalStatePlugin{
+				Name:         pluginConfig.Name,
+				ConfigDigest: configDigest,
+				BinaryDigest: binaryDigest,
+				InputDigest:  inputDigest,
+			},
+		)
+	}
+	return state, nil
+}
+
+// readIncrementalState reads the state at the given path.
+//
+// Returns nil if the file does not exist.
+func readIncrementalState(stateFilePath string) (*incrementalState, error) {
+	data, err := ioutil.ReadFile(stateFilePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	state := &incrementalState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("could not parse incremental state file %s: %v", stateFilePath, err)
+	}
+	return state, nil
+}
+
+// writeIncrementalState writes the state to the given path.
+func writeIncrementalState(stateFilePath string, state *incrementalState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(stateFilePath, append(data, '\n'), 0644)
+}
+
+// getTemplateDigest returns the digest of the config and the generation options
+// that apply to every plugin.
+//
+// Any change to the config results in a different digest, so that every plugin
+// is executed if the template is modified.
+func getTemplateDigest(
+	config *Config,
+	baseOutDirPath string,
+	includeImports bool,
+	includeWellKnownTypes bool,
+) (string, error) {
+	data, err := json.Marshal(
+		struct {
+			Config                *Config `json:"config,omitempty"`
+			BaseOutDirPath        string  `json:"base_out_dir_path,omitempty"`
+			IncludeImports        bool    `json:"include_imports,omitempty"`
+			IncludeWellKnownTypes bool    `json:"include_well_known_types,omitempty"`
+		}{
+			Config:                config,
+			BaseOutDirPath:        baseOutDirPath,
+			IncludeImports:        includeImports,
+			IncludeWellKnownTypes: includeWellKnownTypes,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return getDigest(data), nil
+}
+
+// getPluginConfigDigest returns the digest of the PluginConfig.
+func getPluginConfigDigest(pluginConfig *PluginConfig) (string, error) {
+	data, err := json.Marshal(pluginConfig)
+	if err != nil {
+		return "", err
+	}
+	return getDigest(data), nil
+}
+
+// getPluginBinaryDigest returns the digest of the path, size, and modification
+// time of the binary executed for the plugin, so that the plugin is executed if
+// it is upgraded or resolves to a different binary.
+//
+// Returns an empty digest if the binary cannot be found, in which case the
+// plugin is executed and fails with the error of the handler.
+func getPluginBinaryDigest(pluginConfig *PluginConfig, pluginSearchDirPaths []string) (string, error) {
+	binaryPath, err := appprotoexec.GetPluginBinaryPath(
+		pluginConfig.Name,
+		appprotoexec.HandlerWithPluginPath(pluginConfig.Path),
+		appprotoexec.HandlerWithPluginSearchDirPaths(pluginSearchDirPaths),
+	)
+	if err != nil {
+		return "", nil
+	}
+	fileInfo, err := os.Stat(binaryPath)
+	if err != nil {
+		return "", nil
+	}
+	data, err := json.Marshal(
+		struct {
+			Path    string `json:"path,omitempty"`
+			Size    int64  `json:"size,omitempty"`
+			ModTime int64  `json:"mod_time,omitempty"`
+		}{
+			Path:    binaryPath,
+			Size:    fileInfo.Size(),
+			ModTime: fileInfo.ModTime().UnixNano(),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return getDigest(data), nil
+}
+
+// getRequestsDigest returns the digest of the deterministic binary encoding
+// of the CodeGeneratorRequests.
+func getRequestsDigest(requests []*pluginpb.CodeGeneratorRequest) (string, error) {
+	marshaler := protoencoding.NewWireMarshaler()
+	hash := sha256.New()
+	for _, request := range requests {
+		data, err := marshaler.Marshal(request)
+		if err != nil {
+			return "", err
+		}
+		// the length is included so that the boundaries between requests
+		// are part of the digest
+		if _, err := fmt.Fprintf(hash, "%d\n", len(data)); err != nil {
+			return "", err
+		}
+		if _, err := hash.Write(data); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("sha256:%x", hash.Sum(nil)), nil
+}
+
+func getDigest(data []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+}
+
+// getUnchangedPluginIndexes returns the indexes of the plugins in the new state
+// whose config, binary, and input are the same as in the previous state, and
+// whose output paths recorded in the previous state still exist.
+//
+// Returns nil if the previous state is nil, was recorded by a different version
+// or for a different template, or if a plugin returned an insertion point and
+// not every plugin is unchanged.
+func getUnchangedPluginIndexes(previousState *incrementalState, state *incrementalState) map[int]struct{} {
+	if previousState == nil ||
+		previousState.Version != state.Version ||
+		previousState.TemplateDigest != state.TemplateDigest ||
+		len(previousState.Plugins) != len(state.Plugins) {
+		return nil
+	}
+	unchangedPluginIndexes := make(map[int]struct{})
+	for i, plugin := range state.Plugins {
+		previousPlugin := previousState.Plugins[i]
+		if previousPlugin.Name == plugin.Name &&
+			previousPlugin.ConfigDigest == plugin.ConfigDigest &&
+			previousPlugin.BinaryDigest == plugin.BinaryDigest &&
+			previousPlugin.InputDigest == plugin.InputDigest &&
+			outputPathsExist(previousPlugin.OutputPaths) {
+			unchangedPluginIndexes[i] = struct{}{}
+		}
+	}
+	if previousState.InsertionPoints && len(unchangedPluginIndexes) != len(state.Plugins) {
+		return nil
+	}
+	return unchangedPluginIndexes
+}
+
+// getOutputPaths returns the paths on disk written by a plugin with the given
+// output directory.
+//
+// Files for insertion points are ignored, as they are written to the files of
+// other plugins.
+func getOutputPaths(out string, files []*pluginpb.CodeGeneratorResponse_File) []string {
+	switch filepath.Ext(out) {
+	case ".jar", ".zip":
+		return []string{out}
+	}
+	var outputPaths []string
+	for _, file := range files {
+		if file.GetInsertionPoint() != "" {
+			continue
+		}
+		outputPaths = append(outputPaths, filepath.Join(out, normalpath.Unnormalize(file.GetName())))
+	}
+	return outputPaths
+}
+
+// outputPathsExist returns true if every path exists.
+func outputPathsExist(outputPaths []string) bool {
+	for _, outputPath := range outputPaths {
+		if _, err := os.Stat(outputPath); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func hasInsertionPoint(files []*pluginpb.CodeGeneratorResponse_File) bool {
+	for _, file := range files {
+		if file.GetInsertionPoint() != "" {
+			return true
+		}
+	}
+	return false
+}
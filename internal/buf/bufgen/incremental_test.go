@@ -0,0 +1,147 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestGetUnchangedPluginIndexes(t *testing.T) {
+	t.Parallel()
+	newState := func(version string, templateDigest string, insertionPoints bool, inputDigests ...string) *incrementalState {
+		state := &incrementalState{
+			Version:         version,
+			TemplateDigest:  templateDigest,
+			InsertionPoints: insertionPoints,
+		}
+		for _, inputDigest := range inputDigests {
+			state.Plugins = append(
+				state.Plugins,
+				&incrementalStatePlugin{
+					Name:         "go",
+					ConfigDigest: "config",
+					InputDigest:  inputDigest,
+				},
+			)
+		}
+		return state
+	}
+	state := newState("1.0.0", "template", false, "a", "b")
+	require.Nil(t, getUnchangedPluginIndexes(nil, state))
+	require.Equal(
+		t,
+		map[int]struct{}{0: {}, 1: {}},
+		getUnchangedPluginIndexes(newState("1.0.0", "template", false, "a", "b"), state),
+	)
+	require.Equal(
+		t,
+		map[int]struct{}{1: {}},
+		getUnchangedPluginIndexes(newState("1.0.0", "template", false, "c", "b"), state),
+	)
+	require.Nil(t, getUnchangedPluginIndexes(newState("1.0.1", "template", false, "a", "b"), state))
+	require.Nil(t, getUnchangedPluginIndexes(newState("1.0.0", "template2", false, "a", "b"), state))
+	require.Nil(t, getUnchangedPluginIndexes(newState("1.0.0", "template", false, "a"), state))
+	require.Nil(t, getUnchangedPluginIndexes(newState("1.0.0", "template", true, "c", "b"), state))
+	require.Equal(
+		t,
+		map[int]struct{}{0: {}, 1: {}},
+		getUnchangedPluginIndexes(newState("1.0.0", "template", true, "a", "b"), state),
+	)
+	changedBinaryState := newState("1.0.0", "template", false, "a", "b")
+	changedBinaryState.Plugins[0].BinaryDigest = "binary"
+	require.Equal(
+		t,
+		map[int]struct{}{1: {}},
+		getUnchangedPluginIndexes(changedBinaryState, state),
+	)
+	outputFilePath := filepath.Join(t.TempDir(), "a.pb.go")
+	require.NoError(t, ioutil.WriteFile(outputFilePath, []byte("package a\n"), 0600))
+	outputPathsState := newState("1.0.0", "template", false, "a", "b")
+	outputPathsState.Plugins[0].OutputPaths = []string{outputFilePath}
+	outputPathsState.Plugins[1].OutputPaths = []string{outputFilePath}
+	require.Equal(
+		t,
+		map[int]struct{}{0: {}, 1: {}},
+		getUnchangedPluginIndexes(outputPathsState, state),
+	)
+	outputPathsState.Plugins[1].OutputPaths = []string{outputFilePath, filepath.Join(filepath.Dir(outputFilePath), "b.pb.go")}
+	require.Equal(
+		t,
+		map[int]struct{}{0: {}},
+		getUnchangedPluginIndexes(outputPathsState, state),
+	)
+}
+
+func TestGetOutputPaths(t *testing.T) {
+	t.Parallel()
+	files := []*pluginpb.CodeGeneratorResponse_File{
+		{
+			Name: proto.String("a/a.pb.go"),
+		},
+		{
+			Name:           proto.String("a/a.pb.go"),
+			InsertionPoint: proto.String("imports"),
+		},
+		{
+			Name: proto.String("b.pb.go"),
+		},
+	}
+	require.Equal(
+		t,
+		[]string{
+			filepath.Join("gen", "go", "a", "a.pb.go"),
+			filepath.Join("gen", "go", "b.pb.go"),
+		},
+		getOutputPaths(filepath.Join("gen", "go"), files),
+	)
+	require.Equal(
+		t,
+		[]string{filepath.Join("gen", "java.jar")},
+		getOutputPaths(filepath.Join("gen", "java.jar"), files),
+	)
+}
+
+func TestGetPluginBinaryDigest(t *testing.T) {
+	t.Parallel()
+	tempDirPath := t.TempDir()
+	binaryPath := filepath.Join(tempDirPath, "protoc-gen-foo")
+	require.NoError(t, ioutil.WriteFile(binaryPath, []byte("#!/bin/sh\n"), 0755))
+	pluginConfig := &PluginConfig{Name: "foo"}
+
+	binaryDigest, err := getPluginBinaryDigest(pluginConfig, []string{tempDirPath})
+	require.NoError(t, err)
+	require.NotEmpty(t, binaryDigest)
+	sameBinaryDigest, err := getPluginBinaryDigest(pluginConfig, []string{tempDirPath})
+	require.NoError(t, err)
+	require.Equal(t, binaryDigest, sameBinaryDigest)
+
+	modTime := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(binaryPath, modTime, modTime))
+	changedBinaryDigest, err := getPluginBinaryDigest(pluginConfig, []string{tempDirPath})
+	require.NoError(t, err)
+	require.NotEqual(t, binaryDigest, changedBinaryDigest)
+
+	missingBinaryDigest, err := getPluginBinaryDigest(&PluginConfig{Name: "buf-missing"}, []string{tempDirPath})
+	require.NoError(t, err)
+	require.Empty(t, missingBinaryDigest)
+}
@@ -0,0 +1,154 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	outTemplatePackagePlaceholder = "package"
+	outTemplateDirPlaceholder     = "dir"
+	outTemplateNamePlaceholder    = "name"
+	outTemplateExtPlaceholder     = "ext"
+)
+
+var (
+	outTemplatePlaceholderRegexp = regexp.MustCompile(`\{([^{}]*)\}`)
+	// outTemplatePlaceholders are the sorted placeholders that can be used in an out_template.
+	outTemplatePlaceholders = []string{
+		outTemplateDirPlaceholder,
+		outTemplateExtPlaceholder,
+		outTemplateNamePlaceholder,
+		outTemplatePackagePlaceholder,
+	}
+)
+
+// validateOutTemplate validates that the out template only contains known placeholders.
+func validateOutTemplate(outTemplate string) error {
+	for _, match := range outTemplatePlaceholderRegexp.FindAllStringSubmatch(outTemplate, -1) {
+		if !isOutTemplatePlaceholder(match[1]) {
+			return fmt.Errorf("out_template %q contains unknown placeholder %q, must be one of %s", outTemplate, match[0], stringutil.SliceToString(outTemplatePlaceholders))
+		}
+	}
+	return nil
+}
+
+// applyOutTemplate returns copies of the files generated for the request with
+// their names computed from the out template.
+//
+// Files for insertion points are not renamed, as they refer to files generated
+// by previous plugins.
+func applyOutTemplate(
+	outTemplate string,
+	request *pluginpb.CodeGeneratorRequest,
+	files []*pluginpb.CodeGeneratorResponse_File,
+) ([]*pluginpb.CodeGeneratorResponse_File, error) {
+	var packagePath string
+	if strings.Contains(outTemplate, "{"+outTemplatePackagePlaceholder+"}") {
+		pkg, err := getRequestPackage(request)
+		if err != nil {
+			return nil, fmt.Errorf("out_template %q: %v", outTemplate, err)
+		}
+		packagePath = strings.ReplaceAll(pkg, ".", "/")
+	}
+	templatedFiles := make([]*pluginpb.CodeGeneratorResponse_File, len(files))
+	for i, file := range files {
+		if file.GetInsertionPoint() != "" {
+			templatedFiles[i] = file
+			continue
+		}
+		ext := normalpath.Ext(file.GetName())
+		name := strings.TrimSuffix(normalpath.Base(file.GetName()), ext)
+		templatedName := outTemplatePlaceholderRegexp.ReplaceAllStringFunc(
+			outTemplate,
+			func(placeholder string) string {
+				switch strings.Trim(placeholder, "{}") {
+				case outTemplatePackagePlaceholder:
+					return packagePath
+				case outTemplateDirPlaceholder:
+					return normalpath.Dir(file.GetName())
+				case outTemplateNamePlaceholder:
+					return name
+				case outTemplateExtPlaceholder:
+					return ext
+				default:
+					// validated by validateOutTemplate
+					return placeholder
+				}
+			},
+		)
+		templatedName, err := normalpath.NormalizeAndValidate(templatedName)
+		if err != nil {
+			return nil, fmt.Errorf("out_template %q for file %q: %v", outTemplate, file.GetName(), err)
+		}
+		templatedFiles[i] = &pluginpb.CodeGeneratorResponse_File{
+			Name:    &templatedName,
+			Content: file.Content,
+		}
+	}
+	return templatedFiles, nil
+}
+
+// validateOutTemplateFileNamesUnique validates that an out template did not
+// result in multiple files with the same name.
+func validateOutTemplateFileNamesUnique(outTemplate string, files []*pluginpb.CodeGeneratorResponse_File) error {
+	names := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		if file.GetInsertionPoint() != "" {
+			continue
+		}
+		if _, ok := names[file.GetName()]; ok {
+			return fmt.Errorf("out_template %q results in multiple files named %q", outTemplate, file.GetName())
+		}
+		names[file.GetName()] = struct{}{}
+	}
+	return nil
+}
+
+// getRequestPackage returns the package of the files to generate for the request.
+//
+// Returns error if the files to generate have multiple packages.
+func getRequestPackage(request *pluginpb.CodeGeneratorRequest) (string, error) {
+	fileToGenerate := stringutil.SliceToMap(request.GetFileToGenerate())
+	packages := make(map[string]struct{})
+	for _, protoFile := range request.GetProtoFile() {
+		if _, ok := fileToGenerate[protoFile.GetName()]; ok {
+			packages[protoFile.GetPackage()] = struct{}{}
+		}
+	}
+	if len(packages) > 1 {
+		return "", fmt.Errorf("{%s} requires all files to generate to have the same package, but found packages %s", outTemplatePackagePlaceholder, stringutil.SliceToString(stringutil.MapToSortedSlice(packages)))
+	}
+	for pkg := range packages {
+		return pkg, nil
+	}
+	return "", nil
+}
+
+func isOutTemplatePlaceholder(placeholder string) bool {
+	for _, outTemplatePlaceholder := range outTemplatePlaceholders {
+		if placeholder == outTemplatePlaceholder {
+			return true
+		}
+	}
+	return false
+}
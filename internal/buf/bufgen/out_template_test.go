@@ -0,0 +1,91 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestApplyOutTemplate(t *testing.T) {
+	t.Parallel()
+	request := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"a/v1/a.proto", "a/v1/b.proto"},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			{
+				Name:    proto.String("c/c.proto"),
+				Package: proto.String("c"),
+			},
+			{
+				Name:    proto.String("a/v1/a.proto"),
+				Package: proto.String("a.v1"),
+			},
+			{
+				Name:    proto.String("a/v1/b.proto"),
+				Package: proto.String("a.v1"),
+			},
+		},
+	}
+	files := []*pluginpb.CodeGeneratorResponse_File{
+		{
+			Name:    proto.String("out/a.pb.ext"),
+			Content: proto.String("a"),
+		},
+		{
+			Name:           proto.String("out/other.ext"),
+			InsertionPoint: proto.String("foo"),
+			Content:        proto.String("insert"),
+		},
+	}
+	templatedFiles, err := applyOutTemplate("gen/{package}/{dir}/{name}.api{ext}", request, files)
+	require.NoError(t, err)
+	require.Len(t, templatedFiles, 2)
+	require.Equal(t, "gen/a/v1/out/a.pb.api.ext", templatedFiles[0].GetName())
+	require.Equal(t, "a", templatedFiles[0].GetContent())
+	// insertion points are not renamed
+	require.Equal(t, "out/other.ext", templatedFiles[1].GetName())
+	// the given files are not modified
+	require.Equal(t, "out/a.pb.ext", files[0].GetName())
+
+	_, err = applyOutTemplate("../{name}{ext}", request, files)
+	require.Error(t, err)
+
+	request.FileToGenerate = append(request.FileToGenerate, "c/c.proto")
+	_, err = applyOutTemplate("{package}/{name}{ext}", request, files)
+	require.EqualError(t, err, `out_template "{package}/{name}{ext}": {package} requires all files to generate to have the same package, but found packages [a.v1,c]`)
+	// the package is only needed if the template uses it
+	_, err = applyOutTemplate("{name}{ext}", request, files)
+	require.NoError(t, err)
+}
+
+func TestValidateOutTemplateFileNamesUnique(t *testing.T) {
+	t.Parallel()
+	files := []*pluginpb.CodeGeneratorResponse_File{
+		{
+			Name: proto.String("a.ext"),
+		},
+		{
+			Name:           proto.String("a.ext"),
+			InsertionPoint: proto.String("foo"),
+		},
+	}
+	require.NoError(t, validateOutTemplateFileNamesUnique("{name}{ext}", files))
+	files = append(files, &pluginpb.CodeGeneratorResponse_File{Name: proto.String("a.ext")})
+	require.EqualError(t, validateOutTemplateFileNamesUnique("{name}{ext}", files), `out_template "{name}{ext}" results in multiple files named "a.ext"`)
+}
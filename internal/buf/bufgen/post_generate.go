@@ -0,0 +1,85 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/pkg/app"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+)
+
+// generatedFilesEnvKey is the environment variable that contains the
+// newline-separated absolute paths of the files written by the plugins.
+const generatedFilesEnvKey = "BUF_GENERATED_FILES"
+
+// runPostGenerateCommands runs the commands in order with the base output
+// directory as the working directory, stopping at the first failure.
+//
+// The output of the commands is written to stderr, as stdout may be used
+// for the manifest.
+func runPostGenerateCommands(
+	ctx context.Context,
+	container app.EnvStdioContainer,
+	commands []string,
+	baseOutDirPath string,
+	generatedFilePaths []string,
+) error {
+	if len(commands) == 0 {
+		return nil
+	}
+	if baseOutDirPath == "" {
+		baseOutDirPath = "."
+	}
+	absGeneratedFilePaths := make([]string, len(generatedFilePaths))
+	for i, generatedFilePath := range generatedFilePaths {
+		absGeneratedFilePath, err := filepath.Abs(generatedFilePath)
+		if err != nil {
+			return err
+		}
+		absGeneratedFilePaths[i] = absGeneratedFilePath
+	}
+	env := app.Environ(
+		app.NewEnvContainerWithOverrides(
+			container,
+			map[string]string{
+				generatedFilesEnvKey: strings.Join(stringutil.SliceToUniqueSortedSlice(absGeneratedFilePaths), "\n"),
+			},
+		),
+	)
+	for _, command := range commands {
+		cmd := newShellCommand(ctx, command)
+		cmd.Dir = baseOutDirPath
+		cmd.Env = env
+		cmd.Stdout = container.Stderr()
+		cmd.Stderr = container.Stderr()
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("post_generate command %q: %v", command, err)
+		}
+	}
+	return nil
+}
+
+func newShellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
@@ -132,6 +132,15 @@ func NewRepositoryPrinter(
 // RepositoryBranchPrinter is a repository branch printer.
 type RepositoryBranchPrinter interface {
 	PrintRepositoryBranches(ctx context.Context, repositoryBranches ...*registryv1alpha1.RepositoryBranch) error
+	// PrintRepositoryBranchesWithHeadCommits prints the branches along with the
+	// name of the latest commit on each branch.
+	//
+	// headCommitNames must be of the same length as repositoryBranches.
+	PrintRepositoryBranchesWithHeadCommits(
+		ctx context.Context,
+		repositoryBranches []*registryv1alpha1.RepositoryBranch,
+		headCommitNames []string,
+	) error
 }
 
 // NewRepositoryBranchPrinter returns a new RepositoryBranchPrinter.
@@ -146,6 +155,128 @@ func NewRepositoryBranchPrinter(writer io.Writer, format Format) (RepositoryBran
 	}
 }
 
+// RepositoryCommitPrinter is a repository commit printer.
+type RepositoryCommitPrinter interface {
+	PrintRepositoryCommits(ctx context.Context, repositoryCommits ...*registryv1alpha1.RepositoryCommit) error
+}
+
+// NewRepositoryCommitPrinter returns a new RepositoryCommitPrinter.
+func NewRepositoryCommitPrinter(writer io.Writer, format Format) (RepositoryCommitPrinter, error) {
+	switch format {
+	case FormatText:
+		return newRepositoryCommitPrinter(writer, false), nil
+	case FormatJSON:
+		return newRepositoryCommitPrinter(writer, true), nil
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+// RepositoryMirrorPrinter is a repository mirror printer.
+type RepositoryMirrorPrinter interface {
+	PrintRepositoryMirrors(ctx context.Context, repositoryMirrors ...*registryv1alpha1.RepositoryMirror) error
+}
+
+// NewRepositoryMirrorPrinter returns a new RepositoryMirrorPrinter.
+func NewRepositoryMirrorPrinter(writer io.Writer, format Format) (RepositoryMirrorPrinter, error) {
+	switch format {
+	case FormatText:
+		return newRepositoryMirrorPrinter(writer, false), nil
+	case FormatJSON:
+		return newRepositoryMirrorPrinter(writer, true), nil
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+// RepositoryStatsPrinter is a repository stats printer.
+type RepositoryStatsPrinter interface {
+	PrintRepositoryStats(ctx context.Context, repositoryStats ...*registryv1alpha1.RepositoryStats) error
+}
+
+// NewRepositoryStatsPrinter returns a new RepositoryStatsPrinter.
+func NewRepositoryStatsPrinter(writer io.Writer, format Format) (RepositoryStatsPrinter, error) {
+	switch format {
+	case FormatText:
+		return newRepositoryStatsPrinter(writer, false), nil
+	case FormatJSON:
+		return newRepositoryStatsPrinter(writer, true), nil
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+// RepositoryTagPrinter is a repository tag printer.
+type RepositoryTagPrinter interface {
+	PrintRepositoryTags(ctx context.Context, repositoryTags ...*registryv1alpha1.RepositoryTag) error
+}
+
+// NewRepositoryTagPrinter returns a new RepositoryTagPrinter.
+func NewRepositoryTagPrinter(writer io.Writer, format Format) (RepositoryTagPrinter, error) {
+	switch format {
+	case FormatText:
+		return newRepositoryTagPrinter(writer, false), nil
+	case FormatJSON:
+		return newRepositoryTagPrinter(writer, true), nil
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+// RepositoryTagHistoryEntryPrinter is a repository tag history entry printer.
+type RepositoryTagHistoryEntryPrinter interface {
+	PrintRepositoryTagHistoryEntries(ctx context.Context, repositoryTagHistoryEntries ...*registryv1alpha1.RepositoryTagHistoryEntry) error
+}
+
+// NewRepositoryTagHistoryEntryPrinter returns a new RepositoryTagHistoryEntryPrinter.
+func NewRepositoryTagHistoryEntryPrinter(writer io.Writer, format Format) (RepositoryTagHistoryEntryPrinter, error) {
+	switch format {
+	case FormatText:
+		return newRepositoryTagHistoryEntryPrinter(writer, false), nil
+	case FormatJSON:
+		return newRepositoryTagHistoryEntryPrinter(writer, true), nil
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+// TokenPrinter is a token printer.
+type TokenPrinter interface {
+	PrintTokens(ctx context.Context, tokens ...*registryv1alpha1.Token) error
+}
+
+// NewTokenPrinter returns a new TokenPrinter.
+func NewTokenPrinter(writer io.Writer, format Format) (TokenPrinter, error) {
+	switch format {
+	case FormatText:
+		return newTokenPrinter(writer, false), nil
+	case FormatJSON:
+		return newTokenPrinter(writer, true), nil
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+// ModuleDocumentationPrinter is a module documentation printer.
+type ModuleDocumentationPrinter interface {
+	PrintModuleDocumentation(ctx context.Context, moduleDocumentation *registryv1alpha1.ModuleDocumentation) error
+	PrintFileDocumentation(ctx context.Context, fileDocumentation *registryv1alpha1.FileDocumentation) error
+}
+
+// NewModuleDocumentationPrinter returns a new ModuleDocumentationPrinter.
+//
+// The text format is markdown.
+func NewModuleDocumentationPrinter(writer io.Writer, format Format) (ModuleDocumentationPrinter, error) {
+	switch format {
+	case FormatText:
+		return newModuleDocumentationPrinter(writer, false), nil
+	case FormatJSON:
+		return newModuleDocumentationPrinter(writer, true), nil
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
+
 // PrintProtoMessageJSON prints the Protobuf message as JSON.
 //
 // Shared with internal packages.
@@ -0,0 +1,150 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type moduleDocumentationPrinter struct {
+	writer io.Writer
+	asJSON bool
+}
+
+func newModuleDocumentationPrinter(
+	writer io.Writer,
+	asJSON bool,
+) *moduleDocumentationPrinter {
+	return &moduleDocumentationPrinter{
+		writer: writer,
+		asJSON: asJSON,
+	}
+}
+
+func (p *moduleDocumentationPrinter) PrintModuleDocumentation(
+	ctx context.Context,
+	message *registryv1alpha1.ModuleDocumentation,
+) error {
+	outputFileDocumentations := make([]outputFileDocumentation, len(message.FileDocumentations))
+	for i, fileDocumentation := range message.FileDocumentations {
+		outputFileDocumentations[i] = newOutputFileDocumentation(fileDocumentation)
+	}
+	outputModuleDocumentation := outputModuleDocumentation{
+		Commit: message.Commit,
+		Readme: message.Readme,
+		Files:  outputFileDocumentations,
+	}
+	if p.asJSON {
+		return json.NewEncoder(p.writer).Encode(outputModuleDocumentation)
+	}
+	if readme := strings.TrimSpace(outputModuleDocumentation.Readme); readme != "" {
+		if _, err := fmt.Fprintf(p.writer, "%s\n\n", readme); err != nil {
+			return err
+		}
+	}
+	for _, outputFileDocumentation := range outputModuleDocumentation.Files {
+		if err := p.printFileDocumentationText(outputFileDocumentation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *moduleDocumentationPrinter) PrintFileDocumentation(
+	ctx context.Context,
+	message *registryv1alpha1.FileDocumentation,
+) error {
+	outputFileDocumentation := newOutputFileDocumentation(message)
+	if p.asJSON {
+		return json.NewEncoder(p.writer).Encode(outputFileDocumentation)
+	}
+	return p.printFileDocumentationText(outputFileDocumentation)
+}
+
+func (p *moduleDocumentationPrinter) printFileDocumentationText(outputFileDocumentation outputFileDocumentation) error {
+	if _, err := fmt.Fprintf(p.writer, "# %s\n\n", outputFileDocumentation.Path); err != nil {
+		return err
+	}
+	if outputFileDocumentation.Package != "" {
+		if _, err := fmt.Fprintf(p.writer, "Package: `%s`\n\n", outputFileDocumentation.Package); err != nil {
+			return err
+		}
+	}
+	for _, outputSymbolDocumentation := range outputFileDocumentation.Symbols {
+		if _, err := fmt.Fprintf(
+			p.writer,
+			"## %s `%s`\n\n",
+			outputSymbolDocumentation.Kind,
+			outputSymbolDocumentation.FullName,
+		); err != nil {
+			return err
+		}
+		if comments := strings.TrimSpace(outputSymbolDocumentation.Comments); comments != "" {
+			if _, err := fmt.Fprintf(p.writer, "%s\n\n", comments); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func newOutputFileDocumentation(fileDocumentation *registryv1alpha1.FileDocumentation) outputFileDocumentation {
+	outputSymbolDocumentations := make([]outputSymbolDocumentation, len(fileDocumentation.SymbolDocumentations))
+	for i, symbolDocumentation := range fileDocumentation.SymbolDocumentations {
+		outputSymbolDocumentations[i] = outputSymbolDocumentation{
+			FullName: symbolDocumentation.FullName,
+			Kind:     symbolKindString(symbolDocumentation.Kind),
+			Comments: symbolDocumentation.Comments,
+		}
+	}
+	return outputFileDocumentation{
+		Path:    fileDocumentation.Path,
+		Package: fileDocumentation.Package,
+		Symbols: outputSymbolDocumentations,
+	}
+}
+
+// symbolKindString returns the kind in lowercase without the enum prefix, for example "enum value".
+func symbolKindString(symbolKind registryv1alpha1.SymbolKind) string {
+	return strings.ReplaceAll(
+		strings.ToLower(strings.TrimPrefix(symbolKind.String(), "SYMBOL_KIND_")),
+		"_",
+		" ",
+	)
+}
+
+type outputModuleDocumentation struct {
+	Commit string                    `json:"commit,omitempty"`
+	Readme string                    `json:"readme,omitempty"`
+	Files  []outputFileDocumentation `json:"files,omitempty"`
+}
+
+type outputFileDocumentation struct {
+	Path    string                      `json:"path,omitempty"`
+	Package string                      `json:"package,omitempty"`
+	Symbols []outputSymbolDocumentation `json:"symbols,omitempty"`
+}
+
+type outputSymbolDocumentation struct {
+	FullName string `json:"full_name,omitempty"`
+	Kind     string `json:"kind,omitempty"`
+	Comments string `json:"comments,omitempty"`
+}
@@ -48,10 +48,12 @@ func (p *organizationPrinter) PrintOrganizations(ctx context.Context, messages .
 	var outputOrganizations []outputOrganization
 	for _, organization := range messages {
 		outputOrganization := outputOrganization{
-			ID:         organization.Id,
-			Remote:     p.address,
-			Name:       organization.Name,
-			CreateTime: organization.CreateTime.AsTime(),
+			ID:          organization.Id,
+			Remote:      p.address,
+			Name:        organization.Name,
+			Description: organization.Description,
+			URL:         organization.Url,
+			CreateTime:  organization.CreateTime.AsTime(),
 		}
 		outputOrganizations = append(outputOrganizations, outputOrganization)
 	}
@@ -95,8 +97,10 @@ func (p *organizationPrinter) printOrganizationsText(outputOrganizations []outpu
 }
 
 type outputOrganization struct {
-	ID         string    `json:"id,omitempty"`
-	Remote     string    `json:"remote,omitempty"`
-	Name       string    `json:"name,omitempty"`
-	CreateTime time.Time `json:"create_time,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	Remote      string    `json:"remote,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	CreateTime  time.Time `json:"create_time,omitempty"`
 }
@@ -17,6 +17,7 @@ package bufprint
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"time"
 
@@ -39,22 +40,44 @@ func newRepositoryBranchPrinter(
 }
 
 func (p *repositoryBranchPrinter) PrintRepositoryBranches(ctx context.Context, messages ...*registryv1alpha1.RepositoryBranch) error {
+	return p.printRepositoryBranches(messages, nil)
+}
+
+func (p *repositoryBranchPrinter) PrintRepositoryBranchesWithHeadCommits(
+	ctx context.Context,
+	messages []*registryv1alpha1.RepositoryBranch,
+	headCommitNames []string,
+) error {
+	if len(messages) != len(headCommitNames) {
+		return fmt.Errorf("got %d head commits for %d branches", len(headCommitNames), len(messages))
+	}
+	return p.printRepositoryBranches(messages, headCommitNames)
+}
+
+// headCommitNames is nil if the head commits should not be printed.
+func (p *repositoryBranchPrinter) printRepositoryBranches(
+	messages []*registryv1alpha1.RepositoryBranch,
+	headCommitNames []string,
+) error {
 	if len(messages) == 0 {
 		return nil
 	}
 	var outputRepositoryBranches []outputRepositoryBranch
-	for _, repositoryBranch := range messages {
+	for i, repositoryBranch := range messages {
 		outputRepositoryBranch := outputRepositoryBranch{
 			ID:         repositoryBranch.Id,
 			Name:       repositoryBranch.Name,
 			CreateTime: repositoryBranch.CreateTime.AsTime(),
 		}
+		if headCommitNames != nil {
+			outputRepositoryBranch.HeadCommit = headCommitNames[i]
+		}
 		outputRepositoryBranches = append(outputRepositoryBranches, outputRepositoryBranch)
 	}
 	if p.asJSON {
 		return p.printRepositoryBranchesJSON(outputRepositoryBranches)
 	}
-	return p.printRepositoryBranchesText(outputRepositoryBranches)
+	return p.printRepositoryBranchesText(outputRepositoryBranches, headCommitNames != nil)
 }
 
 func (p *repositoryBranchPrinter) printRepositoryBranchesJSON(outputRepositoryBranches []outputRepositoryBranch) error {
@@ -67,21 +90,32 @@ func (p *repositoryBranchPrinter) printRepositoryBranchesJSON(outputRepositoryBr
 	return nil
 }
 
-func (p *repositoryBranchPrinter) printRepositoryBranchesText(outputRepositoryBranches []outputRepositoryBranch) error {
+func (p *repositoryBranchPrinter) printRepositoryBranchesText(
+	outputRepositoryBranches []outputRepositoryBranch,
+	withHeadCommits bool,
+) error {
+	header := []string{
+		"ID",
+		"Name",
+		"Created",
+	}
+	if withHeadCommits {
+		header = append(header, "Head Commit")
+	}
 	return WithTabWriter(
 		p.writer,
-		[]string{
-			"ID",
-			"Name",
-			"Created",
-		},
+		header,
 		func(tabWriter TabWriter) error {
 			for _, outputRepositoryBranch := range outputRepositoryBranches {
-				if err := tabWriter.Write(
+				values := []string{
 					outputRepositoryBranch.ID,
 					outputRepositoryBranch.Name,
 					outputRepositoryBranch.CreateTime.Format(time.RFC3339),
-				); err != nil {
+				}
+				if withHeadCommits {
+					values = append(values, outputRepositoryBranch.HeadCommit)
+				}
+				if err := tabWriter.Write(values...); err != nil {
 					return err
 				}
 			}
@@ -94,4 +128,5 @@ type outputRepositoryBranch struct {
 	ID         string    `json:"id,omitempty"`
 	Name       string    `json:"name,omitempty"`
 	CreateTime time.Time `json:"create_time,omitempty"`
+	HeadCommit string    `json:"head_commit,omitempty"`
 }
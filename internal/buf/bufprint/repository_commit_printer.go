@@ -0,0 +1,107 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type repositoryCommitPrinter struct {
+	writer io.Writer
+	asJSON bool
+}
+
+func newRepositoryCommitPrinter(
+	writer io.Writer,
+	asJSON bool,
+) *repositoryCommitPrinter {
+	return &repositoryCommitPrinter{
+		writer: writer,
+		asJSON: asJSON,
+	}
+}
+
+func (p *repositoryCommitPrinter) PrintRepositoryCommits(ctx context.Context, messages ...*registryv1alpha1.RepositoryCommit) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var outputRepositoryCommits []outputRepositoryCommit
+	for _, repositoryCommit := range messages {
+		outputRepositoryCommit := outputRepositoryCommit{
+			ID:         repositoryCommit.Id,
+			Name:       repositoryCommit.Name,
+			Digest:     repositoryCommit.Digest,
+			CreateTime: repositoryCommit.CreateTime.AsTime(),
+			Annotation: repositoryCommit.Annotation,
+		}
+		outputRepositoryCommits = append(outputRepositoryCommits, outputRepositoryCommit)
+	}
+	if p.asJSON {
+		return p.printRepositoryCommitsJSON(outputRepositoryCommits)
+	}
+	return p.printRepositoryCommitsText(outputRepositoryCommits)
+}
+
+func (p *repositoryCommitPrinter) printRepositoryCommitsJSON(outputRepositoryCommits []outputRepositoryCommit) error {
+	encoder := json.NewEncoder(p.writer)
+	for _, outputRepositoryCommit := range outputRepositoryCommits {
+		if err := encoder.Encode(outputRepositoryCommit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *repositoryCommitPrinter) printRepositoryCommitsText(outputRepositoryCommits []outputRepositoryCommit) error {
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"ID",
+			"Name",
+			"Digest",
+			"Created",
+			"Annotation",
+		},
+		func(tabWriter TabWriter) error {
+			for _, outputRepositoryCommit := range outputRepositoryCommits {
+				if err := tabWriter.Write(
+					outputRepositoryCommit.ID,
+					outputRepositoryCommit.Name,
+					outputRepositoryCommit.Digest,
+					outputRepositoryCommit.CreateTime.Format(time.RFC3339),
+					// only the first line of the annotation fits in the table
+					strings.SplitN(outputRepositoryCommit.Annotation, "\n", 2)[0],
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+type outputRepositoryCommit struct {
+	ID         string    `json:"id,omitempty"`
+	Name       string    `json:"name,omitempty"`
+	Digest     string    `json:"digest,omitempty"`
+	CreateTime time.Time `json:"create_time,omitempty"`
+	Annotation string    `json:"annotation,omitempty"`
+}
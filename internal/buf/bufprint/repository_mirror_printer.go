@@ -0,0 +1,120 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type repositoryMirrorPrinter struct {
+	writer io.Writer
+	asJSON bool
+}
+
+func newRepositoryMirrorPrinter(
+	writer io.Writer,
+	asJSON bool,
+) *repositoryMirrorPrinter {
+	return &repositoryMirrorPrinter{
+		writer: writer,
+		asJSON: asJSON,
+	}
+}
+
+func (p *repositoryMirrorPrinter) PrintRepositoryMirrors(ctx context.Context, messages ...*registryv1alpha1.RepositoryMirror) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var outputRepositoryMirrors []outputRepositoryMirror
+	for _, repositoryMirror := range messages {
+		outputRepositoryMirror := outputRepositoryMirror{
+			GitURL:            repositoryMirror.GitUrl,
+			GitBranch:         repositoryMirror.GitBranch,
+			Subdir:            repositoryMirror.Subdir,
+			LastSyncGitCommit: repositoryMirror.LastSyncGitCommit,
+			LastSyncError:     repositoryMirror.LastSyncError,
+		}
+		if repositoryMirror.LastSyncTime != nil {
+			lastSyncTime := repositoryMirror.LastSyncTime.AsTime()
+			outputRepositoryMirror.LastSyncTime = &lastSyncTime
+		}
+		outputRepositoryMirrors = append(outputRepositoryMirrors, outputRepositoryMirror)
+	}
+	if p.asJSON {
+		return p.printRepositoryMirrorsJSON(outputRepositoryMirrors)
+	}
+	return p.printRepositoryMirrorsText(outputRepositoryMirrors)
+}
+
+func (p *repositoryMirrorPrinter) printRepositoryMirrorsJSON(outputRepositoryMirrors []outputRepositoryMirror) error {
+	encoder := json.NewEncoder(p.writer)
+	for _, outputRepositoryMirror := range outputRepositoryMirrors {
+		if err := encoder.Encode(outputRepositoryMirror); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *repositoryMirrorPrinter) printRepositoryMirrorsText(outputRepositoryMirrors []outputRepositoryMirror) error {
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"Git URL",
+			"Branch",
+			"Subdir",
+			"Last Synced",
+			"Last Commit",
+			"Last Error",
+		},
+		func(tabWriter TabWriter) error {
+			for _, outputRepositoryMirror := range outputRepositoryMirrors {
+				subdir := outputRepositoryMirror.Subdir
+				if subdir == "" {
+					subdir = "."
+				}
+				lastSyncTime := "never"
+				if outputRepositoryMirror.LastSyncTime != nil {
+					lastSyncTime = outputRepositoryMirror.LastSyncTime.Format(time.RFC3339)
+				}
+				if err := tabWriter.Write(
+					outputRepositoryMirror.GitURL,
+					outputRepositoryMirror.GitBranch,
+					subdir,
+					lastSyncTime,
+					outputRepositoryMirror.LastSyncGitCommit,
+					outputRepositoryMirror.LastSyncError,
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+type outputRepositoryMirror struct {
+	GitURL            string     `json:"git_url,omitempty"`
+	GitBranch         string     `json:"git_branch,omitempty"`
+	Subdir            string     `json:"subdir,omitempty"`
+	LastSyncTime      *time.Time `json:"last_sync_time,omitempty"`
+	LastSyncGitCommit string     `json:"last_sync_git_commit,omitempty"`
+	LastSyncError     string     `json:"last_sync_error,omitempty"`
+}
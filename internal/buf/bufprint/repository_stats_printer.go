@@ -0,0 +1,114 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type repositoryStatsPrinter struct {
+	writer io.Writer
+	asJSON bool
+}
+
+func newRepositoryStatsPrinter(
+	writer io.Writer,
+	asJSON bool,
+) *repositoryStatsPrinter {
+	return &repositoryStatsPrinter{
+		writer: writer,
+		asJSON: asJSON,
+	}
+}
+
+func (p *repositoryStatsPrinter) PrintRepositoryStats(ctx context.Context, messages ...*registryv1alpha1.RepositoryStats) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var outputRepositoryStatsList []outputRepositoryStats
+	for _, repositoryStats := range messages {
+		outputRepositoryStats := outputRepositoryStats{
+			CommitCount: repositoryStats.CommitCount,
+			TagCount:    repositoryStats.TagCount,
+			BranchCount: repositoryStats.BranchCount,
+			SizeBytes:   repositoryStats.SizeBytes,
+		}
+		if repositoryStats.LastPushTime != nil {
+			lastPushTime := repositoryStats.LastPushTime.AsTime()
+			outputRepositoryStats.LastPushTime = &lastPushTime
+		}
+		outputRepositoryStatsList = append(outputRepositoryStatsList, outputRepositoryStats)
+	}
+	if p.asJSON {
+		return p.printRepositoryStatsJSON(outputRepositoryStatsList)
+	}
+	return p.printRepositoryStatsText(outputRepositoryStatsList)
+}
+
+func (p *repositoryStatsPrinter) printRepositoryStatsJSON(outputRepositoryStatsList []outputRepositoryStats) error {
+	encoder := json.NewEncoder(p.writer)
+	for _, outputRepositoryStats := range outputRepositoryStatsList {
+		if err := encoder.Encode(outputRepositoryStats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *repositoryStatsPrinter) printRepositoryStatsText(outputRepositoryStatsList []outputRepositoryStats) error {
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"Commits",
+			"Tags",
+			"Branches",
+			"Size (Bytes)",
+			"Last Push",
+		},
+		func(tabWriter TabWriter) error {
+			for _, outputRepositoryStats := range outputRepositoryStatsList {
+				lastPushTime := "never"
+				if outputRepositoryStats.LastPushTime != nil {
+					lastPushTime = outputRepositoryStats.LastPushTime.Format(time.RFC3339)
+				}
+				if err := tabWriter.Write(
+					strconv.FormatInt(outputRepositoryStats.CommitCount, 10),
+					strconv.FormatInt(outputRepositoryStats.TagCount, 10),
+					strconv.FormatInt(outputRepositoryStats.BranchCount, 10),
+					strconv.FormatInt(outputRepositoryStats.SizeBytes, 10),
+					lastPushTime,
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// the counts are not omitted if zero, as zero is a meaningful value for dashboards
+type outputRepositoryStats struct {
+	CommitCount  int64      `json:"commit_count"`
+	TagCount     int64      `json:"tag_count"`
+	BranchCount  int64      `json:"branch_count"`
+	SizeBytes    int64      `json:"size_bytes"`
+	LastPushTime *time.Time `json:"last_push_time,omitempty"`
+}
@@ -0,0 +1,93 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type repositoryTagHistoryEntryPrinter struct {
+	writer io.Writer
+	asJSON bool
+}
+
+func newRepositoryTagHistoryEntryPrinter(
+	writer io.Writer,
+	asJSON bool,
+) *repositoryTagHistoryEntryPrinter {
+	return &repositoryTagHistoryEntryPrinter{
+		writer: writer,
+		asJSON: asJSON,
+	}
+}
+
+func (p *repositoryTagHistoryEntryPrinter) PrintRepositoryTagHistoryEntries(ctx context.Context, messages ...*registryv1alpha1.RepositoryTagHistoryEntry) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var outputRepositoryTagHistoryEntries []outputRepositoryTagHistoryEntry
+	for _, repositoryTagHistoryEntry := range messages {
+		outputRepositoryTagHistoryEntry := outputRepositoryTagHistoryEntry{
+			CommitName: repositoryTagHistoryEntry.CommitName,
+			TagTime:    repositoryTagHistoryEntry.TagTime.AsTime(),
+		}
+		outputRepositoryTagHistoryEntries = append(outputRepositoryTagHistoryEntries, outputRepositoryTagHistoryEntry)
+	}
+	if p.asJSON {
+		return p.printRepositoryTagHistoryEntriesJSON(outputRepositoryTagHistoryEntries)
+	}
+	return p.printRepositoryTagHistoryEntriesText(outputRepositoryTagHistoryEntries)
+}
+
+func (p *repositoryTagHistoryEntryPrinter) printRepositoryTagHistoryEntriesJSON(outputRepositoryTagHistoryEntries []outputRepositoryTagHistoryEntry) error {
+	encoder := json.NewEncoder(p.writer)
+	for _, outputRepositoryTagHistoryEntry := range outputRepositoryTagHistoryEntries {
+		if err := encoder.Encode(outputRepositoryTagHistoryEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *repositoryTagHistoryEntryPrinter) printRepositoryTagHistoryEntriesText(outputRepositoryTagHistoryEntries []outputRepositoryTagHistoryEntry) error {
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"Commit",
+			"Tagged",
+		},
+		func(tabWriter TabWriter) error {
+			for _, outputRepositoryTagHistoryEntry := range outputRepositoryTagHistoryEntries {
+				if err := tabWriter.Write(
+					outputRepositoryTagHistoryEntry.CommitName,
+					outputRepositoryTagHistoryEntry.TagTime.Format(time.RFC3339),
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+type outputRepositoryTagHistoryEntry struct {
+	CommitName string    `json:"commit_name,omitempty"`
+	TagTime    time.Time `json:"tag_time,omitempty"`
+}
@@ -0,0 +1,101 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type tokenPrinter struct {
+	writer io.Writer
+	asJSON bool
+}
+
+func newTokenPrinter(
+	writer io.Writer,
+	asJSON bool,
+) *tokenPrinter {
+	return &tokenPrinter{
+		writer: writer,
+		asJSON: asJSON,
+	}
+}
+
+func (p *tokenPrinter) PrintTokens(ctx context.Context, messages ...*registryv1alpha1.Token) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var outputTokens []outputToken
+	for _, token := range messages {
+		outputToken := outputToken{
+			ID:         token.Id,
+			Note:       token.Note,
+			CreateTime: token.CreateTime.AsTime(),
+			ExpireTime: token.ExpireTime.AsTime(),
+		}
+		outputTokens = append(outputTokens, outputToken)
+	}
+	if p.asJSON {
+		return p.printTokensJSON(outputTokens)
+	}
+	return p.printTokensText(outputTokens)
+}
+
+func (p *tokenPrinter) printTokensJSON(outputTokens []outputToken) error {
+	encoder := json.NewEncoder(p.writer)
+	for _, outputToken := range outputTokens {
+		if err := encoder.Encode(outputToken); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *tokenPrinter) printTokensText(outputTokens []outputToken) error {
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"ID",
+			"Note",
+			"Created",
+			"Expires",
+		},
+		func(tabWriter TabWriter) error {
+			for _, outputToken := range outputTokens {
+				if err := tabWriter.Write(
+					outputToken.ID,
+					outputToken.Note,
+					outputToken.CreateTime.Format(time.RFC3339),
+					outputToken.ExpireTime.Format(time.RFC3339),
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+type outputToken struct {
+	ID         string    `json:"id,omitempty"`
+	Note       string    `json:"note,omitempty"`
+	CreateTime time.Time `json:"create_time,omitempty"`
+	ExpireTime time.Time `json:"expire_time,omitempty"`
+}
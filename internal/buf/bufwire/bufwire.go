@@ -43,6 +43,9 @@ type ImageConfigReader interface {
 	// GetImageConfig gets the ImageConfig for the fetch value.
 	//
 	// If externalDirOrFilePaths is empty, this builds all files under Buf control.
+	//
+	// If bufimagebuild.WithWarnings is passed as a build option, both an ImageConfig
+	// and warning FileAnnotations may be returned.
 	GetImageConfig(
 		ctx context.Context,
 		container app.EnvStdinContainer,
@@ -73,6 +76,7 @@ func NewImageConfigReader(
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 	moduleFileSetBuilder bufmodulebuild.ModuleFileSetBuilder,
 	imageBuilder bufimagebuild.Builder,
+	options ...ImageConfigReaderOption,
 ) ImageConfigReader {
 	return newImageConfigReader(
 		logger,
@@ -82,9 +86,21 @@ func NewImageConfigReader(
 		moduleBucketBuilder,
 		moduleFileSetBuilder,
 		imageBuilder,
+		options...,
 	)
 }
 
+// ImageConfigReaderOption is an option for a new ImageConfigReader.
+type ImageConfigReaderOption func(*imageConfigReader)
+
+// ImageConfigReaderWithBuildOptions returns a new ImageConfigReaderOption that
+// adds the given BuildOptions to every build.
+func ImageConfigReaderWithBuildOptions(buildOptions ...bufimagebuild.BuildOption) ImageConfigReaderOption {
+	return func(imageConfigReader *imageConfigReader) {
+		imageConfigReader.buildOptions = append(imageConfigReader.buildOptions, buildOptions...)
+	}
+}
+
 // ModuleConfig is an module and configuration.
 type ModuleConfig interface {
 	Module() bufmodule.Module
@@ -19,11 +19,13 @@ import (
 	"fmt"
 
 	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagebuild"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/internal/buf/buffetch"
 	"github.com/bufbuild/buf/internal/pkg/app"
+	"go.opencensus.io/trace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
@@ -65,6 +67,11 @@ func (e *fileLister) ListFiles(
 ) (_ []bufmodule.FileInfo, retErr error) {
 	switch t := ref.(type) {
 	case buffetch.ImageRef:
+		if t.ImageEncoding() == buffetch.ImageEncodingBin {
+			// we do not need the full image to list the files, so we read
+			// the image file-by-file to not hold the entire image in memory
+			return e.listImageFiles(ctx, container, t)
+		}
 		// if we have an image, list the files in the image
 		image, err := e.imageReader.GetImage(
 			ctx,
@@ -119,3 +126,20 @@ func (e *fileLister) ListFiles(
 		return nil, fmt.Errorf("invalid ref: %T", ref)
 	}
 }
+
+func (e *fileLister) listImageFiles(
+	ctx context.Context,
+	container app.EnvStdinContainer,
+	imageRef buffetch.ImageRef,
+) (_ []bufmodule.FileInfo, retErr error) {
+	ctx, span := trace.StartSpan(ctx, "list_image_files")
+	defer span.End()
+	readCloser, err := e.fetchReader.GetImageFile(ctx, container, imageRef)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readCloser.Close())
+	}()
+	return bufimage.NewFileInfosForProtoImageReader(readCloser)
+}
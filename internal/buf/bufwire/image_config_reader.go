@@ -40,6 +40,7 @@ type imageConfigReader struct {
 	imageBuilder         bufimagebuild.Builder
 	moduleConfigReader   *moduleConfigReader
 	imageReader          *imageReader
+	buildOptions         []bufimagebuild.BuildOption
 }
 
 func newImageConfigReader(
@@ -50,8 +51,9 @@ func newImageConfigReader(
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 	moduleFileSetBuilder bufmodulebuild.ModuleFileSetBuilder,
 	imageBuilder bufimagebuild.Builder,
+	options ...ImageConfigReaderOption,
 ) *imageConfigReader {
-	return &imageConfigReader{
+	imageConfigReader := &imageConfigReader{
 		logger:               logger.Named("bufwire"),
 		storageosProvider:    storageosProvider,
 		fetchReader:          fetchReader,
@@ -71,6 +73,10 @@ func newImageConfigReader(
 			fetchReader,
 		),
 	}
+	for _, option := range options {
+		option(imageConfigReader)
+	}
+	return imageConfigReader
 }
 
 func (i *imageConfigReader) GetImageConfig(
@@ -193,10 +199,13 @@ func (i *imageConfigReader) buildModule(
 	if err != nil {
 		return nil, nil, err
 	}
-	var options []bufimagebuild.BuildOption
+	options := append([]bufimagebuild.BuildOption{}, i.buildOptions...)
 	if excludeSourceCodeInfo {
 		options = append(options, bufimagebuild.WithExcludeSourceCodeInfo())
 	}
+	if config.Build != nil && config.Build.MaxImportDepth != 0 {
+		options = append(options, bufimagebuild.WithMaxImportDepth(config.Build.MaxImportDepth))
+	}
 	image, fileAnnotations, err := i.imageBuilder.Build(
 		ctx,
 		moduleFileSet,
@@ -205,8 +214,9 @@ func (i *imageConfigReader) buildModule(
 	if err != nil {
 		return nil, nil, err
 	}
-	if len(fileAnnotations) > 0 {
+	if image == nil {
 		return nil, fileAnnotations, nil
 	}
-	return newImageConfig(image, config), nil, nil
+	// if bufimagebuild.WithWarnings was used, warnings may be returned with the image
+	return newImageConfig(image, config), fileAnnotations, nil
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/bufbuild/buf/internal/buf/buffetch"
 	imagev1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/image/v1"
 	"github.com/bufbuild/buf/internal/pkg/app"
+	"github.com/bufbuild/buf/internal/pkg/mmap"
 	"github.com/bufbuild/buf/internal/pkg/protoencoding"
 	"go.opencensus.io/trace"
 	"go.uber.org/multierr"
@@ -61,9 +62,16 @@ func (i *imageReader) GetImage(
 	defer func() {
 		retErr = multierr.Append(retErr, readCloser.Close())
 	}()
-	data, err := ioutil.ReadAll(readCloser)
-	if err != nil {
-		return nil, err
+	var data []byte
+	if mmapFile, ok := readCloser.(mmap.File); ok {
+		// the data is only used for unmarshalling, which copies
+		// everything that is kept
+		data = mmapFile.Bytes()
+	} else {
+		data, err = ioutil.ReadAll(readCloser)
+		if err != nil {
+			return nil, err
+		}
 	}
 	protoImage := &imagev1.Image{}
 	switch imageEncoding := imageRef.ImageEncoding(); imageEncoding {
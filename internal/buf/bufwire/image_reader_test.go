@@ -0,0 +1,118 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufwire_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagebuild"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/buf/bufwire"
+	"github.com/bufbuild/buf/internal/buf/internal/buftesting"
+	"github.com/bufbuild/buf/internal/pkg/app"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var (
+	buftestingDirPath = filepath.Join(
+		"..",
+		"internal",
+		"buftesting",
+	)
+
+	// the image is built once, as benchmark functions are run multiple times
+	googleapisImageDataOnce sync.Once
+	googleapisImageData     []byte
+)
+
+func BenchmarkGetImageGoogleapis(b *testing.B) {
+	benchmarkGetImageGoogleapis(b, nil)
+}
+
+func BenchmarkGetImageGoogleapisMmap(b *testing.B) {
+	benchmarkGetImageGoogleapis(
+		b,
+		map[string]string{
+			buffetch.ImageMmapEnvKey: "true",
+		},
+	)
+}
+
+// benchmarkGetImageGoogleapis benchmarks reading and unmarshalling a binary
+// image of googleapis with source code info from a local file.
+func benchmarkGetImageGoogleapis(b *testing.B, env map[string]string) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	imageFilePath := filepath.Join(b.TempDir(), "image.bin")
+	require.NoError(b, ioutil.WriteFile(imageFilePath, getGoogleapisImageData(b), 0600))
+	imageRef, err := buffetch.NewImageRefParser(logger).GetImageRef(ctx, imageFilePath)
+	require.NoError(b, err)
+	imageReader := bufwire.NewImageReader(
+		logger,
+		bufcli.NewFetchImageReader(logger, storageos.NewProvider(storageos.ProviderWithSymlinks())),
+	)
+	container := app.NewContainer(env, nil, nil, nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		image, err := imageReader.GetImage(ctx, container, imageRef, nil, false, false)
+		require.NoError(b, err)
+		require.Equal(b, buftesting.NumGoogleapisFilesWithImports, len(image.Files()))
+	}
+}
+
+func getGoogleapisImageData(b *testing.B) []byte {
+	googleapisImageDataOnce.Do(
+		func() {
+			ctx := context.Background()
+			googleapisDirPath := buftesting.GetGoogleapisDirPath(b, buftestingDirPath)
+			readWriteBucket, err := storageos.NewProvider(storageos.ProviderWithSymlinks()).NewReadWriteBucket(
+				googleapisDirPath,
+				storageos.ReadWriteBucketWithSymlinksIfSupported(),
+			)
+			require.NoError(b, err)
+			config, err := bufmodulebuild.NewConfigV1Beta1(bufmodulebuild.ExternalConfigV1Beta1{})
+			require.NoError(b, err)
+			module, err := bufmodulebuild.NewModuleBucketBuilder(zap.NewNop()).BuildForBucket(ctx, readWriteBucket, config)
+			require.NoError(b, err)
+			moduleFileSet, err := bufmodulebuild.NewModuleFileSetBuilder(
+				zap.NewNop(),
+				bufmodule.NewNopModuleReader(),
+			).Build(ctx, module)
+			require.NoError(b, err)
+			image, fileAnnotations, err := bufimagebuild.NewBuilder(zap.NewNop()).Build(ctx, moduleFileSet)
+			require.NoError(b, err)
+			require.Empty(b, fileAnnotations)
+			googleapisImageData, err = protoencoding.NewWireMarshaler().Marshal(bufimage.ImageToProtoImage(image))
+			require.NoError(b, err)
+		},
+	)
+	if googleapisImageData == nil {
+		b.Fatal("could not build the googleapis image")
+	}
+	return googleapisImageData
+}
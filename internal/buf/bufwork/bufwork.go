@@ -0,0 +1,50 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufwork reads workspace configurations.
+//
+// A workspace is a directory with a buf.work.yaml file that lists the
+// directories of the workspace, each of which is an input on its own.
+package bufwork
+
+// ExternalConfigV1Beta1FilePath is the default external configuration file path for v1beta1.
+const ExternalConfigV1Beta1FilePath = "buf.work.yaml"
+
+// Config is a workspace configuration.
+type Config struct {
+	// Directories are the directories of the workspace, relative to the
+	// directory of the configuration file.
+	//
+	// The directories are normalized and validated, unique, do not contain
+	// each other, and are in the order of the configuration file.
+	Directories []string
+}
+
+// ReadConfig reads the workspace configuration file in the directory.
+//
+// An error that fulfills errors.Is(err, os.ErrNotExist) is returned if the
+// directory does not contain a configuration file.
+func ReadConfig(dirPath string) (*Config, error) {
+	return readConfig(dirPath)
+}
+
+// ExternalConfigV1Beta1 is an external workspace configuration.
+type ExternalConfigV1Beta1 struct {
+	Version     string   `json:"version,omitempty" yaml:"version,omitempty"`
+	Directories []string `json:"directories,omitempty" yaml:"directories,omitempty"`
+}
+
+type externalConfigVersion struct {
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
@@ -0,0 +1,115 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufwork
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfig(t *testing.T) {
+	t.Parallel()
+	config, err := testReadConfig(
+		t,
+		`version: v1beta1
+directories:
+  - proto/b
+  - ./proto/a/
+`,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"proto/b", "proto/a"}, config.Directories)
+}
+
+func TestReadConfigNotExist(t *testing.T) {
+	t.Parallel()
+	_, err := ReadConfig(t.TempDir())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestReadConfigError(t *testing.T) {
+	t.Parallel()
+	testReadConfigError(
+		t,
+		`directories:
+  - proto`,
+		`has no version set. Please add "version: v1beta1"`,
+	)
+	testReadConfigError(
+		t,
+		`version: v1beta1`,
+		"no directories set",
+	)
+	testReadConfigError(
+		t,
+		`version: v1beta1
+directories:
+  - proto
+foo: bar`,
+		"field foo not found",
+	)
+	testReadConfigError(
+		t,
+		`version: v1beta1
+directories:
+  - .`,
+		`directory "." is the directory of the workspace, which is not allowed`,
+	)
+	testReadConfigError(
+		t,
+		`version: v1beta1
+directories:
+  - ../proto`,
+		`directory "../proto"`,
+	)
+	testReadConfigError(
+		t,
+		`version: v1beta1
+directories:
+  - proto
+  - proto/`,
+		`duplicate directory "proto"`,
+	)
+	testReadConfigError(
+		t,
+		`version: v1beta1
+directories:
+  - proto/a
+  - proto`,
+		`directory "proto/a" is within directory "proto" which is not allowed`,
+	)
+}
+
+func testReadConfig(t *testing.T, data string) (*Config, error) {
+	dirPath := t.TempDir()
+	require.NoError(
+		t,
+		ioutil.WriteFile(filepath.Join(dirPath, ExternalConfigV1Beta1FilePath), []byte(data), 0600),
+	)
+	return ReadConfig(dirPath)
+}
+
+func testReadConfigError(t *testing.T, data string, expectedErrorSubstring string) {
+	_, err := testReadConfig(t, data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), expectedErrorSubstring)
+}
@@ -0,0 +1,83 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufwork
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/bufbuild/buf/internal/pkg/encoding"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+)
+
+const v1beta1Version = "v1beta1"
+
+func readConfig(dirPath string) (*Config, error) {
+	filePath := filepath.Join(dirPath, ExternalConfigV1Beta1FilePath)
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		// the error is returned as is so that callers can check for os.ErrNotExist
+		return nil, err
+	}
+	var externalConfigVersion externalConfigVersion
+	if err := encoding.UnmarshalYAMLNonStrict(data, &externalConfigVersion); err != nil {
+		return nil, fmt.Errorf("%s: %v", filePath, err)
+	}
+	switch externalConfigVersion.Version {
+	case v1beta1Version:
+	default:
+		return nil, fmt.Errorf(`%s has no version set. Please add "version: %s"`, filePath, v1beta1Version)
+	}
+	var externalConfig ExternalConfigV1Beta1
+	if err := encoding.UnmarshalYAMLStrict(data, &externalConfig); err != nil {
+		return nil, fmt.Errorf("%s: %v", filePath, err)
+	}
+	return newConfigV1Beta1(externalConfig, filePath)
+}
+
+func newConfigV1Beta1(externalConfig ExternalConfigV1Beta1, id string) (*Config, error) {
+	if len(externalConfig.Directories) == 0 {
+		return nil, fmt.Errorf("%s: no directories set", id)
+	}
+	directories := make([]string, len(externalConfig.Directories))
+	for i, directory := range externalConfig.Directories {
+		if directory == "" {
+			return nil, fmt.Errorf("%s: directories contained an empty path", id)
+		}
+		normalizedDirectory, err := normalpath.NormalizeAndValidate(directory)
+		if err != nil {
+			return nil, fmt.Errorf("%s: directory %q: %v", id, directory, err)
+		}
+		if normalizedDirectory == "." {
+			return nil, fmt.Errorf("%s: directory %q is the directory of the workspace, which is not allowed", id, directory)
+		}
+		for _, previousDirectory := range directories[:i] {
+			if previousDirectory == normalizedDirectory {
+				return nil, fmt.Errorf("%s: duplicate directory %q", id, normalizedDirectory)
+			}
+			if normalpath.EqualsOrContainsPath(previousDirectory, normalizedDirectory, normalpath.Relative) {
+				return nil, fmt.Errorf("%s: directory %q is within directory %q which is not allowed", id, normalizedDirectory, previousDirectory)
+			}
+			if normalpath.EqualsOrContainsPath(normalizedDirectory, previousDirectory, normalpath.Relative) {
+				return nil, fmt.Errorf("%s: directory %q is within directory %q which is not allowed", id, previousDirectory, normalizedDirectory)
+			}
+		}
+		directories[i] = normalizedDirectory
+	}
+	return &Config{
+		Directories: directories,
+	}, nil
+}
@@ -19,24 +19,55 @@ import (
 	"time"
 
 	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/image/imageinspect"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/image/imagepruneimports"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/mod/cache/cacheprune"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/mod/modexport"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/mod/modinit"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/mod/modupdate"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/mod/modverify"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/push"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/branch/branchcreate"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/branch/branchlist"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/call"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/commit/commitannotate"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/commit/commitdiff"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/commit/commitdownload"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/commit/commitget"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/commit/commitpin"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/commit/commitverifydigest"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/docs"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/login"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/logout"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/organization/organizationcreate"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/organization/organizationdelete"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/organization/organizationget"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/organization/organizationlist"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/organization/organizationupdate"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorycommitssince"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorycreate"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorydelete"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositoryget"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorylist"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorylistbranches"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorymirrorset"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorymirrorstatus"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorysetdefaultbranch"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorystats"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/repository/repositorytransfer"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/search"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/tag/tagcreate"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/tag/tagdelete"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/tag/taghistory"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/tag/taglist"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/tag/tagmove"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/beta/registry/token/tokenlist"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/breaking"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/build"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/config/configlsbreakingrules"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/config/configlslintrules"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/convert"
+	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/export"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/generate"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/lint"
 	"github.com/bufbuild/buf/internal/buf/cmd/buf/command/lsfiles"
@@ -105,6 +136,7 @@ func NewRootCommand(
 	builder := appflag.NewBuilder(
 		name,
 		appflag.BuilderWithTimeout(120*time.Second),
+		appflag.BuilderWithTimeoutProvider(bufcli.GetTimeout),
 		appflag.BuilderWithTracing(),
 	)
 	moduleResolverReaderProvider := bufcli.NewRegistryModuleResolverReaderProvider()
@@ -143,6 +175,7 @@ func NewRootCommand(
 			generate.NewCommand("generate", builder, moduleResolverReaderProvider),
 			protoc.NewCommand("protoc", builder, moduleResolverReaderProvider),
 			lsfiles.NewCommand("ls-files", builder, moduleResolverReaderProvider),
+			export.NewCommand("export", builder, moduleResolverReaderProvider),
 			{
 				Use:   "config",
 				Short: "Interact with the configuration of Buf.",
@@ -165,10 +198,8 @@ func NewRootCommand(
 						},
 					},
 					{
-						Use:        "image",
-						Short:      "Work with Images and FileDescriptorSets.",
-						Deprecated: imageDeprecationMessage,
-						Hidden:     true,
+						Use:   "image",
+						Short: "Work with Images and FileDescriptorSets.",
 						SubCommands: []*appcmd.Command{
 							convert.NewCommand(
 								"convert",
@@ -176,6 +207,8 @@ func NewRootCommand(
 								imageDeprecationMessage,
 								true,
 							),
+							imageinspect.NewCommand("inspect", builder, moduleResolverReaderProvider),
+							imagepruneimports.NewCommand("prune-imports", builder),
 						},
 					},
 					push.NewCommand("push", builder, moduleResolverReaderProvider),
@@ -186,6 +219,14 @@ func NewRootCommand(
 							modinit.NewCommand("init", builder, "", false),
 							modupdate.NewCommand("update", builder, moduleResolverReaderProvider),
 							modexport.NewCommand("export", builder, moduleResolverReaderProvider),
+							modverify.NewCommand("verify", builder),
+							{
+								Use:   "cache",
+								Short: "Manage the local module cache.",
+								SubCommands: []*appcmd.Command{
+									cacheprune.NewCommand("prune", builder),
+								},
+							},
 						},
 					},
 					{
@@ -198,6 +239,8 @@ func NewRootCommand(
 								SubCommands: []*appcmd.Command{
 									organizationcreate.NewCommand("create", builder),
 									organizationget.NewCommand("get", builder),
+									organizationlist.NewCommand("list", builder),
+									organizationupdate.NewCommand("update", builder),
 									organizationdelete.NewCommand("delete", builder),
 								},
 							},
@@ -208,7 +251,32 @@ func NewRootCommand(
 									repositorycreate.NewCommand("create", builder),
 									repositoryget.NewCommand("get", builder),
 									repositorylist.NewCommand("list", builder),
+									repositorylistbranches.NewCommand("list-branches", builder),
 									repositorydelete.NewCommand("delete", builder),
+									repositorycommitssince.NewCommand("commits-since", builder),
+									repositorysetdefaultbranch.NewCommand("set-default-branch", builder),
+									repositorytransfer.NewCommand("transfer", builder),
+									repositorystats.NewCommand("stats", builder),
+									{
+										Use:   "mirror",
+										Short: "Repository mirror commands.",
+										SubCommands: []*appcmd.Command{
+											repositorymirrorset.NewCommand("set", builder),
+											repositorymirrorstatus.NewCommand("status", builder),
+										},
+									},
+								},
+							},
+							{
+								Use:   "commit",
+								Short: "Repository commit commands.",
+								SubCommands: []*appcmd.Command{
+									commitpin.NewCommand("pin", builder),
+									commitdiff.NewCommand("diff", builder, moduleResolverReaderProvider),
+									commitdownload.NewCommand("download", builder),
+									commitverifydigest.NewCommand("verify-digest", builder),
+									commitget.NewCommand("get", builder),
+									commitannotate.NewCommand("annotate", builder),
 								},
 							},
 							{
@@ -219,6 +287,29 @@ func NewRootCommand(
 									branchlist.NewCommand("list", builder),
 								},
 							},
+							{
+								Use:   "tag",
+								Short: "Repository tag commands.",
+								SubCommands: []*appcmd.Command{
+									tagcreate.NewCommand("create", builder),
+									taglist.NewCommand("list", builder),
+									tagdelete.NewCommand("delete", builder),
+									tagmove.NewCommand("move", builder),
+									taghistory.NewCommand("history", builder),
+								},
+							},
+							{
+								Use:   "token",
+								Short: "Token commands.",
+								SubCommands: []*appcmd.Command{
+									tokenlist.NewCommand("list", builder),
+								},
+							},
+							docs.NewCommand("docs", builder),
+							call.NewCommand("call", builder),
+							login.NewCommand("login", builder),
+							logout.NewCommand("logout", builder),
+							search.NewCommand("search", builder),
 						},
 					},
 				},
@@ -232,10 +323,8 @@ See https://docs.buf.build/faq for more details.`,
 				Hidden: true,
 				SubCommands: []*appcmd.Command{
 					{
-						Use:        "image",
-						Short:      "Work with Images and FileDescriptorSets.",
-						Deprecated: imageDeprecationMessage,
-						Hidden:     true,
+						Use:   "image",
+						Short: "Work with Images and FileDescriptorSets.",
 						SubCommands: []*appcmd.Command{
 							convert.NewCommand(
 								"convert",
@@ -243,6 +332,7 @@ See https://docs.buf.build/faq for more details.`,
 								imageDeprecationMessage,
 								true,
 							),
+							imagepruneimports.NewCommand("prune-imports", builder),
 						},
 					},
 				},
@@ -16,6 +16,7 @@ package buf
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -24,9 +25,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/bufbuild/buf/internal/buf/bufcli"
 	"github.com/bufbuild/buf/internal/buf/bufconfig"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd/appcmdtesting"
+	"github.com/bufbuild/buf/internal/pkg/prototesting"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -37,6 +40,34 @@ func TestSuccess1(t *testing.T) {
 	testRunStdout(t, nil, 0, ``, "build", filepath.Join("testdata", "success"))
 }
 
+func TestSuccessInvalidUserConfig(t *testing.T) {
+	t.Parallel()
+	// commands that do not use the registry only warn about an invalid user configuration
+	// when reading the timeout
+	stderr := bytes.NewBuffer(nil)
+	appcmdtesting.RunCommandExitCode(
+		t,
+		func(use string) *appcmd.Command { return testNewRootCommand(use) },
+		0,
+		func(use string) map[string]string {
+			return map[string]string{
+				useEnvVar(use, "CONFIG_DIR"): filepath.Join("testdata", "config_invalid"),
+				useEnvVar(use, "CACHE_DIR"):  "cache",
+			}
+		},
+		nil,
+		nil,
+		stderr,
+		"protoc",
+		"-I",
+		filepath.Join("testdata", "success"),
+		filepath.Join("testdata", "success", "buf", "buf.proto"),
+		"-o",
+		filepath.Join(t.TempDir(), "image.bin"),
+	)
+	assert.Contains(t, stderr.String(), "using the default timeout, failed to read the user configuration")
+}
+
 func TestSuccess2(t *testing.T) {
 	t.Parallel()
 	testRunStdout(t, nil, 0, ``, "build", "--exclude-imports", "--source", filepath.Join("testdata", "success"))
@@ -172,7 +203,7 @@ func TestFail5(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".
         testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
@@ -182,7 +213,7 @@ func TestFail5(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".
         testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
@@ -190,12 +221,419 @@ func TestFail5(t *testing.T) {
 	)
 }
 
-func TestFail6(t *testing.T) {
+func TestLintJSONAbsolutePath(t *testing.T) {
+	t.Parallel()
+	absolutePath, err := filepath.Abs(filepath.Join("testdata", "fail", "buf", "buf.proto"))
+	require.NoError(t, err)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		fmt.Sprintf(
+			`{"path":"testdata/fail/buf/buf.proto","start_line":3,"start_column":1,"end_line":3,"end_column":15,"type":"PACKAGE_DIRECTORY_MATCH","message":"Files with package \"other\" must be within a directory \"other\" relative to root but were in directory \"buf\".","absolute_path":%q}
+        {"path":"testdata/fail/buf/buf.proto","start_line":6,"start_column":9,"end_line":6,"end_column":15,"type":"FIELD_LOWER_SNAKE_CASE","message":"Field name \"oneTwo\" should be lower_snake_case, such as \"one_two\".","absolute_path":%q}`,
+			absolutePath,
+			absolutePath,
+		),
+		"lint",
+		filepath.Join("testdata", "fail"),
+		"--error-format",
+		"json",
+	)
+}
+
+func TestFailExitCode(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		3,
+		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".
+        testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
+		"lint",
+		"--exit-code",
+		"3",
+		filepath.Join("testdata", "fail"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"lint",
+		"--exit-code",
+		"0",
+		filepath.Join("testdata", "fail"),
+	)
+}
+
+func TestFailOnlyAndIgnore(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		`testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
+		"lint",
+		"--only",
+		"FIELD_LOWER_SNAKE_CASE",
+		filepath.Join("testdata", "fail"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".`,
+		"lint",
+		"--ignore",
+		"FIELD_LOWER_SNAKE_CASE",
+		filepath.Join("testdata", "fail"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"lint",
+		"--only",
+		"FIELD_LOWER_SNAKE_CASE",
+		"--ignore",
+		"FIELD_LOWER_SNAKE_CASE",
+		filepath.Join("testdata", "fail"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"lint",
+		"--only",
+		"FIELD_LOWER_SNAKE_CAS",
+		filepath.Join("testdata", "fail"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"lint",
+		"--only",
+		"COMMENT_MESSAGE",
+		filepath.Join("testdata", "fail"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"breaking",
+		"--ignore",
+		"FILE_NO_DELET",
+		"--against",
+		filepath.Join("testdata", "fail"),
+		filepath.Join("testdata", "fail"),
+	)
+}
+
+func TestLintFix(t *testing.T) {
+	t.Parallel()
+	tempDirPath := testCopyLintFixInput(t)
+	protoFilePath := filepath.Join(tempDirPath, "buf", "buf.proto")
+	testRunStderr(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		fmt.Sprintf(
+			`--- %s.orig
+			+++ %s
+			@@ -3,5 +3,5 @@
+			 package other;
+
+			 message Foo {
+			-  int64 oneTwo = 1;
+			+  int64 one_two = 1;
+			 }`,
+			filepath.ToSlash(protoFilePath),
+			filepath.ToSlash(protoFilePath),
+		),
+		"lint",
+		tempDirPath,
+		"--fix",
+	)
+	data, err := ioutil.ReadFile(protoFilePath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "int64 one_two = 1;")
+	testRun(
+		t,
+		1,
+		nil,
+		nil,
+		"lint",
+		filepath.Join(tempDirPath, "image.bin"),
+		"--fix",
+	)
+}
+
+func TestLintFixPath(t *testing.T) {
+	t.Parallel()
+	tempDirPath := testCopyLintFixInput(t)
+	protoFilePath := filepath.Join(tempDirPath, "buf", "buf.proto")
+	// ref.proto is outside of the path, but has the same package and uses the
+	// name, so the name is not fixed
+	require.NoError(
+		t,
+		ioutil.WriteFile(
+			filepath.Join(tempDirPath, "buf", "ref.proto"),
+			[]byte(`syntax = "proto3";
+
+package other;
+
+message Bar {
+  int64 oneTwo = 1;
+}
+`),
+			0644,
+		),
+	)
+	testRunStderr(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		``,
+		"lint",
+		tempDirPath,
+		"--path",
+		protoFilePath,
+		"--fix",
+	)
+	data, err := ioutil.ReadFile(protoFilePath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "int64 oneTwo = 1;")
+}
+
+func TestLintFixJSON(t *testing.T) {
+	t.Parallel()
+	tempDirPath := testCopyLintFixInput(t)
+	protoFilePath := filepath.Join(tempDirPath, "buf", "buf.proto")
+	// the diff is printed to stderr so that stdout only contains the json
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		fmt.Sprintf(
+			`{"path":%q,"start_line":3,"start_column":1,"end_line":3,"end_column":15,"type":"PACKAGE_DIRECTORY_MATCH","message":"Files with package \"other\" must be within a directory \"other\" relative to root but were in directory \"buf\".","absolute_path":%q}`,
+			protoFilePath,
+			protoFilePath,
+		),
+		"lint",
+		tempDirPath,
+		"--fix",
+		"--error-format",
+		"json",
+	)
+	data, err := ioutil.ReadFile(protoFilePath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "int64 one_two = 1;")
+}
+
+func TestLintDisableDefaultIgnores(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"lint",
+		filepath.Join("testdata", "lintimports"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		`google/protobuf/empty.proto:33:1:Package name "google.protobuf" should be suffixed with a correctly formed version, such as "google.protobuf.v1".`,
+		"lint",
+		filepath.Join("testdata", "lintimports"),
+		"--disable-default-ignores",
+	)
+}
+
+func TestLintDirectorySamePackage(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		`testdata/directorysamepackage/a/v1/a.proto:3:1:Multiple packages "a.v1,a.vl" detected within directory "a/v1": "a.v1" in a/v1/a.proto, a/v1/b.proto; "a.vl" in a/v1/c.proto.
+        testdata/directorysamepackage/a/v1/b.proto:3:1:Multiple packages "a.v1,a.vl" detected within directory "a/v1": "a.v1" in a/v1/a.proto, a/v1/b.proto; "a.vl" in a/v1/c.proto.
+        testdata/directorysamepackage/a/v1/c.proto:3:1:Multiple packages "a.v1,a.vl" detected within directory "a/v1": "a.v1" in a/v1/a.proto, a/v1/b.proto; "a.vl" in a/v1/c.proto.`,
+		"lint",
+		filepath.Join("testdata", "directorysamepackage"),
+	)
+}
+
+func TestLintWarnings(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`testdata/lintwarnings/a.proto:6:9:warning: Field name "oneTwo" should be lower_snake_case, such as "one_two".`),
+		"lint",
+		filepath.Join("testdata", "lintwarnings"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		filepath.FromSlash(`testdata/lintwarnings/a.proto:6:9:warning: Field name "oneTwo" should be lower_snake_case, such as "one_two".`),
+		"lint",
+		filepath.Join("testdata", "lintwarnings"),
+		"--fail-on-warnings",
+	)
+}
+
+func TestBuildMaxErrors(t *testing.T) {
+	t.Parallel()
+	testRunStderr(
+		t,
+		nil,
+		1,
+		filepath.FromSlash(`testdata/maxerrors/a.proto:6:3:field a.Foo.bar: unknown type Bar
+		testdata/maxerrors/b.proto:6:3:field b.Foo.bar: unknown type Bar
+		... and 1 more`),
+		"build",
+		filepath.Join("testdata", "maxerrors"),
+		"--max-errors",
+		"2",
+	)
+	testRunStderr(
+		t,
+		nil,
+		1,
+		filepath.FromSlash(`testdata/maxerrors/a.proto:6:3:field a.Foo.bar: unknown type Bar
+		testdata/maxerrors/b.proto:6:3:field b.Foo.bar: unknown type Bar
+		testdata/maxerrors/c.proto:6:3:field c.Foo.bar: unknown type Bar`),
+		"build",
+		filepath.Join("testdata", "maxerrors"),
+	)
+}
+
+func TestBuildExperimentalEditions(t *testing.T) {
 	t.Parallel()
+	tempDirPath := t.TempDir()
 	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"build",
+		filepath.Join("testdata", "editions"),
+		"--experimental-editions",
+		"-o",
+		filepath.Join(tempDirPath, "image.bin"),
+	)
+	require.FileExists(t, filepath.Join(tempDirPath, "image.bin"))
+	testRunStderr(
+		t,
+		nil,
+		1,
+		`Failed to "build": --output: JSON images are not supported with --experimental-editions, use a binary image instead.`,
+		"build",
+		filepath.Join("testdata", "editions"),
+		"--experimental-editions",
+		"-o",
+		filepath.Join(tempDirPath, "image.json"),
+	)
+	require.NoFileExists(t, filepath.Join(tempDirPath, "image.json"))
+}
+
+func TestBuildWarnings(t *testing.T) {
+	t.Parallel()
+	testRunStderr(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`testdata/warnings/b.proto:5:1:warning: Import "a.proto" is unused.`),
+		"build",
+		filepath.Join("testdata", "warnings"),
+	)
+	testRunStderr(
+		t,
+		nil,
+		1,
+		`{"path":"testdata/warnings/b.proto","start_line":5,"start_column":1,"end_line":5,"end_column":18,"type":"COMPILE","message":"Import \"a.proto\" is unused.","severity":"warning"}`,
+		"build",
+		filepath.Join("testdata", "warnings"),
+		"--error-format",
+		"json",
+		"--fail-on-warnings",
+	)
+	// unused imports are still printed but do not fail the build
+	testRunStderr(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`testdata/warnings/b.proto:5:1:warning: Import "a.proto" is unused.`),
+		"build",
+		filepath.Join("testdata", "warnings"),
+		"--fail-on-warnings",
+		"--allow-unused-imports",
+	)
+}
+
+func TestBuildWarningsNoSyntax(t *testing.T) {
+	t.Parallel()
+	testRunStderr(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`testdata/nosyntax/a.proto:1:1:warning: no syntax specified; defaulting to proto2 syntax`),
+		"build",
+		filepath.Join("testdata", "nosyntax"),
+	)
+	testRunStderr(
 		t,
 		nil,
 		1,
+		filepath.FromSlash(`testdata/nosyntax/a.proto:1:1:warning: no syntax specified; defaulting to proto2 syntax`),
+		"build",
+		filepath.Join("testdata", "nosyntax"),
+		"--fail-on-warnings",
+	)
+}
+
+func TestBuildPathPrefixStrip(t *testing.T) {
+	t.Parallel()
+	testRunStderr(
+		t,
+		nil,
+		1,
+		`Failed to "build": --path-prefix-strip: stripping path prefix "proto" results in both "a.proto" and "proto/a.proto" having path "a.proto".`,
+		"build",
+		filepath.Join("testdata", "pathprefixstrip"),
+		"--path-prefix-strip",
+		"proto/",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"build",
+		filepath.Join("testdata", "pathprefixstrip"),
+		"--path-prefix-strip",
+		"proto/",
+		"--path",
+		filepath.Join("testdata", "pathprefixstrip", "proto", "a.proto"),
+	)
+}
+
+func TestFail6(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".
         testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
@@ -207,7 +645,7 @@ func TestFail6(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".
         testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
@@ -217,12 +655,48 @@ func TestFail6(t *testing.T) {
 	)
 }
 
-func TestFail7(t *testing.T) {
+func TestLintPathsFrom(t *testing.T) {
 	t.Parallel()
+	testRunStdout(
+		t,
+		strings.NewReader("\n"+filepath.Join("testdata", "fail", "buf", "buf.proto")+"\n\n"),
+		bufcli.DefaultViolationsExitCode,
+		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".
+        testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
+		"lint",
+		filepath.Join("testdata", "fail"),
+		"--paths-from",
+		"-",
+	)
+	// an empty list lints nothing
+	testRunStdout(
+		t,
+		strings.NewReader(""),
+		0,
+		``,
+		"lint",
+		filepath.Join("testdata", "fail"),
+		"--paths-from",
+		"-",
+	)
 	testRunStdout(
 		t,
 		nil,
 		1,
+		``,
+		"lint",
+		"-",
+		"--paths-from",
+		"-",
+	)
+}
+
+func TestFail7(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "fail/buf".
         testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
@@ -236,7 +710,7 @@ func TestFail7(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "fail/buf".
         testdata/fail/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
@@ -248,12 +722,40 @@ func TestFail7(t *testing.T) {
 	)
 }
 
-func TestFail8(t *testing.T) {
+func TestFailConfigOverrideFile(t *testing.T) {
 	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "fail/buf".`,
+		"lint",
+		"--path",
+		filepath.Join("testdata", "fail", "buf", "buf.proto"),
+		filepath.Join("testdata"),
+		"--config",
+		`{"lint":{"use":["BASIC"]}}`,
+		"--config-override-file",
+		filepath.Join("testdata", "config_override", "lint_override.yaml"),
+	)
 	testRunStdout(
 		t,
 		nil,
 		1,
+		``,
+		"lint",
+		filepath.Join("testdata"),
+		"--config-override-file",
+		`{"lint":{"use":["BASIC"]}}`,
+	)
+}
+
+func TestFail8(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail2/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".
 		testdata/fail2/buf/buf2.proto:9:9:Field name "oneThree" should be lower_snake_case, such as "one_three".`,
 		"lint",
@@ -263,7 +765,7 @@ func TestFail8(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail2/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".
 		testdata/fail2/buf/buf2.proto:9:9:Field name "oneThree" should be lower_snake_case, such as "one_three".`,
 		"lint",
@@ -276,7 +778,7 @@ func TestFail9(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail2/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
 		"--input",
@@ -287,7 +789,7 @@ func TestFail9(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`testdata/fail2/buf/buf.proto:6:9:Field name "oneTwo" should be lower_snake_case, such as "one_two".`,
 		"lint",
 		filepath.Join("testdata", "fail2"),
@@ -351,7 +853,7 @@ func TestFail12(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`version: v1beta1
 lint:
   ignore_only:
@@ -368,7 +870,7 @@ lint:
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`version: v1beta1
 lint:
   ignore_only:
@@ -462,7 +964,7 @@ func TestFailCheckBreaking1(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:5:1:Previously present field "3" with name "three" on message "Two" was deleted.
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:10:1:Previously present field "3" with name "three" on message "Three" was deleted.
@@ -481,7 +983,7 @@ func TestFailCheckBreaking1(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:5:1:Previously present field "3" with name "three" on message "Two" was deleted.
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:10:1:Previously present field "3" with name "three" on message "Three" was deleted.
@@ -499,7 +1001,7 @@ func TestFailCheckBreaking1(t *testing.T) {
 	testRunStdout(
 		t,
 		nil,
-		1,
+		bufcli.DefaultViolationsExitCode,
 		`
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:5:1:Previously present field "3" with name "three" on message "Two" was deleted.
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:10:1:Previously present field "3" with name "three" on message "Three" was deleted.
@@ -508,16 +1010,61 @@ func TestFailCheckBreaking1(t *testing.T) {
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/2.proto:57:1:Previously present field "3" with name "three" on message "Nine" was deleted.
 		`,
 		"breaking",
-		"--input",
-		// can't bother right now to filepath.Join this
-		"../../bufcheck/bufbreaking/testdata/breaking_field_no_delete",
-		"--against-input",
-		"../../bufcheck/bufbreaking/testdata_previous/breaking_field_no_delete",
+		"--input",
+		// can't bother right now to filepath.Join this
+		"../../bufcheck/bufbreaking/testdata/breaking_field_no_delete",
+		"--against-input",
+		"../../bufcheck/bufbreaking/testdata_previous/breaking_field_no_delete",
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		`
+		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:5:1:Previously present field "3" with name "three" on message "Two" was deleted.
+		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:10:1:Previously present field "3" with name "three" on message "Three" was deleted.
+		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:12:5:Previously present field "3" with name "three" on message "Five" was deleted.
+		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:22:3:Previously present field "3" with name "three" on message "Seven" was deleted.
+		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/2.proto:57:1:Previously present field "3" with name "three" on message "Nine" was deleted.
+		`,
+		"breaking",
+		// can't bother right now to filepath.Join this
+		"../../bufcheck/bufbreaking/testdata/breaking_field_no_delete",
+		"--against",
+		"../../bufcheck/bufbreaking/testdata_previous/breaking_field_no_delete",
+	)
+}
+
+func TestBreakingLimitToInputFiles(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
+		`
+		<input>:1:1:Previously present file "a/a.proto" was deleted.
+		<input>:1:1:Previously present file "no_package.proto" was deleted.
+		`,
+		"breaking",
+		filepath.Join("..", "..", "bufcheck", "bufbreaking", "testdata", "breaking_file_no_delete"),
+		"--against",
+		filepath.Join("..", "..", "bufcheck", "bufbreaking", "testdata_previous", "breaking_file_no_delete"),
 	)
 	testRunStdout(
 		t,
 		nil,
-		1,
+		0,
+		``,
+		"breaking",
+		filepath.Join("..", "..", "bufcheck", "bufbreaking", "testdata", "breaking_file_no_delete"),
+		"--against",
+		filepath.Join("..", "..", "bufcheck", "bufbreaking", "testdata_previous", "breaking_file_no_delete"),
+		"--limit-to-input-files",
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.DefaultViolationsExitCode,
 		`
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:5:1:Previously present field "3" with name "three" on message "Two" was deleted.
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/1.proto:10:1:Previously present field "3" with name "three" on message "Three" was deleted.
@@ -526,10 +1073,10 @@ func TestFailCheckBreaking1(t *testing.T) {
 		../../bufcheck/bufbreaking/testdata/breaking_field_no_delete/2.proto:57:1:Previously present field "3" with name "three" on message "Nine" was deleted.
 		`,
 		"breaking",
-		// can't bother right now to filepath.Join this
-		"../../bufcheck/bufbreaking/testdata/breaking_field_no_delete",
+		filepath.Join("..", "..", "bufcheck", "bufbreaking", "testdata", "breaking_field_no_delete"),
 		"--against",
-		"../../bufcheck/bufbreaking/testdata_previous/breaking_field_no_delete",
+		filepath.Join("..", "..", "bufcheck", "bufbreaking", "testdata_previous", "breaking_field_no_delete"),
+		"--limit-to-input-files",
 	)
 }
 
@@ -577,7 +1124,17 @@ COMMENT_RPC                       COMMENTS                                    Ch
 COMMENT_SERVICE                   COMMENTS                                    Checks that services have non-empty comments.
 RPC_NO_CLIENT_STREAMING           UNARY_RPC                                   Checks that RPCs are not client streaming.
 RPC_NO_SERVER_STREAMING           UNARY_RPC                                   Checks that RPCs are not server streaming.
+COMMENT_RPC_REQUEST_RESPONSE      OTHER                                       Checks that RPCs and their request and response messages and fields have non-empty comments.
+CUSTOM                            OTHER                                       Checks that the custom constraints in the lint configuration are satisfied (constraints are configurable).
+ENUM_ALLOW_ALIAS_CONSISTENT       OTHER                                       Checks that enums set the allow_alias option if and only if they have values with the same number.
 ENUM_FIRST_VALUE_ZERO             OTHER                                       Checks that all first values of enums have a numeric value of 0.
+FIELD_NO_GROUP                    OTHER                                       Checks that fields are not groups.
+FIELD_PRESENCE                    OTHER                                       Checks that proto3 singular scalar fields have the "optional" label (presence is configurable).
+PACKAGE_NO_STUTTER                OTHER                                       Checks that top-level message, enum, and service names do not begin with the last non-version component of the package (names are configurable to allow).
+RESERVED_NOT_USED                 OTHER                                       Checks that fields and enum values do not use reserved names or numbers.
+RPC_HTTP_ANNOTATION               OTHER                                       Checks that RPCs have the google.api.http option set (streaming RPCs are configurable to allow).
+RPC_STREAMING_SUFFIX              OTHER                                       Checks that streaming RPCs are suffixed with Stream and unary RPCs are not (suffix is configurable).
+SYNTAX_SPECIFIED                  OTHER                                       Checks that all files have a syntax explicitly specified.
 		`
 	testRunStdout(
 		t,
@@ -675,6 +1232,8 @@ ENUM_VALUE_NO_DELETE_UNLESS_NAME_RESERVED       WIRE_JSON
 FIELD_NO_DELETE_UNLESS_NAME_RESERVED            WIRE_JSON                       Checks that fields are not deleted from a given message unless the name is reserved.
 ENUM_VALUE_NO_DELETE_UNLESS_NUMBER_RESERVED     WIRE_JSON, WIRE                 Checks that enum values are not deleted from a given enum unless the number is reserved.
 FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED          WIRE_JSON, WIRE                 Checks that fields are not deleted from a given message unless the number is reserved.
+FIELD_NO_DELETE_UNLESS_RESERVED                 OTHER                           Checks that fields are not deleted from a given message unless both the number and name are reserved.
+FIELD_WIRE_COMPATIBLE_TYPE                      OTHER                           Checks that fields have wire-compatible types in a given message.
 		`
 	testRunStdout(
 		t,
@@ -714,6 +1273,84 @@ func TestCheckLsBreakingRules2(t *testing.T) {
 	)
 }
 
+func TestCheckLsRulesNewRulesSince(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`
+		ID                               CATEGORIES  PURPOSE
+		FIELD_NO_DELETE_UNLESS_RESERVED  OTHER       Checks that fields are not deleted from a given message unless both the number and name are reserved.
+		FIELD_WIRE_COMPATIBLE_TYPE       OTHER       Checks that fields have wire-compatible types in a given message.
+		`,
+		"config",
+		"ls-breaking-rules",
+		"--all",
+		"--new-rules-since",
+		"v0.39.1",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"config",
+		"ls-breaking-rules",
+		"--config",
+		filepath.Join("testdata", "small_list_rules", bufconfig.ExternalConfigV1Beta1FilePath),
+		"--new-rules-since",
+		"v0.39.1",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"config",
+		"ls-lint-rules",
+		"--all",
+		"--new-rules-since",
+		"v0.40.0",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"config",
+		"ls-lint-rules",
+		"--all",
+		"--new-rules-since",
+		"latest",
+	)
+}
+
+func TestLintNewRulesSince(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`
+		ID                            CATEGORIES  PURPOSE
+		COMMENT_RPC_REQUEST_RESPONSE  OTHER       Checks that RPCs and their request and response messages and fields have non-empty comments.
+		CUSTOM                        OTHER       Checks that the custom constraints in the lint configuration are satisfied (constraints are configurable).
+		ENUM_ALLOW_ALIAS_CONSISTENT   OTHER       Checks that enums set the allow_alias option if and only if they have values with the same number.
+		FIELD_NO_GROUP                OTHER       Checks that fields are not groups.
+		FIELD_PRESENCE                OTHER       Checks that proto3 singular scalar fields have the "optional" label (presence is configurable).
+		PACKAGE_NO_STUTTER            OTHER       Checks that top-level message, enum, and service names do not begin with the last non-version component of the package (names are configurable to allow).
+		RESERVED_NOT_USED             OTHER       Checks that fields and enum values do not use reserved names or numbers.
+		RPC_HTTP_ANNOTATION           OTHER       Checks that RPCs have the google.api.http option set (streaming RPCs are configurable to allow).
+		RPC_STREAMING_SUFFIX          OTHER       Checks that streaming RPCs are suffixed with Stream and unary RPCs are not (suffix is configurable).
+		SYNTAX_SPECIFIED              OTHER       Checks that all files have a syntax explicitly specified.
+		`,
+		"lint",
+		"--new-rules-since",
+		"v0.39.1",
+	)
+}
+
 func TestLsFiles(t *testing.T) {
 	t.Parallel()
 	testRunStdout(
@@ -791,6 +1428,344 @@ func TestLsFilesImage2(t *testing.T) {
 	)
 }
 
+func TestImageInspect(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`{"name":"Foo","field":[{"name":"one","number":1,"label":"LABEL_OPTIONAL","type":"TYPE_INT64","jsonName":"one"},{"name":"two","number":2,"label":"LABEL_OPTIONAL","type":"TYPE_MESSAGE","typeName":".google.protobuf.DescriptorProto","jsonName":"two"}]}`,
+		"beta",
+		"image",
+		"inspect",
+		"buf.Foo",
+		filepath.Join("testdata", "success"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`{"name":"Label","value":[{"name":"LABEL_OPTIONAL","number":1},{"name":"LABEL_REQUIRED","number":2},{"name":"LABEL_REPEATED","number":3}]}`,
+		"beta",
+		"image",
+		"inspect",
+		".google.protobuf.FieldDescriptorProto.Label",
+		filepath.Join("testdata", "success"),
+	)
+}
+
+func TestImageInspectNotFound(t *testing.T) {
+	t.Parallel()
+	testRunStderr(
+		t,
+		nil,
+		1,
+		`Failed to "inspect": type "foo.Foo" was not found, near matches: buf.Foo.`,
+		"beta",
+		"image",
+		"inspect",
+		"foo.Foo",
+		filepath.Join("testdata", "success"),
+	)
+}
+
+func TestBuildPathWithImports(t *testing.T) {
+	t.Parallel()
+	stdout := bytes.NewBuffer(nil)
+	testRun(
+		t,
+		0,
+		nil,
+		stdout,
+		"build",
+		"--path",
+		filepath.Join("testdata", "imports", "a", "v1", "a.proto"),
+		"-o",
+		"-",
+		filepath.Join("testdata", "imports"),
+	)
+	testRunStdout(
+		t,
+		stdout,
+		0,
+		`
+		c/v1/c.proto
+		google/protobuf/timestamp.proto
+		b/v1/b.proto
+		a/v1/a.proto
+		`,
+		"ls-files",
+		"-",
+	)
+}
+
+func TestExportPathWithImports(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"export",
+		"--path",
+		filepath.Join("testdata", "imports", "a", "v1", "a.proto"),
+		"-o",
+		tempDir,
+		filepath.Join("testdata", "imports"),
+	)
+	var exportedFilePaths []string
+	require.NoError(
+		t,
+		filepath.Walk(
+			tempDir,
+			func(path string, fileInfo os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !fileInfo.IsDir() {
+					relPath, err := filepath.Rel(tempDir, path)
+					if err != nil {
+						return err
+					}
+					exportedFilePaths = append(exportedFilePaths, filepath.ToSlash(relPath))
+				}
+				return nil
+			},
+		),
+	)
+	// d/v1/d.proto is not exported as it is not imported by a/v1/a.proto
+	require.Equal(
+		t,
+		[]string{
+			"a/v1/a.proto",
+			"b/v1/b.proto",
+			"c/v1/c.proto",
+			"google/protobuf/timestamp.proto",
+		},
+		exportedFilePaths,
+	)
+	expectedData, err := ioutil.ReadFile(filepath.Join("testdata", "imports", "b", "v1", "b.proto"))
+	require.NoError(t, err)
+	actualData, err := ioutil.ReadFile(filepath.Join(tempDir, "b", "v1", "b.proto"))
+	require.NoError(t, err)
+	require.Equal(t, string(expectedData), string(actualData))
+}
+
+func TestBuildPathWithImportsProtocDescriptorSetIn(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	imageFilePath := filepath.Join(tempDir, "image.bin")
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"build",
+		"--as-file-descriptor-set",
+		"--path",
+		filepath.Join("testdata", "imports", "a", "v1", "a.proto"),
+		"-o",
+		imageFilePath,
+		filepath.Join("testdata", "imports"),
+	)
+	// protoc will fail if any transitive import of a/v1/a.proto is
+	// missing from the FileDescriptorSet, as there is no include path
+	// for the files in testdata/imports
+	require.NoError(
+		t,
+		prototesting.RunProtoc(
+			context.Background(),
+			nil,
+			[]string{"a/v1/a.proto"},
+			false,
+			false,
+			nil,
+			nil,
+			fmt.Sprintf("--descriptor_set_in=%s", imageFilePath),
+			fmt.Sprintf("--descriptor_set_out=%s", filepath.Join(tempDir, "protoc.bin")),
+		),
+	)
+}
+
+func TestBuildCompression(t *testing.T) {
+	t.Parallel()
+	imageFilePath := filepath.Join(t.TempDir(), "image.bin")
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"build",
+		"--compression",
+		"zstd",
+		"-o",
+		imageFilePath,
+		filepath.Join("testdata", "success"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`
+		google/protobuf/descriptor.proto
+		buf/buf.proto
+		`,
+		"ls-files",
+		imageFilePath+"#compression=zstd",
+	)
+	jsonImageFilePath := filepath.Join(t.TempDir(), "image")
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"build",
+		"--compression",
+		"gzip",
+		"-o",
+		jsonImageFilePath+"#format=json",
+		filepath.Join("testdata", "success"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`
+		google/protobuf/descriptor.proto
+		buf/buf.proto
+		`,
+		"ls-files",
+		jsonImageFilePath+"#format=json,compression=gzip",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"build",
+		"--compression",
+		"zstd",
+		"-o",
+		imageFilePath+".gz",
+		filepath.Join("testdata", "success"),
+	)
+}
+
+func TestBuildImageKind(t *testing.T) {
+	t.Parallel()
+	for _, testCase := range []struct {
+		name          string
+		args          []string
+		expectedFiles string
+	}{
+		{
+			name: "full",
+			expectedFiles: `
+			b.proto
+			c.proto
+			a.proto
+			`,
+		},
+		{
+			name: "minimal",
+			args: []string{"--image-kind", "minimal"},
+			expectedFiles: `
+			b.proto
+			a.proto
+			`,
+		},
+		{
+			name: "minimal_override",
+			args: []string{"--image-kind", "minimal", "--prune-imports=false"},
+			expectedFiles: `
+			b.proto
+			c.proto
+			a.proto
+			`,
+		},
+		{
+			name: "prune_imports",
+			args: []string{"--prune-imports"},
+			expectedFiles: `
+			b.proto
+			a.proto
+			`,
+		},
+	} {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			stdout := bytes.NewBuffer(nil)
+			testRun(
+				t,
+				0,
+				nil,
+				stdout,
+				append(
+					[]string{
+						"build",
+						filepath.Join("testdata", "imagekind"),
+						"--path",
+						filepath.Join("testdata", "imagekind", "a.proto"),
+						"-o",
+						"-",
+					},
+					testCase.args...,
+				)...,
+			)
+			testRunStdout(
+				t,
+				stdout,
+				0,
+				testCase.expectedFiles,
+				"ls-files",
+				"-",
+			)
+		})
+	}
+}
+
+func TestBuildDescriptorSetIn(t *testing.T) {
+	t.Parallel()
+	imageFilePath := filepath.Join(t.TempDir(), "image.bin")
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"build",
+		"--as-file-descriptor-set",
+		"-o",
+		imageFilePath,
+		filepath.Join("testdata", "descriptorsetin", "dep"),
+	)
+	stdout := bytes.NewBuffer(nil)
+	testRun(
+		t,
+		0,
+		nil,
+		stdout,
+		"build",
+		"--descriptor-set-in",
+		imageFilePath,
+		"-o",
+		"-",
+		filepath.Join("testdata", "descriptorsetin", "src"),
+	)
+	testRunStdout(
+		t,
+		stdout,
+		0,
+		`
+		dep.proto
+		src.proto
+		`,
+		"ls-files",
+		"-",
+	)
+}
+
 func TestImageConvertRoundtripBinaryJSONBinary(t *testing.T) {
 	t.Parallel()
 
@@ -995,6 +1970,24 @@ func testRunStdout(t *testing.T, stdin io.Reader, expectedExitCode int, expected
 	)
 }
 
+func testRunStderr(t *testing.T, stdin io.Reader, expectedExitCode int, expectedStderr string, args ...string) {
+	t.Helper()
+	appcmdtesting.RunCommandExitCodeStderr(
+		t,
+		func(use string) *appcmd.Command { return testNewRootCommand(use) },
+		expectedExitCode,
+		expectedStderr,
+		func(use string) map[string]string {
+			return map[string]string{
+				useEnvVar(use, "CONFIG_DIR"): "testdata/config",
+				useEnvVar(use, "CACHE_DIR"):  "cache",
+			}
+		},
+		stdin,
+		args...,
+	)
+}
+
 func testRunStdoutProfile(t *testing.T, stdin io.Reader, expectedExitCode int, expectedStdout string, args ...string) {
 	t.Helper()
 	profileDirPath, err := ioutil.TempDir("", "")
@@ -1015,6 +2008,18 @@ func testRunStdoutProfile(t *testing.T, stdin io.Reader, expectedExitCode int, e
 	)
 }
 
+// testCopyLintFixInput copies the fail testdata to a temporary directory to be fixed.
+func testCopyLintFixInput(t *testing.T) string {
+	tempDirPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDirPath, "buf"), 0755))
+	for _, path := range []string{"buf.yaml", filepath.Join("buf", "buf.proto")} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", "fail", path))
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(tempDirPath, path), data, 0644))
+	}
+	return tempDirPath
+}
+
 func testRun(
 	t *testing.T,
 	expectedExitCode int,
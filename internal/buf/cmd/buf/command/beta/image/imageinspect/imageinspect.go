@@ -0,0 +1,292 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imageinspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	errorFormatFlagName = "error-format"
+	configFlagName      = "config"
+	formatFlagName      = "format"
+
+	formatJSON = "json"
+	formatText = "text"
+
+	// maxNearMatches is the maximum number of near matches listed when a type is not found.
+	maxNearMatches = 10
+)
+
+var allFormats = []string{
+	formatJSON,
+	formatText,
+}
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <type> <input>",
+		Short: "Print the descriptor of a single message or enum.",
+		Long: fmt.Sprintf(
+			`The first argument is the fully-qualified name of the message or enum, i.e. "acme.weather.v1.Forecast".
+The descriptor is printed to stdout, with the options and fully-qualified field type names as they appear in the image.
+
+The second argument is the source or module to build, or image to read.
+The second argument must be one of format %s.
+If no second argument is specified, defaults to ".".`,
+			buffetch.AllFormatsString,
+		),
+		Args: cobra.RangeArgs(1, 2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags, moduleResolverReaderProvider)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ErrorFormat string
+	Config      string
+	Format      string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors, printed to stderr. Must be one of %s.",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The config file or data to use.`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		formatJSON,
+		fmt.Sprintf(
+			`The output format to use. Must be one of %s, where %s is the protobuf text format.`,
+			stringutil.SliceToString(allFormats),
+			formatText,
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
+) error {
+	typeName := strings.TrimPrefix(container.Arg(0), ".")
+	if typeName == "" {
+		return appcmd.NewInvalidArgumentError("a type must be specified")
+	}
+	if !stringutil.SliceElementsContained(allFormats, []string{flags.Format}) {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: %q is not a valid format, must be one of %s",
+			formatFlagName,
+			flags.Format,
+			stringutil.SliceToString(allFormats),
+		)
+	}
+	input := "."
+	if container.NumArgs() > 1 {
+		input = container.Arg(1)
+	}
+	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, input)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	moduleResolver, err := moduleResolverReaderProvider.GetModuleResolver(ctx, container)
+	if err != nil {
+		return err
+	}
+	moduleReader, err := moduleResolverReaderProvider.GetModuleReader(ctx, container)
+	if err != nil {
+		return err
+	}
+	imageConfig, fileAnnotations, err := bufcli.NewWireImageConfigReader(
+		container.Logger(),
+		storageos.NewProvider(storageos.ProviderWithSymlinks()),
+		bufconfig.NewProvider(container.Logger()),
+		moduleResolver,
+		moduleReader,
+	).GetImageConfig(
+		ctx,
+		container,
+		ref,
+		flags.Config,
+		nil,
+		false,
+		// source code info is part of the file descriptor, not the type descriptors
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stderr(),
+			fileAnnotations,
+			flags.ErrorFormat,
+		); err != nil {
+			return err
+		}
+		// we already printed the messages with PrintFileAnnotations so we do
+		// not want to print any additional error message
+		return errors.New("")
+	}
+	image := imageConfig.Image()
+	fullNameToDescriptor := getFullNameToDescriptor(image)
+	descriptor, ok := fullNameToDescriptor[typeName]
+	if !ok {
+		return newTypeNotFoundError(typeName, fullNameToDescriptor)
+	}
+	resolver, err := protoencoding.NewResolver(bufimage.ImageToFileDescriptorProtos(image)...)
+	if err != nil {
+		return err
+	}
+	var marshaler protoencoding.Marshaler
+	switch flags.Format {
+	case formatJSON:
+		marshaler = protoencoding.NewJSONMarshaler(resolver)
+	case formatText:
+		marshaler = protoencoding.NewTextMarshaler(resolver)
+	default:
+		return fmt.Errorf("unknown format: %q", flags.Format)
+	}
+	data, err := marshaler.Marshal(descriptor)
+	if err != nil {
+		return err
+	}
+	if _, err := container.Stdout().Write(data); err != nil {
+		return err
+	}
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		if _, err := container.Stdout().Write([]byte("\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getFullNameToDescriptor returns a map from the fully-qualified names of all messages
+// and enums in the image, including nested types and imports, to their descriptors.
+func getFullNameToDescriptor(image bufimage.Image) map[string]proto.Message {
+	fullNameToDescriptor := make(map[string]proto.Message)
+	for _, imageFile := range image.Files() {
+		fileDescriptorProto := imageFile.Proto()
+		prefix := ""
+		if pkg := fileDescriptorProto.GetPackage(); pkg != "" {
+			prefix = pkg + "."
+		}
+		for _, enumDescriptorProto := range fileDescriptorProto.GetEnumType() {
+			fullNameToDescriptor[prefix+enumDescriptorProto.GetName()] = enumDescriptorProto
+		}
+		for _, descriptorProto := range fileDescriptorProto.GetMessageType() {
+			addMessage(fullNameToDescriptor, prefix, descriptorProto)
+		}
+	}
+	return fullNameToDescriptor
+}
+
+func addMessage(fullNameToDescriptor map[string]proto.Message, prefix string, descriptorProto *descriptorpb.DescriptorProto) {
+	fullName := prefix + descriptorProto.GetName()
+	fullNameToDescriptor[fullName] = descriptorProto
+	for _, enumDescriptorProto := range descriptorProto.GetEnumType() {
+		fullNameToDescriptor[fullName+"."+enumDescriptorProto.GetName()] = enumDescriptorProto
+	}
+	for _, nestedDescriptorProto := range descriptorProto.GetNestedType() {
+		addMessage(fullNameToDescriptor, fullName+".", nestedDescriptorProto)
+	}
+}
+
+// newTypeNotFoundError returns an error for a type that was not found, listing
+// the types whose name is the same as the last component of typeName ignoring
+// case, or if there are none, the types whose fully-qualified name contains it.
+func newTypeNotFoundError(typeName string, fullNameToDescriptor map[string]proto.Message) error {
+	name := strings.ToLower(typeName)
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+	var nameMatches []string
+	var containsMatches []string
+	for fullName := range fullNameToDescriptor {
+		lowerFullName := strings.ToLower(fullName)
+		lowerName := lowerFullName
+		if index := strings.LastIndex(lowerName, "."); index >= 0 {
+			lowerName = lowerName[index+1:]
+		}
+		if lowerName == name {
+			nameMatches = append(nameMatches, fullName)
+		} else if strings.Contains(lowerFullName, name) {
+			containsMatches = append(containsMatches, fullName)
+		}
+	}
+	nearMatches := nameMatches
+	if len(nearMatches) == 0 {
+		nearMatches = containsMatches
+	}
+	if len(nearMatches) == 0 {
+		return fmt.Errorf("type %q was not found", typeName)
+	}
+	sort.Strings(nearMatches)
+	if len(nearMatches) > maxNearMatches {
+		nearMatches = append(
+			nearMatches[:maxNearMatches],
+			fmt.Sprintf("and %d more", len(nearMatches)-maxNearMatches),
+		)
+	}
+	return fmt.Errorf("type %q was not found, near matches: %s", typeName, strings.Join(nearMatches, ", "))
+}
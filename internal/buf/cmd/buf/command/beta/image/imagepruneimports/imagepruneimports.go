@@ -0,0 +1,133 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagepruneimports
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	asFileDescriptorSetFlagName = "as-file-descriptor-set"
+	excludeSourceInfoFlagName   = "exclude-source-info"
+	outputFlagName              = "output"
+	outputFlagShortName         = "o"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <image>",
+		Short: "Remove the imports from an image that are not needed by the non-imports.",
+		Long: fmt.Sprintf(
+			`The first argument is the image to prune, which must be one of format %s.
+
+An import is kept if it defines a type, extendee, or custom option used by a kept file,
+if it is on the chain of public imports through which such a definition is visible,
+or if it extends a message within a kept file. All non-imports are kept.
+The imports of the kept files are updated to match.`,
+			buffetch.ImageFormatsString,
+		),
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	AsFileDescriptorSet bool
+	ExcludeSourceInfo   bool
+	Output              string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindAsFileDescriptorSet(flagSet, &f.AsFileDescriptorSet, asFileDescriptorSetFlagName)
+	bufcli.BindExcludeSourceInfo(flagSet, &f.ExcludeSourceInfo, excludeSourceInfoFlagName)
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		fmt.Sprintf(
+			`Required. The location to write the image to. Must be one of format %s.`,
+			buffetch.ImageFormatsString,
+		),
+	)
+}
+
+func run(ctx context.Context, container appflag.Container, flags *flags) error {
+	if flags.Output == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", outputFlagName)
+	}
+	imageRefParser := buffetch.NewImageRefParser(container.Logger())
+	imageRef, err := imageRefParser.GetImageRef(ctx, container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	outputImageRef, err := imageRefParser.GetImageRef(ctx, flags.Output)
+	if err != nil {
+		return fmt.Errorf("--%s: %v", outputFlagName, err)
+	}
+	image, err := bufcli.NewWireImageReader(
+		container.Logger(),
+		storageos.NewProvider(storageos.ProviderWithSymlinks()),
+	).GetImage(
+		ctx,
+		container,
+		imageRef,
+		nil,
+		false,
+		flags.ExcludeSourceInfo,
+	)
+	if err != nil {
+		return err
+	}
+	image, err = bufimage.ImageWithUnusedImportsPruned(image)
+	if err != nil {
+		return err
+	}
+	return bufcli.NewWireImageWriter(
+		container.Logger(),
+	).PutImage(
+		ctx,
+		container,
+		outputImageRef,
+		image,
+		flags.AsFileDescriptorSet,
+		false,
+	)
+}
@@ -0,0 +1,149 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheprune
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulecache"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	olderThanFlagName  = "older-than"
+	keepLatestFlagName = "keep-latest"
+	dirFlagName        = "dir"
+)
+
+// NewCommand returns a new prune Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name,
+		Short: "Delete modules from the local module cache.",
+		Long: "Deletes modules from the local module cache, printing each deleted module and the reclaimed space. " +
+			"At least one of --" + olderThanFlagName + " or --" + keepLatestFlagName + " must be set, and a module " +
+			"is only deleted if it is not kept by any of the set flags. Modules referenced by the " +
+			bufmodule.LockFilePath + " file in the current directory are never deleted. " +
+			"The last access time of a module is the last time it was downloaded or read from the cache.",
+		Args: cobra.NoArgs,
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	OlderThan  time.Duration
+	KeepLatest int
+	// for testing only
+	Dir string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.DurationVar(
+		&f.OlderThan,
+		olderThanFlagName,
+		0,
+		`Keep modules that were last accessed within this duration, for example "720h".`,
+	)
+	flagSet.IntVar(
+		&f.KeepLatest,
+		keepLatestFlagName,
+		0,
+		"Keep this number of most recently accessed commits of each module.",
+	)
+	flagSet.StringVar(
+		&f.Dir,
+		dirFlagName,
+		".",
+		"The directory to operate in. For testing only.",
+	)
+	_ = flagSet.MarkHidden(dirFlagName)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if flags.OlderThan < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must not be negative", olderThanFlagName)
+	}
+	if flags.KeepLatest < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must not be negative", keepLatestFlagName)
+	}
+	if flags.OlderThan == 0 && flags.KeepLatest == 0 {
+		return appcmd.NewInvalidArgumentErrorf("at least one of --%s or --%s must be set", olderThanFlagName, keepLatestFlagName)
+	}
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		flags.Dir,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	// only used to read the dependencies in the lock file, if any
+	module, err := bufmodule.NewModuleForBucket(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	modulePruner, err := bufcli.NewModuleCachePruner(container)
+	if err != nil {
+		return err
+	}
+	pruneOptions := []bufmodulecache.PruneOption{
+		bufmodulecache.PruneWithKeepModulePins(module.DependencyModulePins()...),
+	}
+	if flags.OlderThan > 0 {
+		pruneOptions = append(pruneOptions, bufmodulecache.PruneWithOlderThan(flags.OlderThan))
+	}
+	if flags.KeepLatest > 0 {
+		pruneOptions = append(pruneOptions, bufmodulecache.PruneWithKeepLatest(flags.KeepLatest))
+	}
+	prunedModules, err := modulePruner.PruneModules(ctx, pruneOptions...)
+	var reclaimedSizeBytes int64
+	for _, prunedModule := range prunedModules {
+		reclaimedSizeBytes += prunedModule.SizeBytes()
+		if _, printErr := fmt.Fprintf(container.Stdout(), "deleted %s (%d bytes)\n", prunedModule.Path(), prunedModule.SizeBytes()); printErr != nil {
+			return printErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(container.Stdout(), "reclaimed %d bytes from %d modules\n", reclaimedSizeBytes, len(prunedModules))
+	return err
+}
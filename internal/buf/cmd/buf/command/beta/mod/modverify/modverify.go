@@ -0,0 +1,126 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modverify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/storage"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const dirFlagName = "dir"
+
+// NewCommand returns a new verify Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name,
+		Short: "Verify that the dependencies in the " + bufmodule.LockFilePath + " file are in the local module cache.",
+		Long: "Checks that every dependency in the " + bufmodule.LockFilePath + " file exists in the local " +
+			"module cache, and that the digest of each cached module matches the digest in the " +
+			bufmodule.LockFilePath + " file. Missing or mismatched modules are printed, and the command " +
+			"exits with a non-zero exit code. No network requests are made.",
+		Args: cobra.NoArgs,
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	// for testing only
+	Dir string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Dir,
+		dirFlagName,
+		".",
+		"The directory to operate in. For testing only.",
+	)
+	_ = flagSet.MarkHidden(dirFlagName)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		flags.Dir,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	exists, err := bufconfig.ConfigExists(ctx, readWriteBucket)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	if !exists {
+		return bufcli.ErrNoConfigFile
+	}
+	module, err := bufmodule.NewModuleForBucket(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	moduleVerifier, err := bufcli.NewModuleCacheVerifier(container)
+	if err != nil {
+		return err
+	}
+	var failed bool
+	for _, modulePin := range module.DependencyModulePins() {
+		if err := moduleVerifier.VerifyModule(ctx, modulePin); err != nil {
+			failed = true
+			if storage.IsNotExist(err) {
+				if _, err := fmt.Fprintf(container.Stdout(), "%s: missing from the module cache\n", modulePin.String()); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(container.Stdout(), "%s: %v\n", modulePin.String(), err); err != nil {
+				return err
+			}
+		}
+	}
+	if failed {
+		return errors.New("")
+	}
+	_, err = fmt.Fprintln(container.Stdout(), "all modules verified")
+	return err
+}
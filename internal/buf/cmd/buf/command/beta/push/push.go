@@ -21,6 +21,8 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/buf/bufcli"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	modulev1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/module/v1alpha1"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
 	"github.com/bufbuild/buf/internal/pkg/rpc"
@@ -33,6 +35,7 @@ import (
 const (
 	branchFlagName      = "branch"
 	branchFlagShortName = "b"
+	draftFlagName       = "draft"
 	errorFormatFlagName = "error-format"
 )
 
@@ -60,6 +63,7 @@ func NewCommand(
 
 type flags struct {
 	Branch      string
+	Draft       bool
 	ErrorFormat string
 	Force       bool
 	// special
@@ -79,6 +83,13 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		bufmodule.MainBranch,
 		`The branch to push to.`,
 	)
+	flagSet.BoolVar(
+		&f.Draft,
+		draftFlagName,
+		false,
+		`Create the commit without advancing the branch to it.
+The commit is printed to stdout, and can be referenced explicitly, for example to create a tag.`,
+	)
 	flagSet.StringVar(
 		&f.ErrorFormat,
 		errorFormatFlagName,
@@ -125,29 +136,73 @@ func run(
 	if err != nil {
 		return err
 	}
-	localModulePin, err := service.Push(
+	commit, err := push(
 		ctx,
+		service,
 		moduleIdentity.Owner(),
 		moduleIdentity.Repository(),
 		flags.Branch,
 		protoModule,
+		flags.Draft,
+		flags.Force,
 	)
 	if err != nil {
-		if rpc.GetErrorCode(err) == rpc.ErrorCodeAlreadyExists && !flags.Force {
-			if _, err := container.Stderr().Write(
-				[]byte(fmt.Sprintf(
-					"The latest commit on branch %q has the same content, not creating a new commit.\n",
-					flags.Branch,
-				)),
-			); err != nil {
-				return err
-			}
-			return nil
-		}
 		return err
 	}
-	if _, err := container.Stdout().Write([]byte(localModulePin.Commit + "\n")); err != nil {
+	if commit == "" {
+		if _, err := container.Stderr().Write(
+			[]byte(fmt.Sprintf(
+				"The latest commit on branch %q has the same content, not creating a new commit.\n",
+				flags.Branch,
+			)),
+		); err != nil {
+			return err
+		}
+		return nil
+	}
+	if _, err := container.Stdout().Write([]byte(commit + "\n")); err != nil {
 		return err
 	}
 	return nil
 }
+
+// push pushes the module and returns the created commit.
+//
+// If the latest commit on the branch already has the same content, no commit
+// is created and an empty commit is returned.
+func push(
+	ctx context.Context,
+	pushService registryv1alpha1api.PushService,
+	owner string,
+	repository string,
+	branch string,
+	protoModule *modulev1alpha1.Module,
+	draft bool,
+	force bool,
+) (string, error) {
+	localModulePin, isDraft, err := pushService.Push(
+		ctx,
+		owner,
+		repository,
+		branch,
+		protoModule,
+		draft,
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeAlreadyExists {
+			if draft {
+				return "", fmt.Errorf("a commit with the same content already exists on branch %q, not creating a draft commit", branch)
+			}
+			if !force {
+				return "", nil
+			}
+		}
+		return "", err
+	}
+	// a registry that does not support drafts ignores the draft field of the
+	// request and advances the branch
+	if draft && !isDraft {
+		return "", fmt.Errorf("the registry does not support --%s, branch %q was advanced to commit %s", draftFlagName, branch, localModulePin.Commit)
+	}
+	return localModulePin.Commit, nil
+}
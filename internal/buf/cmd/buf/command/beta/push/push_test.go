@@ -0,0 +1,106 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	modulev1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/module/v1alpha1"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPush(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pushService := newTestPushService(true)
+	commit, err := push(ctx, pushService, "acme", "weather", "main", &modulev1alpha1.Module{}, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "commit1", commit)
+	assert.Equal(t, "commit1", pushService.branchToCommitName["main"])
+
+	commit, err = push(ctx, pushService, "acme", "weather", "main", &modulev1alpha1.Module{}, true, false)
+	require.NoError(t, err)
+	assert.Equal(t, "commit2", commit)
+	assert.Equal(t, "commit1", pushService.branchToCommitName["main"])
+}
+
+func TestPushSameContent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pushService := newTestPushService(true)
+	pushService.alreadyExists = true
+	commit, err := push(ctx, pushService, "acme", "weather", "main", &modulev1alpha1.Module{}, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "", commit)
+
+	_, err = push(ctx, pushService, "acme", "weather", "main", &modulev1alpha1.Module{}, true, false)
+	require.Error(t, err)
+	assert.Equal(t, `a commit with the same content already exists on branch "main", not creating a draft commit`, err.Error())
+}
+
+func TestPushDraftNotSupported(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pushService := newTestPushService(false)
+	_, err := push(ctx, pushService, "acme", "weather", "main", &modulev1alpha1.Module{}, true, false)
+	require.Error(t, err)
+	assert.Equal(t, `the registry does not support --draft, branch "main" was advanced to commit commit1`, err.Error())
+	assert.Equal(t, "commit1", pushService.branchToCommitName["main"])
+}
+
+type testPushService struct {
+	registryv1alpha1api.PushService
+
+	supportsDraft      bool
+	alreadyExists      bool
+	commits            int
+	branchToCommitName map[string]string
+}
+
+func newTestPushService(supportsDraft bool) *testPushService {
+	return &testPushService{
+		supportsDraft:      supportsDraft,
+		branchToCommitName: make(map[string]string),
+	}
+}
+
+func (s *testPushService) Push(
+	_ context.Context,
+	_ string,
+	_ string,
+	branch string,
+	_ *modulev1alpha1.Module,
+	draft bool,
+) (*registryv1alpha1.LocalModulePin, bool, error) {
+	if s.alreadyExists {
+		return nil, false, rpc.NewAlreadyExistsError("commit already exists")
+	}
+	s.commits++
+	commitName := "commit" + strconv.Itoa(s.commits)
+	isDraft := draft && s.supportsDraft
+	if !isDraft {
+		s.branchToCommitName[branch] = commitName
+	}
+	return &registryv1alpha1.LocalModulePin{Branch: branch, Commit: commitName}, isDraft, nil
+}
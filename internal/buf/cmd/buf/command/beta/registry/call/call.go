@@ -0,0 +1,143 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package call
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	return &appcmd.Command{
+		Use:   name + " [buf.build] <service>/<method>",
+		Short: "Call a registry RPC with a JSON request read from stdin.",
+		Long: "The JSON response is printed to stdout. This is intended for debugging and for calling RPCs " +
+			"that are not otherwise exposed by buf. The same authentication and remote configuration as the " +
+			"other registry commands is used.\n\n" +
+			"The service may be given by its fully-qualified name, or by its name within the " +
+			getDefaultPackage() + " package, for example RepositoryService/GetRepository. " +
+			"If stdin is empty, an empty request is sent.",
+		Args: cobra.RangeArgs(1, 2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+	}
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+) error {
+	remote, args, err := bufcli.GetRemoteAndArgs(container, 1)
+	if err != nil {
+		return err
+	}
+	methodDescriptor, err := getMethodDescriptor(args[0])
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	requestMessageType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Input().FullName())
+	if err != nil {
+		return err
+	}
+	responseMessageType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Output().FullName())
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(container.Stdin())
+	if err != nil {
+		return err
+	}
+	request := requestMessageType.New().Interface()
+	if len(strings.TrimSpace(string(data))) > 0 {
+		if err := protojson.Unmarshal(data, request); err != nil {
+			return appcmd.NewInvalidArgumentErrorf("invalid request for %s: %v", methodDescriptor.FullName(), err)
+		}
+	}
+	registryInvoker, err := bufcli.NewRegistryInvoker(ctx, container)
+	if err != nil {
+		return err
+	}
+	response := responseMessageType.New().Interface()
+	if err := registryInvoker.Invoke(
+		ctx,
+		remote,
+		string(methodDescriptor.Parent().FullName()),
+		string(methodDescriptor.Name()),
+		request,
+		response,
+	); err != nil {
+		return err
+	}
+	responseData, err := protoencoding.NewJSONMarshalerIndent(nil).Marshal(response)
+	if err != nil {
+		return err
+	}
+	_, err = container.Stdout().Write(append(responseData, '\n'))
+	return err
+}
+
+// getMethodDescriptor gets the descriptor for the method given as <service>/<method>.
+//
+// Only registry services are known.
+func getMethodDescriptor(serviceMethod string) (protoreflect.MethodDescriptor, error) {
+	split := strings.Split(strings.TrimPrefix(serviceMethod, "/"), "/")
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("%q is not a valid method, must be of the form <service>/<method>", serviceMethod)
+	}
+	serviceName := split[0]
+	if !strings.Contains(serviceName, ".") {
+		serviceName = getDefaultPackage() + "." + serviceName
+	}
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a known service", split[0])
+	}
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok || serviceDescriptor.ParentFile().Package() != protoreflect.FullName(getDefaultPackage()) {
+		return nil, fmt.Errorf("%q is not a known service", split[0])
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(split[1]))
+	if methodDescriptor == nil {
+		return nil, fmt.Errorf("%q is not a known method of %s", split[1], serviceDescriptor.FullName())
+	}
+	if methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+		return nil, fmt.Errorf("%s/%s is a streaming method, only unary methods can be called", serviceDescriptor.FullName(), split[1])
+	}
+	return methodDescriptor, nil
+}
+
+func getDefaultPackage() string {
+	return string(registryv1alpha1.File_buf_alpha_registry_v1alpha1_repository_proto.Package())
+}
@@ -0,0 +1,197 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitannotate
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unicode"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	fileFlagName   = "file"
+	formatFlagName = "format"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository:commit>",
+		Short: "Set the annotation of a commit.",
+		Long: "The annotation is freeform text, such as release notes, and replaces any existing annotation of the commit. " +
+			"It is read from stdin, or from the file given with --" + fileFlagName + ". Trailing whitespace is removed, " +
+			"and an empty annotation removes the annotation of the commit. The annotated commit is printed afterwards.",
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	File   string
+	Format string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.File,
+		fileFlagName,
+		"",
+		`The file to read the annotation from. If not set or "-", the annotation is read from stdin.`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleReference, err := bufmodule.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if !bufmodule.IsCommitModuleReference(moduleReference) {
+		return appcmd.NewInvalidArgumentErrorf("%q does not reference a commit", container.Arg(0))
+	}
+	annotation, err := readAnnotation(container, flags.File)
+	if err != nil {
+		return err
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	repositoryService, err := apiProvider.NewRepositoryService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	repository, err := repositoryService.GetRepositoryByFullName(ctx, moduleReference.Owner()+"/"+moduleReference.Repository())
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	repositoryCommitService, err := apiProvider.NewRepositoryCommitService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	repositoryCommit, err := updateRepositoryCommitAnnotation(
+		ctx,
+		repositoryCommitService,
+		moduleReference.Remote(),
+		repository.Id,
+		moduleReference.Reference(),
+		annotation,
+	)
+	if err != nil {
+		return err
+	}
+	return bufcli.PrintRepositoryCommits(ctx, container.Stdout(), flags.Format, repositoryCommit)
+}
+
+// updateRepositoryCommitAnnotation sets the annotation of the commit.
+//
+// The commit is looked up first, so that a commit that does not exist is not
+// confused with a registry that does not support commit annotations, which
+// both result in a not found error from the update.
+func updateRepositoryCommitAnnotation(
+	ctx context.Context,
+	repositoryCommitService registryv1alpha1api.RepositoryCommitService,
+	remote string,
+	repositoryID string,
+	commit string,
+	annotation string,
+) (*registryv1alpha1.RepositoryCommit, error) {
+	if _, err := repositoryCommitService.GetRepositoryCommitByName(ctx, repositoryID, commit); err != nil {
+		switch rpc.GetErrorCode(err) {
+		case rpc.ErrorCodeNotFound:
+			return nil, bufcli.NewCommitNotFoundError(commit)
+		case rpc.ErrorCodeUnimplemented:
+			return nil, newAnnotationsNotSupportedError(remote)
+		}
+		return nil, err
+	}
+	repositoryCommit, err := repositoryCommitService.UpdateRepositoryCommitAnnotation(
+		ctx,
+		repositoryID,
+		commit,
+		annotation,
+	)
+	if err != nil {
+		switch rpc.GetErrorCode(err) {
+		case rpc.ErrorCodeNotFound, rpc.ErrorCodeUnimplemented:
+			// the commit exists, so the registry does not have the method
+			return nil, newAnnotationsNotSupportedError(remote)
+		}
+		return nil, err
+	}
+	return repositoryCommit, nil
+}
+
+func newAnnotationsNotSupportedError(remote string) error {
+	return fmt.Errorf("the registry at %s does not support commit annotations", remote)
+}
+
+// readAnnotation reads the annotation from the file, or from stdin if the
+// file is empty or "-".
+func readAnnotation(container appflag.Container, filePath string) (string, error) {
+	var data []byte
+	var err error
+	if filePath == "" || filePath == "-" {
+		data, err = ioutil.ReadAll(container.Stdin())
+		if err != nil {
+			return "", fmt.Errorf("unable to read annotation from stdin: %w", err)
+		}
+	} else {
+		data, err = ioutil.ReadFile(filePath)
+		if err != nil {
+			return "", fmt.Errorf("unable to read annotation from --%s: %w", fileFlagName, err)
+		}
+	}
+	return strings.TrimRightFunc(string(data), unicode.IsSpace), nil
+}
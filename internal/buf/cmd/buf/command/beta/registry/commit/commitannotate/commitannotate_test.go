@@ -0,0 +1,100 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitannotate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateRepositoryCommitAnnotation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repositoryCommitService := newTestRepositoryCommitService(true, true)
+	repositoryCommit, err := updateRepositoryCommitAnnotation(ctx, repositoryCommitService, "buf.build", "repository-id", "commit1", "notes")
+	require.NoError(t, err)
+	assert.Equal(t, "notes", repositoryCommit.Annotation)
+	assert.Equal(t, "notes", repositoryCommitService.commitNameToAnnotation["commit1"])
+
+	_, err = updateRepositoryCommitAnnotation(ctx, repositoryCommitService, "buf.build", "repository-id", "commit2", "notes")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "commit2")
+	assert.NotContains(t, err.Error(), "does not support commit annotations")
+
+	_, err = updateRepositoryCommitAnnotation(ctx, newTestRepositoryCommitService(false, false), "buf.build", "repository-id", "commit1", "notes")
+	require.Error(t, err)
+	assert.Equal(t, "the registry at buf.build does not support commit annotations", err.Error())
+
+	_, err = updateRepositoryCommitAnnotation(ctx, newTestRepositoryCommitService(true, false), "buf.build", "repository-id", "commit1", "notes")
+	require.Error(t, err)
+	assert.Equal(t, "the registry at buf.build does not support commit annotations", err.Error())
+}
+
+type testRepositoryCommitService struct {
+	registryv1alpha1api.RepositoryCommitService
+
+	getImplemented         bool
+	updateImplemented      bool
+	commitNameToAnnotation map[string]string
+}
+
+func newTestRepositoryCommitService(getImplemented bool, updateImplemented bool) *testRepositoryCommitService {
+	return &testRepositoryCommitService{
+		getImplemented:    getImplemented,
+		updateImplemented: updateImplemented,
+		commitNameToAnnotation: map[string]string{
+			"commit1": "",
+		},
+	}
+}
+
+func (s *testRepositoryCommitService) GetRepositoryCommitByName(
+	_ context.Context,
+	_ string,
+	name string,
+) (*registryv1alpha1.RepositoryCommit, error) {
+	if !s.getImplemented {
+		return nil, rpc.NewUnimplementedError("bad route")
+	}
+	annotation, ok := s.commitNameToAnnotation[name]
+	if !ok {
+		return nil, rpc.NewNotFoundErrorf("commit %q not found", name)
+	}
+	return &registryv1alpha1.RepositoryCommit{Name: name, Annotation: annotation}, nil
+}
+
+func (s *testRepositoryCommitService) UpdateRepositoryCommitAnnotation(
+	_ context.Context,
+	_ string,
+	name string,
+	annotation string,
+) (*registryv1alpha1.RepositoryCommit, error) {
+	if !s.updateImplemented {
+		// older registries served over twirp report a missing method as not found
+		return nil, rpc.NewNotFoundError("bad route")
+	}
+	if _, ok := s.commitNameToAnnotation[name]; !ok {
+		return nil, rpc.NewNotFoundErrorf("commit %q not found", name)
+	}
+	s.commitNameToAnnotation[name] = annotation
+	return &registryv1alpha1.RepositoryCommit{Name: name, Annotation: annotation}, nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitdiff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagediff"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/buf/bufwire"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	breakingOnlyFlagName = "breaking-only"
+	errorFormatFlagName  = "error-format"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository:reference> <buf.build/owner/repository:reference>",
+		Short: "Show the schema changes between two commits.",
+		Long: `The references may be commits, tags, or branches, and the repositories may differ.
+The messages, enums, and services of the second commit are compared to those of the first,
+and each type that was added, removed, or changed is printed. Dependencies are not compared.
+
+If --breaking-only is set, the breaking changes from the first commit to the second are
+printed instead, using the breaking configuration of the second commit.`,
+		Args: cobra.ExactArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags, moduleResolverReaderProvider)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	BreakingOnly bool
+	ErrorFormat  string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(
+		&f.BreakingOnly,
+		breakingOnlyFlagName,
+		false,
+		`Only print the breaking changes.`,
+	)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for breaking changes and build errors. Must be one of %s.",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
+) error {
+	for i := 0; i < container.NumArgs(); i++ {
+		if _, err := bufmodule.ModuleReferenceForString(container.Arg(i)); err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+	}
+	moduleResolver, err := moduleResolverReaderProvider.GetModuleResolver(ctx, container)
+	if err != nil {
+		return err
+	}
+	moduleReader, err := moduleResolverReaderProvider.GetModuleReader(ctx, container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader := bufcli.NewWireImageConfigReader(
+		container.Logger(),
+		storageos.NewProvider(storageos.ProviderWithSymlinks()),
+		bufconfig.NewProvider(container.Logger()),
+		moduleResolver,
+		moduleReader,
+	)
+	// source info is only needed for the locations of breaking changes
+	previousImageConfig, err := getImageConfig(ctx, container, imageConfigReader, container.Arg(0), true, flags.ErrorFormat)
+	if err != nil {
+		return err
+	}
+	imageConfig, err := getImageConfig(ctx, container, imageConfigReader, container.Arg(1), !flags.BreakingOnly, flags.ErrorFormat)
+	if err != nil {
+		return err
+	}
+	previousImage := bufimage.ImageWithoutImports(previousImageConfig.Image())
+	image := bufimage.ImageWithoutImports(imageConfig.Image())
+	if flags.BreakingOnly {
+		fileAnnotations, err := bufbreaking.NewHandler(container.Logger()).Check(
+			ctx,
+			imageConfig.Config().Breaking,
+			previousImage,
+			image,
+		)
+		if err != nil {
+			return err
+		}
+		return bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, flags.ErrorFormat)
+	}
+	for _, change := range bufimagediff.Diff(previousImage, image) {
+		if _, err := fmt.Fprintf(
+			container.Stdout(),
+			"%s %s %s\n",
+			change.ChangeType().String(),
+			change.Kind().String(),
+			change.FullName(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getImageConfig(
+	ctx context.Context,
+	container appflag.Container,
+	imageConfigReader bufwire.ImageConfigReader,
+	input string,
+	excludeSourceInfo bool,
+	errorFormat string,
+) (bufwire.ImageConfig, error) {
+	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	imageConfig, fileAnnotations, err := imageConfigReader.GetImageConfig(
+		ctx,
+		container,
+		ref,
+		"",
+		nil,
+		false,
+		excludeSourceInfo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stderr(),
+			fileAnnotations,
+			errorFormat,
+		); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("")
+	}
+	return imageConfig, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitdownload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	outputFlagName      = "output"
+	outputFlagShortName = "o"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository:reference>",
+		Short: "Download the files of a commit as stored by the registry.",
+		Long: "Resolves the reference, which may be a commit, tag, or branch, and writes the files of the commit " +
+			"to the output directory as stored by the registry, along with the " + bufmodule.LockFilePath +
+			" file for its dependencies. Unlike buf beta mod export, the files are always downloaded from the " +
+			"registry instead of read from the module cache, and the digest of the downloaded files is verified " +
+			"against the digest of the commit before anything is written.",
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Output string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		"Required. The directory to write the files to.",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if flags.Output == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", outputFlagName)
+	}
+	moduleReference, err := bufmodule.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	resolveService, err := apiProvider.NewResolveService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	protoModulePins, err := resolveService.GetModulePins(
+		ctx,
+		bufmodule.NewProtoModuleReferencesForModuleReferences(moduleReference),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	modulePins, err := bufmodule.NewModulePinsForProtos(protoModulePins...)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	var modulePin bufmodule.ModulePin
+	for _, resolvedModulePin := range modulePins {
+		if resolvedModulePin.IdentityString() == moduleReference.IdentityString() {
+			modulePin = resolvedModulePin
+			break
+		}
+	}
+	if modulePin == nil {
+		return bufcli.NewInternalError(fmt.Errorf("no pin returned for %q", moduleReference.String()))
+	}
+	downloadService, err := apiProvider.NewDownloadService(ctx, modulePin.Remote())
+	if err != nil {
+		return err
+	}
+	protoModule, err := downloadService.Download(
+		ctx,
+		modulePin.Owner(),
+		modulePin.Repository(),
+		modulePin.Commit(),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	module, err := bufmodule.NewModuleForProto(ctx, protoModule)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	// verify before writing so that nothing is written for a mismatched commit
+	if err := bufmodule.ValidateModuleMatchesDigest(ctx, module, modulePin); err != nil {
+		return fmt.Errorf("commit %s: %v", modulePin.Commit(), err)
+	}
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	writeBucket, err := storageosProvider.NewReadWriteBucket(
+		normalpath.Normalize(flags.Output),
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to download commit files into %s: %v", flags.Output, err)
+	}
+	// note that the registry does not store the configuration file, so this
+	// only writes the files and the buf.lock file
+	if err := bufmodule.ModuleToBucket(ctx, module, writeBucket); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
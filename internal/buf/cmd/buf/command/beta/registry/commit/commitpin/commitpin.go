@@ -0,0 +1,199 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitpin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const dirFlagName = "dir"
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository:reference>",
+		Short: "Pin a single dependency to a commit. Updates the " + bufmodule.LockFilePath + " file.",
+		Long: "Resolves the reference, which may be a commit, tag, or branch, and rewrites only the entry for " +
+			"this dependency and its transitive dependencies in the " + bufmodule.LockFilePath + " file. " +
+			"All other dependencies are left as pinned. " +
+			"The dependency must be declared in the deps of the configuration file.",
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	// for testing only
+	Dir string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Dir,
+		dirFlagName,
+		".",
+		"The directory to operate in. For testing only.",
+	)
+	_ = flagSet.MarkHidden(dirFlagName)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleReference, err := bufmodule.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		flags.Dir,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	exists, err := bufconfig.ConfigExists(ctx, readWriteBucket)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	if !exists {
+		return bufcli.ErrNoConfigFile
+	}
+	moduleConfig, err := bufconfig.NewProvider(container.Logger()).GetConfig(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	if !isDeclaredDependency(moduleConfig.Build.DependencyModuleReferences, moduleReference) {
+		return bufcli.NewDependencyNotDeclaredError(moduleReference.IdentityString())
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	service, err := apiProvider.NewResolveService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	protoModulePins, err := service.GetModulePins(
+		ctx,
+		bufmodule.NewProtoModuleReferencesForModuleReferences(moduleReference),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	modulePins, err := bufmodule.NewModulePinsForProtos(protoModulePins...)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	if !containsModulePinForIdentity(modulePins, moduleReference.IdentityString()) {
+		return bufcli.NewInternalError(fmt.Errorf("no pin returned for %q", moduleReference.String()))
+	}
+	module, err := bufmodule.NewModuleForBucket(ctx, readWriteBucket)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	dependencyModulePins := mergeModulePins(module.DependencyModulePins(), modulePins)
+	module, err = bufmodule.NewModuleForBucketWithDependencyModulePins(
+		ctx,
+		readWriteBucket,
+		dependencyModulePins,
+	)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	if err := bufmodule.PutModuleDependencyModulePinsToBucket(ctx, readWriteBucket, module); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
+
+func isDeclaredDependency(
+	dependencyModuleReferences []bufmodule.ModuleReference,
+	moduleReference bufmodule.ModuleReference,
+) bool {
+	for _, dependencyModuleReference := range dependencyModuleReferences {
+		if dependencyModuleReference.IdentityString() == moduleReference.IdentityString() {
+			return true
+		}
+	}
+	return false
+}
+
+func containsModulePinForIdentity(modulePins []bufmodule.ModulePin, identityString string) bool {
+	for _, modulePin := range modulePins {
+		if modulePin.IdentityString() == identityString {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeModulePins replaces the pins in existingModulePins with the pins in
+// resolvedModulePins of the same identity, and appends the resolved pins
+// that are not already present.
+func mergeModulePins(
+	existingModulePins []bufmodule.ModulePin,
+	resolvedModulePins []bufmodule.ModulePin,
+) []bufmodule.ModulePin {
+	identityStringToResolvedModulePin := make(map[string]bufmodule.ModulePin, len(resolvedModulePins))
+	for _, resolvedModulePin := range resolvedModulePins {
+		identityStringToResolvedModulePin[resolvedModulePin.IdentityString()] = resolvedModulePin
+	}
+	mergedModulePins := make([]bufmodule.ModulePin, 0, len(existingModulePins)+len(resolvedModulePins))
+	for _, existingModulePin := range existingModulePins {
+		if resolvedModulePin, ok := identityStringToResolvedModulePin[existingModulePin.IdentityString()]; ok {
+			mergedModulePins = append(mergedModulePins, resolvedModulePin)
+			delete(identityStringToResolvedModulePin, existingModulePin.IdentityString())
+			continue
+		}
+		mergedModulePins = append(mergedModulePins, existingModulePin)
+	}
+	for _, resolvedModulePin := range resolvedModulePins {
+		if _, ok := identityStringToResolvedModulePin[resolvedModulePin.IdentityString()]; ok {
+			mergedModulePins = append(mergedModulePins, resolvedModulePin)
+		}
+	}
+	return mergedModulePins
+}
@@ -0,0 +1,129 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitverifydigest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository:reference>",
+		Short: "Verify that the files of a commit match the digest reported by the registry.",
+		Long: "Resolves the reference, which may be a commit, tag, or branch, downloads the files of the commit " +
+			"from the registry, and recomputes the digest of the files the same way as for the " + bufmodule.LockFilePath +
+			" file. If the recomputed digest does not match the digest the registry reports for the commit, this " +
+			"exits with a non-zero exit code. Nothing is written to disk. Note that both the files and the reported " +
+			"digest come from the registry, so this verifies that the registry is consistent with itself, and the " +
+			"printed digest can be compared with a digest obtained elsewhere, such as a " + bufmodule.LockFilePath + " file.",
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+	}
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+) error {
+	moduleReference, err := bufmodule.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	resolveService, err := apiProvider.NewResolveService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	protoModulePins, err := resolveService.GetModulePins(
+		ctx,
+		bufmodule.NewProtoModuleReferencesForModuleReferences(moduleReference),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	modulePins, err := bufmodule.NewModulePinsForProtos(protoModulePins...)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	var modulePin bufmodule.ModulePin
+	for _, resolvedModulePin := range modulePins {
+		if resolvedModulePin.IdentityString() == moduleReference.IdentityString() {
+			modulePin = resolvedModulePin
+			break
+		}
+	}
+	if modulePin == nil {
+		return bufcli.NewInternalError(fmt.Errorf("no pin returned for %q", moduleReference.String()))
+	}
+	downloadService, err := apiProvider.NewDownloadService(ctx, modulePin.Remote())
+	if err != nil {
+		return err
+	}
+	protoModule, err := downloadService.Download(
+		ctx,
+		modulePin.Owner(),
+		modulePin.Repository(),
+		modulePin.Commit(),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	module, err := bufmodule.NewModuleForProto(ctx, protoModule)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	digest, err := bufmodule.ModuleDigest(ctx, module)
+	if err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	if digest != modulePin.Digest() {
+		return fmt.Errorf(
+			"commit %s: digest mismatch: the registry reported %s but the files have digest %s",
+			modulePin.Commit(),
+			modulePin.Digest(),
+			digest,
+		)
+	}
+	if _, err := fmt.Fprintf(container.Stdout(), "%s %s\n", modulePin.Commit(), digest); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/bufbuild/buf/internal/pkg/storage"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	outputFlagName      = "output"
+	outputFlagShortName = "o"
+	formatFlagName      = "format"
+
+	readmeFilePath = "README.md"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository[:reference]>",
+		Short: "Fetch the rendered documentation for a module.",
+		Long: "The documentation consists of the README of the module and the documentation of every symbol, " +
+			"grouped by file. The reference may be a commit, tag, or branch, and defaults to the main branch.\n\n" +
+			"By default, the documentation is printed to stdout. If --" + outputFlagName + " is set, the README is " +
+			"written to " + readmeFilePath + " in the directory, and the documentation of each file is written to " +
+			"the path of the file with an added .md extension, or .json extension if --" + formatFlagName + " is json.",
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Output string
+	Format string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		"The directory to write the documentation to. If not set, the documentation is printed to stdout.",
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s. The text format is markdown.`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	moduleReference, err := bufmodule.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	service, err := apiProvider.NewDocService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	moduleDocumentation, err := service.GetModuleDocumentation(
+		ctx,
+		moduleReference.Owner(),
+		moduleReference.Repository(),
+		moduleReference.Reference(),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	if flags.Output == "" {
+		moduleDocumentationPrinter, err := bufprint.NewModuleDocumentationPrinter(container.Stdout(), format)
+		if err != nil {
+			return bufcli.NewInternalError(err)
+		}
+		return moduleDocumentationPrinter.PrintModuleDocumentation(ctx, moduleDocumentation)
+	}
+	writeBucket, err := storageos.NewProvider(storageos.ProviderWithSymlinks()).NewReadWriteBucket(
+		normalpath.Normalize(flags.Output),
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write documentation into %s: %v", flags.Output, err)
+	}
+	if moduleDocumentation.Readme != "" {
+		if err := storage.PutPath(ctx, writeBucket, readmeFilePath, []byte(moduleDocumentation.Readme)); err != nil {
+			return err
+		}
+	}
+	extension := ".md"
+	if format == bufprint.FormatJSON {
+		extension = ".json"
+	}
+	for _, fileDocumentation := range moduleDocumentation.FileDocumentations {
+		buffer := bytes.NewBuffer(nil)
+		moduleDocumentationPrinter, err := bufprint.NewModuleDocumentationPrinter(buffer, format)
+		if err != nil {
+			return bufcli.NewInternalError(err)
+		}
+		if err := moduleDocumentationPrinter.PrintFileDocumentation(ctx, fileDocumentation); err != nil {
+			return err
+		}
+		path, err := normalpath.NormalizeAndValidate(fileDocumentation.Path)
+		if err != nil {
+			return bufcli.NewInternalError(err)
+		}
+		if err := storage.PutPath(ctx, writeBucket, path+extension, buffer.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package login
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/netrc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	tokenStdinFlagName = "token-stdin"
+
+	defaultRemote = "buf.build"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " [buf.build]",
+		Short: "Log in to the Buf Schema Registry.",
+		Long: fmt.Sprintf(
+			`This prompts for your Buf API Key, validates it, and writes it to your %s file.
+
+The first argument is the remote to log in to. If not given, the remote is read from
+the BUF_REMOTE environment variable, falling back to default_remote in the buf
+configuration, and then to %q.`,
+			netrc.Filename,
+			defaultRemote,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	TokenStdin bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(
+		&f.TokenStdin,
+		tokenStdinFlagName,
+		false,
+		"Read the Buf API Key from stdin instead of prompting for it.",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	remote, err := getRemote(container)
+	if err != nil {
+		return err
+	}
+	var token string
+	if flags.TokenStdin {
+		data, err := ioutil.ReadAll(container.Stdin())
+		if err != nil {
+			return fmt.Errorf("unable to read token from stdin: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	} else {
+		var err error
+		token, err = bufcli.PromptUserForToken(container, remote)
+		if err != nil {
+			return err
+		}
+	}
+	if token == "" {
+		return appcmd.NewInvalidArgumentError("token cannot be empty")
+	}
+	apiProvider, err := bufcli.NewRegistryProviderForToken(ctx, container, token)
+	if err != nil {
+		return err
+	}
+	service, err := apiProvider.NewAuthnService(ctx, remote)
+	if err != nil {
+		return err
+	}
+	user, err := service.GetCurrentUser(ctx)
+	if err != nil {
+		// unauthenticated errors are wrapped by the error interceptor
+		// with the same guidance as all other registry commands
+		return err
+	}
+	if err := netrc.PutMachine(
+		container,
+		netrc.NewMachine(
+			remote,
+			user.Username,
+			token,
+			"",
+		),
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(container.Stdout(), "Logged in to %s as %s.\n", remote, user.Username); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
+
+func getRemote(container appflag.Container) (string, error) {
+	if container.NumArgs() > 0 {
+		return container.Arg(0), nil
+	}
+	remote, err := bufcli.GetDefaultRemote(container)
+	if err != nil {
+		return "", err
+	}
+	if remote == "" {
+		return defaultRemote, nil
+	}
+	return remote, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/netrc"
+	"github.com/spf13/cobra"
+)
+
+const defaultRemote = "buf.build"
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	return &appcmd.Command{
+		Use:   name + " [buf.build]",
+		Short: "Log out of the Buf Schema Registry.",
+		Long: fmt.Sprintf(
+			`This removes the entry for the remote from your %s file.
+
+The first argument is the remote to log out of. If not given, the remote is read from
+the BUF_REMOTE environment variable, falling back to default_remote in the buf
+configuration, and then to %q.`,
+			netrc.Filename,
+			defaultRemote,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+	}
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+) error {
+	remote, err := getRemote(container)
+	if err != nil {
+		return err
+	}
+	deleted, err := netrc.DeleteMachineForName(container, remote)
+	if err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Logged out of %s.", remote)
+	if !deleted {
+		message = fmt.Sprintf("Not logged in to %s.", remote)
+	}
+	if _, err := fmt.Fprintln(container.Stdout(), message); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
+
+func getRemote(container appflag.Container) (string, error) {
+	if container.NumArgs() > 0 {
+		return container.Arg(0), nil
+	}
+	remote, err := bufcli.GetDefaultRemote(container)
+	if err != nil {
+		return "", err
+	}
+	if remote == "" {
+		return defaultRemote, nil
+	}
+	return remote, nil
+}
@@ -28,7 +28,11 @@ import (
 	"github.com/spf13/pflag"
 )
 
-const formatFlagName = "format"
+const (
+	formatFlagName      = "format"
+	descriptionFlagName = "description"
+	urlFlagName         = "url"
+)
 
 // NewCommand returns a new Command
 func NewCommand(
@@ -51,7 +55,9 @@ func NewCommand(
 }
 
 type flags struct {
-	Format string
+	Format      string
+	Description string
+	URL         string
 }
 
 func newFlags() *flags {
@@ -65,6 +71,18 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		bufprint.FormatText.String(),
 		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
 	)
+	flagSet.StringVar(
+		&f.Description,
+		descriptionFlagName,
+		"",
+		`The organization's description.`,
+	)
+	flagSet.StringVar(
+		&f.URL,
+		urlFlagName,
+		"",
+		`The organization's URL.`,
+	)
 }
 
 func run(
@@ -87,6 +105,8 @@ func run(
 	organization, err := service.CreateOrganization(
 		ctx,
 		moduleOwner.Owner(),
+		flags.Description,
+		flags.URL,
 	)
 	if err != nil {
 		if rpc.GetErrorCode(err) == rpc.ErrorCodeAlreadyExists {
@@ -0,0 +1,177 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizationupdate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	formatFlagName      = "format"
+	descriptionFlagName = "description"
+	urlFlagName         = "url"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/organization>",
+		Short: "Update an existing organization.",
+		Args:  cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format      string
+	Description string
+	URL         string
+
+	// flagSet is kept so that we can tell whether a flag was explicitly set,
+	// which allows fields to be cleared by setting them to the empty string.
+	flagSet *pflag.FlagSet
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	f.flagSet = flagSet
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+	flagSet.StringVar(
+		&f.Description,
+		descriptionFlagName,
+		"",
+		`The organization's new description.`,
+	)
+	flagSet.StringVar(
+		&f.URL,
+		urlFlagName,
+		"",
+		`The organization's new URL.`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleOwner, err := bufmodule.ModuleOwnerForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	updateDescription := flags.flagSet.Changed(descriptionFlagName)
+	updateURL := flags.flagSet.Changed(urlFlagName)
+	if !updateDescription && !updateURL {
+		return appcmd.NewInvalidArgumentErrorf("at least one of --%s or --%s is required.", descriptionFlagName, urlFlagName)
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	service, err := apiProvider.NewOrganizationService(ctx, moduleOwner.Remote())
+	if err != nil {
+		return err
+	}
+	var description *string
+	if updateDescription {
+		description = &flags.Description
+	}
+	var url *string
+	if updateURL {
+		url = &flags.URL
+	}
+	organization, err := updateOrganization(
+		ctx,
+		service,
+		container.Arg(0),
+		moduleOwner.Owner(),
+		description,
+		url,
+	)
+	if err != nil {
+		return err
+	}
+	return bufcli.PrintOrganizations(ctx, moduleOwner.Remote(), container.Stdout(), flags.Format, organization)
+}
+
+// updateOrganization updates the description and the URL of the organization,
+// skipping either if it is nil.
+//
+// The description and the URL are updated by separate RPCs, so if updating the
+// URL fails after the description was updated, the returned error says that the
+// description was updated.
+func updateOrganization(
+	ctx context.Context,
+	service registryv1alpha1api.OrganizationService,
+	name string,
+	owner string,
+	description *string,
+	url *string,
+) (*registryv1alpha1.Organization, error) {
+	var organization *registryv1alpha1.Organization
+	var err error
+	if description != nil {
+		organization, err = service.UpdateOrganizationDescriptionByName(ctx, owner, *description)
+		if err != nil {
+			if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+				return nil, bufcli.NewOrganizationNotFoundError(name)
+			}
+			return nil, err
+		}
+	}
+	if url != nil {
+		organization, err = service.UpdateOrganizationURLByName(ctx, owner, *url)
+		if err != nil {
+			if description != nil {
+				return nil, fmt.Errorf("the description of organization %q was updated, but updating its URL failed: %w", name, err)
+			}
+			if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+				return nil, bufcli.NewOrganizationNotFoundError(name)
+			}
+			return nil, err
+		}
+	}
+	return organization, nil
+}
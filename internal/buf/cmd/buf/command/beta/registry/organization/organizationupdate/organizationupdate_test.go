@@ -0,0 +1,110 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizationupdate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOrganization(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	description := "The Acme organization."
+	url := "https://acme.com"
+
+	organizationService := newTestOrganizationService()
+	organization, err := updateOrganization(ctx, organizationService, "buf.build/acme", "acme", &description, &url)
+	require.NoError(t, err)
+	assert.Equal(t, "The Acme organization.", organization.Description)
+	assert.Equal(t, "https://acme.com", organization.Url)
+
+	// only the URL is updated, and the description is kept
+	url = ""
+	organization, err = updateOrganization(ctx, organizationService, "buf.build/acme", "acme", nil, &url)
+	require.NoError(t, err)
+	assert.Equal(t, "The Acme organization.", organization.Description)
+	assert.Equal(t, "", organization.Url)
+
+	_, err = updateOrganization(ctx, organizationService, "buf.build/foo", "foo", &description, nil)
+	assert.EqualError(t, err, `an organization named "buf.build/foo" does not exist, use "buf beta registry organization create" to create one`)
+}
+
+func TestUpdateOrganizationURLFailure(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	description := "The Acme organization."
+	url := "https://acme.com"
+
+	organizationService := newTestOrganizationService()
+	organizationService.updateURLErr = errors.New("unavailable")
+	_, err := updateOrganization(ctx, organizationService, "buf.build/acme", "acme", &description, &url)
+	assert.EqualError(t, err, `the description of organization "buf.build/acme" was updated, but updating its URL failed: unavailable`)
+	assert.Equal(t, "The Acme organization.", organizationService.organization.Description)
+	assert.Equal(t, "", organizationService.organization.Url)
+
+	// nothing was applied, so there is nothing to report
+	_, err = updateOrganization(ctx, organizationService, "buf.build/acme", "acme", nil, &url)
+	assert.EqualError(t, err, "unavailable")
+}
+
+type testOrganizationService struct {
+	registryv1alpha1api.OrganizationService
+
+	organization *registryv1alpha1.Organization
+	updateURLErr error
+}
+
+func newTestOrganizationService() *testOrganizationService {
+	return &testOrganizationService{
+		organization: &registryv1alpha1.Organization{
+			Name: "acme",
+		},
+	}
+}
+
+func (s *testOrganizationService) UpdateOrganizationDescriptionByName(
+	_ context.Context,
+	name string,
+	description string,
+) (*registryv1alpha1.Organization, error) {
+	if name != s.organization.Name {
+		return nil, rpc.NewNotFoundErrorf("organization %q not found", name)
+	}
+	s.organization.Description = description
+	return s.organization, nil
+}
+
+func (s *testOrganizationService) UpdateOrganizationURLByName(
+	_ context.Context,
+	name string,
+	url string,
+) (*registryv1alpha1.Organization, error) {
+	if s.updateURLErr != nil {
+		return nil, s.updateURLErr
+	}
+	if name != s.organization.Name {
+		return nil, rpc.NewNotFoundErrorf("organization %q not found", name)
+	}
+	s.organization.Url = url
+	return s.organization, nil
+}
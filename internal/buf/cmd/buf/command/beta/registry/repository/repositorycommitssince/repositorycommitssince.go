@@ -0,0 +1,210 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorycommitssince
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	pageSizeFlagName = "page-size"
+	formatFlagName   = "format"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository[:branch]> <tag>",
+		Short: "List the commits on a repository branch since the specified tag.",
+		Long: `The tag is resolved to a commit, and all commits on the branch after that commit,
+up to and including the branch head, are printed from oldest to newest.
+
+If no branch is specified, the "` + bufmodule.MainBranch + `" branch is used.`,
+		Args: cobra.ExactArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	PageSize uint32
+	Format   string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.Uint32Var(&f.PageSize,
+		pageSizeFlagName,
+		100,
+		`The page size used when listing tags and commits from the registry.`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if container.Arg(0) == "" {
+		return appcmd.NewInvalidArgumentError("repository is required")
+	}
+	tagName := container.Arg(1)
+	if tagName == "" {
+		return appcmd.NewInvalidArgumentError("tag is required")
+	}
+	if flags.PageSize == 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be greater than zero", pageSizeFlagName)
+	}
+	moduleReference, err := bufmodule.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if bufmodule.IsCommitModuleReference(moduleReference) {
+		return appcmd.NewInvalidArgumentErrorf("%q references a commit, a branch is required", container.Arg(0))
+	}
+	branchName := moduleReference.Reference()
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	repositoryService, err := apiProvider.NewRepositoryService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	repository, err := repositoryService.GetRepositoryByFullName(ctx, moduleReference.Owner()+"/"+moduleReference.Repository())
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	repositoryTagService, err := apiProvider.NewRepositoryTagService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	var repositoryTag *registryv1alpha1.RepositoryTag
+	var pageToken string
+	for repositoryTag == nil {
+		repositoryTags, nextPageToken, err := repositoryTagService.ListRepositoryTags(
+			ctx,
+			repository.Id,
+			flags.PageSize,
+			pageToken,
+			false,
+		)
+		if err != nil {
+			return err
+		}
+		for _, candidate := range repositoryTags {
+			if candidate.Name == tagName {
+				repositoryTag = candidate
+				break
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	if repositoryTag == nil {
+		return bufcli.NewTagNotFoundError(tagName)
+	}
+	repositoryCommitService, err := apiProvider.NewRepositoryCommitService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	var allRepositoryCommits []*registryv1alpha1.RepositoryCommit
+	pageToken = ""
+	for {
+		repositoryCommits, nextPageToken, err := repositoryCommitService.ListRepositoryCommits(
+			ctx,
+			repository.Id,
+			branchName,
+			flags.PageSize,
+			pageToken,
+			false,
+		)
+		if err != nil {
+			if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+				return bufcli.NewBranchNotFoundError(branchName)
+			}
+			return err
+		}
+		allRepositoryCommits = append(allRepositoryCommits, repositoryCommits...)
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	commitsSince, ok := getRepositoryCommitsSince(allRepositoryCommits, repositoryTag.CommitName)
+	if !ok {
+		return bufcli.NewTagCommitNotOnBranchError(tagName, repositoryTag.CommitName, branchName)
+	}
+	return bufcli.PrintRepositoryCommits(ctx, container.Stdout(), flags.Format, commitsSince...)
+}
+
+// getRepositoryCommitsSince returns the commits created after the commit with
+// the given name, ordered from oldest to newest.
+//
+// Returns false if no commit with the given name is in repositoryCommits.
+func getRepositoryCommitsSince(
+	repositoryCommits []*registryv1alpha1.RepositoryCommit,
+	commitName string,
+) ([]*registryv1alpha1.RepositoryCommit, bool) {
+	sorted := make([]*registryv1alpha1.RepositoryCommit, len(repositoryCommits))
+	copy(sorted, repositoryCommits)
+	sort.SliceStable(
+		sorted,
+		func(i int, j int) bool {
+			return sorted[i].CreateTime.AsTime().Before(sorted[j].CreateTime.AsTime())
+		},
+	)
+	for i, repositoryCommit := range sorted {
+		if repositoryCommit.Name == commitName {
+			return sorted[i+1:], true
+		}
+	}
+	return nil, false
+}
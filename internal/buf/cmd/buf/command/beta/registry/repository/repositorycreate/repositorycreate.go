@@ -21,6 +21,7 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufcli"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/bufprint"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
 	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
@@ -31,18 +32,13 @@ import (
 )
 
 const (
-	formatFlagName     = "format"
-	visibilityFlagName = "visibility"
-
-	publicVisibility  = "public"
-	privateVisibility = "private"
+	formatFlagName        = "format"
+	visibilityFlagName    = "visibility"
+	fromFlagName          = "from"
+	ifNotExistsFlagName   = "if-not-exists"
+	defaultBranchFlagName = "default-branch"
 )
 
-var allVisibiltyStrings = []string{
-	publicVisibility,
-	privateVisibility,
-}
-
 // NewCommand returns a new Command
 func NewCommand(
 	name string,
@@ -64,8 +60,15 @@ func NewCommand(
 }
 
 type flags struct {
-	Format     string
-	Visibility string
+	Format        string
+	Visibility    string
+	From          string
+	IfNotExists   bool
+	DefaultBranch string
+
+	// flagSet is kept so that we can tell whether --visibility was explicitly set,
+	// as an explicit visibility overrides the visibility of --from.
+	flagSet *pflag.FlagSet
 }
 
 func newFlags() *flags {
@@ -73,6 +76,7 @@ func newFlags() *flags {
 }
 
 func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	f.flagSet = flagSet
 	flagSet.StringVar(
 		&f.Format,
 		formatFlagName,
@@ -82,8 +86,37 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(
 		&f.Visibility,
 		visibilityFlagName,
-		publicVisibility,
-		fmt.Sprintf(`The repository's visibility setting. Must be one of %s.`, stringutil.SliceToString(allVisibiltyStrings)),
+		bufcli.PublicVisibility,
+		fmt.Sprintf(`The repository's visibility setting. Must be one of %s.`, stringutil.SliceToString(bufcli.AllVisibilityStrings)),
+	)
+	flagSet.StringVar(
+		&f.From,
+		fromFlagName,
+		"",
+		fmt.Sprintf(
+			`An existing repository of the form buf.build/owner/repository to copy settings from.
+The visibility and default branch of the existing repository are used unless --%s or --%s are explicitly set.
+The content of the existing repository is not copied.`,
+			visibilityFlagName,
+			defaultBranchFlagName,
+		),
+	)
+	flagSet.BoolVar(
+		&f.IfNotExists,
+		ifNotExistsFlagName,
+		false,
+		`Succeed without creating the repository if it already exists.
+A warning is printed to stderr if the visibility of the existing repository differs from the requested visibility.`,
+	)
+	flagSet.StringVar(
+		&f.DefaultBranch,
+		defaultBranchFlagName,
+		"",
+		fmt.Sprintf(
+			`The default branch of the repository. If not set, the default branch of --%s is used, or the server default if --%s is not set.`,
+			fromFlagName,
+			fromFlagName,
+		),
 	)
 }
 
@@ -96,10 +129,22 @@ func run(
 	if err != nil {
 		return appcmd.NewInvalidArgumentError(err.Error())
 	}
-	visibility, err := visibilityFlagToVisibility(flags.Visibility)
+	visibility, err := bufcli.VisibilityFlagToVisibility(flags.Visibility)
 	if err != nil {
 		return appcmd.NewInvalidArgumentError(err.Error())
 	}
+	if flags.DefaultBranch != "" {
+		if err := bufmodule.ValidateBranch(flags.DefaultBranch); err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+	}
+	var fromModuleIdentity bufmodule.ModuleIdentity
+	if flags.From != "" {
+		fromModuleIdentity, err = bufmodule.ModuleIdentityForString(flags.From)
+		if err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+	}
 	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
 	if err != nil {
 		return err
@@ -108,28 +153,134 @@ func run(
 	if err != nil {
 		return err
 	}
+	defaultBranch := flags.DefaultBranch
+	if fromModuleIdentity != nil {
+		fromService, err := apiProvider.NewRepositoryService(ctx, fromModuleIdentity.Remote())
+		if err != nil {
+			return err
+		}
+		visibility, defaultBranch, err = getFromRepositorySettings(
+			ctx,
+			fromService,
+			fromModuleIdentity,
+			flags.From,
+			visibility,
+			flags.flagSet.Changed(visibilityFlagName),
+			defaultBranch,
+		)
+		if err != nil {
+			return err
+		}
+	}
 	repository, err := service.CreateRepositoryByFullName(
 		ctx,
 		moduleIdentity.Owner()+"/"+moduleIdentity.Repository(),
 		visibility,
+		defaultBranch,
 	)
 	if err != nil {
 		if rpc.GetErrorCode(err) == rpc.ErrorCodeAlreadyExists {
-			return bufcli.NewRepositoryNameAlreadyExistsError(container.Arg(0))
+			if !flags.IfNotExists {
+				return bufcli.NewRepositoryNameAlreadyExistsError(container.Arg(0))
+			}
+			repository, err = getExistingRepository(ctx, container, service, moduleIdentity, visibility, defaultBranch)
+			if err != nil {
+				return err
+			}
+		} else {
+			return err
 		}
-		return err
 	}
 	return bufcli.PrintRepositories(ctx, apiProvider, moduleIdentity.Remote(), container.Stdout(), flags.Format, repository)
 }
 
-// visibilityFlagToVisibility parses the given string as a registryv1alpha1.Visibility.
-func visibilityFlagToVisibility(visibility string) (registryv1alpha1.Visibility, error) {
-	switch visibility {
-	case publicVisibility:
-		return registryv1alpha1.Visibility_VISIBILITY_PUBLIC, nil
-	case privateVisibility:
-		return registryv1alpha1.Visibility_VISIBILITY_PRIVATE, nil
-	default:
-		return 0, fmt.Errorf("invalid visibility: %s, expected one of %s", visibility, stringutil.SliceToString(allVisibiltyStrings))
+// getFromRepositorySettings returns the visibility and default branch to create
+// a repository with for --from.
+//
+// The visibility and default branch of the existing repository are used, unless
+// the visibility was explicitly set or the default branch is not empty.
+func getFromRepositorySettings(
+	ctx context.Context,
+	fromService registryv1alpha1api.RepositoryService,
+	fromModuleIdentity bufmodule.ModuleIdentity,
+	from string,
+	visibility registryv1alpha1.Visibility,
+	visibilityChanged bool,
+	defaultBranch string,
+) (registryv1alpha1.Visibility, string, error) {
+	fromRepository, err := fromService.GetRepositoryByFullName(
+		ctx,
+		fromModuleIdentity.Owner()+"/"+fromModuleIdentity.Repository(),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return 0, "", bufcli.NewRepositoryNotFoundError(from)
+		}
+		return 0, "", err
+	}
+	if !visibilityChanged {
+		visibility = fromRepository.Visibility
+	}
+	if defaultBranch == "" {
+		defaultBranch = fromRepository.DefaultBranch
+	}
+	return visibility, defaultBranch, nil
+}
+
+// getExistingRepository gets the existing repository for --if-not-exists, and
+// warns if the existing repository has a different visibility or default branch
+// than requested.
+func getExistingRepository(
+	ctx context.Context,
+	container appflag.Container,
+	service registryv1alpha1api.RepositoryService,
+	moduleIdentity bufmodule.ModuleIdentity,
+	visibility registryv1alpha1.Visibility,
+	defaultBranch string,
+) (*registryv1alpha1.Repository, error) {
+	repository, err := service.GetRepositoryByFullName(
+		ctx,
+		moduleIdentity.Owner()+"/"+moduleIdentity.Repository(),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			// the repository exists but we were not able to get it, which means
+			// it is most likely owned by someone else
+			return nil, bufcli.NewRepositoryNameAlreadyExistsError(moduleIdentity.IdentityString())
+		}
+		return nil, err
+	}
+	if repository.Visibility != visibility {
+		existingVisibilityString, err := bufcli.VisibilityToVisibilityFlag(repository.Visibility)
+		if err != nil {
+			return nil, err
+		}
+		visibilityString, err := bufcli.VisibilityToVisibilityFlag(visibility)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := container.Stderr().Write(
+			[]byte(fmt.Sprintf(
+				"Warning: repository %q already exists with visibility %q, not %q.\n",
+				moduleIdentity.IdentityString(),
+				existingVisibilityString,
+				visibilityString,
+			)),
+		); err != nil {
+			return nil, err
+		}
+	}
+	if defaultBranch != "" && repository.DefaultBranch != defaultBranch {
+		if _, err := container.Stderr().Write(
+			[]byte(fmt.Sprintf(
+				"Warning: repository %q already exists with default branch %q, not %q.\n",
+				moduleIdentity.IdentityString(),
+				repository.DefaultBranch,
+				defaultBranch,
+			)),
+		); err != nil {
+			return nil, err
+		}
 	}
+	return repository, nil
 }
@@ -0,0 +1,101 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorycreate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFromRepositorySettings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	fromService := &testRepositoryService{
+		fullNameToRepository: map[string]*registryv1alpha1.Repository{
+			"acme/weather": {
+				Name:          "weather",
+				Visibility:    registryv1alpha1.Visibility_VISIBILITY_PRIVATE,
+				DefaultBranch: "develop",
+			},
+		},
+	}
+	fromModuleIdentity, err := bufmodule.ModuleIdentityForString("buf.build/acme/weather")
+	require.NoError(t, err)
+
+	visibility, defaultBranch, err := getFromRepositorySettings(
+		ctx,
+		fromService,
+		fromModuleIdentity,
+		"buf.build/acme/weather",
+		registryv1alpha1.Visibility_VISIBILITY_PUBLIC,
+		false,
+		"",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, registryv1alpha1.Visibility_VISIBILITY_PRIVATE, visibility)
+	assert.Equal(t, "develop", defaultBranch)
+
+	// explicitly set values take precedence
+	visibility, defaultBranch, err = getFromRepositorySettings(
+		ctx,
+		fromService,
+		fromModuleIdentity,
+		"buf.build/acme/weather",
+		registryv1alpha1.Visibility_VISIBILITY_PUBLIC,
+		true,
+		"main",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, registryv1alpha1.Visibility_VISIBILITY_PUBLIC, visibility)
+	assert.Equal(t, "main", defaultBranch)
+
+	missingModuleIdentity, err := bufmodule.ModuleIdentityForString("buf.build/acme/missing")
+	require.NoError(t, err)
+	_, _, err = getFromRepositorySettings(
+		ctx,
+		fromService,
+		missingModuleIdentity,
+		"buf.build/acme/missing",
+		registryv1alpha1.Visibility_VISIBILITY_PUBLIC,
+		false,
+		"",
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "buf.build/acme/missing")
+}
+
+type testRepositoryService struct {
+	registryv1alpha1api.RepositoryService
+
+	fullNameToRepository map[string]*registryv1alpha1.Repository
+}
+
+func (s *testRepositoryService) GetRepositoryByFullName(
+	_ context.Context,
+	fullName string,
+) (*registryv1alpha1.Repository, error) {
+	repository, ok := s.fullNameToRepository[fullName]
+	if !ok {
+		return nil, rpc.NewNotFoundErrorf("repository %q not found", fullName)
+	}
+	return repository, nil
+}
@@ -17,22 +17,37 @@ package repositorylist
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/bufbuild/buf/internal/buf/bufcli"
 	"github.com/bufbuild/buf/internal/buf/bufprint"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
 const (
-	pageSizeFlagName  = "page-size"
-	pageTokenFlagName = "page-token"
-	reverseFlagName   = "reverse"
-	formatFlagName    = "format"
+	pageSizeFlagName   = "page-size"
+	pageTokenFlagName  = "page-token"
+	reverseFlagName    = "reverse"
+	formatFlagName     = "format"
+	visibilityFlagName = "visibility"
+	sortFlagName       = "sort"
+
+	sortUpdated = "updated"
+	sortName    = "name"
+	sortCreated = "created"
 )
 
+var allSorts = []string{
+	sortUpdated,
+	sortName,
+	sortCreated,
+}
+
 // NewCommand returns a new Command
 func NewCommand(
 	name string,
@@ -40,9 +55,9 @@ func NewCommand(
 ) *appcmd.Command {
 	flags := newFlags()
 	return &appcmd.Command{
-		Use:   name + " <buf.build>",
+		Use:   name + " [buf.build]",
 		Short: "List repositories.",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
 			func(ctx context.Context, container appflag.Container) error {
 				return run(ctx, container, flags)
@@ -54,10 +69,12 @@ func NewCommand(
 }
 
 type flags struct {
-	PageSize  uint32
-	PageToken string
-	Reverse   bool
-	Format    string
+	PageSize   uint32
+	PageToken  string
+	Reverse    bool
+	Format     string
+	Visibility string
+	Sort       string
 }
 
 func newFlags() *flags {
@@ -78,7 +95,7 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	flagSet.BoolVar(&f.Reverse,
 		reverseFlagName,
 		false,
-		`Reverse the results.`,
+		fmt.Sprintf(`Reverse the results. If --%s is set, this reverses the sort order.`, sortFlagName),
 	)
 	flagSet.StringVar(
 		&f.Format,
@@ -86,6 +103,22 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		bufprint.FormatText.String(),
 		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
 	)
+	flagSet.StringVar(
+		&f.Visibility,
+		visibilityFlagName,
+		"",
+		fmt.Sprintf(`Only list repositories with the given visibility setting. Must be one of %s. If not set, all repositories are listed.`, stringutil.SliceToString(bufcli.AllVisibilityStrings)),
+	)
+	flagSet.StringVar(
+		&f.Sort,
+		sortFlagName,
+		"",
+		fmt.Sprintf(
+			`Sort the repositories in ascending order by the given field. Must be one of %s. If set, all pages starting at --%s are fetched and sorted client-side.`,
+			stringutil.SliceToString(allSorts),
+			pageTokenFlagName,
+		),
+	)
 }
 
 func run(
@@ -93,9 +126,25 @@ func run(
 	container appflag.Container,
 	flags *flags,
 ) error {
-	remote := container.Arg(0)
-	if remote == "" {
-		return appcmd.NewInvalidArgumentError("a module remote must be specified")
+	remote, _, err := bufcli.GetRemoteAndArgs(container, 0)
+	if err != nil {
+		return err
+	}
+	var visibility registryv1alpha1.Visibility
+	if flags.Visibility != "" {
+		var err error
+		visibility, err = bufcli.VisibilityFlagToVisibility(flags.Visibility)
+		if err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+	}
+	if flags.Sort != "" && !stringutil.SliceElementsContained(allSorts, []string{flags.Sort}) {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: %q is not a valid sort, must be one of %s",
+			sortFlagName,
+			flags.Sort,
+			stringutil.SliceToString(allSorts),
+		)
 	}
 	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
 	if err != nil {
@@ -105,14 +154,79 @@ func run(
 	if err != nil {
 		return err
 	}
-	repositories, _, err := service.ListRepositories(
-		ctx,
-		flags.PageSize,
-		flags.PageToken,
-		flags.Reverse,
-	)
-	if err != nil {
-		return err
+	var repositories []*registryv1alpha1.Repository
+	if flags.Sort == "" {
+		repositories, _, err = service.ListRepositories(
+			ctx,
+			flags.PageSize,
+			flags.PageToken,
+			flags.Reverse,
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		pageToken := flags.PageToken
+		for {
+			pageRepositories, nextPageToken, err := service.ListRepositories(
+				ctx,
+				flags.PageSize,
+				pageToken,
+				false,
+			)
+			if err != nil {
+				return err
+			}
+			repositories = append(repositories, pageRepositories...)
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		sortRepositories(repositories, flags.Sort, flags.Reverse)
+	}
+	if visibility != registryv1alpha1.Visibility_VISIBILITY_UNSPECIFIED {
+		// ListRepositories does not support filtering by visibility, so we
+		// filter the fetched page client-side.
+		filteredRepositories := make([]*registryv1alpha1.Repository, 0, len(repositories))
+		for _, repository := range repositories {
+			if repository.Visibility == visibility {
+				filteredRepositories = append(filteredRepositories, repository)
+			}
+		}
+		repositories = filteredRepositories
 	}
 	return bufcli.PrintRepositories(ctx, apiProvider, remote, container.Stdout(), flags.Format, repositories...)
 }
+
+// sortRepositories sorts the repositories by the given field.
+//
+// Repositories with equal values keep the order returned by the server.
+func sortRepositories(repositories []*registryv1alpha1.Repository, sortField string, reverse bool) {
+	var less func(one *registryv1alpha1.Repository, two *registryv1alpha1.Repository) bool
+	switch sortField {
+	case sortUpdated:
+		less = func(one *registryv1alpha1.Repository, two *registryv1alpha1.Repository) bool {
+			return one.UpdateTime.AsTime().Before(two.UpdateTime.AsTime())
+		}
+	case sortName:
+		less = func(one *registryv1alpha1.Repository, two *registryv1alpha1.Repository) bool {
+			return one.Name < two.Name
+		}
+	case sortCreated:
+		less = func(one *registryv1alpha1.Repository, two *registryv1alpha1.Repository) bool {
+			return one.CreateTime.AsTime().Before(two.CreateTime.AsTime())
+		}
+	default:
+		return
+	}
+	sort.SliceStable(
+		repositories,
+		func(i int, j int) bool {
+			if reverse {
+				return less(repositories[j], repositories[i])
+			}
+			return less(repositories[i], repositories[j])
+		},
+	)
+}
@@ -0,0 +1,234 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorylistbranches
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	pageSizeFlagName  = "page-size"
+	pageTokenFlagName = "page-token"
+	reverseFlagName   = "reverse"
+	allFlagName       = "all"
+	formatFlagName    = "format"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository>",
+		Short: "List the branches of a repository along with their head commits.",
+		Long: "The head commit of a branch is the latest commit pushed to it, and is empty " +
+			"if nothing was pushed to the branch yet.",
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	PageSize  uint32
+	PageToken string
+	Reverse   bool
+	All       bool
+	Format    string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.Uint32Var(&f.PageSize,
+		pageSizeFlagName,
+		10,
+		`The page size.`,
+	)
+	flagSet.StringVar(&f.PageToken,
+		pageTokenFlagName,
+		"",
+		`The page token.`,
+	)
+	flagSet.BoolVar(&f.Reverse,
+		reverseFlagName,
+		false,
+		`Reverse the results.`,
+	)
+	flagSet.BoolVar(&f.All,
+		allFlagName,
+		false,
+		fmt.Sprintf(`List every branch by fetching every page, starting from --%s if set.`, pageTokenFlagName),
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if container.Arg(0) == "" {
+		return appcmd.NewInvalidArgumentError("repository is required")
+	}
+	moduleIdentity, err := bufmodule.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	repositoryService, err := apiProvider.NewRepositoryService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	repository, err := repositoryService.GetRepositoryByFullName(ctx, moduleIdentity.Owner()+"/"+moduleIdentity.Repository())
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	repositoryBranchService, err := apiProvider.NewRepositoryBranchService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	repositoryBranches, err := listRepositoryBranches(
+		ctx,
+		repositoryBranchService,
+		repository.Id,
+		flags.PageSize,
+		flags.PageToken,
+		flags.Reverse,
+		flags.All,
+	)
+	if err != nil {
+		return err
+	}
+	resolveService, err := apiProvider.NewResolveService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	headCommitNames := make([]string, len(repositoryBranches))
+	for i, repositoryBranch := range repositoryBranches {
+		headCommitNames[i], err = getHeadCommitName(ctx, resolveService, moduleIdentity, repositoryBranch)
+		if err != nil {
+			return err
+		}
+	}
+	return bufcli.PrintRepositoryBranchesWithHeadCommits(
+		ctx,
+		container.Stdout(),
+		flags.Format,
+		repositoryBranches,
+		headCommitNames,
+	)
+}
+
+// listRepositoryBranches lists the branches of the repository, fetching every
+// page starting from pageToken if all is set.
+func listRepositoryBranches(
+	ctx context.Context,
+	repositoryBranchService registryv1alpha1api.RepositoryBranchService,
+	repositoryID string,
+	pageSize uint32,
+	pageToken string,
+	reverse bool,
+	all bool,
+) ([]*registryv1alpha1.RepositoryBranch, error) {
+	var repositoryBranches []*registryv1alpha1.RepositoryBranch
+	for {
+		pageRepositoryBranches, nextPageToken, err := repositoryBranchService.ListRepositoryBranches(
+			ctx,
+			repositoryID,
+			pageSize,
+			pageToken,
+			reverse,
+		)
+		if err != nil {
+			return nil, err
+		}
+		repositoryBranches = append(repositoryBranches, pageRepositoryBranches...)
+		if !all || nextPageToken == "" {
+			return repositoryBranches, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// getHeadCommitName returns the name of the latest commit on the branch, or
+// the empty string if the branch has no commits.
+func getHeadCommitName(
+	ctx context.Context,
+	resolveService registryv1alpha1api.ResolveService,
+	moduleIdentity bufmodule.ModuleIdentity,
+	repositoryBranch *registryv1alpha1.RepositoryBranch,
+) (string, error) {
+	moduleReference, err := bufmodule.NewModuleReference(
+		moduleIdentity.Remote(),
+		moduleIdentity.Owner(),
+		moduleIdentity.Repository(),
+		repositoryBranch.Name,
+	)
+	if err != nil {
+		return "", bufcli.NewInternalError(err)
+	}
+	protoModulePins, err := resolveService.GetModulePins(
+		ctx,
+		bufmodule.NewProtoModuleReferencesForModuleReferences(moduleReference),
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	// the pins of the dependencies of the branch are also returned
+	for _, protoModulePin := range protoModulePins {
+		if protoModulePin.Remote == moduleIdentity.Remote() &&
+			protoModulePin.Owner == moduleIdentity.Owner() &&
+			protoModulePin.Repository == moduleIdentity.Repository() {
+			return protoModulePin.Commit, nil
+		}
+	}
+	return "", bufcli.NewInternalError(fmt.Errorf("no pin returned for %q", moduleReference.String()))
+}
@@ -0,0 +1,153 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorylistbranches
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	modulev1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/module/v1alpha1"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRepositoryBranches(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repositoryBranchService := &testRepositoryBranchService{
+		branchNames: []string{"main", "dev", "feature"},
+	}
+	repositoryBranches, err := listRepositoryBranches(ctx, repositoryBranchService, "repository-id", 2, "", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main", "dev"}, getBranchNames(repositoryBranches))
+	repositoryBranches, err = listRepositoryBranches(ctx, repositoryBranchService, "repository-id", 2, "2", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature"}, getBranchNames(repositoryBranches))
+	repositoryBranches, err = listRepositoryBranches(ctx, repositoryBranchService, "repository-id", 2, "", false, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main", "dev", "feature"}, getBranchNames(repositoryBranches))
+	repositoryBranches, err = listRepositoryBranches(ctx, repositoryBranchService, "repository-id", 1, "1", false, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "feature"}, getBranchNames(repositoryBranches))
+}
+
+func TestGetHeadCommitName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	moduleIdentity, err := bufmodule.ModuleIdentityForString("buf.build/acme/weather")
+	require.NoError(t, err)
+
+	resolveService := &testResolveService{
+		branchToCommitName: map[string]string{
+			"main": "commit1",
+		},
+	}
+	commitName, err := getHeadCommitName(ctx, resolveService, moduleIdentity, &registryv1alpha1.RepositoryBranch{Name: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, "commit1", commitName)
+	// a branch without commits has no head commit
+	commitName, err = getHeadCommitName(ctx, resolveService, moduleIdentity, &registryv1alpha1.RepositoryBranch{Name: "dev"})
+	require.NoError(t, err)
+	assert.Equal(t, "", commitName)
+}
+
+func getBranchNames(repositoryBranches []*registryv1alpha1.RepositoryBranch) []string {
+	branchNames := make([]string, len(repositoryBranches))
+	for i, repositoryBranch := range repositoryBranches {
+		branchNames[i] = repositoryBranch.Name
+	}
+	return branchNames
+}
+
+type testRepositoryBranchService struct {
+	registryv1alpha1api.RepositoryBranchService
+
+	branchNames []string
+}
+
+// the page token is the index of the first branch of the page
+func (s *testRepositoryBranchService) ListRepositoryBranches(
+	_ context.Context,
+	_ string,
+	pageSize uint32,
+	pageToken string,
+	_ bool,
+) ([]*registryv1alpha1.RepositoryBranch, string, error) {
+	start := 0
+	if pageToken != "" {
+		var err error
+		start, err = strconv.Atoi(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	end := start + int(pageSize)
+	if end > len(s.branchNames) {
+		end = len(s.branchNames)
+	}
+	var repositoryBranches []*registryv1alpha1.RepositoryBranch
+	for _, branchName := range s.branchNames[start:end] {
+		repositoryBranches = append(repositoryBranches, &registryv1alpha1.RepositoryBranch{Name: branchName})
+	}
+	nextPageToken := ""
+	if end < len(s.branchNames) {
+		nextPageToken = strconv.Itoa(end)
+	}
+	return repositoryBranches, nextPageToken, nil
+}
+
+type testResolveService struct {
+	registryv1alpha1api.ResolveService
+
+	branchToCommitName map[string]string
+}
+
+func (s *testResolveService) GetModulePins(
+	_ context.Context,
+	moduleReferences []*modulev1alpha1.ModuleReference,
+) ([]*modulev1alpha1.ModulePin, error) {
+	var modulePins []*modulev1alpha1.ModulePin
+	for _, moduleReference := range moduleReferences {
+		commitName, ok := s.branchToCommitName[moduleReference.Reference]
+		if !ok {
+			return nil, rpc.NewNotFoundError("branch has no commits")
+		}
+		// the pins of dependencies are returned before the pin of the module
+		modulePins = append(
+			modulePins,
+			&modulev1alpha1.ModulePin{
+				Remote:     moduleReference.Remote,
+				Owner:      "googleapis",
+				Repository: "googleapis",
+				Branch:     "main",
+				Commit:     "dependency",
+			},
+			&modulev1alpha1.ModulePin{
+				Remote:     moduleReference.Remote,
+				Owner:      moduleReference.Owner,
+				Repository: moduleReference.Repository,
+				Branch:     moduleReference.Reference,
+				Commit:     commitName,
+			},
+		)
+	}
+	return modulePins, nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorymirrorset
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	formatFlagName = "format"
+	gitFlagName    = "git"
+	branchFlagName = "branch"
+	subdirFlagName = "subdir"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository>",
+		Short: "Mirror a repository from a git remote.",
+		Long: `The registry periodically syncs the module at --subdir on --branch of the
+git remote into the repository. Any existing mirror configuration is replaced.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format string
+	Git    string
+	Branch string
+	Subdir string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s.`, bufprint.AllFormatsString),
+	)
+	flagSet.StringVar(
+		&f.Git,
+		gitFlagName,
+		"",
+		`The URL of the git remote to mirror. Must use the https, http, ssh, or git scheme, or the scp-like syntax user@host:path.`,
+	)
+	flagSet.StringVar(
+		&f.Branch,
+		branchFlagName,
+		"",
+		`The git branch to mirror.`,
+	)
+	flagSet.StringVar(
+		&f.Subdir,
+		subdirFlagName,
+		"",
+		`The relative path of the module within the git remote. Defaults to the root of the git remote.`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleIdentity, err := bufmodule.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if flags.Git == "" {
+		return bufcli.NewFlagIsRequiredError(gitFlagName)
+	}
+	if err := validateGitURL(flags.Git); err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", gitFlagName, err)
+	}
+	if flags.Branch == "" {
+		return bufcli.NewFlagIsRequiredError(branchFlagName)
+	}
+	subdir, err := normalizeSubdir(flags.Subdir)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", subdirFlagName, err)
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	service, err := apiProvider.NewRepositoryMirrorService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	repositoryMirror, err := service.SetRepositoryMirrorByFullName(
+		ctx,
+		moduleIdentity.Owner()+"/"+moduleIdentity.Repository(),
+		flags.Git,
+		flags.Branch,
+		subdir,
+	)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	return bufcli.PrintRepositoryMirrors(ctx, container.Stdout(), flags.Format, repositoryMirror)
+}
+
+// validateGitURL validates that the git URL can be reached by the registry.
+//
+// Local schemes such as file:// are rejected, as the registry clones the git
+// remote on its side. The scp-like syntax for ssh such as
+// git@github.com:acme/weather.git is accepted.
+func validateGitURL(gitURL string) error {
+	if host, path, ok := splitSCPLikeGitURL(gitURL); ok {
+		if host == "" {
+			return fmt.Errorf("invalid git url %q: must have a host", gitURL)
+		}
+		if path == "" || path == "/" {
+			return fmt.Errorf("invalid git url %q: must have a path", gitURL)
+		}
+		return nil
+	}
+	parsedURL, err := url.Parse(gitURL)
+	if err != nil {
+		return fmt.Errorf("invalid git url %q: %v", gitURL, err)
+	}
+	switch parsedURL.Scheme {
+	case "https", "http", "ssh", "git":
+	case "":
+		return fmt.Errorf("invalid git url %q: must have a scheme of https, http, ssh, or git", gitURL)
+	default:
+		return fmt.Errorf("invalid git url %q: unsupported scheme %q, must be one of https, http, ssh, or git", gitURL, parsedURL.Scheme)
+	}
+	if parsedURL.Host == "" {
+		return fmt.Errorf("invalid git url %q: must have a host", gitURL)
+	}
+	if parsedURL.Path == "" || parsedURL.Path == "/" {
+		return fmt.Errorf("invalid git url %q: must have a path", gitURL)
+	}
+	return nil
+}
+
+// splitSCPLikeGitURL splits a git URL of the scp-like form [user@]host:path
+// into the host and path.
+//
+// Like git, this is only recognized if there is no scheme and no slash
+// before the first colon, otherwise ok is false.
+func splitSCPLikeGitURL(gitURL string) (host string, path string, ok bool) {
+	if strings.Contains(gitURL, "://") {
+		return "", "", false
+	}
+	colonIndex := strings.Index(gitURL, ":")
+	if colonIndex < 0 || strings.Contains(gitURL[:colonIndex], "/") {
+		return "", "", false
+	}
+	host = gitURL[:colonIndex]
+	if atIndex := strings.LastIndex(host, "@"); atIndex >= 0 {
+		host = host[atIndex+1:]
+	}
+	return host, gitURL[colonIndex+1:], true
+}
+
+// normalizeSubdir normalizes and validates the subdir, returning
+// the empty string if the subdir is the root of the git remote.
+func normalizeSubdir(subdir string) (string, error) {
+	if subdir == "" {
+		return "", nil
+	}
+	normalizedSubdir, err := normalpath.NormalizeAndValidate(subdir)
+	if err != nil {
+		return "", err
+	}
+	switch normalizedSubdir {
+	case ".":
+		return "", nil
+	case "..":
+		// normalpath.NormalizeAndValidate only catches paths that
+		// jump context with a trailing component.
+		return "", fmt.Errorf("%s: is outside the context directory", subdir)
+	}
+	return normalizedSubdir, nil
+}
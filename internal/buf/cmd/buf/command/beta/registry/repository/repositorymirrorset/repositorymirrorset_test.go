@@ -0,0 +1,72 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorymirrorset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGitURL(t *testing.T) {
+	t.Parallel()
+	for _, gitURL := range []string{
+		"https://github.com/acme/weather.git",
+		"http://git.acme.com/weather",
+		"ssh://git@github.com/acme/weather.git",
+		"git://github.com/acme/weather.git",
+		"git@github.com:acme/weather.git",
+		"github.com:acme/weather",
+	} {
+		assert.NoError(t, validateGitURL(gitURL), gitURL)
+	}
+	for _, gitURL := range []string{
+		"",
+		"github.com/acme/weather",
+		"git@:acme/weather.git",
+		"git@github.com:",
+		"file:///tmp/weather",
+		"https:///acme/weather.git",
+		"https://github.com",
+		"https://github.com/",
+	} {
+		assert.Error(t, validateGitURL(gitURL), gitURL)
+	}
+}
+
+func TestNormalizeSubdir(t *testing.T) {
+	t.Parallel()
+	for input, expected := range map[string]string{
+		"":            "",
+		".":           "",
+		"./":          "",
+		"proto":       "proto",
+		"proto/":      "proto",
+		"./a/../b/c/": "b/c",
+	} {
+		subdir, err := normalizeSubdir(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, subdir, input)
+	}
+	for _, input := range []string{
+		"..",
+		"../proto",
+		"/proto",
+	} {
+		_, err := normalizeSubdir(input)
+		assert.Error(t, err, input)
+	}
+}
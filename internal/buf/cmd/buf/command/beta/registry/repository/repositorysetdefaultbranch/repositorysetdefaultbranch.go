@@ -0,0 +1,157 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorysetdefaultbranch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+)
+
+// listRepositoryBranchesPageSize is the page size used when checking
+// that the branch exists.
+const listRepositoryBranchesPageSize = 100
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository:branch>",
+		Short: "Set the default branch of a repository.",
+		Long:  `The branch must already exist on the repository.`,
+		Args:  cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+	}
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+) error {
+	moduleReference, err := bufmodule.ModuleReferenceForString(
+		container.Arg(0),
+	)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if bufmodule.IsCommitModuleReference(moduleReference) {
+		return appcmd.NewInvalidArgumentErrorf("branch is required but commit was given: %q", container.Arg(0))
+	}
+	repositoryFullName := moduleReference.Owner() + "/" + moduleReference.Repository()
+	repositoryName := moduleReference.Remote() + "/" + repositoryFullName
+	branch := moduleReference.Reference()
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	repositoryService, err := apiProvider.NewRepositoryService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	repositoryBranchService, err := apiProvider.NewRepositoryBranchService(ctx, moduleReference.Remote())
+	if err != nil {
+		return err
+	}
+	repository, err := repositoryService.GetRepositoryByFullName(ctx, repositoryFullName)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(repositoryName)
+		}
+		return err
+	}
+	branchExists, err := repositoryBranchExists(ctx, repositoryBranchService, repository.Id, branch)
+	if err != nil {
+		return err
+	}
+	if !branchExists {
+		return bufcli.NewBranchNotFoundError(moduleReference.String())
+	}
+	oldDefaultBranch := repository.DefaultBranch
+	if oldDefaultBranch == "" {
+		// repositories created before default branches were configurable
+		// implicitly default to the main branch
+		oldDefaultBranch = bufmodule.MainBranch
+	}
+	repository, err = repositoryService.UpdateRepositoryDefaultBranchByFullName(ctx, repositoryFullName, branch)
+	if err != nil {
+		switch rpc.GetErrorCode(err) {
+		case rpc.ErrorCodeNotFound:
+			// the branch could have been deleted after we checked that it exists
+			return bufcli.NewBranchNotFoundError(moduleReference.String())
+		default:
+			return err
+		}
+	}
+	newDefaultBranch := repository.GetDefaultBranch()
+	if newDefaultBranch == "" {
+		newDefaultBranch = branch
+	}
+	if _, err := fmt.Fprintf(
+		container.Stdout(),
+		"Default branch of %s changed from %q to %q.\n",
+		repositoryName,
+		oldDefaultBranch,
+		newDefaultBranch,
+	); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
+
+// repositoryBranchExists returns true if the repository has a branch
+// with the given name.
+func repositoryBranchExists(
+	ctx context.Context,
+	repositoryBranchService registryv1alpha1api.RepositoryBranchService,
+	repositoryID string,
+	branch string,
+) (bool, error) {
+	var pageToken string
+	for {
+		repositoryBranches, nextPageToken, err := repositoryBranchService.ListRepositoryBranches(
+			ctx,
+			repositoryID,
+			listRepositoryBranchesPageSize,
+			pageToken,
+			false,
+		)
+		if err != nil {
+			return false, err
+		}
+		for _, repositoryBranch := range repositoryBranches {
+			if repositoryBranch.Name == branch {
+				return true, nil
+			}
+		}
+		if nextPageToken == "" {
+			return false, nil
+		}
+		pageToken = nextPageToken
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorytransfer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	toFlagName    = "to"
+	forceFlagName = "force"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository> --" + toFlagName + " <owner>",
+		Short: "Transfer a repository to a new owner.",
+		Long: "The new owner must be an existing organization on the same remote. " +
+			"The new name of the repository is printed.",
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	To    string
+	Force bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.To,
+		toFlagName,
+		"",
+		`The name of the organization to transfer the repository to, i.e. "acme". Required.`,
+	)
+	flagSet.BoolVar(
+		&f.Force,
+		forceFlagName,
+		false,
+		"Force the transfer without confirming. Use with caution.",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if flags.To == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", toFlagName)
+	}
+	if strings.Contains(flags.To, "/") {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be the name of an organization on the same remote, but was %q", toFlagName, flags.To)
+	}
+	moduleIdentity, err := bufmodule.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	repositoryFullName := moduleIdentity.Owner() + "/" + moduleIdentity.Repository()
+	organizationName := moduleIdentity.Remote() + "/" + flags.To
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	repositoryService, err := apiProvider.NewRepositoryService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	organizationService, err := apiProvider.NewOrganizationService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	repository, err := repositoryService.GetRepositoryByFullName(ctx, repositoryFullName)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	organization, err := organizationService.GetOrganizationByName(ctx, flags.To)
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewOrganizationNotFoundError(organizationName)
+		}
+		return err
+	}
+	if repository.GetOrganizationId() != "" && repository.GetOrganizationId() == organization.Id {
+		return appcmd.NewInvalidArgumentErrorf("repository %q is already owned by %q", container.Arg(0), organizationName)
+	}
+	if !flags.Force {
+		if err := bufcli.PromptUserForTransfer(container, "repository", container.Arg(0), organizationName); err != nil {
+			return err
+		}
+	}
+	newRepositoryName := organizationName + "/" + moduleIdentity.Repository()
+	repository, err = repositoryService.TransferRepositoryByFullName(ctx, repositoryFullName, flags.To)
+	if err != nil {
+		switch rpc.GetErrorCode(err) {
+		case rpc.ErrorCodeNotFound:
+			// the repository could have been deleted after we checked that it exists
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		case rpc.ErrorCodeAlreadyExists:
+			return bufcli.NewRepositoryNameAlreadyExistsError(newRepositoryName)
+		default:
+			return err
+		}
+	}
+	if name := repository.GetName(); name != "" {
+		newRepositoryName = organizationName + "/" + name
+	}
+	if _, err := fmt.Fprintln(container.Stdout(), newRepositoryName); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	typeFlagName      = "type"
+	ownerFlagName     = "owner"
+	pageSizeFlagName  = "page-size"
+	pageTokenFlagName = "page-token"
+	formatFlagName    = "format"
+
+	typeRepository = "repository"
+)
+
+// allTypes are the types that can be searched for.
+//
+// Only repositories can be searched for now.
+var allTypes = []string{
+	typeRepository,
+}
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " [buf.build] <query>",
+		Short: "Search the registry.",
+		Long:  "Results whose name or owner name matches the query are listed. Only results you can access are listed.",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Type      string
+	Owner     string
+	PageSize  uint32
+	PageToken string
+	Format    string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Type,
+		typeFlagName,
+		typeRepository,
+		fmt.Sprintf(`The type of result to search for. Must be one of %s.`, stringutil.SliceToString(allTypes)),
+	)
+	flagSet.StringVar(
+		&f.Owner,
+		ownerFlagName,
+		"",
+		`Only search the results owned by the user or organization with the given name, i.e. "acme".`,
+	)
+	flagSet.Uint32Var(&f.PageSize,
+		pageSizeFlagName,
+		10,
+		`The page size.`,
+	)
+	flagSet.StringVar(&f.PageToken,
+		pageTokenFlagName,
+		"",
+		`The page token.`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if !stringutil.SliceElementsContained(allTypes, []string{flags.Type}) {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: %q is not a valid type, must be one of %s",
+			typeFlagName,
+			flags.Type,
+			stringutil.SliceToString(allTypes),
+		)
+	}
+	remote, args, err := bufcli.GetRemoteAndArgs(container, 1)
+	if err != nil {
+		return err
+	}
+	query := args[0]
+	if query == "" {
+		return appcmd.NewInvalidArgumentError("a query must be specified")
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	service, err := apiProvider.NewRepositoryService(ctx, remote)
+	if err != nil {
+		return err
+	}
+	repositories, _, err := service.SearchRepositories(
+		ctx,
+		query,
+		flags.Owner,
+		flags.PageSize,
+		flags.PageToken,
+	)
+	if err != nil {
+		if flags.Owner != "" && rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return fmt.Errorf("a user or organization named %q does not exist", remote+"/"+flags.Owner)
+		}
+		return err
+	}
+	return bufcli.PrintRepositories(ctx, apiProvider, remote, container.Stdout(), flags.Format, repositories...)
+}
@@ -0,0 +1,171 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taghistory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufprint"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	pageSizeFlagName  = "page-size"
+	pageTokenFlagName = "page-token"
+	reverseFlagName   = "reverse"
+	allFlagName       = "all"
+	formatFlagName    = "format"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository> <tag>",
+		Short: "List the commits a tag has pointed to.",
+		Long: "Prints every commit the tag has pointed to, including the current one, with the time the tag was created at or moved to it. " +
+			"Entries are printed from the most recent to the oldest unless --" + reverseFlagName + " is set.",
+		Args: cobra.ExactArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	PageSize  uint32
+	PageToken string
+	Reverse   bool
+	All       bool
+	Format    string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.Uint32Var(&f.PageSize,
+		pageSizeFlagName,
+		10,
+		`The page size.`,
+	)
+	flagSet.StringVar(&f.PageToken,
+		pageTokenFlagName,
+		"",
+		`The page token.`,
+	)
+	flagSet.BoolVar(&f.Reverse,
+		reverseFlagName,
+		false,
+		`Reverse the results.`,
+	)
+	flagSet.BoolVar(&f.All,
+		allFlagName,
+		false,
+		fmt.Sprintf(`List the whole history by fetching every page, starting from --%s if set.`, pageTokenFlagName),
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleIdentity, err := bufmodule.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	tag := container.Arg(1)
+	if tag == "" {
+		return appcmd.NewInvalidArgumentError("tag is required")
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	repositoryService, err := apiProvider.NewRepositoryService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	repository, err := repositoryService.GetRepositoryByFullName(ctx, moduleIdentity.Owner()+"/"+moduleIdentity.Repository())
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	repositoryTagService, err := apiProvider.NewRepositoryTagService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	var repositoryTagHistoryEntries []*registryv1alpha1.RepositoryTagHistoryEntry
+	pageToken := flags.PageToken
+	for {
+		pageRepositoryTagHistoryEntries, nextPageToken, err := repositoryTagService.ListRepositoryTagHistory(
+			ctx,
+			repository.Id,
+			tag,
+			flags.PageSize,
+			pageToken,
+			flags.Reverse,
+		)
+		if err != nil {
+			switch rpc.GetErrorCode(err) {
+			case rpc.ErrorCodeNotFound:
+				return bufcli.NewTagNotFoundError(container.Arg(0) + ":" + tag)
+			case rpc.ErrorCodeUnimplemented:
+				// older registries do not record the history of tags, which is
+				// not an error in this command
+				if _, err := fmt.Fprintf(
+					container.Stderr(),
+					"The registry at %s does not record tag history, use \"buf beta registry tag list\" to see the commit the tag currently points to.\n",
+					moduleIdentity.Remote(),
+				); err != nil {
+					return bufcli.NewInternalError(err)
+				}
+				return nil
+			}
+			return err
+		}
+		repositoryTagHistoryEntries = append(repositoryTagHistoryEntries, pageRepositoryTagHistoryEntries...)
+		if !flags.All || nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return bufcli.PrintRepositoryTagHistoryEntries(ctx, container.Stdout(), flags.Format, repositoryTagHistoryEntries...)
+}
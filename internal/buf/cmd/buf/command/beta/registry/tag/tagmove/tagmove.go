@@ -0,0 +1,169 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagmove
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const forceFlagName = "force"
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository> <tag> <commit>",
+		Short: "Move a tag to the specified commit.",
+		Long: "Moves the tag to the commit atomically, printing the previous and new commit. " +
+			"If the tag does not exist, it is created. Moving an existing tag requires --" + forceFlagName + ".",
+		Args: cobra.ExactArgs(3),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Force bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(
+		&f.Force,
+		forceFlagName,
+		false,
+		"Move the tag if it already exists. Use with caution.",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleIdentity, err := bufmodule.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	tag := container.Arg(1)
+	if tag == "" {
+		return appcmd.NewInvalidArgumentError("tag is required")
+	}
+	commit := container.Arg(2)
+	if err := bufmodule.ValidateCommit(commit); err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	moduleReference, err := bufmodule.NewModuleReference(
+		moduleIdentity.Remote(),
+		moduleIdentity.Owner(),
+		moduleIdentity.Repository(),
+		commit,
+	)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	apiProvider, err := bufcli.NewRegistryProvider(ctx, container)
+	if err != nil {
+		return err
+	}
+	repositoryService, err := apiProvider.NewRepositoryService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	resolveService, err := apiProvider.NewResolveService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	repositoryTagService, err := apiProvider.NewRepositoryTagService(ctx, moduleIdentity.Remote())
+	if err != nil {
+		return err
+	}
+	repository, err := repositoryService.GetRepositoryByFullName(ctx, moduleIdentity.Owner()+"/"+moduleIdentity.Repository())
+	if err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	// validate that the commit exists before touching the tag
+	if _, err := resolveService.GetModulePins(
+		ctx,
+		bufmodule.NewProtoModuleReferencesForModuleReferences(moduleReference),
+	); err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeNotFound {
+			return bufcli.NewCommitNotFoundError(container.Arg(0) + ":" + commit)
+		}
+		return err
+	}
+	message, err := setTag(ctx, repositoryTagService, repository.Id, container.Arg(0), tag, commit, flags.Force)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(container.Stdout(), message); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
+
+// setTag creates the tag at the commit, or moves it to the commit if it
+// already exists and force is set, and returns the message to print.
+func setTag(
+	ctx context.Context,
+	repositoryTagService registryv1alpha1api.RepositoryTagService,
+	repositoryID string,
+	repositoryName string,
+	tag string,
+	commit string,
+	force bool,
+) (string, error) {
+	if force {
+		repositoryTag, previousCommitName, err := repositoryTagService.UpdateRepositoryTag(ctx, repositoryID, tag, commit)
+		if err == nil {
+			return fmt.Sprintf("Tag %s moved from commit %s to commit %s.", tag, previousCommitName, repositoryTag.CommitName), nil
+		}
+		// the repository is known to exist, so the tag does not exist yet
+		if rpc.GetErrorCode(err) != rpc.ErrorCodeNotFound {
+			return "", err
+		}
+	}
+	if _, err := repositoryTagService.CreateRepositoryTag(ctx, tag, commit, repositoryID); err != nil {
+		if rpc.GetErrorCode(err) == rpc.ErrorCodeAlreadyExists {
+			return "", fmt.Errorf("%v, use --%s to move it", bufcli.NewTagNameAlreadyExistsError(repositoryName+":"+tag), forceFlagName)
+		}
+		return "", err
+	}
+	return fmt.Sprintf("Tag %s created at commit %s.", tag, commit), nil
+}
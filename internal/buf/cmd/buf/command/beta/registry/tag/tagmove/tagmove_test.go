@@ -0,0 +1,88 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagmove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/buf/internal/gen/proto/api/buf/alpha/registry/v1alpha1/registryv1alpha1api"
+	registryv1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/internal/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTag(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repositoryTagService := newTestRepositoryTagService()
+	_, err := setTag(ctx, repositoryTagService, "repository-id", "buf.build/acme/weather", "v1", "commit1", false)
+	require.NoError(t, err)
+	_, err = setTag(ctx, repositoryTagService, "repository-id", "buf.build/acme/weather", "v1", "commit2", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use --force to move it")
+	assert.Equal(t, "commit1", repositoryTagService.tagToCommitName["v1"])
+
+	message, err := setTag(ctx, repositoryTagService, "repository-id", "buf.build/acme/weather", "v1", "commit2", true)
+	require.NoError(t, err)
+	assert.Equal(t, "Tag v1 moved from commit commit1 to commit commit2.", message)
+	assert.Equal(t, "commit2", repositoryTagService.tagToCommitName["v1"])
+
+	message, err = setTag(ctx, repositoryTagService, "repository-id", "buf.build/acme/weather", "v2", "commit2", true)
+	require.NoError(t, err)
+	assert.Equal(t, "Tag v2 created at commit commit2.", message)
+	assert.Equal(t, "commit2", repositoryTagService.tagToCommitName["v2"])
+}
+
+type testRepositoryTagService struct {
+	registryv1alpha1api.RepositoryTagService
+
+	tagToCommitName map[string]string
+}
+
+func newTestRepositoryTagService() *testRepositoryTagService {
+	return &testRepositoryTagService{
+		tagToCommitName: make(map[string]string),
+	}
+}
+
+func (s *testRepositoryTagService) CreateRepositoryTag(
+	_ context.Context,
+	name string,
+	commitName string,
+	_ string,
+) (*registryv1alpha1.RepositoryTag, error) {
+	if _, ok := s.tagToCommitName[name]; ok {
+		return nil, rpc.NewAlreadyExistsErrorf("tag %q already exists", name)
+	}
+	s.tagToCommitName[name] = commitName
+	return &registryv1alpha1.RepositoryTag{Name: name, CommitName: commitName}, nil
+}
+
+func (s *testRepositoryTagService) UpdateRepositoryTag(
+	_ context.Context,
+	_ string,
+	name string,
+	commitName string,
+) (*registryv1alpha1.RepositoryTag, string, error) {
+	previousCommitName, ok := s.tagToCommitName[name]
+	if !ok {
+		return nil, "", rpc.NewNotFoundErrorf("tag %q not found", name)
+	}
+	s.tagToCommitName[name] = commitName
+	return &registryv1alpha1.RepositoryTag{Name: name, CommitName: commitName}, previousCommitName, nil
+}
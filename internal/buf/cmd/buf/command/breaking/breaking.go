@@ -22,9 +22,10 @@ import (
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/buf/bufcheck/bufbreaking"
 	"github.com/bufbuild/buf/internal/buf/bufcli"
-	"github.com/bufbuild/buf/internal/buf/bufconfig"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagebuild"
 	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/buf/bufwire"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
@@ -34,13 +35,20 @@ import (
 )
 
 const (
-	errorFormatFlagName       = "error-format"
-	excludeImportsFlagName    = "exclude-imports"
-	pathsFlagName             = "path"
-	limitToInputFilesFlagName = "limit-to-input-files"
-	configFlagName            = "config"
-	againstFlagName           = "against"
-	againstConfigFlagName     = "against-config"
+	errorFormatFlagName          = "error-format"
+	excludeImportsFlagName       = "exclude-imports"
+	pathsFlagName                = "path"
+	limitToInputFilesFlagName    = "limit-to-input-files"
+	ignoreFileMovesFlagName      = "ignore-file-moves"
+	configFlagName               = "config"
+	againstFlagName              = "against"
+	againstConfigFlagName        = "against-config"
+	configOverrideFileFlagName   = "config-override-file"
+	exitCodeFlagName             = "exit-code"
+	onlyFlagName                 = "only"
+	ignoreFlagName               = "ignore"
+	maxErrorsFlagName            = "max-errors"
+	experimentalEditionsFlagName = "experimental-editions"
 
 	// deprecated
 	inputFlagName = "input"
@@ -81,13 +89,20 @@ func NewCommand(
 }
 
 type flags struct {
-	ErrorFormat       string
-	ExcludeImports    bool
-	LimitToInputFiles bool
-	Paths             []string
-	Config            string
-	Against           string
-	AgainstConfig     string
+	ErrorFormat          string
+	ExcludeImports       bool
+	LimitToInputFiles    bool
+	IgnoreFileMoves      bool
+	Paths                []string
+	Config               string
+	Against              string
+	AgainstConfig        string
+	ConfigOverrideFile   string
+	ExitCode             int
+	Only                 []string
+	Ignore               []string
+	MaxErrors            int
+	ExperimentalEditions bool
 
 	// deprecated
 	Input string
@@ -131,17 +146,33 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		false,
 		fmt.Sprintf(
 			`Only run breaking checks against the files in the input.
-This has the effect of filtering the against input to only contain the files in the input.
-Overrides --%s.`,
+This has the effect of filtering the against input to only contain the files in the input,
+and only reporting breaking changes for files that exist in the input, so that files
+that were deleted or moved elsewhere are not reported.
+Overrides --%s for the against input.`,
 			pathsFlagName,
 		),
 	)
+	flagSet.BoolVar(
+		&f.IgnoreFileMoves,
+		ignoreFileMovesFlagName,
+		false,
+		`Do not treat files that were moved to a new path as deleted.
+A file in the against input is considered moved if its path no longer exists, and exactly one
+new file has the same package and defines all of its top-level declarations. The moved file is
+then checked against the file at its new path.`,
+	)
 	flagSet.StringVar(
 		&f.Config,
 		configFlagName,
 		"",
 		`The config file or data to use.`,
 	)
+	bufcli.BindConfigOverrideFile(flagSet, &f.ConfigOverrideFile, configOverrideFileFlagName, configFlagName)
+	bufcli.BindViolationsExitCode(flagSet, &f.ExitCode, exitCodeFlagName)
+	bufcli.BindMaxErrors(flagSet, &f.MaxErrors, maxErrorsFlagName)
+	bufcli.BindExperimentalEditions(flagSet, &f.ExperimentalEditions, experimentalEditionsFlagName)
+	bufcli.BindOnlyAndIgnoreRuleIDs(flagSet, &f.Only, onlyFlagName, &f.Ignore, ignoreFlagName)
 	flagSet.StringVar(
 		&f.Against,
 		againstFlagName,
@@ -220,6 +251,12 @@ func run(
 	flags *flags,
 	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
 ) error {
+	if err := bufcli.CheckViolationsExitCode(flags.ExitCode, exitCodeFlagName); err != nil {
+		return err
+	}
+	if err := bufcli.CheckMaxErrors(flags.MaxErrors, maxErrorsFlagName); err != nil {
+		return err
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, flags.Input, inputFlagName, ".")
 	if err != nil {
 		return err
@@ -267,7 +304,10 @@ func run(
 	if err != nil {
 		return err
 	}
-	configProvider := bufconfig.NewProvider(container.Logger())
+	configProvider, err := bufcli.NewConfigProvider(container.Logger(), flags.ConfigOverrideFile)
+	if err != nil {
+		return err
+	}
 	moduleResolver, err := moduleResolverReaderProvider.GetModuleResolver(ctx, container)
 	if err != nil {
 		return err
@@ -276,6 +316,13 @@ func run(
 	if err != nil {
 		return err
 	}
+	var imageConfigReaderOptions []bufwire.ImageConfigReaderOption
+	if flags.ExperimentalEditions {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithBuildOptions(bufimagebuild.WithExperimentalEditions()),
+		)
+	}
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
 	imageConfig, fileAnnotations, err := bufcli.NewWireImageConfigReader(
 		container.Logger(),
@@ -283,6 +330,7 @@ func run(
 		configProvider,
 		moduleResolver,
 		moduleReader,
+		imageConfigReaderOptions...,
 	).GetImageConfig(
 		ctx,
 		container,
@@ -300,6 +348,7 @@ func run(
 			container.Stdout(),
 			fileAnnotations,
 			flags.ErrorFormat,
+			bufanalysis.PrintFileAnnotationsWithMaxCount(flags.MaxErrors),
 		); err != nil {
 			return err
 		}
@@ -310,16 +359,12 @@ func run(
 		image = bufimage.ImageWithoutImports(image)
 	}
 
-	// TODO: this doesn't actually work because we're using the same file paths for both sides
-	// if the roots change, then we're torched
 	externalPaths := paths
 	if flags.LimitToInputFiles {
-		files := image.Files()
-		// we know that the file descriptors have unique names from validation
-		externalPaths = make([]string, len(files))
-		for i, file := range files {
-			externalPaths[i] = file.ExternalPath()
-		}
+		// we filter the against image by root relative paths once it is built instead
+		// of by external paths, as the external paths of the input and the against
+		// input will differ if they are different types of inputs
+		externalPaths = nil
 	}
 
 	againstRef, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, againstInput)
@@ -332,6 +377,7 @@ func run(
 		configProvider,
 		moduleResolver,
 		moduleReader,
+		imageConfigReaderOptions...,
 	).GetImageConfig(
 		ctx,
 		container,
@@ -349,6 +395,7 @@ func run(
 			container.Stdout(),
 			fileAnnotations,
 			flags.ErrorFormat,
+			bufanalysis.PrintFileAnnotationsWithMaxCount(flags.MaxErrors),
 		); err != nil {
 			return err
 		}
@@ -358,24 +405,80 @@ func run(
 	if flags.ExcludeImports {
 		againstImage = bufimage.ImageWithoutImports(againstImage)
 	}
+	var inputFilePaths map[string]struct{}
+	if flags.LimitToInputFiles {
+		files := image.Files()
+		// we know that the file descriptors have unique names from validation
+		inputFilePaths = make(map[string]struct{}, len(files))
+		paths := make([]string, len(files))
+		for i, file := range files {
+			inputFilePaths[file.Path()] = struct{}{}
+			paths[i] = file.Path()
+		}
+		againstImage, err = bufimage.ImageWithOnlyPathsAllowNotExist(againstImage, paths)
+		if err != nil {
+			return err
+		}
+	}
+	breakingConfig := imageConfig.Config().Breaking
+	if len(flags.Only) > 0 || len(flags.Ignore) > 0 {
+		breakingConfig, err = bufbreaking.NewConfigForRuleIDsV1Beta1(breakingConfig, flags.Only, flags.Ignore)
+		if err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+	}
+	if flags.IgnoreFileMoves {
+		// copy so that we do not modify the config of the image config
+		ignoreFileMovesBreakingConfig := *breakingConfig
+		ignoreFileMovesBreakingConfig.IgnoreFileMoves = true
+		breakingConfig = &ignoreFileMovesBreakingConfig
+	}
 	fileAnnotations, err = bufbreaking.NewHandler(container.Logger()).Check(
 		ctx,
-		imageConfig.Config().Breaking,
+		breakingConfig,
 		againstImage,
 		image,
 	)
 	if err != nil {
 		return err
 	}
+	if inputFilePaths != nil {
+		fileAnnotations = filterFileAnnotationsForPaths(fileAnnotations, inputFilePaths)
+	}
 	if len(fileAnnotations) > 0 {
 		if err := bufanalysis.PrintFileAnnotations(
 			container.Stdout(),
 			fileAnnotations,
 			flags.ErrorFormat,
+			bufanalysis.PrintFileAnnotationsWithMaxCount(flags.MaxErrors),
 		); err != nil {
 			return err
 		}
-		return errors.New("")
+		if !bufanalysis.HasErrorFileAnnotations(fileAnnotations) {
+			// only warnings, such as unused comment ignores
+			return nil
+		}
+		return bufcli.NewViolationsFoundError(flags.ExitCode)
 	}
 	return nil
 }
+
+// filterFileAnnotationsForPaths filters the FileAnnotations to those for files that have
+// the given root relative paths.
+//
+// FileAnnotations without a FileInfo are always kept.
+func filterFileAnnotationsForPaths(
+	fileAnnotations []bufanalysis.FileAnnotation,
+	paths map[string]struct{},
+) []bufanalysis.FileAnnotation {
+	filteredFileAnnotations := make([]bufanalysis.FileAnnotation, 0, len(fileAnnotations))
+	for _, fileAnnotation := range fileAnnotations {
+		if fileInfo := fileAnnotation.FileInfo(); fileInfo != nil {
+			if _, ok := paths[fileInfo.Path()]; !ok {
+				continue
+			}
+		}
+		filteredFileAnnotations = append(filteredFileAnnotations, fileAnnotation)
+	}
+	return filteredFileAnnotations
+}
@@ -18,14 +18,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"strings"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/buf/bufcli"
 	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagebuild"
 	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/buf/bufwire"
 	"github.com/bufbuild/buf/internal/pkg/app"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 	"github.com/bufbuild/buf/internal/pkg/stringutil"
 	"github.com/spf13/cobra"
@@ -33,14 +39,33 @@ import (
 )
 
 const (
-	asFileDescriptorSetFlagName = "as-file-descriptor-set"
-	errorFormatFlagName         = "error-format"
-	excludeImportsFlagName      = "exclude-imports"
-	excludeSourceInfoFlagName   = "exclude-source-info"
-	pathsFlagName               = "path"
-	outputFlagName              = "output"
-	outputFlagShortName         = "o"
-	configFlagName              = "config"
+	asFileDescriptorSetFlagName  = "as-file-descriptor-set"
+	errorFormatFlagName          = "error-format"
+	excludeImportsFlagName       = "exclude-imports"
+	excludeSourceInfoFlagName    = "exclude-source-info"
+	pathsFlagName                = "path"
+	outputFlagName               = "output"
+	outputFlagShortName          = "o"
+	configFlagName               = "config"
+	compressionFlagName          = "compression"
+	descriptorSetInFlagName      = "descriptor-set-in"
+	failOnWarningsFlagName       = "fail-on-warnings"
+	allowUnusedImportsFlagName   = "allow-unused-imports"
+	pathPrefixStripFlagName      = "path-prefix-strip"
+	pruneImportsFlagName         = "prune-imports"
+	imageKindFlagName            = "image-kind"
+	overrideImportFlagName       = "override-import"
+	maxErrorsFlagName            = "max-errors"
+	experimentalEditionsFlagName = "experimental-editions"
+
+	includeSourceRetentionOptionsFlagName = "include-source-retention-options"
+
+	compressionNone = "none"
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+
+	imageKindFull    = "full"
+	imageKindMinimal = "minimal"
 
 	// deprecated
 	sourceFlagName = "source"
@@ -50,6 +75,17 @@ const (
 	filesFlagName = "file"
 )
 
+var allCompressions = []string{
+	compressionNone,
+	compressionGzip,
+	compressionZstd,
+}
+
+var allImageKinds = []string{
+	imageKindFull,
+	imageKindMinimal,
+}
+
 // NewCommand returns a new Command.
 func NewCommand(
 	name string,
@@ -77,13 +113,25 @@ func NewCommand(
 }
 
 type flags struct {
-	AsFileDescriptorSet bool
-	ErrorFormat         string
-	ExcludeImports      bool
-	ExcludeSourceInfo   bool
-	Paths               []string
-	Output              string
-	Config              string
+	AsFileDescriptorSet  bool
+	ErrorFormat          string
+	ExcludeImports       bool
+	ExcludeSourceInfo    bool
+	Paths                []string
+	Output               string
+	Config               string
+	Compression          string
+	DescriptorSetIn      []string
+	FailOnWarnings       bool
+	AllowUnusedImports   bool
+	PathPrefixStrip      string
+	PruneImports         bool
+	ImageKind            string
+	OverrideImports      []string
+	MaxErrors            int
+	ExperimentalEditions bool
+
+	IncludeSourceRetentionOptions bool
 
 	// deprecated
 	Source string
@@ -93,6 +141,10 @@ type flags struct {
 	Files []string
 	// special
 	InputHashtag string
+
+	// flagSet is kept so that we can tell whether the options set by
+	// --image-kind were explicitly set.
+	flagSet *pflag.FlagSet
 }
 
 func newFlags() *flags {
@@ -100,11 +152,15 @@ func newFlags() *flags {
 }
 
 func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	f.flagSet = flagSet
 	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
 	bufcli.BindAsFileDescriptorSet(flagSet, &f.AsFileDescriptorSet, asFileDescriptorSetFlagName)
 	bufcli.BindExcludeImports(flagSet, &f.ExcludeImports, excludeImportsFlagName)
 	bufcli.BindExcludeSourceInfo(flagSet, &f.ExcludeSourceInfo, excludeSourceInfoFlagName)
 	bufcli.BindPathsAndDeprecatedFiles(flagSet, &f.Paths, pathsFlagName, &f.Files, filesFlagName)
+	bufcli.BindDescriptorSetIn(flagSet, &f.DescriptorSetIn, descriptorSetInFlagName)
+	bufcli.BindMaxErrors(flagSet, &f.MaxErrors, maxErrorsFlagName)
+	bufcli.BindExperimentalEditions(flagSet, &f.ExperimentalEditions, experimentalEditionsFlagName)
 	flagSet.StringVar(
 		&f.ErrorFormat,
 		errorFormatFlagName,
@@ -130,6 +186,69 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		"",
 		`The config file or data to use.`,
 	)
+	flagSet.StringVar(
+		&f.Compression,
+		compressionFlagName,
+		"",
+		fmt.Sprintf(
+			`The compression to use for the output image. Must be one of %s. By default, compression is inferred from the --%s extension, where .gz is gzip and .zst is zstd.`,
+			stringutil.SliceToString(allCompressions),
+			outputFlagName,
+		),
+	)
+	flagSet.BoolVar(
+		&f.FailOnWarnings,
+		failOnWarningsFlagName,
+		false,
+		`Fail the build if there are compiler warnings, such as unused imports. By default, warnings are printed to stderr but do not fail the build.`,
+	)
+	flagSet.BoolVar(
+		&f.AllowUnusedImports,
+		allowUnusedImportsFlagName,
+		false,
+		fmt.Sprintf(
+			`Do not fail the build for unused imports when --%s is set. Unused imports are still printed to stderr as warnings.`,
+			failOnWarningsFlagName,
+		),
+	)
+	flagSet.StringVar(
+		&f.PathPrefixStrip,
+		pathPrefixStripFlagName,
+		"",
+		`The directory prefix to strip from the file paths in the output image, such as "proto/". Imports are rewritten to match. Files not within the directory are unchanged.`,
+	)
+	flagSet.BoolVar(
+		&f.IncludeSourceRetentionOptions,
+		includeSourceRetentionOptionsFlagName,
+		false,
+		`Include options whose definition has retention = RETENTION_SOURCE in the output image. By default, these options are stripped.`,
+	)
+	flagSet.BoolVar(
+		&f.PruneImports,
+		pruneImportsFlagName,
+		false,
+		`Remove the imports from the output image that are not needed by the files that are not imports.`,
+	)
+	flagSet.StringArrayVar(
+		&f.OverrideImports,
+		overrideImportFlagName,
+		nil,
+		`Resolve an import path to the given file instead of to the file in the input or the bundled well-known type, in the form path=file, for example google/protobuf/descriptor.proto=patched/descriptor.proto.
+This can produce images that are incompatible with other tools, so a warning is printed for every override. May be provided multiple times.`,
+	)
+	flagSet.StringVar(
+		&f.ImageKind,
+		imageKindFlagName,
+		imageKindFull,
+		fmt.Sprintf(
+			`The kind of image to output. Must be one of %s. The %s kind uses the defaults of the individual flags. The %s kind sets --%s and --%s, and does not include source retention options, to output a small image for distribution. Each of these flags can still be set explicitly to override the kind.`,
+			stringutil.SliceToString(allImageKinds),
+			imageKindFull,
+			imageKindMinimal,
+			excludeSourceInfoFlagName,
+			pruneImportsFlagName,
+		),
+	)
 
 	// deprecated
 	flagSet.StringVar(
@@ -169,6 +288,51 @@ func run(
 	if flags.Output == "" {
 		return appcmd.NewInvalidArgumentErrorf("Flag --%s is required.", outputFlagName)
 	}
+	if err := bufcli.CheckMaxErrors(flags.MaxErrors, maxErrorsFlagName); err != nil {
+		return err
+	}
+	if flags.Compression != "" && !stringutil.SliceElementsContained(allCompressions, []string{flags.Compression}) {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: %q is not a valid compression, must be one of %s",
+			compressionFlagName,
+			flags.Compression,
+			stringutil.SliceToString(allCompressions),
+		)
+	}
+	var getImageRefOptions []buffetch.GetImageRefOption
+	if flags.Compression != "" {
+		getImageRefOptions = append(
+			getImageRefOptions,
+			buffetch.GetImageRefWithCompression(flags.Compression),
+		)
+	}
+	imageRef, err := buffetch.NewImageRefParser(container.Logger()).GetImageRef(ctx, flags.Output, getImageRefOptions...)
+	if err != nil {
+		return fmt.Errorf("--%s: %v", outputFlagName, err)
+	}
+	if flags.ExperimentalEditions && imageRef.ImageEncoding() == buffetch.ImageEncodingJSON {
+		// the features of files that declare an edition are unknown fields,
+		// which cannot be written as JSON
+		return fmt.Errorf(
+			"--%s: JSON images are not supported with --%s, use a binary image instead",
+			outputFlagName,
+			experimentalEditionsFlagName,
+		)
+	}
+	imageOptions, err := getImageOptions(flags)
+	if err != nil {
+		return err
+	}
+	var pathPrefixStrip string
+	if flags.PathPrefixStrip != "" {
+		pathPrefixStrip, err = normalpath.NormalizeAndValidate(flags.PathPrefixStrip)
+		if err != nil {
+			return appcmd.NewInvalidArgumentErrorf("--%s: %v", pathPrefixStripFlagName, err)
+		}
+		if pathPrefixStrip == "." {
+			return appcmd.NewInvalidArgumentErrorf("--%s: %q is not a directory prefix", pathPrefixStripFlagName, flags.PathPrefixStrip)
+		}
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, flags.Source, sourceFlagName, ".")
 	if err != nil {
 		return err
@@ -195,6 +359,39 @@ func run(
 	if err != nil {
 		return err
 	}
+	imageConfigReaderOptions := []bufwire.ImageConfigReaderOption{
+		bufwire.ImageConfigReaderWithBuildOptions(
+			bufimagebuild.WithWarnings(),
+		),
+	}
+	if len(flags.DescriptorSetIn) > 0 {
+		fileDescriptorProtos, err := bufcli.ReadFileDescriptorSets(flags.DescriptorSetIn)
+		if err != nil {
+			return fmt.Errorf("--%s: %v", descriptorSetInFlagName, err)
+		}
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithBuildOptions(
+				bufimagebuild.WithImportFileDescriptorProtos(fileDescriptorProtos...),
+			),
+		)
+	}
+	if flags.ExperimentalEditions {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithBuildOptions(bufimagebuild.WithExperimentalEditions()),
+		)
+	}
+	for _, overrideImport := range flags.OverrideImports {
+		buildOption, err := getImportOverrideBuildOption(overrideImport)
+		if err != nil {
+			return err
+		}
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithBuildOptions(buildOption),
+		)
+	}
 	configProvider := bufconfig.NewProvider(container.Logger())
 	moduleResolver, err := moduleResolverReaderProvider.GetModuleResolver(ctx, container)
 	if err != nil {
@@ -211,6 +408,7 @@ func run(
 		configProvider,
 		moduleResolver,
 		moduleReader,
+		imageConfigReaderOptions...,
 	).GetImageConfig(
 		ctx,
 		container,
@@ -218,7 +416,7 @@ func run(
 		inputConfig,
 		paths,
 		false,
-		flags.ExcludeSourceInfo,
+		imageOptions.excludeSourceInfo,
 	)
 	if err != nil {
 		return err
@@ -229,9 +427,16 @@ func run(
 			container.Stderr(),
 			fileAnnotations,
 			flags.ErrorFormat,
+			bufanalysis.PrintFileAnnotationsWithMaxCount(flags.MaxErrors),
 		); err != nil {
 			return err
 		}
+		if !bufanalysis.HasErrorFileAnnotations(fileAnnotations) && !hasFailingWarnings(fileAnnotations, flags) {
+			// only warnings, the build succeeded
+			fileAnnotations = nil
+		}
+	}
+	if len(fileAnnotations) > 0 {
 		// app works on the concept that an error results in a non-zero exit code
 		// we already printed the messages with PrintFileAnnotations so we do
 		// not want to print any additional error message
@@ -240,9 +445,26 @@ func run(
 		// so doing this here is consistent with lint/breaking change detection
 		return errors.New("")
 	}
-	imageRef, err := buffetch.NewImageRefParser(container.Logger()).GetImageRef(ctx, flags.Output)
-	if err != nil {
-		return fmt.Errorf("--%s: %v", outputFlagName, err)
+	image := imageConfig.Image()
+	if !imageOptions.includeSourceRetentionOptions {
+		image, err = bufimage.ImageWithoutSourceRetentionOptions(image)
+		if err != nil {
+			return err
+		}
+	}
+	// done after stripping source retention options, as these may be
+	// the only use of an import
+	if imageOptions.pruneImports {
+		image, err = bufimage.ImageWithUnusedImportsPruned(image)
+		if err != nil {
+			return err
+		}
+	}
+	if pathPrefixStrip != "" {
+		image, err = bufimage.ImageWithPathPrefixStripped(image, pathPrefixStrip)
+		if err != nil {
+			return fmt.Errorf("--%s: %v", pathPrefixStripFlagName, err)
+		}
 	}
 	return bufcli.NewWireImageWriter(
 		container.Logger(),
@@ -250,8 +472,81 @@ func run(
 		ctx,
 		container,
 		imageRef,
-		imageConfig.Image(),
+		image,
 		flags.AsFileDescriptorSet,
 		flags.ExcludeImports,
 	)
 }
+
+// imageOptions are the options that control what is included in the output image.
+type imageOptions struct {
+	excludeSourceInfo             bool
+	pruneImports                  bool
+	includeSourceRetentionOptions bool
+}
+
+// getImageOptions resolves the --image-kind flag into the individual options.
+//
+// The image kind only sets the options that were not explicitly set by their
+// own flag, so that each option can still be tuned.
+func getImageOptions(flags *flags) (*imageOptions, error) {
+	imageOptions := &imageOptions{
+		excludeSourceInfo:             flags.ExcludeSourceInfo,
+		pruneImports:                  flags.PruneImports,
+		includeSourceRetentionOptions: flags.IncludeSourceRetentionOptions,
+	}
+	switch flags.ImageKind {
+	case imageKindFull:
+	case imageKindMinimal:
+		if !flags.flagSet.Changed(excludeSourceInfoFlagName) {
+			imageOptions.excludeSourceInfo = true
+		}
+		if !flags.flagSet.Changed(pruneImportsFlagName) {
+			imageOptions.pruneImports = true
+		}
+		if !flags.flagSet.Changed(includeSourceRetentionOptionsFlagName) {
+			imageOptions.includeSourceRetentionOptions = false
+		}
+	default:
+		return nil, appcmd.NewInvalidArgumentErrorf(
+			"--%s: %q is not a valid image kind, must be one of %s",
+			imageKindFlagName,
+			flags.ImageKind,
+			stringutil.SliceToString(allImageKinds),
+		)
+	}
+	return imageOptions, nil
+}
+
+// hasFailingWarnings returns true if any of the warning FileAnnotations should
+// fail the build.
+func hasFailingWarnings(fileAnnotations []bufanalysis.FileAnnotation, flags *flags) bool {
+	if !flags.FailOnWarnings {
+		return false
+	}
+	for _, fileAnnotation := range fileAnnotations {
+		if flags.AllowUnusedImports && bufimagebuild.IsUnusedImportFileAnnotation(fileAnnotation) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// getImportOverrideBuildOption returns the BuildOption for an --override-import
+// flag value of the form path=file.
+func getImportOverrideBuildOption(overrideImport string) (bufimagebuild.BuildOption, error) {
+	split := strings.SplitN(overrideImport, "=", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s: %q must be of the form path=file", overrideImportFlagName, overrideImport)
+	}
+	path, err := normalpath.NormalizeAndValidate(split[0])
+	if err != nil {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s: %v", overrideImportFlagName, err)
+	}
+	data, err := ioutil.ReadFile(split[1])
+	if err != nil {
+		return nil, fmt.Errorf("--%s: %v", overrideImportFlagName, err)
+	}
+	return bufimagebuild.WithImportOverride(path, split[1], data), nil
+}
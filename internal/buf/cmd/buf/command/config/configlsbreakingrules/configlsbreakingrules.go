@@ -29,10 +29,11 @@ import (
 )
 
 const (
-	allFlagName        = "all"
-	categoriesFlagName = "category"
-	configFlagName     = "config"
-	formatFlagName     = "format"
+	allFlagName           = "all"
+	categoriesFlagName    = "category"
+	configFlagName        = "config"
+	formatFlagName        = "format"
+	newRulesSinceFlagName = "new-rules-since"
 )
 
 // NewCommand returns a new Command.
@@ -61,9 +62,10 @@ func NewCommand(
 type flags struct {
 	All bool
 	// TODO: remove for v1.0
-	Categories []string
-	Config     string
-	Format     string
+	Categories    []string
+	Config        string
+	Format        string
+	NewRulesSince string
 }
 
 func newFlags() *flags {
@@ -75,6 +77,7 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	configinternal.BindLSRulesCategories(flagSet, &f.Categories, categoriesFlagName)
 	configinternal.BindLSRulesConfig(flagSet, &f.Config, configFlagName, allFlagName)
 	configinternal.BindLSRulesFormat(flagSet, &f.Format, formatFlagName)
+	configinternal.BindLSRulesNewRulesSince(flagSet, &f.NewRulesSince, newRulesSinceFlagName)
 }
 
 func run(
@@ -112,6 +115,13 @@ func run(
 		}
 		rules = config.Breaking.GetRules()
 	}
+	if flags.NewRulesSince != "" {
+		newRules, err := bufbreaking.GetRulesIntroducedSinceV1Beta1(flags.NewRulesSince)
+		if err != nil {
+			return appcmd.NewInvalidArgumentErrorf("--%s: %v", newRulesSinceFlagName, err)
+		}
+		rules = configinternal.FilterLSRules(rules, newRules)
+	}
 	return bufcheck.PrintRules(
 		container.Stdout(),
 		rules,
@@ -77,3 +77,28 @@ func CheckLSRulesCategories(value []string, flagName string) error {
 	}
 	return nil
 }
+
+// BindLSRulesNewRulesSince binds the new rules since flag for an ls rules command.
+func BindLSRulesNewRulesSince(flagSet *pflag.FlagSet, addr *string, flagName string) {
+	flagSet.StringVar(
+		addr,
+		flagName,
+		"",
+		`Only list the rules that were introduced in buf versions later than this buf version, such as "v0.39.1".`,
+	)
+}
+
+// FilterLSRules returns the rules whose IDs are the IDs of one of the filter rules.
+func FilterLSRules(rules []bufcheck.Rule, filterRules []bufcheck.Rule) []bufcheck.Rule {
+	filterIDs := make(map[string]struct{}, len(filterRules))
+	for _, filterRule := range filterRules {
+		filterIDs[filterRule.ID()] = struct{}{}
+	}
+	var filteredRules []bufcheck.Rule
+	for _, rule := range rules {
+		if _, ok := filterIDs[rule.ID()]; ok {
+			filteredRules = append(filteredRules, rule)
+		}
+	}
+	return filteredRules
+}
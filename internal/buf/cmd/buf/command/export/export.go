@@ -0,0 +1,228 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufcli"
+	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagebuild"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/gen/data/datawkt"
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
+	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/storage"
+	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
+	"github.com/bufbuild/buf/internal/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
+)
+
+const (
+	configFlagName      = "config"
+	errorFormatFlagName = "error-format"
+	outputFlagName      = "output"
+	outputFlagShortName = "o"
+	pathsFlagName       = "path"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <source>",
+		Short: "Export the Protobuf files of the input and all of their imports to a directory.",
+		Long: `Every file of the input, and every file that they import, including the files of
+dependencies and the well-known types, is written to the output directory at its import path,
+so that the output directory can be given to protoc with -I.
+
+` + bufcli.GetSourceOrModuleLong(`the source or module to export`),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags, moduleResolverReaderProvider)
+			},
+			bufcli.NewErrorInterceptor(name),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Output      string
+	Config      string
+	Paths       []string
+	ErrorFormat string
+
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		`Required. The directory to write the files to.`,
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The config file or data to use.`,
+	)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors, printed to stderr. Must be one of %s.",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
+) error {
+	if flags.Output == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", outputFlagName)
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, "", "", ".")
+	if err != nil {
+		return err
+	}
+	sourceOrModuleRef, err := buffetch.NewRefParser(container.Logger()).GetSourceOrModuleRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	moduleResolver, err := moduleResolverReaderProvider.GetModuleResolver(ctx, container)
+	if err != nil {
+		return err
+	}
+	moduleReader, err := moduleResolverReaderProvider.GetModuleReader(ctx, container)
+	if err != nil {
+		return err
+	}
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	moduleConfig, err := bufcli.NewWireModuleConfigReader(
+		container.Logger(),
+		storageosProvider,
+		bufconfig.NewProvider(container.Logger()),
+		moduleResolver,
+		moduleReader,
+	).GetModuleConfig(
+		ctx,
+		container,
+		sourceOrModuleRef,
+		flags.Config,
+		flags.Paths,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	moduleFileSet, err := bufmodulebuild.NewModuleFileSetBuilder(
+		container.Logger(),
+		moduleReader,
+	).Build(
+		ctx,
+		moduleConfig.Module(),
+	)
+	if err != nil {
+		return err
+	}
+	// we build the files so that we only export the imports that are used
+	image, fileAnnotations, err := bufimagebuild.NewBuilder(
+		container.Logger(),
+	).Build(
+		ctx,
+		moduleFileSet,
+		bufimagebuild.WithExcludeSourceCodeInfo(),
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stderr(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return errors.New("")
+	}
+	writeBucket, err := storageosProvider.NewReadWriteBucket(
+		normalpath.Normalize(flags.Output),
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to export files into %s: %v", flags.Output, err)
+	}
+	for _, imageFile := range image.Files() {
+		if err := exportFile(ctx, moduleFileSet, imageFile.Path(), writeBucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportFile copies the file at the path to the WriteBucket.
+//
+// The well-known types are not part of the ModuleFileSet unless a module
+// provides them itself, in which case the file of the module is used.
+func exportFile(
+	ctx context.Context,
+	moduleFileSet bufmodule.ModuleFileSet,
+	path string,
+	writeBucket storage.WriteBucket,
+) (retErr error) {
+	var readObjectCloser storage.ReadObjectCloser
+	moduleFile, err := moduleFileSet.GetModuleFile(ctx, path)
+	if err != nil {
+		if !storage.IsNotExist(err) {
+			return err
+		}
+		readObjectCloser, err = datawkt.ReadBucket.Get(ctx, path)
+		if err != nil {
+			return err
+		}
+	} else {
+		readObjectCloser = moduleFile
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readObjectCloser.Close())
+	}()
+	return storage.CopyReadObject(ctx, writeBucket, readObjectCloser)
+}
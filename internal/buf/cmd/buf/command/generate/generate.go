@@ -16,16 +16,29 @@ package generate
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
 	"github.com/bufbuild/buf/internal/buf/bufcli"
 	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufmodule"
 	"github.com/bufbuild/buf/internal/buf/buffetch"
 	"github.com/bufbuild/buf/internal/buf/bufgen"
+	"github.com/bufbuild/buf/internal/buf/bufwork"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 	"github.com/bufbuild/buf/internal/pkg/stringutil"
 	"github.com/spf13/cobra"
@@ -33,12 +46,26 @@ import (
 )
 
 const (
-	templateFlagName            = "template"
-	baseOutDirPathFlagName      = "output"
-	baseOutDirPathFlagShortName = "o"
-	errorFormatFlagName         = "error-format"
-	configFlagName              = "config"
-	pathsFlagName               = "path"
+	templateFlagName             = "template"
+	baseOutDirPathFlagName       = "output"
+	baseOutDirPathFlagShortName  = "o"
+	errorFormatFlagName          = "error-format"
+	configFlagName               = "config"
+	pathsFlagName                = "path"
+	writeManifestFlagName        = "write-manifest"
+	strictEnvFlagName            = "strict-env"
+	includeImportsFlagName       = "include-imports"
+	includeWKTFlagName           = "include-wkt"
+	strictPluginVersionsFlagName = "strict-plugin-versions"
+	pluginFlagName               = "plugin"
+	pluginPathFlagName           = "plugin-path"
+	failFastFlagName             = "fail-fast"
+	incrementalFlagName          = "incremental"
+	incrementalStateFlagName     = "incremental-state"
+	noIncrementalFlagName        = "no-incremental"
+	allowOverwriteFlagName       = "allow-overwrite"
+	dumpRequestFlagName          = "dump-request"
+	recursiveFlagName            = "recursive"
 
 	// deprecated
 	inputFlagName = "input"
@@ -68,7 +95,10 @@ version: v1beta1
 plugins:
     # The name of the plugin.
     # Required.
-    # By default, buf generate will look for a binary named protoc-gen-NAME on your $PATH.
+    # By default, buf generate will look for a binary named protoc-gen-NAME in the
+    # directories given with --plugin-path, in order, and then on your $PATH.
+    # The name can be suffixed with @VERSION to declare the version the plugin is expected
+    # to report when invoked with --version, for example go@v1.28.
   - name: go
     # The the relative output directory.
     # Required.
@@ -104,6 +134,25 @@ plugins:
     #
     # Optional. If omitted, "directory" is used. Most users should not need to set this option.
     strategy: directory
+    # Whether to also generate for imports, overriding --include-imports for this plugin.
+    # Optional. If omitted, the value of --include-imports is used.
+    include_imports: true
+    # Whether to also generate for the well-known types when imports are included,
+    # overriding --include-wkt for this plugin.
+    # Optional. If omitted, the value of --include-wkt is used.
+    include_wkt: false
+    # The template for the names of the generated files, relative to out.
+    # Optional. If omitted, the names returned by the plugin are used.
+    # The available placeholders are:
+    #
+    #   {package}: the package of the files to generate, with dots replaced by slashes.
+    #              All files to generate in a plugin invocation must have the same package.
+    #   {dir}:     the directory of the name returned by the plugin.
+    #   {name}:    the base name returned by the plugin, without the extension.
+    #   {ext}:     the extension of the name returned by the plugin, including the dot.
+    #
+    # Unknown placeholders are an error. Files for insertion points are not renamed.
+    out_template: "{package}/{name}{ext}"
   - name: java
     out: gen/java
 
@@ -164,6 +213,134 @@ as "proto/foo" is contained within "proto".
 Plugins are invoked in the order they are specified in the template, but each plugin
 has a per-directory parallel invocation, with results from each invocation combined
 before writing the result. This is equivalent behavior to "buf protoc --by_dir".
+
+If you want to record how stubs were generated, you can write a JSON manifest via the
+--write-manifest flag. The manifest contains the buf version, the time of generation,
+a digest of the input, the resolved template, and the list of files that were written:
+
+$ buf generate --write-manifest gen/manifest.json
+
+By default, stubs are only generated for the files in your input, and not for their
+imports. The --include-imports flag also generates stubs for imports, except for the
+well-known types, which are also included with the --include-wkt flag. Each import is
+only generated once, even if it is imported from multiple directories. Both flags can
+be overridden per plugin with include_imports and include_wkt, for example to give
+a documentation plugin all imports but not a Go plugin:
+
+version: v1beta1
+plugins:
+  - name: doc
+    out: gen/doc
+    include_imports: true
+  - name: go
+    out: gen/go
+
+To catch generation drift between developers and CI, each plugin can declare the version
+it is expected to be by suffixing its name with @VERSION. Before generating, buf invokes
+each such plugin with --version and compares the last field of the output, ignoring a
+leading "v", so that "go@v1.28" matches "protoc-gen-go v1.28.1". A mismatch results in
+a warning, or an error if the --strict-plugin-versions flag is set:
+
+version: v1beta1
+plugins:
+  - name: go@v1.28
+    out: gen/go
+
+$ buf generate --strict-plugin-versions
+
+To test a locally-built plugin without editing the template, the path of a plugin can
+be overridden with the --plugin flag, keeping the out and opt of the plugin:
+
+$ buf generate --plugin go=./bin/protoc-gen-go
+
+By default, generation stops at the first plugin that fails, and the output of the
+plugins that succeeded before it is kept. To see the failures of all plugins at once,
+set --fail-fast=false. All plugins are then executed before anything is written, and
+if any plugin fails, the failures are printed together and nothing is written:
+
+$ buf generate --fail-fast=false
+
+To debug why a plugin behaves differently under buf than under protoc, the requests sent
+to a plugin can be written to a directory with --dump-request, and then replayed:
+
+$ buf generate --dump-request go=debug/go
+$ protoc-gen-go < debug/go/request-0.bin
+
+Options shared by many plugins can be set once in a plugin_defaults block. The opt
+values in plugin_defaults are prepended to the options of every plugin, in order. If a
+plugin sets an option with the same key, that is the part before any "=", the default
+is dropped and the plugin's own option is used instead:
+
+version: v1beta1
+plugin_defaults:
+  opt:
+    - paths=source_relative
+    - Mfoo/foo.proto=example.com/foo
+plugins:
+  - name: go
+    out: gen/go
+  - name: go-grpc
+    out: gen/go
+    # results in Mfoo/foo.proto=example.com/foo,paths=import
+    opt: paths=import
+
+YAML anchors and aliases are also supported, so a list of options can be declared on
+one plugin with "opt: &name" and reused on another with "opt: *name".
+
+Environment variables are expanded in the out, opt, and path fields of the template,
+including plugin_defaults.
+Both $VAR and ${VAR} are supported, and ${VAR:-default} expands to default if VAR is
+not set or is empty. Use $$ for a literal $. Variables that are not set expand to the
+empty string, unless the --strict-env flag is set, in which case this is an error:
+
+version: v1beta1
+plugins:
+  - name: go
+    out: ${GEN_DIR:-gen}/go
+
+$ GEN_DIR=build/gen buf generate --strict-env
+
+Note that expanding the environment into the path field means that whoever controls
+the environment controls which plugin binaries are executed, so only rely on this
+in environments you trust. Environment variables are not expanded in buf.yaml.
+
+Commands to run after generation, such as formatters, can be listed in post_generate.
+The commands are run in order by the shell once every plugin has succeeded and all
+files are written, with the output directory given by --output as the working
+directory. The newline-separated absolute paths of the written files are given in the
+BUF_GENERATED_FILES environment variable. If a command fails, the commands after it
+are not run and buf generate fails, but the generated files are kept:
+
+version: v1beta1
+plugins:
+  - name: go
+    out: gen/go
+post_generate:
+  - gofmt -s -w gen/go
+
+Files of plugins that are skipped by --incremental are not included in
+BUF_GENERATED_FILES. As with plugins, post_generate runs arbitrary commands with
+your permissions, so only use templates you trust. The commands are not subject to
+environment variable expansion by buf, as the shell already expands them.
+
+To generate for every directory of a workspace at once, use the --recursive flag with a
+directory input that contains a buf.work.yaml file listing the directories:
+
+version: v1beta1
+directories:
+  - proto/a
+  - proto/b
+
+$ buf generate --recursive
+
+Each directory is generated on its own, as if it was given as the input. A directory uses
+the buf.gen.yaml in the directory if present, and otherwise the buf.gen.yaml in the input
+directory. The out directories of these templates are relative to the directory of the
+template, unless --output is set. If --template is set, it is used for every directory,
+and its out directories are relative to your current directory as usual.
+
+The result of every directory is printed to stderr. A failure does not stop the generation
+of the other directories, and buf generate fails if any directory failed.
 `,
 		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
@@ -177,12 +354,26 @@ before writing the result. This is equivalent behavior to "buf protoc --by_dir".
 }
 
 type flags struct {
-	Template       string
-	BaseOutDirPath string
-	ErrorFormat    string
-	Files          []string
-	Config         string
-	Paths          []string
+	Template             string
+	BaseOutDirPath       string
+	ErrorFormat          string
+	Files                []string
+	Config               string
+	Paths                []string
+	WriteManifest        string
+	StrictEnv            bool
+	IncludeImports       bool
+	IncludeWKT           bool
+	StrictPluginVersions bool
+	Plugins              []string
+	PluginPaths          []string
+	FailFast             bool
+	Incremental          bool
+	IncrementalState     string
+	NoIncremental        bool
+	AllowOverwrite       bool
+	DumpRequests         []string
+	Recursive            bool
 
 	// deprecated
 	Input string
@@ -190,6 +381,10 @@ type flags struct {
 	InputConfig string
 	// special
 	InputHashtag string
+
+	// flagSet is kept so that we can tell whether --template was
+	// explicitly set with --recursive.
+	flagSet *pflag.FlagSet
 }
 
 func newFlags() *flags {
@@ -197,6 +392,7 @@ func newFlags() *flags {
 }
 
 func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	f.flagSet = flagSet
 	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
 	bufcli.BindPathsAndDeprecatedFiles(flagSet, &f.Paths, pathsFlagName, &f.Files, filesFlagName)
 	flagSet.StringVar(
@@ -227,6 +423,119 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		"",
 		`The config file or data to use.`,
 	)
+	flagSet.StringVar(
+		&f.WriteManifest,
+		writeManifestFlagName,
+		"",
+		`The file to write a JSON manifest of the generation to. The manifest contains the buf version, the time of generation, the input digest, the resolved template, and the output files.`,
+	)
+	flagSet.BoolVar(
+		&f.StrictEnv,
+		strictEnvFlagName,
+		false,
+		`Error if an environment variable in the generation template without a default is not set or is empty, instead of expanding it to the empty string.`,
+	)
+	flagSet.BoolVar(
+		&f.IncludeImports,
+		includeImportsFlagName,
+		false,
+		`Also generate all imports except for the well-known types. Can be overridden per plugin with include_imports in the generation template.`,
+	)
+	flagSet.BoolVar(
+		&f.StrictPluginVersions,
+		strictPluginVersionsFlagName,
+		false,
+		`Error if a plugin declared as name@version in the generation template does not report a matching version, instead of printing a warning.`,
+	)
+	flagSet.BoolVar(
+		&f.IncludeWKT,
+		includeWKTFlagName,
+		false,
+		fmt.Sprintf(
+			`Also generate the well-known types when imports are included. Cannot be set without --%s. Can be overridden per plugin with include_wkt in the generation template.`,
+			includeImportsFlagName,
+		),
+	)
+	flagSet.StringArrayVar(
+		&f.Plugins,
+		pluginFlagName,
+		nil,
+		`Override the path of the plugins with the given name in the template, in the form name=path.
+The out and opt of the plugins are kept. If no plugin in the template has the name, a plugin that outputs to the base output directory is added.
+May be provided multiple times.`,
+	)
+	flagSet.StringArrayVar(
+		&f.PluginPaths,
+		pluginPathFlagName,
+		nil,
+		`Additional directories to look for plugins in. For plugins without a path in the template, a binary named protoc-gen-NAME is looked for in these directories in the order given, and then on your $PATH.
+Plugins with a path in the template or given with --`+pluginFlagName+` are not affected.
+May be provided multiple times.`,
+	)
+	flagSet.BoolVar(
+		&f.FailFast,
+		failFastFlagName,
+		true,
+		`Stop at the first plugin that fails. If set to false, all plugins are executed, all failures are printed, and no output is written if any plugin failed.`,
+	)
+	flagSet.BoolVar(
+		&f.Incremental,
+		incrementalFlagName,
+		false,
+		fmt.Sprintf(
+			`Skip the plugins whose configuration and input files are unchanged since the last generation, as recorded in the file given by --%s.
+Any change to the template or the buf version results in every plugin being executed. Plugins are executed if any of their output files was deleted, but not if their output files were modified, use --%s to execute every plugin.`,
+			incrementalStateFlagName,
+			noIncrementalFlagName,
+		),
+	)
+	flagSet.StringVar(
+		&f.IncrementalState,
+		incrementalStateFlagName,
+		".buf.gen.state.json",
+		fmt.Sprintf(
+			`The file to record the state of the generation in for --%s.`,
+			incrementalFlagName,
+		),
+	)
+	flagSet.BoolVar(
+		&f.NoIncremental,
+		noIncrementalFlagName,
+		false,
+		fmt.Sprintf(
+			`Execute every plugin even if --%s is set. The state of the generation is still recorded if --%s is set.`,
+			incrementalFlagName,
+			incrementalFlagName,
+		),
+	)
+	flagSet.BoolVar(
+		&f.AllowOverwrite,
+		allowOverwriteFlagName,
+		false,
+		`Allow plugins to generate the same file with different content, in which case the file generated by the last plugin in the template is written.
+By default, this is an error. Files generated for insertion points are always allowed.`,
+	)
+	flagSet.StringArrayVar(
+		&f.DumpRequests,
+		dumpRequestFlagName,
+		nil,
+		`Write the CodeGeneratorRequests sent to the plugins with the given name to a directory, in the form name=dir.
+Each request is written to its own file named request-N.bin before any plugin is executed, and can be replayed with protoc-gen-NAME < dir/request-N.bin. The plugins are still executed.
+May be provided multiple times.`,
+	)
+	flagSet.BoolVar(
+		&f.Recursive,
+		recursiveFlagName,
+		false,
+		fmt.Sprintf(
+			`Generate for every directory of the workspace defined by the %s file in the input directory.
+Each directory uses the %s in the directory if present, and otherwise the %s in the input directory. If --%s is set, it is used for every directory.`,
+			bufwork.ExternalConfigV1Beta1FilePath,
+			bufgen.ExternalConfigV1Beta1FilePath,
+			bufgen.ExternalConfigV1Beta1FilePath,
+			templateFlagName,
+		),
+	)
 
 	// deprecated
 	flagSet.StringVar(
@@ -263,7 +572,6 @@ func run(
 	flags *flags,
 	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
 ) (retErr error) {
-	logger := container.Logger()
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, flags.Input, inputFlagName, ".")
 	if err != nil {
 		return err
@@ -286,9 +594,35 @@ func run(
 	if err != nil {
 		return err
 	}
-	ref, err := buffetch.NewRefParser(logger).GetRef(ctx, input)
-	if err != nil {
-		return err
+	if flags.IncludeWKT && !flags.IncludeImports {
+		return appcmd.NewInvalidArgumentErrorf("Cannot set --%s without --%s.", includeWKTFlagName, includeImportsFlagName)
+	}
+	if flags.Incremental {
+		if flags.IncrementalState == "" {
+			return appcmd.NewInvalidArgumentErrorf("--%s is required if --%s is set.", incrementalStateFlagName, incrementalFlagName)
+		}
+		if flags.WriteManifest != "" {
+			// the output files of skipped plugins are not known
+			return appcmd.NewInvalidArgumentErrorf("Cannot set --%s with --%s.", writeManifestFlagName, incrementalFlagName)
+		}
+	}
+	if flags.Recursive {
+		// these flags only apply to a single input
+		if len(paths) > 0 {
+			return appcmd.NewInvalidArgumentErrorf("Cannot set --%s with --%s.", pathsFlagName, recursiveFlagName)
+		}
+		if inputConfig != "" {
+			return appcmd.NewInvalidArgumentErrorf("Cannot set --%s with --%s.", configFlagName, recursiveFlagName)
+		}
+		if flags.WriteManifest != "" {
+			return appcmd.NewInvalidArgumentErrorf("Cannot set --%s with --%s.", writeManifestFlagName, recursiveFlagName)
+		}
+		if flags.Incremental {
+			return appcmd.NewInvalidArgumentErrorf("Cannot set --%s with --%s.", incrementalFlagName, recursiveFlagName)
+		}
+		if len(flags.DumpRequests) > 0 {
+			return appcmd.NewInvalidArgumentErrorf("Cannot set --%s with --%s.", dumpRequestFlagName, recursiveFlagName)
+		}
 	}
 	moduleResolver, err := moduleResolverReaderProvider.GetModuleResolver(ctx, container)
 	if err != nil {
@@ -298,10 +632,164 @@ func run(
 	if err != nil {
 		return err
 	}
-	genConfig, err := bufgen.ReadConfig(flags.Template)
+	if flags.Recursive {
+		return generateWorkspace(
+			ctx,
+			container,
+			flags,
+			moduleResolver,
+			moduleReader,
+			input,
+		)
+	}
+	return generate(
+		ctx,
+		container,
+		flags,
+		moduleResolver,
+		moduleReader,
+		input,
+		inputConfig,
+		paths,
+		flags.Template,
+		flags.BaseOutDirPath,
+	)
+}
+
+// generateWorkspace generates for every directory of the workspace in the
+// workspace directory.
+//
+// The result of every directory is printed to stderr. Generation continues
+// after a directory failed, and an error is returned if any directory failed.
+func generateWorkspace(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+	moduleResolver bufmodule.ModuleResolver,
+	moduleReader bufmodule.ModuleReader,
+	workspaceDirPath string,
+) error {
+	workspaceConfig, err := bufwork.ReadConfig(workspaceDirPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+			return appcmd.NewInvalidArgumentErrorf(
+				"--%s requires the input to be a directory with a %s file, but %q has none.",
+				recursiveFlagName,
+				bufwork.ExternalConfigV1Beta1FilePath,
+				workspaceDirPath,
+			)
+		}
+		return err
+	}
+	var failedCount int
+	for _, directory := range workspaceConfig.Directories {
+		dirPath := filepath.Join(workspaceDirPath, normalpath.Unnormalize(directory))
+		template, baseOutDirPath, err := getWorkspaceTemplate(flags, workspaceDirPath, dirPath)
+		if err == nil {
+			err = generate(
+				ctx,
+				container,
+				flags,
+				moduleResolver,
+				moduleReader,
+				dirPath,
+				"",
+				nil,
+				template,
+				baseOutDirPath,
+			)
+		}
+		result := "success"
+		if err != nil {
+			failedCount++
+			result = "failure"
+			// build errors are already printed, and result in an empty error
+			if err.Error() != "" {
+				result += ": " + err.Error()
+			}
+		}
+		if _, err := fmt.Fprintf(container.Stderr(), "%s: %s\n", directory, result); err != nil {
+			return err
+		}
+	}
+	if failedCount > 0 {
+		return fmt.Errorf("generation failed for %d of %d directories", failedCount, len(workspaceConfig.Directories))
+	}
+	return nil
+}
+
+// getWorkspaceTemplate returns the template to use for the directory of the
+// workspace, and the base directory that the out directories of the template
+// are relative to.
+//
+// If --template is set, it is used for every directory. Otherwise, the template
+// in the directory is used if present, and the template in the workspace
+// directory if not. The out directories of a template found in the workspace
+// are relative to the directory of the template, unless --output is set.
+func getWorkspaceTemplate(
+	flags *flags,
+	workspaceDirPath string,
+	dirPath string,
+) (string, string, error) {
+	if flags.flagSet.Changed(templateFlagName) {
+		return flags.Template, flags.BaseOutDirPath, nil
+	}
+	for _, templateDirPath := range []string{dirPath, workspaceDirPath} {
+		templateFilePath := filepath.Join(templateDirPath, bufgen.ExternalConfigV1Beta1FilePath)
+		if _, err := os.Stat(templateFilePath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return "", "", err
+		}
+		if flags.flagSet.Changed(baseOutDirPathFlagName) {
+			return templateFilePath, flags.BaseOutDirPath, nil
+		}
+		return templateFilePath, templateDirPath, nil
+	}
+	return "", "", fmt.Errorf(
+		"no %s found in %s or %s",
+		bufgen.ExternalConfigV1Beta1FilePath,
+		dirPath,
+		workspaceDirPath,
+	)
+}
+
+// generate generates for the input with the template.
+func generate(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+	moduleResolver bufmodule.ModuleResolver,
+	moduleReader bufmodule.ModuleReader,
+	input string,
+	inputConfig string,
+	paths []string,
+	template string,
+	baseOutDirPath string,
+) error {
+	logger := container.Logger()
+	ref, err := buffetch.NewRefParser(logger).GetRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	readConfigOptions := []bufgen.ReadConfigOption{
+		bufgen.ReadConfigWithEnvContainer(container),
+	}
+	if flags.StrictEnv {
+		readConfigOptions = append(readConfigOptions, bufgen.ReadConfigWithStrictEnv())
+	}
+	genConfig, err := bufgen.ReadConfig(template, readConfigOptions...)
 	if err != nil {
 		return err
 	}
+	for _, plugin := range flags.Plugins {
+		split := strings.SplitN(plugin, "=", 2)
+		if len(split) != 2 || split[0] == "" || split[1] == "" {
+			return appcmd.NewInvalidArgumentErrorf("--%s: %q must be of the form name=path", pluginFlagName, plugin)
+		}
+		genConfig = bufgen.ConfigWithPluginPath(genConfig, split[0], split[1])
+	}
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
 	imageConfig, fileAnnotations, err := bufcli.NewWireImageConfigReader(
 		logger,
@@ -327,11 +815,157 @@ func run(
 		}
 		return errors.New("")
 	}
-	return bufgen.NewGenerator(logger, storageosProvider).Generate(
+	generateOptions := []bufgen.GenerateOption{
+		bufgen.GenerateWithBaseOutDirPath(baseOutDirPath),
+	}
+	if flags.IncludeImports {
+		generateOptions = append(generateOptions, bufgen.GenerateWithIncludeImports())
+	}
+	if flags.IncludeWKT {
+		generateOptions = append(generateOptions, bufgen.GenerateWithIncludeWellKnownTypes())
+	}
+	if flags.StrictPluginVersions {
+		generateOptions = append(generateOptions, bufgen.GenerateWithStrictPluginVersions())
+	}
+	if !flags.FailFast {
+		generateOptions = append(generateOptions, bufgen.GenerateWithoutFailFast())
+	}
+	if flags.AllowOverwrite {
+		generateOptions = append(generateOptions, bufgen.GenerateWithAllowOverwrite())
+	}
+	if len(flags.PluginPaths) > 0 {
+		generateOptions = append(generateOptions, bufgen.GenerateWithPluginSearchDirPaths(flags.PluginPaths...))
+	}
+	for _, dumpRequest := range flags.DumpRequests {
+		split := strings.SplitN(dumpRequest, "=", 2)
+		if len(split) != 2 || split[0] == "" || split[1] == "" {
+			return appcmd.NewInvalidArgumentErrorf("--%s: %q must be of the form name=dir", dumpRequestFlagName, dumpRequest)
+		}
+		if !hasPluginName(genConfig, split[0]) {
+			return fmt.Errorf("--%s: no plugin named %q in the template", dumpRequestFlagName, split[0])
+		}
+		generateOptions = append(generateOptions, bufgen.GenerateWithDumpRequestDirPath(split[0], split[1]))
+	}
+	if flags.Incremental {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithIncrementalStateFilePath(flags.IncrementalState, bufcli.Version),
+		)
+		if flags.NoIncremental {
+			generateOptions = append(generateOptions, bufgen.GenerateWithIncrementalForce())
+		}
+	}
+	outputFilePathMap := make(map[string]struct{})
+	if flags.WriteManifest != "" {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithOutputFilePathFunc(
+				func(outputFilePath string) {
+					outputFilePathMap[outputFilePath] = struct{}{}
+				},
+			),
+		)
+	}
+	if err := bufgen.NewGenerator(logger, storageosProvider).Generate(
 		ctx,
 		container,
 		genConfig,
 		imageConfig.Image(),
-		bufgen.GenerateWithBaseOutDirPath(flags.BaseOutDirPath),
-	)
+		generateOptions...,
+	); err != nil {
+		return err
+	}
+	if flags.WriteManifest != "" {
+		return writeManifest(
+			flags.WriteManifest,
+			genConfig,
+			imageConfig.Image(),
+			stringutil.MapToSortedSlice(outputFilePathMap),
+		)
+	}
+	return nil
+}
+
+func writeManifest(
+	manifestFilePath string,
+	genConfig *bufgen.Config,
+	image bufimage.Image,
+	outputFilePaths []string,
+) error {
+	inputDigest, err := getImageDigest(image)
+	if err != nil {
+		return err
+	}
+	externalManifest := externalManifest{
+		BufVersion:  bufcli.Version,
+		Time:        time.Now().UTC(),
+		InputDigest: inputDigest,
+		OutputFiles: outputFilePaths,
+	}
+	for _, pluginConfig := range genConfig.PluginConfigs {
+		name := pluginConfig.Name
+		if pluginConfig.Version != "" {
+			name = name + "@" + pluginConfig.Version
+		}
+		externalManifest.Template.Plugins = append(
+			externalManifest.Template.Plugins,
+			externalManifestPlugin{
+				Name:           name,
+				Out:            pluginConfig.Out,
+				Opt:            pluginConfig.Opt,
+				Path:           pluginConfig.Path,
+				Strategy:       pluginConfig.Strategy.String(),
+				IncludeImports: pluginConfig.IncludeImports,
+				IncludeWKT:     pluginConfig.IncludeWellKnownTypes,
+				OutTemplate:    pluginConfig.OutTemplate,
+			},
+		)
+	}
+	data, err := json.MarshalIndent(externalManifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(manifestFilePath, append(data, '\n'), 0644)
+}
+
+func hasPluginName(config *bufgen.Config, name string) bool {
+	for _, pluginConfig := range config.PluginConfigs {
+		if pluginConfig.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// getImageDigest returns the SHA256 digest of the deterministic binary
+// encoding of the Image, including imports and source code info.
+func getImageDigest(image bufimage.Image) (string, error) {
+	data, err := protoencoding.NewWireMarshaler().Marshal(bufimage.ImageToProtoImage(image))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data)), nil
+}
+
+type externalManifest struct {
+	BufVersion  string                   `json:"buf_version,omitempty"`
+	Time        time.Time                `json:"time"`
+	InputDigest string                   `json:"input_digest,omitempty"`
+	Template    externalManifestTemplate `json:"template"`
+	OutputFiles []string                 `json:"output_files"`
+}
+
+type externalManifestTemplate struct {
+	Plugins []externalManifestPlugin `json:"plugins,omitempty"`
+}
+
+type externalManifestPlugin struct {
+	Name           string `json:"name,omitempty"`
+	Out            string `json:"out,omitempty"`
+	Opt            string `json:"opt,omitempty"`
+	Path           string `json:"path,omitempty"`
+	Strategy       string `json:"strategy,omitempty"`
+	IncludeImports *bool  `json:"include_imports,omitempty"`
+	IncludeWKT     *bool  `json:"include_wkt,omitempty"`
+	OutTemplate    string `json:"out_template,omitempty"`
 }
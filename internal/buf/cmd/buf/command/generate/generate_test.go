@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/bufbuild/buf/internal/buf/bufcli"
@@ -30,12 +32,15 @@ import (
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd/appcmdtesting"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/normalpath"
+	"github.com/bufbuild/buf/internal/pkg/protoencoding"
 	"github.com/bufbuild/buf/internal/pkg/storage"
 	"github.com/bufbuild/buf/internal/pkg/storage/storagearchive"
 	"github.com/bufbuild/buf/internal/pkg/storage/storagemem"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 var buftestingDirPath = filepath.Join(
@@ -297,6 +302,530 @@ func testCompareGeneratedStubsArchive(
 	assert.Empty(t, string(diff))
 }
 
+func TestGenerateWriteManifest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	insertionTestdataDirPath := filepath.Join("testdata", "insertion")
+	bufGenDir := t.TempDir()
+	manifestFilePath := filepath.Join(t.TempDir(), "manifest.json")
+	appcmdtesting.RunCommandSuccess(
+		t,
+		func(name string) *appcmd.Command {
+			return NewCommand(
+				name,
+				appflag.NewBuilder(name),
+				bufcli.NopModuleResolverReaderProvider{},
+			)
+		},
+		func(string) map[string]string {
+			return map[string]string{
+				"PATH": os.Getenv("PATH"),
+			}
+		},
+		nil,
+		nil,
+		insertionTestdataDirPath,
+		"--template",
+		newExternalConfigV1Beta1String(
+			t,
+			[]testPluginInfo{
+				{name: "insertion-point-receiver"},
+				{name: "insertion-point-writer"},
+			},
+			bufGenDir,
+		),
+		"--write-manifest",
+		manifestFilePath,
+	)
+	data, err := ioutil.ReadFile(manifestFilePath)
+	require.NoError(t, err)
+	var manifest externalManifest
+	require.NoError(t, json.Unmarshal(data, &manifest))
+	assert.Equal(t, bufcli.Version, manifest.BufVersion)
+	assert.False(t, manifest.Time.IsZero())
+	assert.NotEmpty(t, manifest.InputDigest)
+	assert.Equal(
+		t,
+		externalManifestTemplate{
+			Plugins: []externalManifestPlugin{
+				{
+					Name:     "insertion-point-receiver",
+					Out:      bufGenDir,
+					Strategy: "directory",
+				},
+				{
+					Name:     "insertion-point-writer",
+					Out:      bufGenDir,
+					Strategy: "directory",
+				},
+			},
+		},
+		manifest.Template,
+	)
+	assert.Equal(
+		t,
+		[]string{
+			normalpath.Join(normalpath.Normalize(bufGenDir), "test.txt"),
+		},
+		manifest.OutputFiles,
+	)
+}
+
+func TestGenerateWithoutFailFast(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	insertionTestdataDirPath := filepath.Join("testdata", "insertion")
+	bufGenDir := t.TempDir()
+	appcmdtesting.RunCommandExitCodeStderr(
+		t,
+		func(name string) *appcmd.Command {
+			return NewCommand(
+				name,
+				appflag.NewBuilder(name),
+				bufcli.NopModuleResolverReaderProvider{},
+			)
+		},
+		1,
+		`Failed to "test": plugin missing1: could not find protoc plugin for name missing1
+plugin missing2: could not find protoc plugin for name missing2.`,
+		func(string) map[string]string {
+			return map[string]string{
+				"PATH": os.Getenv("PATH"),
+			}
+		},
+		nil,
+		insertionTestdataDirPath,
+		"--template",
+		newExternalConfigV1Beta1String(
+			t,
+			[]testPluginInfo{
+				{name: "insertion-point-receiver"},
+				{name: "missing1"},
+				{name: "insertion-point-writer"},
+				{name: "missing2"},
+			},
+			bufGenDir,
+		),
+		"--fail-fast=false",
+	)
+	// nothing is written if any plugin failed, including the
+	// output of the plugins that succeeded
+	fileInfos, err := ioutil.ReadDir(bufGenDir)
+	require.NoError(t, err)
+	assert.Empty(t, fileInfos)
+}
+
+func TestGenerateIncremental(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	insertionTestdataDirPath := filepath.Join("testdata", "insertion")
+	bufGenDir := t.TempDir()
+	stateFilePath := filepath.Join(t.TempDir(), "state.json")
+	template := newExternalConfigV1Beta1String(
+		t,
+		[]testPluginInfo{
+			{name: "insertion-point-receiver"},
+		},
+		bufGenDir,
+	)
+	outputFilePath := filepath.Join(bufGenDir, "test.txt")
+	runGenerate := func(args ...string) {
+		appcmdtesting.RunCommandSuccess(
+			t,
+			func(name string) *appcmd.Command {
+				return NewCommand(
+					name,
+					appflag.NewBuilder(name),
+					bufcli.NopModuleResolverReaderProvider{},
+				)
+			},
+			func(string) map[string]string {
+				return map[string]string{
+					"PATH": os.Getenv("PATH"),
+				}
+			},
+			nil,
+			nil,
+			append(
+				[]string{
+					insertionTestdataDirPath,
+					"--template",
+					template,
+					"--incremental",
+					"--incremental-state",
+					stateFilePath,
+				},
+				args...,
+			)...,
+		)
+	}
+	// a skipped plugin does not write its files, so the modified content
+	// of the output file is kept
+	modifyOutputFile := func() {
+		require.NoError(t, ioutil.WriteFile(outputFilePath, []byte("modified"), 0600))
+	}
+	requireOutputFile := func(expectedContent string) {
+		data, err := ioutil.ReadFile(outputFilePath)
+		require.NoError(t, err)
+		require.Equal(t, expectedContent, string(data))
+	}
+	runGenerate()
+	require.FileExists(t, stateFilePath)
+	data, err := ioutil.ReadFile(outputFilePath)
+	require.NoError(t, err)
+	generatedContent := string(data)
+	modifyOutputFile()
+	// nothing changed, so the plugin is skipped
+	runGenerate()
+	requireOutputFile("modified")
+	// the plugin is executed if one of its output files was deleted
+	require.NoError(t, os.Remove(outputFilePath))
+	runGenerate()
+	requireOutputFile(generatedContent)
+	// the plugin is executed if the generation options change
+	modifyOutputFile()
+	runGenerate("--include-imports")
+	requireOutputFile(generatedContent)
+	modifyOutputFile()
+	runGenerate("--include-imports")
+	requireOutputFile("modified")
+	runGenerate("--include-imports", "--no-incremental")
+	requireOutputFile(generatedContent)
+}
+
+func TestGeneratePluginPath(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	insertionTestdataDirPath := filepath.Join("testdata", "insertion")
+	bufGenDir := t.TempDir()
+	receiverPath, err := exec.LookPath("protoc-gen-insertion-point-receiver")
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(receiverPath)
+	require.NoError(t, err)
+	// the plugin is copied to a name that is only in the plugin path and not on the PATH
+	pluginDirPath := t.TempDir()
+	require.NoError(
+		t,
+		ioutil.WriteFile(
+			filepath.Join(pluginDirPath, "protoc-gen-plugin-path-receiver"+filepath.Ext(receiverPath)),
+			data,
+			0755,
+		),
+	)
+	appcmdtesting.RunCommandSuccess(
+		t,
+		func(name string) *appcmd.Command {
+			return NewCommand(
+				name,
+				appflag.NewBuilder(name),
+				bufcli.NopModuleResolverReaderProvider{},
+			)
+		},
+		func(string) map[string]string {
+			return map[string]string{
+				"PATH": os.Getenv("PATH"),
+			}
+		},
+		nil,
+		nil,
+		insertionTestdataDirPath,
+		"--template",
+		newExternalConfigV1Beta1String(
+			t,
+			[]testPluginInfo{
+				{name: "plugin-path-receiver"},
+			},
+			bufGenDir,
+		),
+		"--plugin-path",
+		t.TempDir(),
+		"--plugin-path",
+		pluginDirPath,
+	)
+	require.FileExists(t, filepath.Join(bufGenDir, "test.txt"))
+}
+
+func TestGenerateDumpRequest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	insertionTestdataDirPath := filepath.Join("testdata", "insertion")
+	bufGenDir := t.TempDir()
+	dumpRequestDirPath := filepath.Join(t.TempDir(), "receiver")
+	appcmdtesting.RunCommandSuccess(
+		t,
+		func(name string) *appcmd.Command {
+			return NewCommand(
+				name,
+				appflag.NewBuilder(name),
+				bufcli.NopModuleResolverReaderProvider{},
+			)
+		},
+		func(string) map[string]string {
+			return map[string]string{
+				"PATH": os.Getenv("PATH"),
+			}
+		},
+		nil,
+		nil,
+		insertionTestdataDirPath,
+		"--template",
+		newExternalConfigV1Beta1String(
+			t,
+			[]testPluginInfo{
+				{name: "insertion-point-receiver", opt: "foo=bar"},
+			},
+			bufGenDir,
+		),
+		"--dump-request",
+		"insertion-point-receiver="+dumpRequestDirPath,
+	)
+	// the plugin is still executed
+	require.FileExists(t, filepath.Join(bufGenDir, "test.txt"))
+	data, err := ioutil.ReadFile(filepath.Join(dumpRequestDirPath, "request-0.bin"))
+	require.NoError(t, err)
+	request := &pluginpb.CodeGeneratorRequest{}
+	require.NoError(t, protoencoding.NewWireUnmarshaler(nil).Unmarshal(data, request))
+	assert.Equal(t, []string{"test.proto"}, request.GetFileToGenerate())
+	assert.Equal(t, "foo=bar", request.GetParameter())
+	require.NoFileExists(t, filepath.Join(dumpRequestDirPath, "request-1.bin"))
+	appcmdtesting.RunCommandExitCodeStderr(
+		t,
+		func(name string) *appcmd.Command {
+			return NewCommand(
+				name,
+				appflag.NewBuilder(name),
+				bufcli.NopModuleResolverReaderProvider{},
+			)
+		},
+		1,
+		`Failed to "test": --dump-request: no plugin named "missing" in the template.`,
+		func(string) map[string]string {
+			return map[string]string{
+				"PATH": os.Getenv("PATH"),
+			}
+		},
+		nil,
+		insertionTestdataDirPath,
+		"--template",
+		newExternalConfigV1Beta1String(
+			t,
+			[]testPluginInfo{
+				{name: "insertion-point-receiver"},
+			},
+			bufGenDir,
+		),
+		"--dump-request",
+		"missing="+dumpRequestDirPath,
+	)
+}
+
+func TestGeneratePostGenerate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping test on windows")
+	}
+	t.Parallel()
+	insertionTestdataDirPath := filepath.Join("testdata", "insertion")
+	bufGenDir := t.TempDir()
+	hookDirPath := t.TempDir()
+	generatedFilesPath := filepath.Join(hookDirPath, "generated_files.txt")
+	notRunPath := filepath.Join(hookDirPath, "not_run.txt")
+	data, err := json.Marshal(
+		bufgen.ExternalConfigV1Beta1{
+			Version: "v1beta1",
+			Plugins: []bufgen.ExternalPluginConfigV1Beta1{
+				{
+					Name: "insertion-point-receiver",
+					Out:  bufGenDir,
+				},
+			},
+			PostGenerate: []string{
+				`printf '%s' "$BUF_GENERATED_FILES" > ` + generatedFilesPath,
+				"exit 3",
+				"touch " + notRunPath,
+			},
+		},
+	)
+	require.NoError(t, err)
+	appcmdtesting.RunCommandExitCodeStderr(
+		t,
+		func(name string) *appcmd.Command {
+			return NewCommand(
+				name,
+				appflag.NewBuilder(name),
+				bufcli.NopModuleResolverReaderProvider{},
+			)
+		},
+		1,
+		`Failed to "test": post_generate command "exit 3": exit status 3.`,
+		func(string) map[string]string {
+			return map[string]string{
+				"PATH": os.Getenv("PATH"),
+			}
+		},
+		nil,
+		insertionTestdataDirPath,
+		"--template",
+		string(data),
+	)
+	generatedFilesData, err := ioutil.ReadFile(generatedFilesPath)
+	require.NoError(t, err)
+	absBufGenDir, err := filepath.Abs(bufGenDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(absBufGenDir, "test.txt"), string(generatedFilesData))
+	// commands after the first failure are not run
+	assert.NoFileExists(t, notRunPath)
+}
+
+func TestGenerateRecursive(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	workspaceDirPath := newTestWorkspace(t, true)
+	testRunGenerateStderr(
+		t,
+		0,
+		`a: success
+b: success`,
+		workspaceDirPath,
+		"--recursive",
+	)
+	// a has its own template, b uses the template of the workspace, and the
+	// out directories are relative to the directory of the template
+	assert.FileExists(t, filepath.Join(workspaceDirPath, "a", "gen", "test.txt"))
+	assert.FileExists(t, filepath.Join(workspaceDirPath, "gen", "shared", "test.txt"))
+	assert.NoDirExists(t, filepath.Join(workspaceDirPath, "b", "gen"))
+}
+
+func TestGenerateRecursiveTemplate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	workspaceDirPath := newTestWorkspace(t, true)
+	bufGenDir := t.TempDir()
+	testRunGenerateStderr(
+		t,
+		0,
+		`a: success
+b: success`,
+		workspaceDirPath,
+		"--recursive",
+		"--template",
+		newExternalConfigV1Beta1String(
+			t,
+			[]testPluginInfo{
+				{name: "insertion-point-receiver"},
+			},
+			bufGenDir,
+		),
+	)
+	assert.FileExists(t, filepath.Join(bufGenDir, "test.txt"))
+	assert.NoDirExists(t, filepath.Join(workspaceDirPath, "a", "gen"))
+	assert.NoDirExists(t, filepath.Join(workspaceDirPath, "gen"))
+}
+
+func TestGenerateRecursiveFailure(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	t.Parallel()
+	workspaceDirPath := newTestWorkspace(t, false)
+	testRunGenerateStderr(
+		t,
+		1,
+		fmt.Sprintf(
+			`a: success
+b: failure: no buf.gen.yaml found in %s or %s
+Failed to "test": generation failed for 1 of 2 directories.`,
+			filepath.Join(workspaceDirPath, "b"),
+			workspaceDirPath,
+		),
+		workspaceDirPath,
+		"--recursive",
+	)
+	// the directories after a failure are still generated, and the
+	// directories that succeeded are kept
+	assert.FileExists(t, filepath.Join(workspaceDirPath, "a", "gen", "test.txt"))
+}
+
+// newTestWorkspace returns the path of a new workspace with the directories
+// a and b, where a has its own template that outputs to gen.
+//
+// If sharedTemplate is true, the workspace directory has a template that
+// outputs to gen/shared.
+func newTestWorkspace(t *testing.T, sharedTemplate bool) string {
+	workspaceDirPath := t.TempDir()
+	files := map[string]string{
+		"buf.work.yaml": `version: v1beta1
+directories:
+  - a
+  - b
+`,
+		"a/a.proto": `syntax = "proto3";
+
+package a;
+`,
+		"b/b.proto": `syntax = "proto3";
+
+package b;
+`,
+		"a/buf.gen.yaml": `version: v1beta1
+plugins:
+  - name: insertion-point-receiver
+    out: gen
+`,
+	}
+	if sharedTemplate {
+		files["buf.gen.yaml"] = `version: v1beta1
+plugins:
+  - name: insertion-point-receiver
+    out: gen/shared
+`
+	}
+	for path, data := range files {
+		filePath := filepath.Join(workspaceDirPath, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.NoError(t, ioutil.WriteFile(filePath, []byte(data), 0600))
+	}
+	return workspaceDirPath
+}
+
+func testRunGenerateStderr(t *testing.T, expectedExitCode int, expectedStderr string, args ...string) {
+	appcmdtesting.RunCommandExitCodeStderr(
+		t,
+		func(name string) *appcmd.Command {
+			return NewCommand(
+				name,
+				appflag.NewBuilder(name),
+				bufcli.NopModuleResolverReaderProvider{},
+			)
+		},
+		expectedExitCode,
+		expectedStderr,
+		func(string) map[string]string {
+			return map[string]string{
+				"PATH": os.Getenv("PATH"),
+			}
+		},
+		nil,
+		args...,
+	)
+}
+
 type testPluginInfo struct {
 	name string
 	opt  string
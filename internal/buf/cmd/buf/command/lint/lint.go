@@ -18,15 +18,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
+	"github.com/bufbuild/buf/internal/buf/bufcheck"
 	"github.com/bufbuild/buf/internal/buf/bufcheck/buflint"
 	"github.com/bufbuild/buf/internal/buf/bufcli"
-	"github.com/bufbuild/buf/internal/buf/bufconfig"
+	"github.com/bufbuild/buf/internal/buf/bufcore"
 	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage"
+	"github.com/bufbuild/buf/internal/buf/bufcore/bufimage/bufimagebuild"
 	"github.com/bufbuild/buf/internal/buf/buffetch"
+	"github.com/bufbuild/buf/internal/buf/bufwire"
+	"github.com/bufbuild/buf/internal/pkg/app"
 	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/bufbuild/buf/internal/pkg/app/appflag"
+	"github.com/bufbuild/buf/internal/pkg/diff"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 	"github.com/bufbuild/buf/internal/pkg/stringutil"
 	"github.com/spf13/cobra"
@@ -34,9 +43,21 @@ import (
 )
 
 const (
-	errorFormatFlagName = "error-format"
-	configFlagName      = "config"
-	pathsFlagName       = "path"
+	errorFormatFlagName            = "error-format"
+	configFlagName                 = "config"
+	pathsFlagName                  = "path"
+	pathsFromFlagName              = "paths-from"
+	ignoreUnstablePackagesFlagName = "ignore-unstable-packages"
+	fixFlagName                    = "fix"
+	configOverrideFileFlagName     = "config-override-file"
+	exitCodeFlagName               = "exit-code"
+	onlyFlagName                   = "only"
+	ignoreFlagName                 = "ignore"
+	disableDefaultIgnoresFlagName  = "disable-default-ignores"
+	failOnWarningsFlagName         = "fail-on-warnings"
+	maxErrorsFlagName              = "max-errors"
+	experimentalEditionsFlagName   = "experimental-editions"
+	newRulesSinceFlagName          = "new-rules-since"
 
 	// deprecated
 	inputFlagName = "input"
@@ -73,9 +94,21 @@ func NewCommand(
 }
 
 type flags struct {
-	ErrorFormat string
-	Config      string
-	Paths       []string
+	ErrorFormat            string
+	Config                 string
+	Paths                  []string
+	PathsFrom              string
+	IgnoreUnstablePackages bool
+	Fix                    bool
+	ConfigOverrideFile     string
+	ExitCode               int
+	Only                   []string
+	Ignore                 []string
+	DisableDefaultIgnores  bool
+	FailOnWarnings         bool
+	MaxErrors              int
+	ExperimentalEditions   bool
+	NewRulesSince          string
 
 	// deprecated
 	Input string
@@ -94,6 +127,7 @@ func newFlags() *flags {
 func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
 	bufcli.BindPathsAndDeprecatedFiles(flagSet, &f.Paths, pathsFlagName, &f.Files, filesFlagName)
+	bufcli.BindPathsFrom(flagSet, &f.PathsFrom, pathsFromFlagName, pathsFlagName)
 	flagSet.StringVar(
 		&f.ErrorFormat,
 		errorFormatFlagName,
@@ -109,6 +143,49 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		"",
 		`The config file or data to use.`,
 	)
+	bufcli.BindConfigOverrideFile(flagSet, &f.ConfigOverrideFile, configOverrideFileFlagName, configFlagName)
+	bufcli.BindViolationsExitCode(flagSet, &f.ExitCode, exitCodeFlagName)
+	bufcli.BindMaxErrors(flagSet, &f.MaxErrors, maxErrorsFlagName)
+	bufcli.BindExperimentalEditions(flagSet, &f.ExperimentalEditions, experimentalEditionsFlagName)
+	bufcli.BindOnlyAndIgnoreRuleIDs(flagSet, &f.Only, onlyFlagName, &f.Ignore, ignoreFlagName)
+	flagSet.BoolVar(
+		&f.IgnoreUnstablePackages,
+		ignoreUnstablePackagesFlagName,
+		false,
+		`Ignore files with packages that have an unstable version suffix, such as "foo.v1alpha1" or "foo.v1beta1".
+This is equivalent to setting "ignore_unstable_packages" in the lint configuration.`,
+	)
+	flagSet.BoolVar(
+		&f.Fix,
+		fixFlagName,
+		false,
+		`Fix violations of fixable rules by renaming declarations in the source files in place, and print a diff of the changes to stderr.
+The fixable rules are ENUM_VALUE_PREFIX, ENUM_VALUE_UPPER_SNAKE_CASE, ENUM_ZERO_VALUE_SUFFIX, and FIELD_LOWER_SNAKE_CASE.
+Names that are referenced elsewhere in their file, or in other files that can reference them, are not fixed. Only local directory inputs can be fixed.
+With --path, only the given files are fixed, but references are checked in every file of the input.`,
+	)
+	flagSet.BoolVar(
+		&f.DisableDefaultIgnores,
+		disableDefaultIgnoresFlagName,
+		false,
+		`Lint every file in the input, including imports.
+By default, imports are not linted, which includes the Well-Known Types, dependencies, and any files outside of the given paths.
+Ignores set in the lint configuration or with comments still apply.`,
+	)
+	flagSet.BoolVar(
+		&f.FailOnWarnings,
+		failOnWarningsFlagName,
+		false,
+		`Fail if there are violations of rules with severity warning in the "rule_severities" section of the lint configuration.
+By default, these violations are printed but do not fail the lint.`,
+	)
+	flagSet.StringVar(
+		&f.NewRulesSince,
+		newRulesSinceFlagName,
+		"",
+		`List the lint rules that were introduced in buf versions later than this buf version, such as "v0.39.1", instead of linting.
+Use the version of buf you upgraded from to review the new rules and add the ones you want to your lint configuration.`,
+	)
 
 	// deprecated
 	flagSet.StringVar(
@@ -145,6 +222,19 @@ func run(
 	flags *flags,
 	moduleResolverReaderProvider bufcli.ModuleResolverReaderProvider,
 ) (retErr error) {
+	if err := bufcli.CheckViolationsExitCode(flags.ExitCode, exitCodeFlagName); err != nil {
+		return err
+	}
+	if err := bufcli.CheckMaxErrors(flags.MaxErrors, maxErrorsFlagName); err != nil {
+		return err
+	}
+	if flags.NewRulesSince != "" {
+		rules, err := buflint.GetRulesIntroducedSinceV1Beta1(flags.NewRulesSince)
+		if err != nil {
+			return appcmd.NewInvalidArgumentErrorf("--%s: %v", newRulesSinceFlagName, err)
+		}
+		return bufcheck.PrintRules(container.Stdout(), rules, "text")
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, flags.Input, inputFlagName, ".")
 	if err != nil {
 		return err
@@ -167,11 +257,40 @@ func run(
 	if err != nil {
 		return err
 	}
+	if flags.PathsFrom != "" {
+		if (flags.PathsFrom == "-" || app.IsDevStdin(flags.PathsFrom)) && isStdinInput(input) {
+			return appcmd.NewInvalidArgumentErrorf("--%s cannot read from stdin when the input is read from stdin", pathsFromFlagName)
+		}
+		pathsFrom, err := bufcli.ReadPathsFrom(container, flags.PathsFrom)
+		if err != nil {
+			return fmt.Errorf("--%s: %v", pathsFromFlagName, err)
+		}
+		if len(pathsFrom) == 0 && len(paths) == 0 {
+			// an empty list, such as from a diff with no changed files,
+			// means there is nothing to lint rather than lint everything
+			return nil
+		}
+		paths = append(paths, pathsFrom...)
+	}
 	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, input)
 	if err != nil {
 		return err
 	}
-	configProvider := bufconfig.NewProvider(container.Logger())
+	sourceRef, isSourceRef := ref.(buffetch.SourceRef)
+	isLocalDir := isSourceRef && sourceRef.IsLocalDir()
+	if flags.Fix {
+		if !isLocalDir {
+			return appcmd.NewInvalidArgumentErrorf("--%s can only be used with local directory inputs", fixFlagName)
+		}
+		if flags.DisableDefaultIgnores {
+			// imports may not be local files
+			return appcmd.NewInvalidArgumentErrorf("--%s cannot be used with --%s", fixFlagName, disableDefaultIgnoresFlagName)
+		}
+	}
+	configProvider, err := bufcli.NewConfigProvider(container.Logger(), flags.ConfigOverrideFile)
+	if err != nil {
+		return err
+	}
 	moduleResolver, err := moduleResolverReaderProvider.GetModuleResolver(ctx, container)
 	if err != nil {
 		return err
@@ -180,14 +299,23 @@ func run(
 	if err != nil {
 		return err
 	}
+	var imageConfigReaderOptions []bufwire.ImageConfigReaderOption
+	if flags.ExperimentalEditions {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithBuildOptions(bufimagebuild.WithExperimentalEditions()),
+		)
+	}
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
-	imageConfig, fileAnnotations, err := bufcli.NewWireImageConfigReader(
+	imageConfigReader := bufcli.NewWireImageConfigReader(
 		container.Logger(),
 		storageosProvider,
 		configProvider,
 		moduleResolver,
 		moduleReader,
-	).GetImageConfig(
+		imageConfigReaderOptions...,
+	)
+	imageConfig, fileAnnotations, err := imageConfigReader.GetImageConfig(
 		ctx,
 		container,
 		ref,
@@ -200,32 +328,168 @@ func run(
 		return err
 	}
 	if len(fileAnnotations) > 0 {
+		if isLocalDir {
+			fileAnnotations, err = bufanalysis.FileAnnotationsWithAbsolutePaths(fileAnnotations, getAbsolutePath)
+			if err != nil {
+				return err
+			}
+		}
 		formatString := flags.ErrorFormat
 		if formatString == "config-ignore-yaml" {
 			formatString = "text"
 		}
-		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, formatString); err != nil {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stdout(),
+			fileAnnotations,
+			formatString,
+			bufanalysis.PrintFileAnnotationsWithMaxCount(flags.MaxErrors),
+		); err != nil {
 			return err
 		}
 		return errors.New("")
 	}
+	lintConfig := imageConfig.Config().Lint
+	if flags.IgnoreUnstablePackages {
+		lintConfig.IgnoreUnstablePackages = true
+	}
+	if len(flags.Only) > 0 || len(flags.Ignore) > 0 {
+		lintConfig, err = buflint.NewConfigForRuleIDsV1Beta1(lintConfig, flags.Only, flags.Ignore)
+		if err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+	}
+	image := imageConfig.Image()
+	if !flags.DisableDefaultIgnores {
+		image = bufimage.ImageWithoutImports(image)
+	}
 	fileAnnotations, err = buflint.NewHandler(container.Logger()).Check(
 		ctx,
-		imageConfig.Config().Lint,
-		bufimage.ImageWithoutImports(imageConfig.Image()),
+		lintConfig,
+		image,
 	)
 	if err != nil {
 		return err
 	}
+	if flags.Fix && len(fileAnnotations) > 0 {
+		fixImage := image
+		if len(paths) > 0 {
+			// files outside of the paths may reference the names that are fixed,
+			// so references are checked against every file of the input, while
+			// only the files with FileAnnotations are fixed
+			fixImageConfig, fixFileAnnotations, err := imageConfigReader.GetImageConfig(
+				ctx,
+				container,
+				ref,
+				inputConfig,
+				nil,
+				false,
+				false,
+			)
+			if err != nil {
+				return err
+			}
+			if len(fixFileAnnotations) > 0 {
+				// the files outside of the paths do not compile, so
+				// references to the fixed names cannot be checked
+				return fmt.Errorf("--%s: the input must compile outside of the given paths, run without --%s to see the errors", fixFlagName, fixFlagName)
+			}
+			fixImage = bufimage.ImageWithoutImports(fixImageConfig.Image())
+		}
+		fileAnnotations, err = fix(ctx, container, lintConfig, fixImage, fileAnnotations)
+		if err != nil {
+			return err
+		}
+	}
 	if len(fileAnnotations) > 0 {
+		if isLocalDir {
+			fileAnnotations, err = bufanalysis.FileAnnotationsWithAbsolutePaths(fileAnnotations, getAbsolutePath)
+			if err != nil {
+				return err
+			}
+		}
 		if err := buflint.PrintFileAnnotations(
 			container.Stdout(),
 			fileAnnotations,
 			flags.ErrorFormat,
+			bufanalysis.PrintFileAnnotationsWithMaxCount(flags.MaxErrors),
 		); err != nil {
 			return err
 		}
-		return errors.New("")
+		if !bufanalysis.HasErrorFileAnnotations(fileAnnotations) && !flags.FailOnWarnings {
+			// only warnings
+			return nil
+		}
+		return bufcli.NewViolationsFoundError(flags.ExitCode)
 	}
 	return nil
 }
+
+// fix fixes the fileAnnotations in place, printing a diff of the changes.
+//
+// The diff is printed to stderr, as the remaining FileAnnotations are printed to
+// stdout in the error format, which may be a machine-readable format such as json.
+//
+// Returns the FileAnnotations that were not fixed.
+func fix(
+	ctx context.Context,
+	container appflag.Container,
+	lintConfig *buflint.Config,
+	image bufimage.Image,
+	fileAnnotations []bufanalysis.FileAnnotation,
+) ([]bufanalysis.FileAnnotation, error) {
+	fixedFiles, unfixedFileAnnotations, err := buflint.Fix(
+		ctx,
+		lintConfig,
+		image,
+		fileAnnotations,
+		func(fileInfo bufanalysis.FileInfo) ([]byte, error) {
+			return ioutil.ReadFile(fileInfo.ExternalPath())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, fixedFile := range fixedFiles {
+		diffData, err := diff.Diff(
+			ctx,
+			fixedFile.OriginalData(),
+			fixedFile.FixedData(),
+			fixedFile.ExternalPath(),
+			fixedFile.ExternalPath(),
+			diff.DiffWithSuppressCommands(),
+			diff.DiffWithSuppressTimestamps(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := container.Stderr().Write(diffData); err != nil {
+			return nil, err
+		}
+		fileInfo, err := os.Stat(fixedFile.ExternalPath())
+		if err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(fixedFile.ExternalPath(), fixedFile.FixedData(), fileInfo.Mode().Perm()); err != nil {
+			return nil, err
+		}
+	}
+	return unfixedFileAnnotations, nil
+}
+
+// getAbsolutePath returns the absolute path of the file for a local directory input.
+//
+// Imports may not be within the directory, and do not have an absolute path.
+func getAbsolutePath(fileInfo bufanalysis.FileInfo) (string, error) {
+	if coreFileInfo, ok := fileInfo.(bufcore.FileInfo); ok && coreFileInfo.IsImport() {
+		return "", nil
+	}
+	return filepath.Abs(fileInfo.ExternalPath())
+}
+
+// isStdinInput returns true if the input is read from stdin.
+func isStdinInput(input string) bool {
+	if index := strings.Index(input, "#"); index >= 0 {
+		input = input[:index]
+	}
+	return input == "-" || app.IsDevStdin(input)
+}
@@ -32,10 +32,6 @@ func newCannotSpecifyPathWithoutOutError(pluginName string) error {
 	return fmt.Errorf("cannot specify --%s=protoc-gen-%s without --%s_out", pluginPathValuesFlagName, pluginName, pluginName)
 }
 
-func newRecursiveReferenceError(flagFilePath string) error {
-	return fmt.Errorf("%s recursively referenced", flagFilePath)
-}
-
 func newOutMultipleColonsError(pluginName string, out string) error {
 	return fmt.Errorf("invalid value for --%s_out=%s (multiple colons)", pluginName, out)
 }
@@ -93,15 +89,3 @@ Please email us at support@buf.build if this is a need for your organization.`,
 		decodeRawFlagName,
 	)
 }
-
-func newDescriptorSetInNotSupportedError() error {
-	return fmt.Errorf(
-		`--%s is not supported by buf.
-
-Buf will work with cross-repository imports Buf Schema Registry, which will be based on source files, not pre-compiled images.
-We think this is a much safer option that leads to less errors and more consistent results.
-
-Please email us at support@buf.build if this is a need for your organization.`,
-		descriptorSetInFlagName,
-	)
-}
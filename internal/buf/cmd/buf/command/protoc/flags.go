@@ -16,7 +16,6 @@ package protoc
 
 import (
 	"fmt"
-	"io/ioutil"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -58,6 +57,7 @@ type flags struct {
 	Output                string
 	ErrorFormat           string
 	ByDir                 bool
+	DescriptorSetIn       []string
 }
 
 type env struct {
@@ -73,10 +73,9 @@ type flagsBuilder struct {
 
 	PluginPathValues []string
 
-	Encode          string
-	Decode          string
-	DecodeRaw       bool
-	DescriptorSetIn []string
+	Encode    string
+	Decode    string
+	DecodeRaw bool
 
 	pluginFake        []string
 	pluginNameToValue map[string]*pluginValue
@@ -145,6 +144,13 @@ func (f *flagsBuilder) Bind(flagSet *pflag.FlagSet) {
 		nil,
 		`The paths to the plugin executables to use, either in the form "path/to/protoc-gen-foo" or "protoc-gen-foo=path/to/binary".`,
 	)
+	flagSet.StringSliceVar(
+		&f.DescriptorSetIn,
+		descriptorSetInFlagName,
+		nil,
+		`The FileDescriptorSets whose files can be imported without source, delimited by the path list separator.
+Files found on the include paths take precedence over files with the same path in a FileDescriptorSet.`,
+	)
 	flagSet.BoolVar(
 		&f.ByDir,
 		byDirFlagName,
@@ -183,13 +189,6 @@ func (f *flagsBuilder) Bind(flagSet *pflag.FlagSet) {
 		`Not supported by buf.`,
 	)
 	_ = flagSet.MarkHidden(decodeRawFlagName)
-	flagSet.StringSliceVar(
-		&f.DescriptorSetIn,
-		descriptorSetInFlagName,
-		nil,
-		`Not supported by buf.`,
-	)
-	_ = flagSet.MarkHidden(descriptorSetInFlagName)
 }
 
 func (f *flagsBuilder) Normalize(flagSet *pflag.FlagSet, name string) string {
@@ -206,11 +205,17 @@ func (f *flagsBuilder) Normalize(flagSet *pflag.FlagSet, name string) string {
 
 func (f *flagsBuilder) Build(args []string) (*env, error) {
 	pluginNameToPluginInfo := make(map[string]*pluginInfo)
-	seenFlagFilePaths := make(map[string]struct{})
-	filePaths, err := f.buildRec(args, pluginNameToPluginInfo, seenFlagFilePaths)
-	if err != nil {
+	if err := f.parsePluginNameToPluginInfo(pluginNameToPluginInfo); err != nil {
 		return nil, err
 	}
+	// @filename arguments are expanded by appcmd before the flags are parsed
+	filePaths := make([]string, 0, len(args))
+	for _, arg := range args {
+		if len(arg) == 0 {
+			return nil, errArgEmpty
+		}
+		filePaths = append(filePaths, arg)
+	}
 	if err := f.checkUnsupported(); err != nil {
 		return nil, err
 	}
@@ -258,98 +263,6 @@ func (f *flagsBuilder) pluginFakeParse(name string, suffix string, isOut bool) {
 	}
 }
 
-func (f *flagsBuilder) buildRec(
-	args []string,
-	pluginNameToPluginInfo map[string]*pluginInfo,
-	seenFlagFilePaths map[string]struct{},
-) ([]string, error) {
-	if err := f.parsePluginNameToPluginInfo(pluginNameToPluginInfo); err != nil {
-		return nil, err
-	}
-	filePaths := make([]string, 0, len(args))
-	for _, arg := range args {
-		if len(arg) == 0 {
-			return nil, errArgEmpty
-		}
-		if arg[0] != '@' {
-			filePaths = append(filePaths, arg)
-		} else {
-			flagFilePath := arg[1:]
-			if _, ok := seenFlagFilePaths[flagFilePath]; ok {
-				return nil, newRecursiveReferenceError(flagFilePath)
-			}
-			seenFlagFilePaths[flagFilePath] = struct{}{}
-			data, err := ioutil.ReadFile(flagFilePath)
-			if err != nil {
-				return nil, err
-			}
-			var flagFilePathArgs []string
-			for _, flagFilePathArg := range strings.Split(string(data), "\n") {
-				flagFilePathArg = strings.TrimSpace(flagFilePathArg)
-				if flagFilePathArg != "" {
-					flagFilePathArgs = append(flagFilePathArgs, flagFilePathArg)
-				}
-			}
-			subFlagsBuilder := newFlagsBuilder()
-			flagSet := pflag.NewFlagSet(flagFilePath, pflag.ContinueOnError)
-			subFlagsBuilder.Bind(flagSet)
-			flagSet.SetNormalizeFunc(normalizeFunc(subFlagsBuilder.Normalize))
-			if err := flagSet.Parse(flagFilePathArgs); err != nil {
-				return nil, err
-			}
-			subFilePaths, err := subFlagsBuilder.buildRec(
-				flagSet.Args(),
-				pluginNameToPluginInfo,
-				seenFlagFilePaths,
-			)
-			if err != nil {
-				return nil, err
-			}
-			if err := f.merge(subFlagsBuilder); err != nil {
-				return nil, err
-			}
-			filePaths = append(filePaths, subFilePaths...)
-		}
-	}
-	return filePaths, nil
-}
-
-// we need to bind a separate flags as pflags overrides the values with defaults if you bind again
-// note that pflags does not error on duplicates so we do not either
-func (f *flagsBuilder) merge(subFlagsBuilder *flagsBuilder) error {
-	f.IncludeDirPaths = append(f.IncludeDirPaths, subFlagsBuilder.IncludeDirPaths...)
-	if subFlagsBuilder.IncludeImports {
-		f.IncludeImports = true
-	}
-	if subFlagsBuilder.IncludeSourceInfo {
-		f.IncludeSourceInfo = true
-	}
-	if subFlagsBuilder.PrintFreeFieldNumbers {
-		f.PrintFreeFieldNumbers = true
-	}
-	if subFlagsBuilder.Output != "" {
-		f.Output = subFlagsBuilder.Output
-	}
-	if subFlagsBuilder.ErrorFormat != "" {
-		f.ErrorFormat = subFlagsBuilder.ErrorFormat
-	}
-	if subFlagsBuilder.ByDir {
-		f.ByDir = true
-	}
-	f.PluginPathValues = append(f.PluginPathValues, subFlagsBuilder.PluginPathValues...)
-	if subFlagsBuilder.Encode != "" {
-		f.Encode = subFlagsBuilder.Encode
-	}
-	if subFlagsBuilder.Decode != "" {
-		f.Decode = subFlagsBuilder.Decode
-	}
-	if subFlagsBuilder.DecodeRaw {
-		f.DecodeRaw = true
-	}
-	f.DescriptorSetIn = append(f.DescriptorSetIn, subFlagsBuilder.DescriptorSetIn...)
-	return nil
-}
-
 func (f *flagsBuilder) parsePluginNameToPluginInfo(pluginNameToPluginInfo map[string]*pluginInfo) error {
 	for pluginName, pluginValue := range f.pluginNameToValue {
 		switch len(pluginValue.OutIndexes) {
@@ -483,9 +396,6 @@ func (f *flagsBuilder) checkUnsupported() error {
 	if f.DecodeRaw {
 		return newDecodeRawNotSupportedError()
 	}
-	if len(f.DescriptorSetIn) > 0 {
-		return newDescriptorSetInNotSupportedError()
-	}
 	return nil
 }
 
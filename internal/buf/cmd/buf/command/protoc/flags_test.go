@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/bufbuild/buf/internal/pkg/app/appcmd"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -49,10 +50,7 @@ func TestParseFlags(t *testing.T) {
 		},
 		{
 			Args: []string{
-				"-I",
-				"proto",
-				"--error_format",
-				"text",
+				"@" + filepath.Join("testdata", "1", "flags.txt"),
 				"foo.proto",
 			},
 			Expected: &env{
@@ -62,6 +60,16 @@ func TestParseFlags(t *testing.T) {
 					},
 					ErrorFormat: "text",
 				},
+				PluginNamesSortedByOutIndex: []string{
+					"go",
+				},
+				PluginNameToPluginInfo: map[string]*pluginInfo{
+					"go": {
+						Out:  "go_out",
+						Opt:  []string{"plugins=grpc"},
+						Path: "/bin/protoc-gen-go",
+					},
+				},
 				FilePaths: []string{
 					"foo.proto",
 				},
@@ -69,12 +77,7 @@ func TestParseFlags(t *testing.T) {
 		},
 		{
 			Args: []string{
-				"-I",
-				"proto",
-				"--error_format",
-				"text",
-				"--go_out",
-				"plugins=grpc:go_out",
+				"@" + filepath.Join("testdata", "2", "flags1.txt"),
 				"foo.proto",
 			},
 			Expected: &env{
@@ -89,8 +92,9 @@ func TestParseFlags(t *testing.T) {
 				},
 				PluginNameToPluginInfo: map[string]*pluginInfo{
 					"go": {
-						Out: "go_out",
-						Opt: []string{"plugins=grpc"},
+						Out:  "go_out",
+						Opt:  []string{"plugins=grpc"},
+						Path: "/bin/protoc-gen-go",
 					},
 				},
 				FilePaths: []string{
@@ -100,14 +104,14 @@ func TestParseFlags(t *testing.T) {
 		},
 		{
 			Args: []string{
-				"-I",
-				"proto",
-				"--error_format",
-				"text",
-				"--go_out",
-				"go_out",
-				"--go_opt",
-				"plugins=grpc",
+				"@" + filepath.Join("testdata", "3", "flags1.txt"),
+				"foo.proto",
+			},
+			ExpectedError: fmt.Errorf("%s recursively referenced", "testdata/3/flags1.txt"),
+		},
+		{
+			Args: []string{
+				"@" + filepath.Join("testdata", "4", "flags.txt"),
 				"foo.proto",
 			},
 			Expected: &env{
@@ -115,16 +119,35 @@ func TestParseFlags(t *testing.T) {
 					IncludeDirPaths: []string{
 						"proto",
 					},
-					ErrorFormat: "text",
+					ErrorFormat: "gcc",
 				},
 				PluginNamesSortedByOutIndex: []string{
 					"go",
 				},
 				PluginNameToPluginInfo: map[string]*pluginInfo{
 					"go": {
-						Out: "go_out",
-						Opt: []string{"plugins=grpc"},
+						Out: "#go_out",
+					},
+				},
+				FilePaths: []string{
+					"foo.proto",
+				},
+			},
+		},
+		{
+			Args: []string{
+				"-I",
+				"proto",
+				"--error_format",
+				"text",
+				"foo.proto",
+			},
+			Expected: &env{
+				flags: flags{
+					IncludeDirPaths: []string{
+						"proto",
 					},
+					ErrorFormat: "text",
 				},
 				FilePaths: []string{
 					"foo.proto",
@@ -138,11 +161,7 @@ func TestParseFlags(t *testing.T) {
 				"--error_format",
 				"text",
 				"--go_out",
-				"go_out",
-				"--go_opt",
-				"plugins=grpc",
-				"--plugin",
-				"/bin/protoc-gen-go",
+				"plugins=grpc:go_out",
 				"foo.proto",
 			},
 			Expected: &env{
@@ -157,9 +176,8 @@ func TestParseFlags(t *testing.T) {
 				},
 				PluginNameToPluginInfo: map[string]*pluginInfo{
 					"go": {
-						Out:  "go_out",
-						Opt:  []string{"plugins=grpc"},
-						Path: "/bin/protoc-gen-go",
+						Out: "go_out",
+						Opt: []string{"plugins=grpc"},
 					},
 				},
 				FilePaths: []string{
@@ -177,8 +195,6 @@ func TestParseFlags(t *testing.T) {
 				"go_out",
 				"--go_opt",
 				"plugins=grpc",
-				"--plugin",
-				"protoc-gen-go=/bin/foo",
 				"foo.proto",
 			},
 			Expected: &env{
@@ -193,9 +209,8 @@ func TestParseFlags(t *testing.T) {
 				},
 				PluginNameToPluginInfo: map[string]*pluginInfo{
 					"go": {
-						Out:  "go_out",
-						Opt:  []string{"plugins=grpc"},
-						Path: "/bin/foo",
+						Out: "go_out",
+						Opt: []string{"plugins=grpc"},
 					},
 				},
 				FilePaths: []string{
@@ -205,7 +220,16 @@ func TestParseFlags(t *testing.T) {
 		},
 		{
 			Args: []string{
-				"@" + filepath.Join("testdata", "1", "flags.txt"),
+				"-I",
+				"proto",
+				"--error_format",
+				"text",
+				"--go_out",
+				"go_out",
+				"--go_opt",
+				"plugins=grpc",
+				"--plugin",
+				"/bin/protoc-gen-go",
 				"foo.proto",
 			},
 			Expected: &env{
@@ -232,7 +256,16 @@ func TestParseFlags(t *testing.T) {
 		},
 		{
 			Args: []string{
-				"@" + filepath.Join("testdata", "2", "flags1.txt"),
+				"-I",
+				"proto",
+				"--error_format",
+				"text",
+				"--go_out",
+				"go_out",
+				"--go_opt",
+				"plugins=grpc",
+				"--plugin",
+				"protoc-gen-go=/bin/foo",
 				"foo.proto",
 			},
 			Expected: &env{
@@ -249,7 +282,7 @@ func TestParseFlags(t *testing.T) {
 					"go": {
 						Out:  "go_out",
 						Opt:  []string{"plugins=grpc"},
-						Path: "/bin/protoc-gen-go",
+						Path: "/bin/foo",
 					},
 				},
 				FilePaths: []string{
@@ -257,13 +290,6 @@ func TestParseFlags(t *testing.T) {
 				},
 			},
 		},
-		{
-			Args: []string{
-				"@" + filepath.Join("testdata", "3", "flags1.txt"),
-				"foo.proto",
-			},
-			ExpectedError: newRecursiveReferenceError(filepath.Join("testdata", "3", "flags1.txt")),
-		},
 		{
 			Args: []string{
 				"-I",
@@ -499,6 +525,10 @@ func testParseFlags(name string, args []string) (*env, error) {
 	flagSet := pflag.NewFlagSet(name, pflag.ContinueOnError)
 	flagsBuilder.Bind(flagSet)
 	flagSet.SetNormalizeFunc(normalizeFunc(flagsBuilder.Normalize))
+	args, err := appcmd.ExpandArgFiles(args)
+	if err != nil {
+		return nil, err
+	}
 	if err := flagSet.Parse(args); err != nil {
 		return nil, err
 	}
@@ -56,6 +56,8 @@ func executePlugin(
 		bufimage.ImagesToCodeGeneratorRequests(
 			images,
 			strings.Join(pluginInfo.Opt, ","),
+			false,
+			false,
 		),
 		appprotoos.GenerateWithPluginPath(pluginInfo.Path),
 	); err != nil {
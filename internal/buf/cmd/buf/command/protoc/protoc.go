@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/bufbuild/buf/internal/buf/bufanalysis"
@@ -123,6 +124,17 @@ func run(
 	if len(env.PluginNameToPluginInfo) == 0 && !env.IncludeSourceInfo {
 		buildOptions = append(buildOptions, bufimagebuild.WithExcludeSourceCodeInfo())
 	}
+	if len(env.DescriptorSetIn) > 0 {
+		var descriptorSetInPaths []string
+		for _, descriptorSetIn := range env.DescriptorSetIn {
+			descriptorSetInPaths = append(descriptorSetInPaths, filepath.SplitList(descriptorSetIn)...)
+		}
+		fileDescriptorProtos, err := bufcli.ReadFileDescriptorSets(descriptorSetInPaths)
+		if err != nil {
+			return fmt.Errorf("--%s: %v", descriptorSetInFlagName, err)
+		}
+		buildOptions = append(buildOptions, bufimagebuild.WithImportFileDescriptorProtos(fileDescriptorProtos...))
+	}
 	image, fileAnnotations, err := bufimagebuild.NewBuilder(container.Logger()).Build(
 		ctx,
 		moduleFileSet,
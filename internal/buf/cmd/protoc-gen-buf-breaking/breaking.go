@@ -179,6 +179,13 @@ func handle(
 		if err := bufanalysis.PrintFileAnnotations(buffer, fileAnnotations, externalConfig.ErrorFormat); err != nil {
 			return err
 		}
+		if !bufanalysis.HasErrorFileAnnotations(fileAnnotations) {
+			// only warnings, such as unused comment ignores
+			if _, err := container.Stderr().Write(buffer.Bytes()); err != nil {
+				return err
+			}
+			return nil
+		}
 		responseWriter.AddError(strings.TrimSpace(buffer.String()))
 	}
 	return nil
@@ -245,5 +245,5 @@ func testBuildCodeGeneratorRequest(
 	}
 	image, err := bufimage.NewImage(imageFiles)
 	require.NoError(t, err)
-	return bufimage.ImageToCodeGeneratorRequest(image, parameter)
+	return bufimage.ImageToCodeGeneratorRequest(image, parameter, false, false)
 }
@@ -99,7 +99,7 @@ func RunActualProtoc(
 }
 
 // GetGoogleapisDirPath gets the path to a clone of googleapis.
-func GetGoogleapisDirPath(t *testing.T, buftestingDirPath string) string {
+func GetGoogleapisDirPath(t testing.TB, buftestingDirPath string) string {
 	googleapisDirPath := filepath.Join(buftestingDirPath, testGoogleapisDirPath)
 	require.NoError(
 		t,
@@ -43,7 +43,12 @@ type OrganizationService interface {
 		reverse bool,
 	) (organizations []*v1alpha1.Organization, nextPageToken string, err error)
 	// CreateOrganization creates a new organization.
-	CreateOrganization(ctx context.Context, name string) (organization *v1alpha1.Organization, err error)
+	CreateOrganization(
+		ctx context.Context,
+		name string,
+		description string,
+		url string,
+	) (organization *v1alpha1.Organization, err error)
 	// UpdateOrganizationName updates a organization's name.
 	UpdateOrganizationName(
 		ctx context.Context,
@@ -56,6 +61,30 @@ type OrganizationService interface {
 		name string,
 		newName string,
 	) (organization *v1alpha1.Organization, err error)
+	// UpdateOrganizationDescription updates a organization's description.
+	UpdateOrganizationDescription(
+		ctx context.Context,
+		id string,
+		newDescription string,
+	) (organization *v1alpha1.Organization, err error)
+	// UpdateOrganizationDescriptionByName updates a organization's description by name.
+	UpdateOrganizationDescriptionByName(
+		ctx context.Context,
+		name string,
+		newDescription string,
+	) (organization *v1alpha1.Organization, err error)
+	// UpdateOrganizationURL updates a organization's URL.
+	UpdateOrganizationURL(
+		ctx context.Context,
+		id string,
+		newUrl string,
+	) (organization *v1alpha1.Organization, err error)
+	// UpdateOrganizationURLByName updates a organization's URL by name.
+	UpdateOrganizationURLByName(
+		ctx context.Context,
+		name string,
+		newUrl string,
+	) (organization *v1alpha1.Organization, err error)
 	// DeleteOrganization deletes a organization.
 	DeleteOrganization(ctx context.Context, id string) (err error)
 	// DeleteOrganizationByName deletes a organization by name.
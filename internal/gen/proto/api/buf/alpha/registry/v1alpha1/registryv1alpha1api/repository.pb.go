@@ -27,6 +27,8 @@ type RepositoryService interface {
 	GetRepository(ctx context.Context, id string) (repository *v1alpha1.Repository, err error)
 	// GetRepositoryByFullName gets a repository by full name.
 	GetRepositoryByFullName(ctx context.Context, fullName string) (repository *v1alpha1.Repository, err error)
+	// GetRepositoryStatsByFullName gets the usage statistics of a repository by full name.
+	GetRepositoryStatsByFullName(ctx context.Context, fullName string) (repositoryStats *v1alpha1.RepositoryStats, err error)
 	// ListRepositories lists all repositories.
 	ListRepositories(
 		ctx context.Context,
@@ -50,11 +52,22 @@ type RepositoryService interface {
 		pageToken string,
 		reverse bool,
 	) (repositories []*v1alpha1.Repository, nextPageToken string, err error)
+	// SearchRepositories searches for repositories whose name or owner name matches the query.
+	//
+	// Only repositories visible to the caller are returned.
+	SearchRepositories(
+		ctx context.Context,
+		query string,
+		ownerName string,
+		pageSize uint32,
+		pageToken string,
+	) (repositories []*v1alpha1.Repository, nextPageToken string, err error)
 	// CreateRepositoryByFullName creates a new repository by full name.
 	CreateRepositoryByFullName(
 		ctx context.Context,
 		fullName string,
 		visibility v1alpha1.Visibility,
+		defaultBranch string,
 	) (repository *v1alpha1.Repository, err error)
 	// UpdateRepositoryName updates a repository's name.
 	UpdateRepositoryName(
@@ -81,6 +94,22 @@ type RepositoryService interface {
 		repositoryName string,
 		newVisibility v1alpha1.Visibility,
 	) (repository *v1alpha1.Repository, err error)
+	// UpdateRepositoryDefaultBranchByFullName updates a repository's default branch by full name.
+	//
+	// The branch must already exist on the repository.
+	UpdateRepositoryDefaultBranchByFullName(
+		ctx context.Context,
+		fullName string,
+		newDefaultBranch string,
+	) (repository *v1alpha1.Repository, err error)
+	// TransferRepositoryByFullName transfers a repository to a new owner by full name.
+	//
+	// The new owner must be an existing organization.
+	TransferRepositoryByFullName(
+		ctx context.Context,
+		fullName string,
+		newOwnerName string,
+	) (repository *v1alpha1.Repository, err error)
 	// DeleteRepository deletes a repository.
 	DeleteRepository(ctx context.Context, id string) (err error)
 	// DeleteRepositoryByFullName deletes a repository by full name.
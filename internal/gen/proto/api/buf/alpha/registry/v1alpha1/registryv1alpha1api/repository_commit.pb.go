@@ -32,4 +32,19 @@ type RepositoryCommitService interface {
 		pageToken string,
 		reverse bool,
 	) (repositoryCommits []*v1alpha1.RepositoryCommit, nextPageToken string, err error)
+	// GetRepositoryCommitByName gets a repository commit by name.
+	GetRepositoryCommitByName(
+		ctx context.Context,
+		repositoryId string,
+		name string,
+	) (repositoryCommit *v1alpha1.RepositoryCommit, err error)
+	// UpdateRepositoryCommitAnnotation sets the annotation of a repository commit.
+	//
+	// An empty annotation removes the annotation of the commit.
+	UpdateRepositoryCommitAnnotation(
+		ctx context.Context,
+		repositoryId string,
+		name string,
+		annotation string,
+	) (repositoryCommit *v1alpha1.RepositoryCommit, err error)
 }
@@ -0,0 +1,38 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-api. DO NOT EDIT.
+
+package registryv1alpha1api
+
+import (
+	context "context"
+	v1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+// RepositoryMirrorService is the Repository mirror service.
+type RepositoryMirrorService interface {
+	// SetRepositoryMirrorByFullName configures the repository to be mirrored from a git remote.
+	//
+	// This overwrites any existing mirror configuration for the repository.
+	SetRepositoryMirrorByFullName(
+		ctx context.Context,
+		fullName string,
+		gitUrl string,
+		gitBranch string,
+		subdir string,
+	) (repositoryMirror *v1alpha1.RepositoryMirror, err error)
+	// GetRepositoryMirrorByFullName gets the mirror configuration and last sync status of a repository.
+	GetRepositoryMirrorByFullName(ctx context.Context, fullName string) (repositoryMirror *v1alpha1.RepositoryMirror, err error)
+}
@@ -28,6 +28,7 @@ type RepositoryTagService interface {
 		ctx context.Context,
 		name string,
 		commitName string,
+		repositoryId string,
 	) (repositoryTag *v1alpha1.RepositoryTag, err error)
 	// ListRepositoryTags lists the repository tags associated with a Repository.
 	ListRepositoryTags(
@@ -37,4 +38,31 @@ type RepositoryTagService interface {
 		pageToken string,
 		reverse bool,
 	) (repositoryTags []*v1alpha1.RepositoryTag, nextPageToken string, err error)
+	// DeleteRepositoryTag deletes a repository tag.
+	DeleteRepositoryTag(
+		ctx context.Context,
+		repositoryId string,
+		name string,
+	) (err error)
+	// UpdateRepositoryTag moves an existing repository tag to a different commit.
+	//
+	// The tag is moved atomically.
+	UpdateRepositoryTag(
+		ctx context.Context,
+		repositoryId string,
+		name string,
+		commitName string,
+	) (repositoryTag *v1alpha1.RepositoryTag, previousCommitName string, err error)
+	// ListRepositoryTagHistory lists the commits a repository tag has pointed to,
+	// including the commit it currently points to.
+	//
+	// Entries are returned from the most recent to the oldest, unless reverse is set.
+	ListRepositoryTagHistory(
+		ctx context.Context,
+		repositoryId string,
+		name string,
+		pageSize uint32,
+		pageToken string,
+		reverse bool,
+	) (repositoryTagHistoryEntries []*v1alpha1.RepositoryTagHistoryEntry, nextPageToken string, err error)
 }
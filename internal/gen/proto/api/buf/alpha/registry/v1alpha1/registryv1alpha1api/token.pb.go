@@ -0,0 +1,35 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-api. DO NOT EDIT.
+
+package registryv1alpha1api
+
+import (
+	context "context"
+	v1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+// TokenService is the Token service.
+type TokenService interface {
+	// ListTokens lists the tokens of the current user.
+	//
+	// The token values are never returned.
+	ListTokens(
+		ctx context.Context,
+		pageSize uint32,
+		pageToken string,
+		reverse bool,
+	) (tokens []*v1alpha1.Token, nextPageToken string, err error)
+}
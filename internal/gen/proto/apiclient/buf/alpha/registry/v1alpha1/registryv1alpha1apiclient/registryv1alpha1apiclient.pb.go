@@ -23,17 +23,31 @@ import (
 
 // Provider provides all the types in registryv1alpha1apiclient.
 type Provider interface {
+	AuthnServiceProvider
+	DocServiceProvider
 	DownloadServiceProvider
 	OrganizationServiceProvider
 	PushServiceProvider
 	RepositoryBranchServiceProvider
 	RepositoryCommitServiceProvider
+	RepositoryMirrorServiceProvider
 	RepositoryServiceProvider
 	RepositoryTagServiceProvider
 	ResolveServiceProvider
+	TokenServiceProvider
 	UserServiceProvider
 }
 
+// AuthnServiceProvider provides a client-side AuthnService for an address.
+type AuthnServiceProvider interface {
+	NewAuthnService(ctx context.Context, address string) (registryv1alpha1api.AuthnService, error)
+}
+
+// DocServiceProvider provides a client-side DocService for an address.
+type DocServiceProvider interface {
+	NewDocService(ctx context.Context, address string) (registryv1alpha1api.DocService, error)
+}
+
 // DownloadServiceProvider provides a client-side DownloadService for an address.
 type DownloadServiceProvider interface {
 	NewDownloadService(ctx context.Context, address string) (registryv1alpha1api.DownloadService, error)
@@ -59,6 +73,11 @@ type RepositoryCommitServiceProvider interface {
 	NewRepositoryCommitService(ctx context.Context, address string) (registryv1alpha1api.RepositoryCommitService, error)
 }
 
+// RepositoryMirrorServiceProvider provides a client-side RepositoryMirrorService for an address.
+type RepositoryMirrorServiceProvider interface {
+	NewRepositoryMirrorService(ctx context.Context, address string) (registryv1alpha1api.RepositoryMirrorService, error)
+}
+
 // RepositoryServiceProvider provides a client-side RepositoryService for an address.
 type RepositoryServiceProvider interface {
 	NewRepositoryService(ctx context.Context, address string) (registryv1alpha1api.RepositoryService, error)
@@ -74,6 +93,11 @@ type ResolveServiceProvider interface {
 	NewResolveService(ctx context.Context, address string) (registryv1alpha1api.ResolveService, error)
 }
 
+// TokenServiceProvider provides a client-side TokenService for an address.
+type TokenServiceProvider interface {
+	NewTokenService(ctx context.Context, address string) (registryv1alpha1api.TokenService, error)
+}
+
 // UserServiceProvider provides a client-side UserService for an address.
 type UserServiceProvider interface {
 	NewUserService(ctx context.Context, address string) (registryv1alpha1api.UserService, error)
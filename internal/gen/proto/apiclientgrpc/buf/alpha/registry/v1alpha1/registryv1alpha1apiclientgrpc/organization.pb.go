@@ -113,14 +113,21 @@ func (s *organizationService) ListUserOrganizations(
 }
 
 // CreateOrganization creates a new organization.
-func (s *organizationService) CreateOrganization(ctx context.Context, name string) (organization *v1alpha1.Organization, _ error) {
+func (s *organizationService) CreateOrganization(
+	ctx context.Context,
+	name string,
+	description string,
+	url string,
+) (organization *v1alpha1.Organization, _ error) {
 	if s.contextModifier != nil {
 		ctx = s.contextModifier(ctx)
 	}
 	response, err := s.client.CreateOrganization(
 		ctx,
 		&v1alpha1.CreateOrganizationRequest{
-			Name: name,
+			Name:        name,
+			Description: description,
+			Url:         url,
 		},
 	)
 	if err != nil {
@@ -173,6 +180,94 @@ func (s *organizationService) UpdateOrganizationNameByName(
 	return response.Organization, nil
 }
 
+// UpdateOrganizationDescription updates a organization's description.
+func (s *organizationService) UpdateOrganizationDescription(
+	ctx context.Context,
+	id string,
+	newDescription string,
+) (organization *v1alpha1.Organization, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.UpdateOrganizationDescription(
+		ctx,
+		&v1alpha1.UpdateOrganizationDescriptionRequest{
+			Id:             id,
+			NewDescription: newDescription,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.Organization, nil
+}
+
+// UpdateOrganizationDescriptionByName updates a organization's description by name.
+func (s *organizationService) UpdateOrganizationDescriptionByName(
+	ctx context.Context,
+	name string,
+	newDescription string,
+) (organization *v1alpha1.Organization, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.UpdateOrganizationDescriptionByName(
+		ctx,
+		&v1alpha1.UpdateOrganizationDescriptionByNameRequest{
+			Name:           name,
+			NewDescription: newDescription,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.Organization, nil
+}
+
+// UpdateOrganizationURL updates a organization's URL.
+func (s *organizationService) UpdateOrganizationURL(
+	ctx context.Context,
+	id string,
+	newUrl string,
+) (organization *v1alpha1.Organization, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.UpdateOrganizationURL(
+		ctx,
+		&v1alpha1.UpdateOrganizationURLRequest{
+			Id:     id,
+			NewUrl: newUrl,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.Organization, nil
+}
+
+// UpdateOrganizationURLByName updates a organization's URL by name.
+func (s *organizationService) UpdateOrganizationURLByName(
+	ctx context.Context,
+	name string,
+	newUrl string,
+) (organization *v1alpha1.Organization, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.UpdateOrganizationURLByName(
+		ctx,
+		&v1alpha1.UpdateOrganizationURLByNameRequest{
+			Name:   name,
+			NewUrl: newUrl,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.Organization, nil
+}
+
 // DeleteOrganization deletes a organization.
 func (s *organizationService) DeleteOrganization(ctx context.Context, id string) (_ error) {
 	if s.contextModifier != nil {
@@ -36,7 +36,8 @@ func (s *pushService) Push(
 	repository string,
 	branch string,
 	module *v1alpha11.Module,
-) (localModulePin *v1alpha1.LocalModulePin, _ error) {
+	draft bool,
+) (localModulePin *v1alpha1.LocalModulePin, isDraft bool, _ error) {
 	if s.contextModifier != nil {
 		ctx = s.contextModifier(ctx)
 	}
@@ -47,10 +48,11 @@ func (s *pushService) Push(
 			Repository: repository,
 			Branch:     branch,
 			Module:     module,
+			Draft:      draft,
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return response.LocalModulePin, nil
+	return response.LocalModulePin, response.IsDraft, nil
 }
@@ -66,6 +66,52 @@ func WithContextModifierProvider(contextModifierProvider func(address string) (f
 	}
 }
 
+func (p *provider) NewAuthnService(ctx context.Context, address string) (registryv1alpha1api.AuthnService, error) {
+	var contextModifier func(context.Context) context.Context
+	var err error
+	if p.contextModifierProvider != nil {
+		contextModifier, err = p.contextModifierProvider(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.addressMapper != nil {
+		address = p.addressMapper(address)
+	}
+	clientConn, err := p.clientConnProvider.NewClientConn(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &authnService{
+		logger:          p.logger,
+		client:          v1alpha1.NewAuthnServiceClient(clientConn),
+		contextModifier: contextModifier,
+	}, nil
+}
+
+func (p *provider) NewDocService(ctx context.Context, address string) (registryv1alpha1api.DocService, error) {
+	var contextModifier func(context.Context) context.Context
+	var err error
+	if p.contextModifierProvider != nil {
+		contextModifier, err = p.contextModifierProvider(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.addressMapper != nil {
+		address = p.addressMapper(address)
+	}
+	clientConn, err := p.clientConnProvider.NewClientConn(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &docService{
+		logger:          p.logger,
+		client:          v1alpha1.NewDocServiceClient(clientConn),
+		contextModifier: contextModifier,
+	}, nil
+}
+
 func (p *provider) NewDownloadService(ctx context.Context, address string) (registryv1alpha1api.DownloadService, error) {
 	var contextModifier func(context.Context) context.Context
 	var err error
@@ -181,6 +227,29 @@ func (p *provider) NewRepositoryCommitService(ctx context.Context, address strin
 	}, nil
 }
 
+func (p *provider) NewRepositoryMirrorService(ctx context.Context, address string) (registryv1alpha1api.RepositoryMirrorService, error) {
+	var contextModifier func(context.Context) context.Context
+	var err error
+	if p.contextModifierProvider != nil {
+		contextModifier, err = p.contextModifierProvider(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.addressMapper != nil {
+		address = p.addressMapper(address)
+	}
+	clientConn, err := p.clientConnProvider.NewClientConn(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &repositoryMirrorService{
+		logger:          p.logger,
+		client:          v1alpha1.NewRepositoryMirrorServiceClient(clientConn),
+		contextModifier: contextModifier,
+	}, nil
+}
+
 func (p *provider) NewRepositoryService(ctx context.Context, address string) (registryv1alpha1api.RepositoryService, error) {
 	var contextModifier func(context.Context) context.Context
 	var err error
@@ -250,6 +319,29 @@ func (p *provider) NewResolveService(ctx context.Context, address string) (regis
 	}, nil
 }
 
+func (p *provider) NewTokenService(ctx context.Context, address string) (registryv1alpha1api.TokenService, error) {
+	var contextModifier func(context.Context) context.Context
+	var err error
+	if p.contextModifierProvider != nil {
+		contextModifier, err = p.contextModifierProvider(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.addressMapper != nil {
+		address = p.addressMapper(address)
+	}
+	clientConn, err := p.clientConnProvider.NewClientConn(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenService{
+		logger:          p.logger,
+		client:          v1alpha1.NewTokenServiceClient(clientConn),
+		contextModifier: contextModifier,
+	}, nil
+}
+
 func (p *provider) NewUserService(ctx context.Context, address string) (registryv1alpha1api.UserService, error) {
 	var contextModifier func(context.Context) context.Context
 	var err error
@@ -62,6 +62,23 @@ func (s *repositoryService) GetRepositoryByFullName(ctx context.Context, fullNam
 	return response.Repository, nil
 }
 
+// GetRepositoryStatsByFullName gets the usage statistics of a repository by full name.
+func (s *repositoryService) GetRepositoryStatsByFullName(ctx context.Context, fullName string) (repositoryStats *v1alpha1.RepositoryStats, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.GetRepositoryStatsByFullName(
+		ctx,
+		&v1alpha1.GetRepositoryStatsByFullNameRequest{
+			FullName: fullName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.RepositoryStats, nil
+}
+
 // ListRepositories lists all repositories.
 func (s *repositoryService) ListRepositories(
 	ctx context.Context,
@@ -138,11 +155,40 @@ func (s *repositoryService) ListOrganizationRepositories(
 	return response.Repositories, response.NextPageToken, nil
 }
 
+// SearchRepositories searches for repositories whose name or owner name matches the query.
+//
+// Only repositories visible to the caller are returned.
+func (s *repositoryService) SearchRepositories(
+	ctx context.Context,
+	query string,
+	ownerName string,
+	pageSize uint32,
+	pageToken string,
+) (repositories []*v1alpha1.Repository, nextPageToken string, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.SearchRepositories(
+		ctx,
+		&v1alpha1.SearchRepositoriesRequest{
+			Query:     query,
+			OwnerName: ownerName,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.Repositories, response.NextPageToken, nil
+}
+
 // CreateRepositoryByFullName creates a new repository by full name.
 func (s *repositoryService) CreateRepositoryByFullName(
 	ctx context.Context,
 	fullName string,
 	visibility v1alpha1.Visibility,
+	defaultBranch string,
 ) (repository *v1alpha1.Repository, _ error) {
 	if s.contextModifier != nil {
 		ctx = s.contextModifier(ctx)
@@ -150,8 +196,9 @@ func (s *repositoryService) CreateRepositoryByFullName(
 	response, err := s.client.CreateRepositoryByFullName(
 		ctx,
 		&v1alpha1.CreateRepositoryByFullNameRequest{
-			FullName:   fullName,
-			Visibility: visibility,
+			FullName:      fullName,
+			Visibility:    visibility,
+			DefaultBranch: defaultBranch,
 		},
 	)
 	if err != nil {
@@ -250,6 +297,54 @@ func (s *repositoryService) UpdateRepositoryVisibilityByName(
 	return response.Repository, nil
 }
 
+// UpdateRepositoryDefaultBranchByFullName updates a repository's default branch by full name.
+//
+// The branch must already exist on the repository.
+func (s *repositoryService) UpdateRepositoryDefaultBranchByFullName(
+	ctx context.Context,
+	fullName string,
+	newDefaultBranch string,
+) (repository *v1alpha1.Repository, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.UpdateRepositoryDefaultBranchByFullName(
+		ctx,
+		&v1alpha1.UpdateRepositoryDefaultBranchByFullNameRequest{
+			FullName:         fullName,
+			NewDefaultBranch: newDefaultBranch,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.Repository, nil
+}
+
+// TransferRepositoryByFullName transfers a repository to a new owner by full name.
+//
+// The new owner must be an existing organization.
+func (s *repositoryService) TransferRepositoryByFullName(
+	ctx context.Context,
+	fullName string,
+	newOwnerName string,
+) (repository *v1alpha1.Repository, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.TransferRepositoryByFullName(
+		ctx,
+		&v1alpha1.TransferRepositoryByFullNameRequest{
+			FullName:     fullName,
+			NewOwnerName: newOwnerName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.Repository, nil
+}
+
 // DeleteRepository deletes a repository.
 func (s *repositoryService) DeleteRepository(ctx context.Context, id string) (_ error) {
 	if s.contextModifier != nil {
@@ -55,3 +55,51 @@ func (s *repositoryCommitService) ListRepositoryCommits(
 	}
 	return response.RepositoryCommits, response.NextPageToken, nil
 }
+
+// GetRepositoryCommitByName gets a repository commit by name.
+func (s *repositoryCommitService) GetRepositoryCommitByName(
+	ctx context.Context,
+	repositoryId string,
+	name string,
+) (repositoryCommit *v1alpha1.RepositoryCommit, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.GetRepositoryCommitByName(
+		ctx,
+		&v1alpha1.GetRepositoryCommitByNameRequest{
+			RepositoryId: repositoryId,
+			Name:         name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.RepositoryCommit, nil
+}
+
+// UpdateRepositoryCommitAnnotation sets the annotation of a repository commit.
+//
+// An empty annotation removes the annotation of the commit.
+func (s *repositoryCommitService) UpdateRepositoryCommitAnnotation(
+	ctx context.Context,
+	repositoryId string,
+	name string,
+	annotation string,
+) (repositoryCommit *v1alpha1.RepositoryCommit, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.UpdateRepositoryCommitAnnotation(
+		ctx,
+		&v1alpha1.UpdateRepositoryCommitAnnotationRequest{
+			RepositoryId: repositoryId,
+			Name:         name,
+			Annotation:   annotation,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.RepositoryCommit, nil
+}
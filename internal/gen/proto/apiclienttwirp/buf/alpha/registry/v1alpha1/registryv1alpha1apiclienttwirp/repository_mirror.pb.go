@@ -0,0 +1,74 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-apiclienttwirp. DO NOT EDIT.
+
+package registryv1alpha1apiclienttwirp
+
+import (
+	context "context"
+	v1alpha1 "github.com/bufbuild/buf/internal/gen/proto/go/buf/alpha/registry/v1alpha1"
+	zap "go.uber.org/zap"
+)
+
+type repositoryMirrorService struct {
+	logger          *zap.Logger
+	client          v1alpha1.RepositoryMirrorService
+	contextModifier func(context.Context) context.Context
+}
+
+// SetRepositoryMirrorByFullName configures the repository to be mirrored from a git remote.
+//
+// This overwrites any existing mirror configuration for the repository.
+func (s *repositoryMirrorService) SetRepositoryMirrorByFullName(
+	ctx context.Context,
+	fullName string,
+	gitUrl string,
+	gitBranch string,
+	subdir string,
+) (repositoryMirror *v1alpha1.RepositoryMirror, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.SetRepositoryMirrorByFullName(
+		ctx,
+		&v1alpha1.SetRepositoryMirrorByFullNameRequest{
+			FullName:  fullName,
+			GitUrl:    gitUrl,
+			GitBranch: gitBranch,
+			Subdir:    subdir,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.RepositoryMirror, nil
+}
+
+// GetRepositoryMirrorByFullName gets the mirror configuration and last sync status of a repository.
+func (s *repositoryMirrorService) GetRepositoryMirrorByFullName(ctx context.Context, fullName string) (repositoryMirror *v1alpha1.RepositoryMirror, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.GetRepositoryMirrorByFullName(
+		ctx,
+		&v1alpha1.GetRepositoryMirrorByFullNameRequest{
+			FullName: fullName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.RepositoryMirror, nil
+}
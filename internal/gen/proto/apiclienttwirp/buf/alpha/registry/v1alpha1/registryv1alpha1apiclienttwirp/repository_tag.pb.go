@@ -33,6 +33,7 @@ func (s *repositoryTagService) CreateRepositoryTag(
 	ctx context.Context,
 	name string,
 	commitName string,
+	repositoryId string,
 ) (repositoryTag *v1alpha1.RepositoryTag, _ error) {
 	if s.contextModifier != nil {
 		ctx = s.contextModifier(ctx)
@@ -40,8 +41,9 @@ func (s *repositoryTagService) CreateRepositoryTag(
 	response, err := s.client.CreateRepositoryTag(
 		ctx,
 		&v1alpha1.CreateRepositoryTagRequest{
-			Name:       name,
-			CommitName: commitName,
+			Name:         name,
+			CommitName:   commitName,
+			RepositoryId: repositoryId,
 		},
 	)
 	if err != nil {
@@ -75,3 +77,82 @@ func (s *repositoryTagService) ListRepositoryTags(
 	}
 	return response.RepositoryTags, response.NextPageToken, nil
 }
+
+// DeleteRepositoryTag deletes a repository tag.
+func (s *repositoryTagService) DeleteRepositoryTag(
+	ctx context.Context,
+	repositoryId string,
+	name string,
+) (_ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	_, err := s.client.DeleteRepositoryTag(
+		ctx,
+		&v1alpha1.DeleteRepositoryTagRequest{
+			RepositoryId: repositoryId,
+			Name:         name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateRepositoryTag moves an existing repository tag to a different commit.
+//
+// The tag is moved atomically.
+func (s *repositoryTagService) UpdateRepositoryTag(
+	ctx context.Context,
+	repositoryId string,
+	name string,
+	commitName string,
+) (repositoryTag *v1alpha1.RepositoryTag, previousCommitName string, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.UpdateRepositoryTag(
+		ctx,
+		&v1alpha1.UpdateRepositoryTagRequest{
+			RepositoryId: repositoryId,
+			Name:         name,
+			CommitName:   commitName,
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.RepositoryTag, response.PreviousCommitName, nil
+}
+
+// ListRepositoryTagHistory lists the commits a repository tag has pointed to,
+// including the commit it currently points to.
+//
+// Entries are returned from the most recent to the oldest, unless reverse is set.
+func (s *repositoryTagService) ListRepositoryTagHistory(
+	ctx context.Context,
+	repositoryId string,
+	name string,
+	pageSize uint32,
+	pageToken string,
+	reverse bool,
+) (repositoryTagHistoryEntries []*v1alpha1.RepositoryTagHistoryEntry, nextPageToken string, _ error) {
+	if s.contextModifier != nil {
+		ctx = s.contextModifier(ctx)
+	}
+	response, err := s.client.ListRepositoryTagHistory(
+		ctx,
+		&v1alpha1.ListRepositoryTagHistoryRequest{
+			RepositoryId: repositoryId,
+			Name:         name,
+			PageSize:     pageSize,
+			PageToken:    pageToken,
+			Reverse:      reverse,
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.RepositoryTagHistoryEntries, response.NextPageToken, nil
+}
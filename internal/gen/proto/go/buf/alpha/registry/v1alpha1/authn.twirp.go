@@ -0,0 +1,521 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-twirp v7.1.0, DO NOT EDIT.
+// source: buf/alpha/registry/v1alpha1/authn.proto
+
+package registryv1alpha1
+
+import bytes "bytes"
+import strings "strings"
+import context "context"
+import fmt "fmt"
+import ioutil "io/ioutil"
+import http "net/http"
+import strconv "strconv"
+
+import jsonpb "github.com/golang/protobuf/jsonpb"
+import proto "github.com/golang/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the twirp package used in your project.
+// A compilation error at this line likely means your copy of the
+// twirp package needs to be updated.
+const _ = twirp.TwirpPackageIsVersion7
+
+// ======================
+// AuthnService Interface
+// ======================
+
+// AuthnService supplies authentication helpers.
+type AuthnService interface {
+	// GetCurrentUser gets information associated with the current user.
+	//
+	// The user's ID is retrieved from the request's authentication header.
+	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
+}
+
+// ============================
+// AuthnService Protobuf Client
+// ============================
+
+type authnServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAuthnServiceProtobufClient creates a Protobuf client that implements the AuthnService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAuthnServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AuthnService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "AuthnService")
+	urls := [1]string{
+		serviceURL + "GetCurrentUser",
+	}
+
+	return &authnServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *authnServiceProtobufClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "AuthnService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentUser")
+	caller := c.callGetCurrentUser
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCurrentUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCurrentUserRequest) when calling interceptor")
+					}
+					return c.callGetCurrentUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCurrentUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCurrentUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authnServiceProtobufClient) callGetCurrentUser(ctx context.Context, in *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+	out := new(GetCurrentUserResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ========================
+// AuthnService JSON Client
+// ========================
+
+type authnServiceJSONClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAuthnServiceJSONClient creates a JSON client that implements the AuthnService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAuthnServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AuthnService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "AuthnService")
+	urls := [1]string{
+		serviceURL + "GetCurrentUser",
+	}
+
+	return &authnServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *authnServiceJSONClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "AuthnService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentUser")
+	caller := c.callGetCurrentUser
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCurrentUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCurrentUserRequest) when calling interceptor")
+					}
+					return c.callGetCurrentUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCurrentUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCurrentUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authnServiceJSONClient) callGetCurrentUser(ctx context.Context, in *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+	out := new(GetCurrentUserResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// AuthnService Server Handler
+// ===========================
+
+type authnServiceServer struct {
+	AuthnService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewAuthnServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAuthnServiceServer(svc AuthnService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewAuthnServiceServer", o))
+		}
+	}
+
+	return &authnServiceServer{
+		AuthnService:     svc,
+		pathPrefix:       serverOpts.PathPrefix(),
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:            serverOpts.Hooks,
+		jsonSkipDefaults: serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *authnServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// AuthnServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AuthnServicePathPrefix = "/twirp/buf.alpha.registry.v1alpha1.AuthnService/"
+
+func (s *authnServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "AuthnService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "buf.alpha.registry.v1alpha1.AuthnService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetCurrentUser":
+		s.serveGetCurrentUser(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *authnServiceServer) serveGetCurrentUser(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetCurrentUserJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetCurrentUserProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authnServiceServer) serveGetCurrentUserJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GetCurrentUserRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.AuthnService.GetCurrentUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCurrentUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCurrentUserRequest) when calling interceptor")
+					}
+					return s.AuthnService.GetCurrentUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCurrentUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCurrentUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetCurrentUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetCurrentUserResponse and nil error while calling GetCurrentUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authnServiceServer) serveGetCurrentUserProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GetCurrentUserRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AuthnService.GetCurrentUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCurrentUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCurrentUserRequest) when calling interceptor")
+					}
+					return s.AuthnService.GetCurrentUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCurrentUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCurrentUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetCurrentUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetCurrentUserResponse and nil error while calling GetCurrentUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authnServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor1, 0
+}
+
+func (s *authnServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *authnServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "AuthnService")
+}
+
+var twirpFileDescriptor1 = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x2a, 0x4d, 0xd3,
+	0x4f, 0xcc, 0x29, 0xc8, 0x48, 0xd4, 0x2f, 0x4a, 0x4d, 0xcf, 0x2c, 0x2e, 0x29, 0xaa, 0xd4, 0x2f,
+	0x33, 0x04, 0x0b, 0x18, 0xea, 0x27, 0x96, 0x96, 0x64, 0xe4, 0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4,
+	0x0b, 0x49, 0x27, 0x95, 0xa6, 0xe9, 0x81, 0xc5, 0xf5, 0x60, 0x0a, 0xf5, 0x60, 0x0a, 0xa5, 0x14,
+	0x10, 0xa6, 0x24, 0x16, 0x64, 0x22, 0x19, 0x50, 0x90, 0x09, 0xd1, 0x2e, 0xa5, 0x86, 0xcf, 0x9e,
+	0xd2, 0xe2, 0xd4, 0x22, 0x88, 0x3a, 0x25, 0x71, 0x2e, 0x51, 0xf7, 0xd4, 0x12, 0xe7, 0xd2, 0xa2,
+	0xa2, 0xd4, 0xbc, 0x92, 0xd0, 0xe2, 0xd4, 0xa2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25,
+	0x7f, 0x2e, 0x31, 0x74, 0x89, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x53, 0x2e, 0x16, 0x90,
+	0x01, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x8a, 0x7a, 0x78, 0x1c, 0xaa, 0x07, 0xd6, 0x08,
+	0x56, 0x6e, 0xd4, 0xcf, 0xc8, 0xc5, 0xe3, 0x08, 0xf2, 0x60, 0x70, 0x6a, 0x51, 0x59, 0x66, 0x72,
+	0xaa, 0x50, 0x3d, 0x17, 0x1f, 0xaa, 0x0d, 0x42, 0x46, 0x78, 0xcd, 0xc2, 0xea, 0x4e, 0x29, 0x63,
+	0x92, 0xf4, 0x40, 0xbc, 0xa0, 0xc4, 0xd2, 0x31, 0x5d, 0x89, 0xd1, 0x29, 0x26, 0x2a, 0x2a, 0x3d,
+	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xa9, 0x34, 0x2d, 0xa9, 0x34, 0x33,
+	0x27, 0x05, 0xc4, 0xd0, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0xca, 0x4b, 0xcc, 0xd1, 0x4f, 0x4f, 0xcd,
+	0xd3, 0x07, 0x87, 0x94, 0x7e, 0x7a, 0xbe, 0x3e, 0x9e, 0x30, 0xb5, 0x86, 0x89, 0xc0, 0x04, 0x92,
+	0xd8, 0xc0, 0xda, 0x8c, 0x01, 0x03, 0x00, 0xe9, 0xe0, 0xb1, 0xd0, 0xf2, 0x01, 0x00, 0x00,
+}
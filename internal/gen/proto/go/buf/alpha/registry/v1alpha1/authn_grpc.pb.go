@@ -0,0 +1,118 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package registryv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// AuthnServiceClient is the client API for AuthnService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthnServiceClient interface {
+	// GetCurrentUser gets information associated with the current user.
+	//
+	// The user's ID is retrieved from the request's authentication header.
+	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
+}
+
+type authnServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthnServiceClient(cc grpc.ClientConnInterface) AuthnServiceClient {
+	return &authnServiceClient{cc}
+}
+
+func (c *authnServiceClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error) {
+	out := new(GetCurrentUserResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.AuthnService/GetCurrentUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthnServiceServer is the server API for AuthnService service.
+// All implementations should embed UnimplementedAuthnServiceServer
+// for forward compatibility
+type AuthnServiceServer interface {
+	// GetCurrentUser gets information associated with the current user.
+	//
+	// The user's ID is retrieved from the request's authentication header.
+	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
+}
+
+// UnimplementedAuthnServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAuthnServiceServer struct {
+}
+
+func (UnimplementedAuthnServiceServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
+}
+
+// UnsafeAuthnServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthnServiceServer will
+// result in compilation errors.
+type UnsafeAuthnServiceServer interface {
+	mustEmbedUnimplementedAuthnServiceServer()
+}
+
+func RegisterAuthnServiceServer(s grpc.ServiceRegistrar, srv AuthnServiceServer) {
+	s.RegisterService(&AuthnService_ServiceDesc, srv)
+}
+
+func _AuthnService_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthnServiceServer).GetCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.AuthnService/GetCurrentUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthnServiceServer).GetCurrentUser(ctx, req.(*GetCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthnService_ServiceDesc is the grpc.ServiceDesc for AuthnService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthnService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buf.alpha.registry.v1alpha1.AuthnService",
+	HandlerType: (*AuthnServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCurrentUser",
+			Handler:    _AuthnService_GetCurrentUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "buf/alpha/registry/v1alpha1/authn.proto",
+}
@@ -0,0 +1,540 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-twirp v7.1.0, DO NOT EDIT.
+// source: buf/alpha/registry/v1alpha1/doc.proto
+
+package registryv1alpha1
+
+import bytes "bytes"
+import strings "strings"
+import context "context"
+import fmt "fmt"
+import ioutil "io/ioutil"
+import http "net/http"
+import strconv "strconv"
+
+import jsonpb "github.com/golang/protobuf/jsonpb"
+import proto "github.com/golang/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the twirp package used in your project.
+// A compilation error at this line likely means your copy of the
+// twirp package needs to be updated.
+const _ = twirp.TwirpPackageIsVersion7
+
+// ====================
+// DocService Interface
+// ====================
+
+// DocService is the doc service.
+type DocService interface {
+	// GetModuleDocumentation gets the rendered documentation for a module.
+	GetModuleDocumentation(context.Context, *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error)
+}
+
+// ==========================
+// DocService Protobuf Client
+// ==========================
+
+type docServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewDocServiceProtobufClient creates a Protobuf client that implements the DocService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewDocServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) DocService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "DocService")
+	urls := [1]string{
+		serviceURL + "GetModuleDocumentation",
+	}
+
+	return &docServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *docServiceProtobufClient) GetModuleDocumentation(ctx context.Context, in *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "DocService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetModuleDocumentation")
+	caller := c.callGetModuleDocumentation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetModuleDocumentationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetModuleDocumentationRequest) when calling interceptor")
+					}
+					return c.callGetModuleDocumentation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetModuleDocumentationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModuleDocumentationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *docServiceProtobufClient) callGetModuleDocumentation(ctx context.Context, in *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+	out := new(GetModuleDocumentationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ======================
+// DocService JSON Client
+// ======================
+
+type docServiceJSONClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewDocServiceJSONClient creates a JSON client that implements the DocService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewDocServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) DocService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "DocService")
+	urls := [1]string{
+		serviceURL + "GetModuleDocumentation",
+	}
+
+	return &docServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *docServiceJSONClient) GetModuleDocumentation(ctx context.Context, in *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "DocService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetModuleDocumentation")
+	caller := c.callGetModuleDocumentation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetModuleDocumentationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetModuleDocumentationRequest) when calling interceptor")
+					}
+					return c.callGetModuleDocumentation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetModuleDocumentationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModuleDocumentationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *docServiceJSONClient) callGetModuleDocumentation(ctx context.Context, in *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+	out := new(GetModuleDocumentationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// DocService Server Handler
+// =========================
+
+type docServiceServer struct {
+	DocService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewDocServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewDocServiceServer(svc DocService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewDocServiceServer", o))
+		}
+	}
+
+	return &docServiceServer{
+		DocService:       svc,
+		pathPrefix:       serverOpts.PathPrefix(),
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:            serverOpts.Hooks,
+		jsonSkipDefaults: serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *docServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// DocServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const DocServicePathPrefix = "/twirp/buf.alpha.registry.v1alpha1.DocService/"
+
+func (s *docServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "DocService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "buf.alpha.registry.v1alpha1.DocService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetModuleDocumentation":
+		s.serveGetModuleDocumentation(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *docServiceServer) serveGetModuleDocumentation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetModuleDocumentationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetModuleDocumentationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *docServiceServer) serveGetModuleDocumentationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetModuleDocumentation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GetModuleDocumentationRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.DocService.GetModuleDocumentation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetModuleDocumentationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetModuleDocumentationRequest) when calling interceptor")
+					}
+					return s.DocService.GetModuleDocumentation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetModuleDocumentationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModuleDocumentationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetModuleDocumentationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetModuleDocumentationResponse and nil error while calling GetModuleDocumentation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *docServiceServer) serveGetModuleDocumentationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetModuleDocumentation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GetModuleDocumentationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.DocService.GetModuleDocumentation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetModuleDocumentationRequest) (*GetModuleDocumentationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetModuleDocumentationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetModuleDocumentationRequest) when calling interceptor")
+					}
+					return s.DocService.GetModuleDocumentation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetModuleDocumentationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModuleDocumentationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetModuleDocumentationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetModuleDocumentationResponse and nil error while calling GetModuleDocumentation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *docServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor2, 0
+}
+
+func (s *docServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *docServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "DocService")
+}
+
+var twirpFileDescriptor2 = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x51, 0x4e, 0xdb, 0x4c,
+	0x10, 0xc7, 0x3f, 0x43, 0xe0, 0x83, 0xa9, 0x54, 0x99, 0x49, 0x08, 0x51, 0x68, 0x11, 0xb2, 0x54,
+	0x15, 0xf5, 0xc1, 0x2e, 0xf4, 0xad, 0x3c, 0x01, 0x31, 0x34, 0x82, 0x84, 0x2a, 0x2e, 0x48, 0x45,
+	0x95, 0xa2, 0xb5, 0x3d, 0x0e, 0x2b, 0xec, 0x5d, 0xd7, 0x5e, 0x53, 0x71, 0x80, 0x4a, 0x95, 0x7a,
+	0x00, 0x6e, 0x80, 0x7a, 0x88, 0x1e, 0xae, 0x8a, 0x13, 0x2b, 0x21, 0x49, 0xd3, 0xaa, 0x6f, 0x3b,
+	0xbf, 0x9d, 0xff, 0xcc, 0xdf, 0x33, 0xd6, 0xc2, 0x0b, 0x37, 0x0b, 0x2c, 0x16, 0xc6, 0xd7, 0xcc,
+	0x4a, 0xa8, 0xc7, 0x53, 0x95, 0xdc, 0x59, 0xb7, 0xbb, 0x39, 0xd8, 0xb5, 0x7c, 0xe9, 0x99, 0x71,
+	0x22, 0x95, 0xc4, 0x4d, 0x37, 0x0b, 0xcc, 0x9c, 0x9a, 0x45, 0x9a, 0x59, 0xa4, 0xd5, 0xb7, 0x47,
+	0x35, 0x58, 0xcc, 0x47, 0x72, 0x16, 0xf3, 0x81, 0xdc, 0x78, 0xd0, 0xa0, 0xdc, 0x92, 0x7e, 0x16,
+	0x52, 0x43, 0x7a, 0x59, 0x44, 0x42, 0x31, 0xc5, 0xa5, 0xc0, 0x2a, 0x2c, 0x7b, 0x32, 0x8a, 0xb8,
+	0xaa, 0x69, 0xdb, 0xda, 0xce, 0x6a, 0x67, 0x18, 0xf5, 0x79, 0x42, 0xcc, 0x8f, 0xa8, 0xb6, 0x30,
+	0xe0, 0x83, 0x08, 0xbb, 0x50, 0x0e, 0x78, 0x48, 0x5d, 0x7f, 0xbc, 0x4a, 0x5a, 0x5b, 0xdc, 0x5e,
+	0xdc, 0x79, 0xb2, 0x67, 0x9a, 0x73, 0x4c, 0x9a, 0xc7, 0x7c, 0xa2, 0x79, 0x07, 0x83, 0x49, 0x94,
+	0x1a, 0x3f, 0x34, 0x58, 0x9b, 0xca, 0x44, 0x84, 0x52, 0xcc, 0xd4, 0xf5, 0xd0, 0x64, 0x7e, 0xc6,
+	0x1a, 0xfc, 0x1f, 0x33, 0xef, 0x86, 0xf5, 0x0a, 0x8f, 0x45, 0x88, 0x04, 0xeb, 0xe9, 0x5d, 0xe4,
+	0xca, 0x70, 0xb6, 0xcd, 0xd7, 0x73, 0x6d, 0x3a, 0xb9, 0xf2, 0xb1, 0xd1, 0x4a, 0x3a, 0x0d, 0x53,
+	0xe3, 0xbb, 0x06, 0xe5, 0x19, 0xd9, 0xb8, 0x09, 0xab, 0x41, 0x16, 0x86, 0x5d, 0xc1, 0x22, 0x1a,
+	0x3a, 0x5e, 0xe9, 0x83, 0x36, 0x8b, 0x08, 0xf7, 0xa1, 0x74, 0xc3, 0x85, 0x9f, 0x5b, 0x7e, 0xba,
+	0xf7, 0xf2, 0x2f, 0xac, 0x9c, 0x72, 0xe1, 0x77, 0x72, 0x11, 0xd6, 0x61, 0xa5, 0xbf, 0x1f, 0x12,
+	0xaa, 0xff, 0x2d, 0x79, 0xe1, 0x22, 0x36, 0x52, 0x78, 0x7e, 0x42, 0x6a, 0xc6, 0x8e, 0x3b, 0xf4,
+	0x39, 0xa3, 0x54, 0x61, 0x05, 0x96, 0xe4, 0x17, 0x41, 0xc9, 0xd0, 0xd2, 0x20, 0xc0, 0x2d, 0x80,
+	0x84, 0x62, 0x99, 0x72, 0x25, 0x93, 0xbb, 0xe1, 0x20, 0xc7, 0x08, 0x3e, 0x83, 0xd5, 0x84, 0x02,
+	0x4a, 0x48, 0x78, 0x34, 0xec, 0x39, 0x02, 0xc6, 0x57, 0x0d, 0xb6, 0x7e, 0xd7, 0x35, 0x8d, 0xa5,
+	0x48, 0x09, 0x3d, 0xa8, 0x44, 0xf9, 0xf5, 0xe3, 0x65, 0xe4, 0x2e, 0xfe, 0xb4, 0x8b, 0x59, 0x75,
+	0xcb, 0xd1, 0x34, 0x7c, 0xf5, 0x53, 0x03, 0x18, 0x4d, 0x0b, 0x37, 0x61, 0xc3, 0xf9, 0xd8, 0x3a,
+	0x3c, 0x3f, 0xeb, 0x9e, 0x36, 0xdb, 0x8d, 0xee, 0x45, 0xdb, 0x79, 0x6f, 0x1f, 0x35, 0x8f, 0x9b,
+	0x76, 0x43, 0xff, 0x0f, 0x37, 0xa0, 0x3c, 0x7e, 0xd9, 0xb2, 0x1d, 0xe7, 0xe0, 0xc4, 0xd6, 0x35,
+	0x5c, 0x87, 0xb5, 0xf1, 0x8b, 0xe3, 0xa6, 0x7d, 0xd6, 0xd0, 0x17, 0xb0, 0x02, 0xfa, 0x38, 0xb6,
+	0xdb, 0x17, 0x2d, 0x7d, 0x11, 0xeb, 0x50, 0x9d, 0xa4, 0xdd, 0xcb, 0x83, 0xb3, 0x0b, 0x5b, 0x2f,
+	0x4d, 0x76, 0x70, 0xec, 0xce, 0x65, 0xf3, 0xc8, 0xd6, 0x97, 0xb0, 0x0a, 0xf8, 0xb8, 0xf5, 0x87,
+	0x77, 0xe7, 0x0d, 0x7d, 0x79, 0xef, 0x41, 0x03, 0x68, 0x48, 0xcf, 0xa1, 0xe4, 0x96, 0x7b, 0x84,
+	0xf7, 0x1a, 0x54, 0x67, 0x4f, 0x15, 0xdf, 0xce, 0x9d, 0xd7, 0xdc, 0x1f, 0xa0, 0xbe, 0xff, 0x4f,
+	0xda, 0xc1, 0x1a, 0x8d, 0xd2, 0xb7, 0x7b, 0x43, 0x3b, 0xfc, 0x74, 0x75, 0xd5, 0xe3, 0xea, 0x3a,
+	0x73, 0x4d, 0x4f, 0x46, 0x96, 0x9b, 0x05, 0x6e, 0xc6, 0x43, 0xbf, 0x7f, 0xb0, 0xb8, 0x50, 0x94,
+	0x08, 0x16, 0x5a, 0x3d, 0x12, 0x56, 0xfe, 0xe8, 0x58, 0x3d, 0x69, 0xcd, 0x79, 0xdc, 0xf6, 0x0b,
+	0x52, 0x00, 0x77, 0x39, 0x97, 0xbd, 0xf9, 0x35, 0x00, 0x1d, 0x9f, 0xde, 0xc7, 0x13, 0x05, 0x00,
+	0x00,
+}
@@ -63,6 +63,18 @@ type OrganizationService interface {
 	// UpdateOrganizationNameByName updates a organization's name by name.
 	UpdateOrganizationNameByName(context.Context, *UpdateOrganizationNameByNameRequest) (*UpdateOrganizationNameByNameResponse, error)
 
+	// UpdateOrganizationDescription updates a organization's description.
+	UpdateOrganizationDescription(context.Context, *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error)
+
+	// UpdateOrganizationDescriptionByName updates a organization's description by name.
+	UpdateOrganizationDescriptionByName(context.Context, *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error)
+
+	// UpdateOrganizationURL updates a organization's URL.
+	UpdateOrganizationURL(context.Context, *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error)
+
+	// UpdateOrganizationURLByName updates a organization's URL by name.
+	UpdateOrganizationURLByName(context.Context, *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error)
+
 	// DeleteOrganization deletes a organization.
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
 
@@ -88,7 +100,7 @@ type OrganizationService interface {
 
 type organizationServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [13]string
+	urls        [17]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -108,7 +120,7 @@ func NewOrganizationServiceProtobufClient(baseURL string, client HTTPClient, opt
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "OrganizationService")
-	urls := [13]string{
+	urls := [17]string{
 		serviceURL + "GetOrganization",
 		serviceURL + "GetOrganizationByName",
 		serviceURL + "ListOrganizations",
@@ -116,6 +128,10 @@ func NewOrganizationServiceProtobufClient(baseURL string, client HTTPClient, opt
 		serviceURL + "CreateOrganization",
 		serviceURL + "UpdateOrganizationName",
 		serviceURL + "UpdateOrganizationNameByName",
+		serviceURL + "UpdateOrganizationDescription",
+		serviceURL + "UpdateOrganizationDescriptionByName",
+		serviceURL + "UpdateOrganizationURL",
+		serviceURL + "UpdateOrganizationURLByName",
 		serviceURL + "DeleteOrganization",
 		serviceURL + "DeleteOrganizationByName",
 		serviceURL + "AddOrganizationBaseRepositoryScope",
@@ -454,6 +470,190 @@ func (c *organizationServiceProtobufClient) callUpdateOrganizationNameByName(ctx
 	return out, nil
 }
 
+func (c *organizationServiceProtobufClient) UpdateOrganizationDescription(ctx context.Context, in *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescription")
+	caller := c.callUpdateOrganizationDescription
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationDescriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionRequest) when calling interceptor")
+					}
+					return c.callUpdateOrganizationDescription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceProtobufClient) callUpdateOrganizationDescription(ctx context.Context, in *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
+	out := new(UpdateOrganizationDescriptionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *organizationServiceProtobufClient) UpdateOrganizationDescriptionByName(ctx context.Context, in *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescriptionByName")
+	caller := c.callUpdateOrganizationDescriptionByName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationDescriptionByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionByNameRequest) when calling interceptor")
+					}
+					return c.callUpdateOrganizationDescriptionByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceProtobufClient) callUpdateOrganizationDescriptionByName(ctx context.Context, in *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
+	out := new(UpdateOrganizationDescriptionByNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *organizationServiceProtobufClient) UpdateOrganizationURL(ctx context.Context, in *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURL")
+	caller := c.callUpdateOrganizationURL
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationURLRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLRequest) when calling interceptor")
+					}
+					return c.callUpdateOrganizationURL(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationURLResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceProtobufClient) callUpdateOrganizationURL(ctx context.Context, in *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
+	out := new(UpdateOrganizationURLResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *organizationServiceProtobufClient) UpdateOrganizationURLByName(ctx context.Context, in *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURLByName")
+	caller := c.callUpdateOrganizationURLByName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationURLByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLByNameRequest) when calling interceptor")
+					}
+					return c.callUpdateOrganizationURLByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationURLByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceProtobufClient) callUpdateOrganizationURLByName(ctx context.Context, in *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
+	out := new(UpdateOrganizationURLByNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *organizationServiceProtobufClient) DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
@@ -485,7 +685,7 @@ func (c *organizationServiceProtobufClient) DeleteOrganization(ctx context.Conte
 
 func (c *organizationServiceProtobufClient) callDeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
 	out := new(DeleteOrganizationResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -531,7 +731,7 @@ func (c *organizationServiceProtobufClient) DeleteOrganizationByName(ctx context
 
 func (c *organizationServiceProtobufClient) callDeleteOrganizationByName(ctx context.Context, in *DeleteOrganizationByNameRequest) (*DeleteOrganizationByNameResponse, error) {
 	out := new(DeleteOrganizationByNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -577,7 +777,7 @@ func (c *organizationServiceProtobufClient) AddOrganizationBaseRepositoryScope(c
 
 func (c *organizationServiceProtobufClient) callAddOrganizationBaseRepositoryScope(ctx context.Context, in *AddOrganizationBaseRepositoryScopeRequest) (*AddOrganizationBaseRepositoryScopeResponse, error) {
 	out := new(AddOrganizationBaseRepositoryScopeResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -623,7 +823,7 @@ func (c *organizationServiceProtobufClient) AddOrganizationBaseRepositoryScopeBy
 
 func (c *organizationServiceProtobufClient) callAddOrganizationBaseRepositoryScopeByName(ctx context.Context, in *AddOrganizationBaseRepositoryScopeByNameRequest) (*AddOrganizationBaseRepositoryScopeByNameResponse, error) {
 	out := new(AddOrganizationBaseRepositoryScopeByNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -669,7 +869,7 @@ func (c *organizationServiceProtobufClient) RemoveOrganizationBaseRepositoryScop
 
 func (c *organizationServiceProtobufClient) callRemoveOrganizationBaseRepositoryScope(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeRequest) (*RemoveOrganizationBaseRepositoryScopeResponse, error) {
 	out := new(RemoveOrganizationBaseRepositoryScopeResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -715,7 +915,7 @@ func (c *organizationServiceProtobufClient) RemoveOrganizationBaseRepositoryScop
 
 func (c *organizationServiceProtobufClient) callRemoveOrganizationBaseRepositoryScopeByName(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeByNameRequest) (*RemoveOrganizationBaseRepositoryScopeByNameResponse, error) {
 	out := new(RemoveOrganizationBaseRepositoryScopeByNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -736,7 +936,7 @@ func (c *organizationServiceProtobufClient) callRemoveOrganizationBaseRepository
 
 type organizationServiceJSONClient struct {
 	client      HTTPClient
-	urls        [13]string
+	urls        [17]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -756,7 +956,7 @@ func NewOrganizationServiceJSONClient(baseURL string, client HTTPClient, opts ..
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "OrganizationService")
-	urls := [13]string{
+	urls := [17]string{
 		serviceURL + "GetOrganization",
 		serviceURL + "GetOrganizationByName",
 		serviceURL + "ListOrganizations",
@@ -764,6 +964,10 @@ func NewOrganizationServiceJSONClient(baseURL string, client HTTPClient, opts ..
 		serviceURL + "CreateOrganization",
 		serviceURL + "UpdateOrganizationName",
 		serviceURL + "UpdateOrganizationNameByName",
+		serviceURL + "UpdateOrganizationDescription",
+		serviceURL + "UpdateOrganizationDescriptionByName",
+		serviceURL + "UpdateOrganizationURL",
+		serviceURL + "UpdateOrganizationURLByName",
 		serviceURL + "DeleteOrganization",
 		serviceURL + "DeleteOrganizationByName",
 		serviceURL + "AddOrganizationBaseRepositoryScope",
@@ -1102,26 +1306,26 @@ func (c *organizationServiceJSONClient) callUpdateOrganizationNameByName(ctx con
 	return out, nil
 }
 
-func (c *organizationServiceJSONClient) DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
+func (c *organizationServiceJSONClient) UpdateOrganizationDescription(ctx context.Context, in *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteOrganization")
-	caller := c.callDeleteOrganization
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescription")
+	caller := c.callUpdateOrganizationDescription
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
+		caller = func(ctx context.Context, req *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteOrganizationRequest)
+					typedReq, ok := req.(*UpdateOrganizationDescriptionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteOrganizationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionRequest) when calling interceptor")
 					}
-					return c.callDeleteOrganization(ctx, typedReq)
+					return c.callUpdateOrganizationDescription(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteOrganizationResponse)
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteOrganizationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1131,8 +1335,8 @@ func (c *organizationServiceJSONClient) DeleteOrganization(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *organizationServiceJSONClient) callDeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
-	out := new(DeleteOrganizationResponse)
+func (c *organizationServiceJSONClient) callUpdateOrganizationDescription(ctx context.Context, in *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
+	out := new(UpdateOrganizationDescriptionResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1148,26 +1352,26 @@ func (c *organizationServiceJSONClient) callDeleteOrganization(ctx context.Conte
 	return out, nil
 }
 
-func (c *organizationServiceJSONClient) DeleteOrganizationByName(ctx context.Context, in *DeleteOrganizationByNameRequest) (*DeleteOrganizationByNameResponse, error) {
+func (c *organizationServiceJSONClient) UpdateOrganizationDescriptionByName(ctx context.Context, in *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteOrganizationByName")
-	caller := c.callDeleteOrganizationByName
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescriptionByName")
+	caller := c.callUpdateOrganizationDescriptionByName
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteOrganizationByNameRequest) (*DeleteOrganizationByNameResponse, error) {
+		caller = func(ctx context.Context, req *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteOrganizationByNameRequest)
+					typedReq, ok := req.(*UpdateOrganizationDescriptionByNameRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteOrganizationByNameRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionByNameRequest) when calling interceptor")
 					}
-					return c.callDeleteOrganizationByName(ctx, typedReq)
+					return c.callUpdateOrganizationDescriptionByName(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteOrganizationByNameResponse)
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionByNameResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteOrganizationByNameResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionByNameResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1177,8 +1381,8 @@ func (c *organizationServiceJSONClient) DeleteOrganizationByName(ctx context.Con
 	return caller(ctx, in)
 }
 
-func (c *organizationServiceJSONClient) callDeleteOrganizationByName(ctx context.Context, in *DeleteOrganizationByNameRequest) (*DeleteOrganizationByNameResponse, error) {
-	out := new(DeleteOrganizationByNameResponse)
+func (c *organizationServiceJSONClient) callUpdateOrganizationDescriptionByName(ctx context.Context, in *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
+	out := new(UpdateOrganizationDescriptionByNameResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1194,26 +1398,26 @@ func (c *organizationServiceJSONClient) callDeleteOrganizationByName(ctx context
 	return out, nil
 }
 
-func (c *organizationServiceJSONClient) AddOrganizationBaseRepositoryScope(ctx context.Context, in *AddOrganizationBaseRepositoryScopeRequest) (*AddOrganizationBaseRepositoryScopeResponse, error) {
+func (c *organizationServiceJSONClient) UpdateOrganizationURL(ctx context.Context, in *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
-	ctx = ctxsetters.WithMethodName(ctx, "AddOrganizationBaseRepositoryScope")
-	caller := c.callAddOrganizationBaseRepositoryScope
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURL")
+	caller := c.callUpdateOrganizationURL
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AddOrganizationBaseRepositoryScopeRequest) (*AddOrganizationBaseRepositoryScopeResponse, error) {
+		caller = func(ctx context.Context, req *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AddOrganizationBaseRepositoryScopeRequest)
+					typedReq, ok := req.(*UpdateOrganizationURLRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AddOrganizationBaseRepositoryScopeRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLRequest) when calling interceptor")
 					}
-					return c.callAddOrganizationBaseRepositoryScope(ctx, typedReq)
+					return c.callUpdateOrganizationURL(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AddOrganizationBaseRepositoryScopeResponse)
+				typedResp, ok := resp.(*UpdateOrganizationURLResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AddOrganizationBaseRepositoryScopeResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1223,8 +1427,8 @@ func (c *organizationServiceJSONClient) AddOrganizationBaseRepositoryScope(ctx c
 	return caller(ctx, in)
 }
 
-func (c *organizationServiceJSONClient) callAddOrganizationBaseRepositoryScope(ctx context.Context, in *AddOrganizationBaseRepositoryScopeRequest) (*AddOrganizationBaseRepositoryScopeResponse, error) {
-	out := new(AddOrganizationBaseRepositoryScopeResponse)
+func (c *organizationServiceJSONClient) callUpdateOrganizationURL(ctx context.Context, in *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
+	out := new(UpdateOrganizationURLResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1240,26 +1444,26 @@ func (c *organizationServiceJSONClient) callAddOrganizationBaseRepositoryScope(c
 	return out, nil
 }
 
-func (c *organizationServiceJSONClient) AddOrganizationBaseRepositoryScopeByName(ctx context.Context, in *AddOrganizationBaseRepositoryScopeByNameRequest) (*AddOrganizationBaseRepositoryScopeByNameResponse, error) {
+func (c *organizationServiceJSONClient) UpdateOrganizationURLByName(ctx context.Context, in *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
-	ctx = ctxsetters.WithMethodName(ctx, "AddOrganizationBaseRepositoryScopeByName")
-	caller := c.callAddOrganizationBaseRepositoryScopeByName
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURLByName")
+	caller := c.callUpdateOrganizationURLByName
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AddOrganizationBaseRepositoryScopeByNameRequest) (*AddOrganizationBaseRepositoryScopeByNameResponse, error) {
+		caller = func(ctx context.Context, req *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AddOrganizationBaseRepositoryScopeByNameRequest)
+					typedReq, ok := req.(*UpdateOrganizationURLByNameRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AddOrganizationBaseRepositoryScopeByNameRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLByNameRequest) when calling interceptor")
 					}
-					return c.callAddOrganizationBaseRepositoryScopeByName(ctx, typedReq)
+					return c.callUpdateOrganizationURLByName(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AddOrganizationBaseRepositoryScopeByNameResponse)
+				typedResp, ok := resp.(*UpdateOrganizationURLByNameResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AddOrganizationBaseRepositoryScopeByNameResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLByNameResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1269,8 +1473,8 @@ func (c *organizationServiceJSONClient) AddOrganizationBaseRepositoryScopeByName
 	return caller(ctx, in)
 }
 
-func (c *organizationServiceJSONClient) callAddOrganizationBaseRepositoryScopeByName(ctx context.Context, in *AddOrganizationBaseRepositoryScopeByNameRequest) (*AddOrganizationBaseRepositoryScopeByNameResponse, error) {
-	out := new(AddOrganizationBaseRepositoryScopeByNameResponse)
+func (c *organizationServiceJSONClient) callUpdateOrganizationURLByName(ctx context.Context, in *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
+	out := new(UpdateOrganizationURLByNameResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1286,26 +1490,26 @@ func (c *organizationServiceJSONClient) callAddOrganizationBaseRepositoryScopeBy
 	return out, nil
 }
 
-func (c *organizationServiceJSONClient) RemoveOrganizationBaseRepositoryScope(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeRequest) (*RemoveOrganizationBaseRepositoryScopeResponse, error) {
+func (c *organizationServiceJSONClient) DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveOrganizationBaseRepositoryScope")
-	caller := c.callRemoveOrganizationBaseRepositoryScope
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteOrganization")
+	caller := c.callDeleteOrganization
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *RemoveOrganizationBaseRepositoryScopeRequest) (*RemoveOrganizationBaseRepositoryScopeResponse, error) {
+		caller = func(ctx context.Context, req *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RemoveOrganizationBaseRepositoryScopeRequest)
+					typedReq, ok := req.(*DeleteOrganizationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RemoveOrganizationBaseRepositoryScopeRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteOrganizationRequest) when calling interceptor")
 					}
-					return c.callRemoveOrganizationBaseRepositoryScope(ctx, typedReq)
+					return c.callDeleteOrganization(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RemoveOrganizationBaseRepositoryScopeResponse)
+				typedResp, ok := resp.(*DeleteOrganizationResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RemoveOrganizationBaseRepositoryScopeResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteOrganizationResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1315,8 +1519,8 @@ func (c *organizationServiceJSONClient) RemoveOrganizationBaseRepositoryScope(ct
 	return caller(ctx, in)
 }
 
-func (c *organizationServiceJSONClient) callRemoveOrganizationBaseRepositoryScope(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeRequest) (*RemoveOrganizationBaseRepositoryScopeResponse, error) {
-	out := new(RemoveOrganizationBaseRepositoryScopeResponse)
+func (c *organizationServiceJSONClient) callDeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
+	out := new(DeleteOrganizationResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1332,26 +1536,26 @@ func (c *organizationServiceJSONClient) callRemoveOrganizationBaseRepositoryScop
 	return out, nil
 }
 
-func (c *organizationServiceJSONClient) RemoveOrganizationBaseRepositoryScopeByName(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeByNameRequest) (*RemoveOrganizationBaseRepositoryScopeByNameResponse, error) {
+func (c *organizationServiceJSONClient) DeleteOrganizationByName(ctx context.Context, in *DeleteOrganizationByNameRequest) (*DeleteOrganizationByNameResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveOrganizationBaseRepositoryScopeByName")
-	caller := c.callRemoveOrganizationBaseRepositoryScopeByName
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteOrganizationByName")
+	caller := c.callDeleteOrganizationByName
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *RemoveOrganizationBaseRepositoryScopeByNameRequest) (*RemoveOrganizationBaseRepositoryScopeByNameResponse, error) {
+		caller = func(ctx context.Context, req *DeleteOrganizationByNameRequest) (*DeleteOrganizationByNameResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RemoveOrganizationBaseRepositoryScopeByNameRequest)
+					typedReq, ok := req.(*DeleteOrganizationByNameRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RemoveOrganizationBaseRepositoryScopeByNameRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteOrganizationByNameRequest) when calling interceptor")
 					}
-					return c.callRemoveOrganizationBaseRepositoryScopeByName(ctx, typedReq)
+					return c.callDeleteOrganizationByName(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RemoveOrganizationBaseRepositoryScopeByNameResponse)
+				typedResp, ok := resp.(*DeleteOrganizationByNameResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RemoveOrganizationBaseRepositoryScopeByNameResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteOrganizationByNameResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1361,8 +1565,8 @@ func (c *organizationServiceJSONClient) RemoveOrganizationBaseRepositoryScopeByN
 	return caller(ctx, in)
 }
 
-func (c *organizationServiceJSONClient) callRemoveOrganizationBaseRepositoryScopeByName(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeByNameRequest) (*RemoveOrganizationBaseRepositoryScopeByNameResponse, error) {
-	out := new(RemoveOrganizationBaseRepositoryScopeByNameResponse)
+func (c *organizationServiceJSONClient) callDeleteOrganizationByName(ctx context.Context, in *DeleteOrganizationByNameRequest) (*DeleteOrganizationByNameResponse, error) {
+	out := new(DeleteOrganizationByNameResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1378,22 +1582,206 @@ func (c *organizationServiceJSONClient) callRemoveOrganizationBaseRepositoryScop
 	return out, nil
 }
 
-// ==================================
-// OrganizationService Server Handler
-// ==================================
-
-type organizationServiceServer struct {
-	OrganizationService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-}
-
-// NewOrganizationServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewOrganizationServiceServer(svc OrganizationService, opts ...interface{}) TwirpServer {
+func (c *organizationServiceJSONClient) AddOrganizationBaseRepositoryScope(ctx context.Context, in *AddOrganizationBaseRepositoryScopeRequest) (*AddOrganizationBaseRepositoryScopeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddOrganizationBaseRepositoryScope")
+	caller := c.callAddOrganizationBaseRepositoryScope
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddOrganizationBaseRepositoryScopeRequest) (*AddOrganizationBaseRepositoryScopeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddOrganizationBaseRepositoryScopeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddOrganizationBaseRepositoryScopeRequest) when calling interceptor")
+					}
+					return c.callAddOrganizationBaseRepositoryScope(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddOrganizationBaseRepositoryScopeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddOrganizationBaseRepositoryScopeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceJSONClient) callAddOrganizationBaseRepositoryScope(ctx context.Context, in *AddOrganizationBaseRepositoryScopeRequest) (*AddOrganizationBaseRepositoryScopeResponse, error) {
+	out := new(AddOrganizationBaseRepositoryScopeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *organizationServiceJSONClient) AddOrganizationBaseRepositoryScopeByName(ctx context.Context, in *AddOrganizationBaseRepositoryScopeByNameRequest) (*AddOrganizationBaseRepositoryScopeByNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddOrganizationBaseRepositoryScopeByName")
+	caller := c.callAddOrganizationBaseRepositoryScopeByName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddOrganizationBaseRepositoryScopeByNameRequest) (*AddOrganizationBaseRepositoryScopeByNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddOrganizationBaseRepositoryScopeByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddOrganizationBaseRepositoryScopeByNameRequest) when calling interceptor")
+					}
+					return c.callAddOrganizationBaseRepositoryScopeByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddOrganizationBaseRepositoryScopeByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddOrganizationBaseRepositoryScopeByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceJSONClient) callAddOrganizationBaseRepositoryScopeByName(ctx context.Context, in *AddOrganizationBaseRepositoryScopeByNameRequest) (*AddOrganizationBaseRepositoryScopeByNameResponse, error) {
+	out := new(AddOrganizationBaseRepositoryScopeByNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *organizationServiceJSONClient) RemoveOrganizationBaseRepositoryScope(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeRequest) (*RemoveOrganizationBaseRepositoryScopeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveOrganizationBaseRepositoryScope")
+	caller := c.callRemoveOrganizationBaseRepositoryScope
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveOrganizationBaseRepositoryScopeRequest) (*RemoveOrganizationBaseRepositoryScopeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveOrganizationBaseRepositoryScopeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveOrganizationBaseRepositoryScopeRequest) when calling interceptor")
+					}
+					return c.callRemoveOrganizationBaseRepositoryScope(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveOrganizationBaseRepositoryScopeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveOrganizationBaseRepositoryScopeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceJSONClient) callRemoveOrganizationBaseRepositoryScope(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeRequest) (*RemoveOrganizationBaseRepositoryScopeResponse, error) {
+	out := new(RemoveOrganizationBaseRepositoryScopeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *organizationServiceJSONClient) RemoveOrganizationBaseRepositoryScopeByName(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeByNameRequest) (*RemoveOrganizationBaseRepositoryScopeByNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrganizationService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveOrganizationBaseRepositoryScopeByName")
+	caller := c.callRemoveOrganizationBaseRepositoryScopeByName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveOrganizationBaseRepositoryScopeByNameRequest) (*RemoveOrganizationBaseRepositoryScopeByNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveOrganizationBaseRepositoryScopeByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveOrganizationBaseRepositoryScopeByNameRequest) when calling interceptor")
+					}
+					return c.callRemoveOrganizationBaseRepositoryScopeByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveOrganizationBaseRepositoryScopeByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveOrganizationBaseRepositoryScopeByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *organizationServiceJSONClient) callRemoveOrganizationBaseRepositoryScopeByName(ctx context.Context, in *RemoveOrganizationBaseRepositoryScopeByNameRequest) (*RemoveOrganizationBaseRepositoryScopeByNameResponse, error) {
+	out := new(RemoveOrganizationBaseRepositoryScopeByNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==================================
+// OrganizationService Server Handler
+// ==================================
+
+type organizationServiceServer struct {
+	OrganizationService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewOrganizationServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewOrganizationServiceServer(svc OrganizationService, opts ...interface{}) TwirpServer {
 	serverOpts := twirp.ServerOptions{}
 	for _, opt := range opts {
 		switch o := opt.(type) {
@@ -1483,6 +1871,18 @@ func (s *organizationServiceServer) ServeHTTP(resp http.ResponseWriter, req *htt
 	case "UpdateOrganizationNameByName":
 		s.serveUpdateOrganizationNameByName(ctx, resp, req)
 		return
+	case "UpdateOrganizationDescription":
+		s.serveUpdateOrganizationDescription(ctx, resp, req)
+		return
+	case "UpdateOrganizationDescriptionByName":
+		s.serveUpdateOrganizationDescriptionByName(ctx, resp, req)
+		return
+	case "UpdateOrganizationURL":
+		s.serveUpdateOrganizationURL(ctx, resp, req)
+		return
+	case "UpdateOrganizationURLByName":
+		s.serveUpdateOrganizationURLByName(ctx, resp, req)
+		return
 	case "DeleteOrganization":
 		s.serveDeleteOrganization(ctx, resp, req)
 		return
@@ -2733,6 +3133,706 @@ func (s *organizationServiceServer) serveUpdateOrganizationNameByNameProtobuf(ct
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *organizationServiceServer) serveUpdateOrganizationDescription(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateOrganizationDescriptionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateOrganizationDescriptionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationDescriptionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(UpdateOrganizationDescriptionRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationDescription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationDescriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationDescription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationDescriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationDescriptionResponse and nil error while calling UpdateOrganizationDescription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationDescriptionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(UpdateOrganizationDescriptionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationDescription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationDescriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationDescription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationDescriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationDescriptionResponse and nil error while calling UpdateOrganizationDescription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationDescriptionByName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateOrganizationDescriptionByNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateOrganizationDescriptionByNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationDescriptionByNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescriptionByName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(UpdateOrganizationDescriptionByNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationDescriptionByName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationDescriptionByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionByNameRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationDescriptionByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationDescriptionByNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationDescriptionByNameResponse and nil error while calling UpdateOrganizationDescriptionByName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationDescriptionByNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationDescriptionByName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(UpdateOrganizationDescriptionByNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationDescriptionByName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationDescriptionByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationDescriptionByNameRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationDescriptionByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationDescriptionByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationDescriptionByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationDescriptionByNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationDescriptionByNameResponse and nil error while calling UpdateOrganizationDescriptionByName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationURL(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateOrganizationURLJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateOrganizationURLProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationURLJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURL")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(UpdateOrganizationURLRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationURL
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationURLRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationURL(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationURLResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationURLResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationURLResponse and nil error while calling UpdateOrganizationURL. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationURLProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURL")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(UpdateOrganizationURLRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationURL
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationURLRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationURL(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationURLResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationURLResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationURLResponse and nil error while calling UpdateOrganizationURL. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationURLByName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateOrganizationURLByNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateOrganizationURLByNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationURLByNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURLByName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(UpdateOrganizationURLByNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationURLByName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationURLByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLByNameRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationURLByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationURLByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationURLByNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationURLByNameResponse and nil error while calling UpdateOrganizationURLByName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *organizationServiceServer) serveUpdateOrganizationURLByNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateOrganizationURLByName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(UpdateOrganizationURLByNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrganizationService.UpdateOrganizationURLByName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateOrganizationURLByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateOrganizationURLByNameRequest) when calling interceptor")
+					}
+					return s.OrganizationService.UpdateOrganizationURLByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateOrganizationURLByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateOrganizationURLByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateOrganizationURLByNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateOrganizationURLByNameResponse and nil error while calling UpdateOrganizationURLByName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *organizationServiceServer) serveDeleteOrganization(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
@@ -3784,7 +4884,7 @@ func (s *organizationServiceServer) serveRemoveOrganizationBaseRepositoryScopeBy
 }
 
 func (s *organizationServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor1, 0
+	return twirpFileDescriptor4, 0
 }
 
 func (s *organizationServiceServer) ProtocGenTwirpVersion() string {
@@ -3798,68 +4898,81 @@ func (s *organizationServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "OrganizationService")
 }
 
-var twirpFileDescriptor1 = []byte{
-	// 983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xd6, 0x6c, 0xa2, 0x26, 0x79, 0x69, 0x1a, 0x3a, 0x88, 0x76, 0xe3, 0x26, 0x74, 0x65, 0x7e,
-	0xa5, 0x34, 0xd8, 0x74, 0x43, 0x40, 0x10, 0x90, 0x68, 0x41, 0x54, 0xad, 0x5a, 0x52, 0x39, 0xa9,
-	0x90, 0x2a, 0xa4, 0x95, 0x37, 0xfb, 0xe2, 0x8c, 0xba, 0x6b, 0x1b, 0xff, 0x48, 0x49, 0x8e, 0x70,
-	0xa9, 0x84, 0xb8, 0x45, 0x0a, 0x17, 0x90, 0x38, 0x22, 0xf1, 0x1f, 0x70, 0xe5, 0xca, 0x95, 0xbf,
-	0x07, 0x79, 0x6c, 0xa7, 0x9e, 0x5d, 0x7b, 0x6c, 0x67, 0xb3, 0x2a, 0xb7, 0xf5, 0xf8, 0x7d, 0xdf,
-	0x7c, 0xdf, 0x7b, 0xe3, 0x79, 0x4f, 0x0b, 0x5a, 0x37, 0xdc, 0xd3, 0xcd, 0xbe, 0xbb, 0x6f, 0xea,
-	0x1e, 0x5a, 0xcc, 0x0f, 0xbc, 0x43, 0xfd, 0xe0, 0x16, 0x5f, 0xb8, 0xa5, 0x3b, 0x9e, 0x65, 0xda,
-	0xec, 0xc8, 0x0c, 0x98, 0x63, 0x6b, 0xae, 0xe7, 0x04, 0x0e, 0xbd, 0xd6, 0x0d, 0xf7, 0x34, 0xfe,
-	0x5a, 0x4b, 0xe3, 0xb5, 0x34, 0x5e, 0x69, 0xbd, 0x20, 0x33, 0x5d, 0xf6, 0x82, 0xc7, 0x74, 0x59,
-	0x0c, 0x57, 0xde, 0x91, 0x6d, 0xe7, 0xef, 0x3a, 0x2e, 0x26, 0x81, 0xd7, 0x2d, 0xc7, 0xb1, 0xfa,
-	0xa8, 0xf3, 0xa7, 0x08, 0x14, 0xb0, 0x01, 0xfa, 0x81, 0x39, 0x70, 0xe3, 0x00, 0xf5, 0x4f, 0x02,
-	0x17, 0xb7, 0x32, 0xfa, 0xe8, 0x25, 0x68, 0xb0, 0x5e, 0x93, 0xb4, 0xc8, 0xea, 0x9c, 0xd1, 0x60,
-	0x3d, 0xba, 0x09, 0xf3, 0xbb, 0x1e, 0x9a, 0x01, 0x76, 0x22, 0x68, 0xb3, 0xd1, 0x22, 0xab, 0xf3,
-	0x6d, 0x45, 0x8b, 0x79, 0xb5, 0x94, 0x57, 0xdb, 0x49, 0x79, 0x0d, 0x88, 0xc3, 0xa3, 0x85, 0x08,
-	0x1c, 0xba, 0xbd, 0x53, 0xf0, 0x54, 0x39, 0x38, 0x0e, 0xe7, 0x60, 0x0a, 0xd3, 0xb6, 0x39, 0xc0,
-	0xe6, 0x34, 0xd7, 0xc2, 0x7f, 0xab, 0xab, 0x70, 0xe5, 0x2e, 0x06, 0x59, 0xc1, 0x06, 0x7e, 0x17,
-	0xa2, 0x1f, 0x0c, 0xeb, 0x56, 0xf7, 0xe1, 0xea, 0x48, 0xa4, 0xef, 0x3a, 0xb6, 0x8f, 0xf4, 0x21,
-	0x5c, 0xcc, 0x96, 0x84, 0x83, 0xe6, 0xdb, 0x37, 0x34, 0x49, 0x4d, 0x34, 0x81, 0x48, 0x80, 0xab,
-	0x6d, 0x58, 0x1e, 0xda, 0xe9, 0xce, 0xe1, 0xd7, 0xe6, 0x00, 0x53, 0x65, 0xa9, 0x0f, 0x92, 0xf1,
-	0x61, 0xc3, 0x4a, 0x01, 0x66, 0x32, 0x1a, 0x5d, 0x68, 0x3e, 0x60, 0xbe, 0xb0, 0xa1, 0x9f, 0xea,
-	0xbb, 0x06, 0x73, 0xae, 0x69, 0x61, 0xc7, 0x67, 0x47, 0xb1, 0xc8, 0x05, 0x63, 0x36, 0x5a, 0xd8,
-	0x66, 0x47, 0x48, 0x57, 0x00, 0xf8, 0xcb, 0xc0, 0x79, 0x8a, 0x36, 0xaf, 0xfe, 0x9c, 0xc1, 0xc3,
-	0x77, 0xa2, 0x05, 0xda, 0x84, 0x19, 0x0f, 0x0f, 0xd0, 0xf3, 0xe3, 0xe2, 0xce, 0x1a, 0xe9, 0xa3,
-	0x7a, 0x4c, 0x60, 0x29, 0x67, 0xcb, 0xc4, 0xde, 0x16, 0x2c, 0x64, 0xf5, 0xf9, 0x4d, 0xd2, 0x9a,
-	0xaa, 0xe7, 0x4f, 0xc4, 0xd3, 0xb7, 0x61, 0xd1, 0xc6, 0xef, 0x83, 0xce, 0x88, 0xd8, 0x85, 0x68,
-	0xf9, 0x51, 0x2a, 0x58, 0xfd, 0x99, 0xc0, 0x72, 0x24, 0xeb, 0xb1, 0x8f, 0x5e, 0x6e, 0x36, 0xae,
-	0xc2, 0x4c, 0xe8, 0xa3, 0xd7, 0x39, 0x3d, 0x4c, 0x17, 0xa2, 0xc7, 0x7b, 0x3d, 0x31, 0x4d, 0x0d,
-	0x69, 0x9a, 0xa6, 0x24, 0x69, 0x9a, 0x16, 0xd3, 0xf4, 0x0b, 0x81, 0x95, 0x02, 0x3d, 0x2f, 0x3b,
-	0x55, 0x3a, 0x2c, 0x7d, 0xc1, 0x3f, 0xe5, 0xbc, 0xcf, 0x2d, 0xef, 0x50, 0x3f, 0x05, 0x25, 0x0f,
-	0x30, 0x99, 0x13, 0x7d, 0x1f, 0x56, 0x1e, 0xf3, 0xbb, 0x22, 0x1b, 0x93, 0xfd, 0xec, 0x86, 0x2f,
-	0xb2, 0x25, 0x98, 0xb5, 0xf1, 0x59, 0x87, 0xab, 0x8e, 0xfd, 0xce, 0xd8, 0xf8, 0x2c, 0x42, 0xa8,
-	0x0e, 0xbc, 0x5e, 0xc4, 0x35, 0x19, 0xf1, 0x3b, 0xf0, 0x46, 0xfe, 0x86, 0xa5, 0x37, 0x87, 0xcc,
-	0x46, 0x08, 0x6f, 0xca, 0x59, 0x27, 0x63, 0xe6, 0x26, 0x2c, 0x7d, 0x89, 0x7d, 0xcc, 0x3f, 0x27,
-	0xc3, 0xd7, 0xf2, 0x32, 0x28, 0x79, 0xc1, 0xb1, 0x32, 0x75, 0x03, 0xae, 0x8f, 0xbe, 0x2d, 0xbf,
-	0x4d, 0x55, 0x68, 0x15, 0xc3, 0x12, 0xea, 0x63, 0x02, 0x37, 0x6e, 0xf7, 0x7a, 0x42, 0x84, 0xe9,
-	0xa3, 0x81, 0xae, 0xe3, 0xb3, 0xc0, 0xf1, 0x0e, 0xb7, 0xa3, 0xb6, 0x59, 0x74, 0x78, 0xbe, 0x81,
-	0x57, 0xbc, 0xd3, 0xc8, 0x0e, 0xef, 0xb0, 0x3c, 0xfb, 0x97, 0xda, 0x6b, 0xd2, 0xb4, 0x0d, 0xd3,
-	0x2f, 0x7a, 0xe2, 0x82, 0xba, 0x06, 0xef, 0x56, 0x51, 0x95, 0x98, 0xf8, 0x8d, 0x80, 0x5e, 0x1e,
-	0x5e, 0x7e, 0x88, 0x26, 0x66, 0xa7, 0x0d, 0xef, 0x57, 0xd7, 0x97, 0x98, 0x3a, 0x21, 0xb0, 0x66,
-	0xe0, 0xc0, 0x39, 0xc0, 0xff, 0x5b, 0x71, 0x74, 0x78, 0xaf, 0xa2, 0xb0, 0xc4, 0xca, 0xef, 0x04,
-	0xda, 0x95, 0x10, 0x2f, 0xb1, 0x44, 0x1b, 0xb0, 0x5e, 0x4b, 0x62, 0x6c, 0xad, 0xfd, 0xe3, 0x65,
-	0x78, 0x35, 0x8b, 0xd8, 0x46, 0xef, 0x80, 0xed, 0x22, 0xfd, 0x81, 0xc0, 0xe2, 0xd0, 0x28, 0x43,
-	0xd7, 0xa5, 0x0a, 0xf3, 0x07, 0x38, 0xe5, 0x83, 0x7a, 0xa0, 0x24, 0xe3, 0xd3, 0xcf, 0x4f, 0x54,
-	0x42, 0x8f, 0x09, 0xbc, 0x96, 0x3b, 0x4f, 0xd1, 0x8f, 0xeb, 0xb0, 0x0a, 0x55, 0x51, 0x3e, 0x39,
-	0x0b, 0x54, 0x90, 0xf5, 0x9c, 0xc0, 0xe5, 0x91, 0x19, 0x88, 0x6e, 0x48, 0x79, 0x8b, 0xc6, 0x34,
-	0xe5, 0xc3, 0xba, 0xb0, 0x91, 0x0c, 0xe5, 0xce, 0x19, 0x25, 0x19, 0x92, 0xcd, 0x4a, 0x25, 0x19,
-	0x92, 0x8e, 0x35, 0x89, 0xac, 0x9f, 0x08, 0xd0, 0xd1, 0x99, 0x81, 0xca, 0xbd, 0x16, 0x4e, 0x25,
-	0xca, 0x47, 0xb5, 0x71, 0x19, 0x35, 0x0d, 0x7a, 0x42, 0xe0, 0x4a, 0x7e, 0x07, 0xa5, 0x72, 0xab,
-	0xd2, 0x49, 0x44, 0xd9, 0x3c, 0x13, 0x56, 0x50, 0xf6, 0x07, 0x81, 0x65, 0x59, 0x6f, 0xa7, 0x9f,
-	0x9f, 0x61, 0x0f, 0xf1, 0xb8, 0xdf, 0x1e, 0x83, 0x41, 0xd0, 0x1a, 0xd5, 0x74, 0xb4, 0x1d, 0x97,
-	0xd4, 0xb4, 0x70, 0x82, 0x28, 0xa9, 0xa9, 0x64, 0x98, 0x88, 0xd5, 0xfc, 0x4a, 0xa0, 0x59, 0x34,
-	0x1c, 0xd0, 0x4f, 0x6b, 0x72, 0x8b, 0x19, 0xfb, 0xec, 0x8c, 0x68, 0x41, 0xdf, 0x5f, 0x04, 0xd4,
-	0xf2, 0x96, 0x49, 0xbf, 0x92, 0xee, 0x55, 0x79, 0xb0, 0x51, 0xee, 0x8e, 0xcd, 0x23, 0xa8, 0xff,
-	0x87, 0xc0, 0x6a, 0xd5, 0x86, 0x4f, 0x1f, 0x8c, 0xb9, 0xb7, 0x98, 0xfd, 0x87, 0xe7, 0xc4, 0x26,
-	0xf8, 0xf9, 0x9b, 0xc0, 0x5b, 0x95, 0xba, 0x23, 0xbd, 0x57, 0xd2, 0x85, 0xab, 0xcf, 0x33, 0xca,
-	0xfd, 0xf3, 0xa0, 0x12, 0x6c, 0xfc, 0x4b, 0xe0, 0x66, 0x8d, 0x26, 0x4f, 0xb7, 0xc6, 0x57, 0x20,
-	0x16, 0xe7, 0xd1, 0xf9, 0x11, 0x66, 0x8d, 0xdd, 0xf9, 0xf6, 0xc9, 0x13, 0x8b, 0x05, 0xfb, 0x61,
-	0x57, 0xdb, 0x75, 0x06, 0x7a, 0x37, 0xdc, 0xeb, 0x86, 0xac, 0xdf, 0x8b, 0x7e, 0xe8, 0xcc, 0x0e,
-	0xd0, 0xb3, 0xcd, 0xbe, 0x6e, 0xa1, 0x1d, 0xff, 0xdf, 0xa5, 0x5b, 0x8e, 0x2e, 0xf9, 0xa3, 0x6c,
-	0x33, 0x5d, 0x49, 0x17, 0xba, 0x17, 0x38, 0x6c, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbe,
-	0x71, 0x62, 0xb5, 0xce, 0x13, 0x00, 0x00,
+var twirpFileDescriptor4 = []byte{
+	// 1189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xd6, 0xa4, 0xa5, 0x3f, 0x5e, 0xb7, 0xed, 0x32, 0x88, 0x36, 0x75, 0x5b, 0x36, 0x72, 0x59,
+	0xb6, 0xbb, 0x2d, 0x36, 0x9b, 0x52, 0x56, 0xb4, 0x20, 0x76, 0xcb, 0x8a, 0xb2, 0xab, 0x2e, 0x5d,
+	0xd2, 0x56, 0x48, 0x2b, 0xa4, 0xc8, 0x49, 0xa6, 0xa9, 0xb5, 0x89, 0x6d, 0xfc, 0x23, 0xa1, 0x15,
+	0x27, 0x4e, 0x2b, 0x21, 0x6e, 0x95, 0xca, 0x05, 0x24, 0x2e, 0x48, 0x48, 0xdc, 0x38, 0x22, 0x71,
+	0xe2, 0xca, 0x95, 0x33, 0x7f, 0x0a, 0x1a, 0x3b, 0x6e, 0x3c, 0xb1, 0x3d, 0xb6, 0x93, 0x46, 0xcb,
+	0x2d, 0x1e, 0xbf, 0xef, 0xbd, 0xef, 0x7b, 0xf3, 0xc6, 0xfd, 0x46, 0x05, 0xa9, 0xe2, 0x1c, 0xcb,
+	0x4a, 0xc3, 0x38, 0x51, 0x64, 0x93, 0xd4, 0x55, 0xcb, 0x36, 0x4f, 0xe5, 0xd6, 0x5d, 0x77, 0xe1,
+	0xae, 0xac, 0x9b, 0x75, 0x45, 0x53, 0xcf, 0x14, 0x5b, 0xd5, 0x35, 0xc9, 0x30, 0x75, 0x5b, 0xc7,
+	0x8b, 0x15, 0xe7, 0x58, 0x72, 0x5f, 0x4b, 0x7e, 0xbc, 0xe4, 0xc7, 0x0b, 0x85, 0x6e, 0x32, 0xc5,
+	0x50, 0xbb, 0x79, 0x14, 0x43, 0xf5, 0xe0, 0xc2, 0x2d, 0x5e, 0x39, 0xab, 0xaa, 0x1b, 0xa4, 0x13,
+	0x78, 0xa3, 0xae, 0xeb, 0xf5, 0x06, 0x91, 0xdd, 0x27, 0x0a, 0xb2, 0xd5, 0x26, 0xb1, 0x6c, 0xa5,
+	0x69, 0x78, 0x01, 0xe2, 0xbf, 0x08, 0xae, 0xed, 0x07, 0xf8, 0xe1, 0x19, 0xc8, 0xa9, 0xb5, 0x3c,
+	0x2a, 0xa0, 0xd5, 0xc9, 0x52, 0x4e, 0xad, 0xe1, 0x6d, 0x98, 0xaa, 0x9a, 0x44, 0xb1, 0x49, 0x99,
+	0x42, 0xf3, 0xb9, 0x02, 0x5a, 0x9d, 0x2a, 0x0a, 0x92, 0x97, 0x57, 0xf2, 0xf3, 0x4a, 0x87, 0x7e,
+	0xde, 0x12, 0x78, 0xe1, 0x74, 0x81, 0x82, 0x1d, 0xa3, 0x76, 0x09, 0x1e, 0x49, 0x06, 0x7b, 0xe1,
+	0x2e, 0x18, 0xc3, 0xa8, 0xa6, 0x34, 0x49, 0x7e, 0xd4, 0xe5, 0xe2, 0xfe, 0xc6, 0x05, 0x98, 0xaa,
+	0x11, 0xab, 0x6a, 0xaa, 0x06, 0x25, 0x9b, 0x7f, 0xc5, 0x7d, 0x15, 0x5c, 0xc2, 0xd7, 0x61, 0xc4,
+	0x31, 0x1b, 0xf9, 0x31, 0xf7, 0x0d, 0xfd, 0x29, 0xae, 0xc2, 0xdc, 0x2e, 0xb1, 0x83, 0x22, 0x4b,
+	0xe4, 0x2b, 0x87, 0x58, 0x76, 0xaf, 0x56, 0xf1, 0x04, 0xe6, 0x43, 0x91, 0x96, 0xa1, 0x6b, 0x16,
+	0xc1, 0x4f, 0xe0, 0x5a, 0x70, 0x1b, 0x5d, 0xd0, 0x54, 0xf1, 0xb6, 0xc4, 0xd9, 0x47, 0x89, 0x49,
+	0xc4, 0xc0, 0xc5, 0x22, 0x2c, 0xf5, 0x54, 0xda, 0x39, 0xfd, 0x4c, 0x69, 0x12, 0x9f, 0x99, 0xaf,
+	0x1d, 0x75, 0xb5, 0x8b, 0x1a, 0x2c, 0xc7, 0x60, 0x86, 0xc3, 0xd1, 0x80, 0xfc, 0x9e, 0x6a, 0x31,
+	0x05, 0x2d, 0x9f, 0xdf, 0x22, 0x4c, 0x1a, 0x4a, 0x9d, 0x94, 0x2d, 0xf5, 0xcc, 0x23, 0x39, 0x5d,
+	0x9a, 0xa0, 0x0b, 0x07, 0xea, 0x19, 0xc1, 0xcb, 0x00, 0xee, 0x4b, 0x5b, 0x7f, 0x4e, 0x34, 0x77,
+	0x62, 0x26, 0x4b, 0x6e, 0xf8, 0x21, 0x5d, 0xc0, 0x79, 0x18, 0x37, 0x49, 0x8b, 0x98, 0x96, 0x37,
+	0x10, 0x13, 0x25, 0xff, 0x51, 0x3c, 0x47, 0xb0, 0x10, 0x51, 0xb2, 0x23, 0x6f, 0x1f, 0xa6, 0x83,
+	0xfc, 0xac, 0x3c, 0x2a, 0x8c, 0x64, 0xd3, 0xc7, 0xe2, 0xf1, 0x5b, 0x30, 0xab, 0x91, 0xaf, 0xed,
+	0x72, 0x88, 0xec, 0x34, 0x5d, 0x7e, 0xea, 0x13, 0x16, 0xbf, 0x47, 0xb0, 0x44, 0x69, 0x1d, 0x59,
+	0xc4, 0x8c, 0xec, 0xc6, 0x3c, 0x8c, 0x3b, 0x16, 0x31, 0xcb, 0x97, 0xc3, 0x34, 0x46, 0x1f, 0x1f,
+	0xd5, 0xd8, 0x36, 0xe5, 0xb8, 0x6d, 0x1a, 0xe1, 0xb4, 0x69, 0x94, 0x6d, 0xd3, 0x0f, 0x08, 0x96,
+	0x63, 0xf8, 0xbc, 0xec, 0x56, 0x55, 0x61, 0xe1, 0x63, 0xf7, 0xf8, 0x47, 0x1d, 0xb7, 0x88, 0xa1,
+	0xee, 0x3d, 0xd0, 0xb9, 0xd8, 0x03, 0x3d, 0xd2, 0x3d, 0xd0, 0xcf, 0x41, 0x88, 0x2a, 0x32, 0x9c,
+	0x53, 0xf0, 0x18, 0x96, 0x8f, 0xdc, 0x6f, 0x52, 0x30, 0x26, 0x78, 0x54, 0x7b, 0x3f, 0x98, 0x0b,
+	0x30, 0xa1, 0x91, 0x76, 0xd9, 0x55, 0xea, 0xc9, 0x19, 0xd7, 0x48, 0x9b, 0x22, 0x44, 0x1d, 0xde,
+	0x88, 0xcb, 0x35, 0x1c, 0xf2, 0x87, 0xb0, 0x12, 0x5d, 0x30, 0xf1, 0x6b, 0xc3, 0x93, 0xe1, 0xc0,
+	0x9b, 0xfc, 0xac, 0xc3, 0x11, 0x53, 0x8e, 0x2a, 0xfb, 0xb0, 0x3b, 0x29, 0x71, 0x1b, 0x72, 0x8b,
+	0xce, 0x6e, 0xbb, 0x1c, 0x1e, 0xb3, 0x19, 0x8d, 0xb4, 0x03, 0x78, 0xb1, 0x05, 0x37, 0x13, 0x0a,
+	0x0c, 0x47, 0x98, 0x0a, 0x77, 0xb8, 0x75, 0x93, 0x37, 0x2b, 0xb5, 0xc4, 0x6f, 0x60, 0x2d, 0x55,
+	0xa9, 0xe1, 0x08, 0xdd, 0x85, 0xa5, 0x70, 0xf5, 0xa3, 0xd2, 0x5e, 0xdc, 0xce, 0xcd, 0x03, 0x9d,
+	0xb9, 0x32, 0x3d, 0xfe, 0x9e, 0x9c, 0x31, 0x8d, 0xb4, 0x8f, 0xcc, 0x06, 0xfd, 0x53, 0x18, 0x93,
+	0x68, 0x38, 0xc4, 0x3f, 0x07, 0x31, 0xb2, 0x5e, 0xf2, 0xce, 0xc4, 0x4a, 0xb0, 0x61, 0x85, 0x9b,
+	0x72, 0x38, 0x42, 0xd6, 0x60, 0xe1, 0x21, 0x69, 0x90, 0xe8, 0xef, 0x73, 0xaf, 0x1d, 0x5a, 0x02,
+	0x21, 0x2a, 0xd8, 0x63, 0x26, 0x6e, 0xc2, 0x8d, 0xf0, 0xdb, 0x64, 0x17, 0x23, 0x42, 0x21, 0x1e,
+	0xd6, 0x49, 0x7d, 0x8e, 0xe0, 0xf6, 0x83, 0x5a, 0x8d, 0x89, 0x50, 0x2c, 0x52, 0x22, 0x86, 0x6e,
+	0xa9, 0xb6, 0x6e, 0x9e, 0x1e, 0x50, 0x8b, 0x1b, 0x37, 0x35, 0x5f, 0xc0, 0x75, 0xf3, 0x32, 0xb2,
+	0xec, 0xba, 0x61, 0xb7, 0xf7, 0x33, 0xc5, 0x75, 0x6e, 0xdb, 0x7a, 0xd3, 0xcf, 0x9a, 0xec, 0x82,
+	0xb8, 0x0e, 0x77, 0xd2, 0xb0, 0xea, 0x88, 0xf8, 0x09, 0x81, 0x9c, 0x1c, 0x9e, 0x3c, 0x41, 0x43,
+	0x93, 0x53, 0x84, 0x77, 0xd2, 0xf3, 0xeb, 0x88, 0xba, 0x40, 0xb0, 0x5e, 0x22, 0x4d, 0xbd, 0x45,
+	0xfe, 0x6f, 0x9b, 0x23, 0xc3, 0xdb, 0x29, 0x89, 0x75, 0xa4, 0xfc, 0x8c, 0xa0, 0x98, 0x0a, 0xf1,
+	0x12, 0xb7, 0x68, 0x13, 0x36, 0x32, 0x51, 0xf4, 0xa4, 0x15, 0x7f, 0x9f, 0x83, 0xd7, 0x82, 0x88,
+	0x03, 0x62, 0xb6, 0xd4, 0x2a, 0xc1, 0xdf, 0x22, 0x98, 0xed, 0xb9, 0x42, 0xe0, 0x0d, 0x2e, 0xc3,
+	0xe8, 0x8b, 0x93, 0xf0, 0x6e, 0x36, 0x50, 0xa7, 0xe3, 0xa3, 0x2f, 0x2e, 0x44, 0x84, 0xcf, 0x11,
+	0xbc, 0x1e, 0x79, 0x8f, 0xc1, 0xef, 0x67, 0xc9, 0xca, 0xec, 0x8a, 0xb0, 0xd5, 0x0f, 0x94, 0xa1,
+	0xf5, 0x02, 0xc1, 0xab, 0xa1, 0xbb, 0x07, 0xde, 0xe4, 0xe6, 0x8d, 0xbb, 0x1e, 0x09, 0xef, 0x65,
+	0x85, 0x85, 0x3a, 0x14, 0xe9, 0xef, 0x13, 0x3a, 0xc4, 0xbb, 0xa3, 0x08, 0x5b, 0xfd, 0x40, 0x19,
+	0x5a, 0xdf, 0x21, 0xc0, 0x61, 0xdf, 0x8d, 0xf9, 0x5a, 0x63, 0x6f, 0x03, 0xc2, 0xbd, 0xcc, 0xb8,
+	0x00, 0x9b, 0x1c, 0xbe, 0x40, 0x30, 0x17, 0xed, 0x42, 0x31, 0x5f, 0x2a, 0xd7, 0xcd, 0x0b, 0xdb,
+	0x7d, 0x61, 0x19, 0x66, 0xbf, 0x22, 0x58, 0x8a, 0x0e, 0xec, 0xcc, 0xf9, 0xfd, 0x3e, 0x6a, 0xb0,
+	0xe3, 0xfe, 0x60, 0x80, 0x0c, 0x0c, 0xd7, 0xdf, 0x10, 0x2c, 0x73, 0x0d, 0x21, 0xce, 0x5a, 0x2a,
+	0x6c, 0xc8, 0x85, 0x9d, 0x41, 0x52, 0x30, 0x74, 0xff, 0x44, 0xb0, 0xc2, 0x8d, 0xef, 0x74, 0x78,
+	0xb7, 0xff, 0x8a, 0x6c, 0xa3, 0x3f, 0x1d, 0x3c, 0x11, 0x23, 0x80, 0x1e, 0xed, 0x48, 0xdb, 0x97,
+	0x70, 0xb4, 0x79, 0xb6, 0x59, 0xd8, 0xea, 0x07, 0xca, 0xd0, 0xfa, 0x05, 0xc1, 0x22, 0xc7, 0x8d,
+	0xe2, 0x8f, 0xb2, 0x57, 0x60, 0xfb, 0x78, 0xbf, 0xff, 0x04, 0x0c, 0x51, 0xfa, 0x0d, 0x0a, 0xdb,
+	0xc7, 0x84, 0x6f, 0x50, 0xac, 0xe3, 0x15, 0xee, 0x65, 0xc6, 0x31, 0x6c, 0x7e, 0x44, 0x90, 0x8f,
+	0x33, 0xb3, 0xf8, 0x83, 0x8c, 0xb9, 0xd9, 0x86, 0x7d, 0xd8, 0x27, 0x9a, 0xe1, 0xf7, 0x07, 0x02,
+	0x31, 0xd9, 0xe2, 0xe1, 0x4f, 0xb8, 0xb5, 0x52, 0x1b, 0x71, 0x61, 0x77, 0xe0, 0x3c, 0x0c, 0xfb,
+	0xbf, 0x11, 0xac, 0xa6, 0x35, 0xa8, 0x78, 0x6f, 0xc0, 0xda, 0x6c, 0xf7, 0x9f, 0x5c, 0x51, 0x36,
+	0x46, 0xcf, 0x5f, 0x08, 0x6e, 0xa6, 0x72, 0x73, 0xf8, 0x51, 0x82, 0x6b, 0x4c, 0xef, 0xbf, 0x85,
+	0xc7, 0x57, 0x91, 0x8a, 0x91, 0xf1, 0x0f, 0x82, 0xb5, 0x0c, 0xa6, 0x14, 0xef, 0x0f, 0xce, 0x80,
+	0xdd, 0x9c, 0xa7, 0x57, 0x97, 0x30, 0x28, 0x6c, 0xe7, 0xcb, 0x67, 0xcf, 0xea, 0xaa, 0x7d, 0xe2,
+	0x54, 0xa4, 0xaa, 0xde, 0x94, 0x2b, 0xce, 0x71, 0xc5, 0x51, 0x1b, 0x35, 0xfa, 0x43, 0x56, 0x35,
+	0x9b, 0x98, 0x9a, 0xd2, 0x90, 0xeb, 0x44, 0xf3, 0xfe, 0x97, 0x22, 0xd7, 0x75, 0x99, 0xf3, 0x4f,
+	0x98, 0x6d, 0x7f, 0xc5, 0x5f, 0xa8, 0x8c, 0xb9, 0xb0, 0x8d, 0xff, 0x06, 0x00, 0x66, 0xdc, 0x87,
+	0x68, 0x2a, 0x1a, 0x00, 0x00,
 }
@@ -45,6 +45,14 @@ type OrganizationServiceClient interface {
 	UpdateOrganizationName(ctx context.Context, in *UpdateOrganizationNameRequest, opts ...grpc.CallOption) (*UpdateOrganizationNameResponse, error)
 	// UpdateOrganizationNameByName updates a organization's name by name.
 	UpdateOrganizationNameByName(ctx context.Context, in *UpdateOrganizationNameByNameRequest, opts ...grpc.CallOption) (*UpdateOrganizationNameByNameResponse, error)
+	// UpdateOrganizationDescription updates a organization's description.
+	UpdateOrganizationDescription(ctx context.Context, in *UpdateOrganizationDescriptionRequest, opts ...grpc.CallOption) (*UpdateOrganizationDescriptionResponse, error)
+	// UpdateOrganizationDescriptionByName updates a organization's description by name.
+	UpdateOrganizationDescriptionByName(ctx context.Context, in *UpdateOrganizationDescriptionByNameRequest, opts ...grpc.CallOption) (*UpdateOrganizationDescriptionByNameResponse, error)
+	// UpdateOrganizationURL updates a organization's URL.
+	UpdateOrganizationURL(ctx context.Context, in *UpdateOrganizationURLRequest, opts ...grpc.CallOption) (*UpdateOrganizationURLResponse, error)
+	// UpdateOrganizationURLByName updates a organization's URL by name.
+	UpdateOrganizationURLByName(ctx context.Context, in *UpdateOrganizationURLByNameRequest, opts ...grpc.CallOption) (*UpdateOrganizationURLByNameResponse, error)
 	// DeleteOrganization deletes a organization.
 	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
 	// DeleteOrganizationByName deletes a organization by name.
@@ -130,6 +138,42 @@ func (c *organizationServiceClient) UpdateOrganizationNameByName(ctx context.Con
 	return out, nil
 }
 
+func (c *organizationServiceClient) UpdateOrganizationDescription(ctx context.Context, in *UpdateOrganizationDescriptionRequest, opts ...grpc.CallOption) (*UpdateOrganizationDescriptionResponse, error) {
+	out := new(UpdateOrganizationDescriptionResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateOrganizationDescriptionByName(ctx context.Context, in *UpdateOrganizationDescriptionByNameRequest, opts ...grpc.CallOption) (*UpdateOrganizationDescriptionByNameResponse, error) {
+	out := new(UpdateOrganizationDescriptionByNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationDescriptionByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateOrganizationURL(ctx context.Context, in *UpdateOrganizationURLRequest, opts ...grpc.CallOption) (*UpdateOrganizationURLResponse, error) {
+	out := new(UpdateOrganizationURLResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateOrganizationURLByName(ctx context.Context, in *UpdateOrganizationURLByNameRequest, opts ...grpc.CallOption) (*UpdateOrganizationURLByNameResponse, error) {
+	out := new(UpdateOrganizationURLByNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationURLByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error) {
 	out := new(DeleteOrganizationResponse)
 	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.OrganizationService/DeleteOrganization", in, out, opts...)
@@ -202,6 +246,14 @@ type OrganizationServiceServer interface {
 	UpdateOrganizationName(context.Context, *UpdateOrganizationNameRequest) (*UpdateOrganizationNameResponse, error)
 	// UpdateOrganizationNameByName updates a organization's name by name.
 	UpdateOrganizationNameByName(context.Context, *UpdateOrganizationNameByNameRequest) (*UpdateOrganizationNameByNameResponse, error)
+	// UpdateOrganizationDescription updates a organization's description.
+	UpdateOrganizationDescription(context.Context, *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error)
+	// UpdateOrganizationDescriptionByName updates a organization's description by name.
+	UpdateOrganizationDescriptionByName(context.Context, *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error)
+	// UpdateOrganizationURL updates a organization's URL.
+	UpdateOrganizationURL(context.Context, *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error)
+	// UpdateOrganizationURLByName updates a organization's URL by name.
+	UpdateOrganizationURLByName(context.Context, *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error)
 	// DeleteOrganization deletes a organization.
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
 	// DeleteOrganizationByName deletes a organization by name.
@@ -241,6 +293,18 @@ func (UnimplementedOrganizationServiceServer) UpdateOrganizationName(context.Con
 func (UnimplementedOrganizationServiceServer) UpdateOrganizationNameByName(context.Context, *UpdateOrganizationNameByNameRequest) (*UpdateOrganizationNameByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationNameByName not implemented")
 }
+func (UnimplementedOrganizationServiceServer) UpdateOrganizationDescription(context.Context, *UpdateOrganizationDescriptionRequest) (*UpdateOrganizationDescriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationDescription not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateOrganizationDescriptionByName(context.Context, *UpdateOrganizationDescriptionByNameRequest) (*UpdateOrganizationDescriptionByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationDescriptionByName not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateOrganizationURL(context.Context, *UpdateOrganizationURLRequest) (*UpdateOrganizationURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationURL not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateOrganizationURLByName(context.Context, *UpdateOrganizationURLByNameRequest) (*UpdateOrganizationURLByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationURLByName not implemented")
+}
 func (UnimplementedOrganizationServiceServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganization not implemented")
 }
@@ -397,6 +461,78 @@ func _OrganizationService_UpdateOrganizationNameByName_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationService_UpdateOrganizationDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationDescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateOrganizationDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateOrganizationDescription(ctx, req.(*UpdateOrganizationDescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateOrganizationDescriptionByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationDescriptionByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateOrganizationDescriptionByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationDescriptionByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateOrganizationDescriptionByName(ctx, req.(*UpdateOrganizationDescriptionByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateOrganizationURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateOrganizationURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateOrganizationURL(ctx, req.(*UpdateOrganizationURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateOrganizationURLByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationURLByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateOrganizationURLByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.OrganizationService/UpdateOrganizationURLByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateOrganizationURLByName(ctx, req.(*UpdateOrganizationURLByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrganizationService_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteOrganizationRequest)
 	if err := dec(in); err != nil {
@@ -540,6 +676,22 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrganizationNameByName",
 			Handler:    _OrganizationService_UpdateOrganizationNameByName_Handler,
 		},
+		{
+			MethodName: "UpdateOrganizationDescription",
+			Handler:    _OrganizationService_UpdateOrganizationDescription_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationDescriptionByName",
+			Handler:    _OrganizationService_UpdateOrganizationDescriptionByName_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationURL",
+			Handler:    _OrganizationService_UpdateOrganizationURL_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationURLByName",
+			Handler:    _OrganizationService_UpdateOrganizationURLByName_Handler,
+		},
 		{
 			MethodName: "DeleteOrganization",
 			Handler:    _OrganizationService_DeleteOrganization_Handler,
@@ -484,7 +484,7 @@ func (s *pushServiceServer) servePushProtobuf(ctx context.Context, resp http.Res
 }
 
 func (s *pushServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor2, 0
+	return twirpFileDescriptor5, 0
 }
 
 func (s *pushServiceServer) ProtocGenTwirpVersion() string {
@@ -498,27 +498,29 @@ func (s *pushServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "PushService")
 }
 
-var twirpFileDescriptor2 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x51, 0x4b, 0xeb, 0x30,
-	0x18, 0xa5, 0xbb, 0xdb, 0xe0, 0x66, 0x97, 0x8b, 0x04, 0x91, 0x32, 0x41, 0x66, 0x1f, 0xa4, 0x22,
-	0x24, 0x6c, 0x3e, 0x89, 0x6f, 0x3e, 0x2b, 0x8c, 0x8a, 0x2f, 0x43, 0x18, 0x49, 0x97, 0xb5, 0x81,
-	0x2c, 0x89, 0x49, 0x33, 0xd9, 0x3f, 0xf0, 0x17, 0xe8, 0xdf, 0x95, 0x25, 0x2d, 0xad, 0x2f, 0xc5,
-	0xb7, 0x9c, 0xf3, 0x9d, 0x73, 0x72, 0xbe, 0x04, 0x5c, 0x51, 0xb7, 0xc5, 0x44, 0xe8, 0x92, 0x60,
-	0xc3, 0x0a, 0x6e, 0x2b, 0x73, 0xc0, 0xfb, 0xb9, 0x27, 0xe6, 0x58, 0x3b, 0x5b, 0x22, 0x6d, 0x54,
-	0xa5, 0xe0, 0x39, 0x75, 0x5b, 0xe4, 0x69, 0xd4, 0xe8, 0x50, 0xa3, 0x9b, 0xce, 0xda, 0x10, 0xa2,
-	0x79, 0xeb, 0x27, 0x9a, 0x07, 0xfb, 0xb4, 0x73, 0xcd, 0x4e, 0x6d, 0x9c, 0x60, 0xad, 0x28, 0xe0,
-	0x5a, 0x97, 0xf6, 0xd5, 0xe9, 0x2a, 0x93, 0xcf, 0x08, 0x4c, 0x96, 0xce, 0x96, 0x19, 0x7b, 0x73,
-	0xcc, 0x56, 0xf0, 0x14, 0x8c, 0xd4, 0xbb, 0x64, 0x26, 0x8e, 0x66, 0x51, 0xfa, 0x37, 0x0b, 0x00,
-	0x5e, 0x00, 0x60, 0x98, 0x56, 0x96, 0x57, 0xca, 0x1c, 0xe2, 0x81, 0x1f, 0x75, 0x18, 0x78, 0x06,
-	0xc6, 0xd4, 0x10, 0x99, 0x97, 0xf1, 0x1f, 0x3f, 0xab, 0x11, 0xbc, 0x03, 0xe3, 0x70, 0x5b, 0x3c,
-	0x9c, 0x45, 0xe9, 0x64, 0x71, 0x89, 0xda, 0xfd, 0xeb, 0x1a, 0x4d, 0x2d, 0xf4, 0xe4, 0x71, 0x56,
-	0x1b, 0x12, 0x06, 0xfe, 0x85, 0x5e, 0x56, 0x2b, 0x69, 0x19, 0x7c, 0x01, 0x27, 0x42, 0xe5, 0x44,
-	0xac, 0xc3, 0x7c, 0xad, 0xb9, 0x8c, 0x47, 0x3e, 0xf4, 0x06, 0xf5, 0x3c, 0x2a, 0x7a, 0x3c, 0x9a,
-	0x42, 0xf6, 0x92, 0xcb, 0xec, 0xbf, 0xf8, 0x81, 0x17, 0x3a, 0xac, 0xff, 0xcc, 0xcc, 0x9e, 0xe7,
-	0x0c, 0x12, 0x30, 0x3c, 0x42, 0x98, 0xf6, 0x66, 0x76, 0x1e, 0x6c, 0x7a, 0xfd, 0x0b, 0x65, 0x58,
-	0x21, 0x19, 0x7e, 0x7c, 0x25, 0x83, 0x87, 0xd7, 0xd5, 0xaa, 0xe0, 0x55, 0xe9, 0x28, 0xca, 0xd5,
-	0x0e, 0x53, 0xb7, 0xa5, 0x8e, 0x8b, 0xcd, 0xf1, 0x80, 0xb9, 0xac, 0x98, 0x91, 0x44, 0xe0, 0x82,
-	0x49, 0xec, 0xff, 0x07, 0x17, 0x0a, 0xf7, 0xfc, 0xe5, 0x7d, 0xc3, 0x34, 0x04, 0x1d, 0x7b, 0xdb,
-	0xed, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xaa, 0x78, 0x51, 0x91, 0x02, 0x00, 0x00,
+var twirpFileDescriptor5 = []byte{
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x6b, 0xdb, 0x30,
+	0x1c, 0xc5, 0x71, 0x96, 0x78, 0x99, 0x32, 0xc6, 0x10, 0x63, 0x78, 0x19, 0x0c, 0xcf, 0x87, 0xe1,
+	0x31, 0x90, 0x48, 0x76, 0x2a, 0xbd, 0x95, 0x1e, 0x5b, 0x08, 0x2e, 0xbd, 0x84, 0x42, 0x90, 0x1d,
+	0xd9, 0x16, 0x38, 0x92, 0x2a, 0x59, 0x29, 0xb9, 0xf5, 0xd8, 0x6f, 0xd0, 0x2f, 0xd1, 0x0f, 0x59,
+	0x2c, 0xd9, 0xd8, 0xbd, 0x98, 0xde, 0xfc, 0x7b, 0x7a, 0xcf, 0xff, 0xa7, 0xbf, 0xc0, 0x9f, 0xd4,
+	0xe4, 0x98, 0x54, 0xb2, 0x24, 0x58, 0xd1, 0x82, 0xe9, 0x5a, 0x9d, 0xf0, 0x71, 0x65, 0x85, 0x15,
+	0x96, 0x46, 0x97, 0x48, 0x2a, 0x51, 0x0b, 0xf8, 0x33, 0x35, 0x39, 0xb2, 0x32, 0xea, 0x7c, 0xa8,
+	0xf3, 0x2d, 0xc3, 0xfe, 0x27, 0x44, 0xb2, 0x3e, 0x4f, 0x24, 0x73, 0xf1, 0xe5, 0x60, 0xcc, 0x41,
+	0xec, 0x4d, 0x45, 0x7b, 0x93, 0xe3, 0xd6, 0x17, 0x8f, 0xd5, 0x19, 0x3a, 0xa3, 0x17, 0x0f, 0x2c,
+	0x36, 0x46, 0x97, 0x09, 0xbd, 0x37, 0x54, 0xd7, 0xf0, 0x1b, 0x98, 0x89, 0x07, 0x4e, 0x55, 0xe0,
+	0x85, 0x5e, 0xfc, 0x29, 0x71, 0x00, 0x7f, 0x01, 0xa0, 0xa8, 0x14, 0x9a, 0xd5, 0x42, 0x9d, 0x82,
+	0x89, 0x3d, 0x1a, 0x28, 0xf0, 0x3b, 0xf0, 0x53, 0x45, 0x78, 0x56, 0x06, 0x1f, 0xec, 0x59, 0x4b,
+	0xf0, 0x0c, 0xf8, 0x6e, 0x5a, 0x30, 0x0d, 0xbd, 0x78, 0xb1, 0xfe, 0x8d, 0xfa, 0xfb, 0xb7, 0x35,
+	0xba, 0x5a, 0xe8, 0xda, 0x72, 0xd2, 0x06, 0x9a, 0x22, 0x7b, 0x45, 0xf2, 0x3a, 0x98, 0x85, 0x5e,
+	0x3c, 0x4f, 0x1c, 0x44, 0x8f, 0x1e, 0xf8, 0xec, 0xea, 0x6a, 0x29, 0xb8, 0xa6, 0xf0, 0x16, 0x7c,
+	0xad, 0x44, 0x46, 0xaa, 0x9d, 0x8b, 0xed, 0x24, 0xe3, 0x36, 0xb1, 0x58, 0xff, 0x43, 0x23, 0xbb,
+	0x46, 0x57, 0x4d, 0xc8, 0x8d, 0xdc, 0x30, 0x9e, 0x7c, 0xa9, 0xde, 0x30, 0xfc, 0x01, 0xe6, 0x4c,
+	0xef, 0x5c, 0x01, 0xdf, 0x16, 0xf8, 0xc8, 0xf4, 0x65, 0x83, 0x6b, 0xe9, 0x16, 0x76, 0x43, 0xd5,
+	0x91, 0x65, 0x14, 0x12, 0x30, 0x6d, 0x10, 0xc6, 0xa3, 0xe3, 0x06, 0x2b, 0x5e, 0xfe, 0x7d, 0x87,
+	0xd3, 0xdd, 0x2e, 0x9a, 0x3e, 0x3d, 0x47, 0x93, 0x8b, 0xbb, 0xed, 0xb6, 0x60, 0x75, 0x69, 0x52,
+	0x94, 0x89, 0x03, 0x4e, 0x4d, 0x9e, 0x1a, 0x56, 0xed, 0x9b, 0x0f, 0xcc, 0x78, 0x4d, 0x15, 0x27,
+	0x15, 0x2e, 0x28, 0xc7, 0xf6, 0x45, 0x71, 0x21, 0xf0, 0xc8, 0xeb, 0x9f, 0x77, 0x4a, 0x27, 0xa4,
+	0xbe, 0x8d, 0xfd, 0x7f, 0x1d, 0x00, 0xc7, 0x34, 0x9e, 0x20, 0xc3, 0x02, 0x00, 0x00,
 }
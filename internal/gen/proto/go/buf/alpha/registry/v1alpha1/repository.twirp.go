@@ -48,6 +48,9 @@ type RepositoryService interface {
 	// GetRepositoryByFullName gets a repository by full name.
 	GetRepositoryByFullName(context.Context, *GetRepositoryByFullNameRequest) (*GetRepositoryByFullNameResponse, error)
 
+	// GetRepositoryStatsByFullName gets the usage statistics of a repository by full name.
+	GetRepositoryStatsByFullName(context.Context, *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error)
+
 	// ListRepositories lists all repositories.
 	ListRepositories(context.Context, *ListRepositoriesRequest) (*ListRepositoriesResponse, error)
 
@@ -57,6 +60,11 @@ type RepositoryService interface {
 	// ListOrganizationRepositories lists all repositories for an organization.
 	ListOrganizationRepositories(context.Context, *ListOrganizationRepositoriesRequest) (*ListOrganizationRepositoriesResponse, error)
 
+	// SearchRepositories searches for repositories whose name or owner name matches the query.
+	//
+	// Only repositories visible to the caller are returned.
+	SearchRepositories(context.Context, *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error)
+
 	// CreateRepositoryByFullName creates a new repository by full name.
 	CreateRepositoryByFullName(context.Context, *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error)
 
@@ -72,6 +80,16 @@ type RepositoryService interface {
 	// UpdateRepositoryVisibilityByName updates a repository's visibility by name.
 	UpdateRepositoryVisibilityByName(context.Context, *UpdateRepositoryVisibilityByNameRequest) (*UpdateRepositoryVisibilityByNameResponse, error)
 
+	// UpdateRepositoryDefaultBranchByFullName updates a repository's default branch by full name.
+	//
+	// The branch must already exist on the repository.
+	UpdateRepositoryDefaultBranchByFullName(context.Context, *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error)
+
+	// TransferRepositoryByFullName transfers a repository to a new owner by full name.
+	//
+	// The new owner must be an existing organization.
+	TransferRepositoryByFullName(context.Context, *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error)
+
 	// DeleteRepository deletes a repository.
 	DeleteRepository(context.Context, *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error)
 
@@ -85,7 +103,7 @@ type RepositoryService interface {
 
 type repositoryServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [16]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -105,17 +123,21 @@ func NewRepositoryServiceProtobufClient(baseURL string, client HTTPClient, opts
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryService")
-	urls := [12]string{
+	urls := [16]string{
 		serviceURL + "GetRepository",
 		serviceURL + "GetRepositoryByFullName",
+		serviceURL + "GetRepositoryStatsByFullName",
 		serviceURL + "ListRepositories",
 		serviceURL + "ListUserRepositories",
 		serviceURL + "ListOrganizationRepositories",
+		serviceURL + "SearchRepositories",
 		serviceURL + "CreateRepositoryByFullName",
 		serviceURL + "UpdateRepositoryName",
 		serviceURL + "UpdateRepositoryNameByFullName",
 		serviceURL + "UpdateRepositoryVisibility",
 		serviceURL + "UpdateRepositoryVisibilityByName",
+		serviceURL + "UpdateRepositoryDefaultBranchByFullName",
+		serviceURL + "TransferRepositoryByFullName",
 		serviceURL + "DeleteRepository",
 		serviceURL + "DeleteRepositoryByFullName",
 	}
@@ -220,6 +242,52 @@ func (c *repositoryServiceProtobufClient) callGetRepositoryByFullName(ctx contex
 	return out, nil
 }
 
+func (c *repositoryServiceProtobufClient) GetRepositoryStatsByFullName(ctx context.Context, in *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryStatsByFullName")
+	caller := c.callGetRepositoryStatsByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryStatsByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryStatsByFullNameRequest) when calling interceptor")
+					}
+					return c.callGetRepositoryStatsByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryStatsByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryStatsByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceProtobufClient) callGetRepositoryStatsByFullName(ctx context.Context, in *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+	out := new(GetRepositoryStatsByFullNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *repositoryServiceProtobufClient) ListRepositories(ctx context.Context, in *ListRepositoriesRequest) (*ListRepositoriesResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
@@ -251,7 +319,7 @@ func (c *repositoryServiceProtobufClient) ListRepositories(ctx context.Context,
 
 func (c *repositoryServiceProtobufClient) callListRepositories(ctx context.Context, in *ListRepositoriesRequest) (*ListRepositoriesResponse, error) {
 	out := new(ListRepositoriesResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -297,7 +365,7 @@ func (c *repositoryServiceProtobufClient) ListUserRepositories(ctx context.Conte
 
 func (c *repositoryServiceProtobufClient) callListUserRepositories(ctx context.Context, in *ListUserRepositoriesRequest) (*ListUserRepositoriesResponse, error) {
 	out := new(ListUserRepositoriesResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -343,7 +411,53 @@ func (c *repositoryServiceProtobufClient) ListOrganizationRepositories(ctx conte
 
 func (c *repositoryServiceProtobufClient) callListOrganizationRepositories(ctx context.Context, in *ListOrganizationRepositoriesRequest) (*ListOrganizationRepositoriesResponse, error) {
 	out := new(ListOrganizationRepositoriesResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryServiceProtobufClient) SearchRepositories(ctx context.Context, in *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRepositories")
+	caller := c.callSearchRepositories
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchRepositoriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRepositoriesRequest) when calling interceptor")
+					}
+					return c.callSearchRepositories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchRepositoriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRepositoriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceProtobufClient) callSearchRepositories(ctx context.Context, in *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
+	out := new(SearchRepositoriesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -389,7 +503,7 @@ func (c *repositoryServiceProtobufClient) CreateRepositoryByFullName(ctx context
 
 func (c *repositoryServiceProtobufClient) callCreateRepositoryByFullName(ctx context.Context, in *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error) {
 	out := new(CreateRepositoryByFullNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -435,7 +549,7 @@ func (c *repositoryServiceProtobufClient) UpdateRepositoryName(ctx context.Conte
 
 func (c *repositoryServiceProtobufClient) callUpdateRepositoryName(ctx context.Context, in *UpdateRepositoryNameRequest) (*UpdateRepositoryNameResponse, error) {
 	out := new(UpdateRepositoryNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -481,7 +595,7 @@ func (c *repositoryServiceProtobufClient) UpdateRepositoryNameByFullName(ctx con
 
 func (c *repositoryServiceProtobufClient) callUpdateRepositoryNameByFullName(ctx context.Context, in *UpdateRepositoryNameByFullNameRequest) (*UpdateRepositoryNameByFullNameResponse, error) {
 	out := new(UpdateRepositoryNameByFullNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -527,7 +641,7 @@ func (c *repositoryServiceProtobufClient) UpdateRepositoryVisibility(ctx context
 
 func (c *repositoryServiceProtobufClient) callUpdateRepositoryVisibility(ctx context.Context, in *UpdateRepositoryVisibilityRequest) (*UpdateRepositoryVisibilityResponse, error) {
 	out := new(UpdateRepositoryVisibilityResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -573,7 +687,99 @@ func (c *repositoryServiceProtobufClient) UpdateRepositoryVisibilityByName(ctx c
 
 func (c *repositoryServiceProtobufClient) callUpdateRepositoryVisibilityByName(ctx context.Context, in *UpdateRepositoryVisibilityByNameRequest) (*UpdateRepositoryVisibilityByNameResponse, error) {
 	out := new(UpdateRepositoryVisibilityByNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryServiceProtobufClient) UpdateRepositoryDefaultBranchByFullName(ctx context.Context, in *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryDefaultBranchByFullName")
+	caller := c.callUpdateRepositoryDefaultBranchByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryDefaultBranchByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryDefaultBranchByFullNameRequest) when calling interceptor")
+					}
+					return c.callUpdateRepositoryDefaultBranchByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryDefaultBranchByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryDefaultBranchByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceProtobufClient) callUpdateRepositoryDefaultBranchByFullName(ctx context.Context, in *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+	out := new(UpdateRepositoryDefaultBranchByFullNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryServiceProtobufClient) TransferRepositoryByFullName(ctx context.Context, in *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "TransferRepositoryByFullName")
+	caller := c.callTransferRepositoryByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TransferRepositoryByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TransferRepositoryByFullNameRequest) when calling interceptor")
+					}
+					return c.callTransferRepositoryByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TransferRepositoryByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TransferRepositoryByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceProtobufClient) callTransferRepositoryByFullName(ctx context.Context, in *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+	out := new(TransferRepositoryByFullNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -619,7 +825,7 @@ func (c *repositoryServiceProtobufClient) DeleteRepository(ctx context.Context,
 
 func (c *repositoryServiceProtobufClient) callDeleteRepository(ctx context.Context, in *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
 	out := new(DeleteRepositoryResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -665,7 +871,7 @@ func (c *repositoryServiceProtobufClient) DeleteRepositoryByFullName(ctx context
 
 func (c *repositoryServiceProtobufClient) callDeleteRepositoryByFullName(ctx context.Context, in *DeleteRepositoryByFullNameRequest) (*DeleteRepositoryByFullNameResponse, error) {
 	out := new(DeleteRepositoryByFullNameResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -686,7 +892,7 @@ func (c *repositoryServiceProtobufClient) callDeleteRepositoryByFullName(ctx con
 
 type repositoryServiceJSONClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [16]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -706,17 +912,21 @@ func NewRepositoryServiceJSONClient(baseURL string, client HTTPClient, opts ...t
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryService")
-	urls := [12]string{
+	urls := [16]string{
 		serviceURL + "GetRepository",
 		serviceURL + "GetRepositoryByFullName",
+		serviceURL + "GetRepositoryStatsByFullName",
 		serviceURL + "ListRepositories",
 		serviceURL + "ListUserRepositories",
 		serviceURL + "ListOrganizationRepositories",
+		serviceURL + "SearchRepositories",
 		serviceURL + "CreateRepositoryByFullName",
 		serviceURL + "UpdateRepositoryName",
 		serviceURL + "UpdateRepositoryNameByFullName",
 		serviceURL + "UpdateRepositoryVisibility",
 		serviceURL + "UpdateRepositoryVisibilityByName",
+		serviceURL + "UpdateRepositoryDefaultBranchByFullName",
+		serviceURL + "TransferRepositoryByFullName",
 		serviceURL + "DeleteRepository",
 		serviceURL + "DeleteRepositoryByFullName",
 	}
@@ -821,6 +1031,52 @@ func (c *repositoryServiceJSONClient) callGetRepositoryByFullName(ctx context.Co
 	return out, nil
 }
 
+func (c *repositoryServiceJSONClient) GetRepositoryStatsByFullName(ctx context.Context, in *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryStatsByFullName")
+	caller := c.callGetRepositoryStatsByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryStatsByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryStatsByFullNameRequest) when calling interceptor")
+					}
+					return c.callGetRepositoryStatsByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryStatsByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryStatsByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceJSONClient) callGetRepositoryStatsByFullName(ctx context.Context, in *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+	out := new(GetRepositoryStatsByFullNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *repositoryServiceJSONClient) ListRepositories(ctx context.Context, in *ListRepositoriesRequest) (*ListRepositoriesResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
@@ -852,7 +1108,7 @@ func (c *repositoryServiceJSONClient) ListRepositories(ctx context.Context, in *
 
 func (c *repositoryServiceJSONClient) callListRepositories(ctx context.Context, in *ListRepositoriesRequest) (*ListRepositoriesResponse, error) {
 	out := new(ListRepositoriesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -898,7 +1154,7 @@ func (c *repositoryServiceJSONClient) ListUserRepositories(ctx context.Context,
 
 func (c *repositoryServiceJSONClient) callListUserRepositories(ctx context.Context, in *ListUserRepositoriesRequest) (*ListUserRepositoriesResponse, error) {
 	out := new(ListUserRepositoriesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -944,7 +1200,53 @@ func (c *repositoryServiceJSONClient) ListOrganizationRepositories(ctx context.C
 
 func (c *repositoryServiceJSONClient) callListOrganizationRepositories(ctx context.Context, in *ListOrganizationRepositoriesRequest) (*ListOrganizationRepositoriesResponse, error) {
 	out := new(ListOrganizationRepositoriesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryServiceJSONClient) SearchRepositories(ctx context.Context, in *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRepositories")
+	caller := c.callSearchRepositories
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchRepositoriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRepositoriesRequest) when calling interceptor")
+					}
+					return c.callSearchRepositories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchRepositoriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRepositoriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceJSONClient) callSearchRepositories(ctx context.Context, in *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
+	out := new(SearchRepositoriesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -990,7 +1292,7 @@ func (c *repositoryServiceJSONClient) CreateRepositoryByFullName(ctx context.Con
 
 func (c *repositoryServiceJSONClient) callCreateRepositoryByFullName(ctx context.Context, in *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error) {
 	out := new(CreateRepositoryByFullNameResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1036,7 +1338,7 @@ func (c *repositoryServiceJSONClient) UpdateRepositoryName(ctx context.Context,
 
 func (c *repositoryServiceJSONClient) callUpdateRepositoryName(ctx context.Context, in *UpdateRepositoryNameRequest) (*UpdateRepositoryNameResponse, error) {
 	out := new(UpdateRepositoryNameResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1082,7 +1384,7 @@ func (c *repositoryServiceJSONClient) UpdateRepositoryNameByFullName(ctx context
 
 func (c *repositoryServiceJSONClient) callUpdateRepositoryNameByFullName(ctx context.Context, in *UpdateRepositoryNameByFullNameRequest) (*UpdateRepositoryNameByFullNameResponse, error) {
 	out := new(UpdateRepositoryNameByFullNameResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1128,7 +1430,7 @@ func (c *repositoryServiceJSONClient) UpdateRepositoryVisibility(ctx context.Con
 
 func (c *repositoryServiceJSONClient) callUpdateRepositoryVisibility(ctx context.Context, in *UpdateRepositoryVisibilityRequest) (*UpdateRepositoryVisibilityResponse, error) {
 	out := new(UpdateRepositoryVisibilityResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1174,7 +1476,7 @@ func (c *repositoryServiceJSONClient) UpdateRepositoryVisibilityByName(ctx conte
 
 func (c *repositoryServiceJSONClient) callUpdateRepositoryVisibilityByName(ctx context.Context, in *UpdateRepositoryVisibilityByNameRequest) (*UpdateRepositoryVisibilityByNameResponse, error) {
 	out := new(UpdateRepositoryVisibilityByNameResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1189,26 +1491,26 @@ func (c *repositoryServiceJSONClient) callUpdateRepositoryVisibilityByName(ctx c
 	return out, nil
 }
 
-func (c *repositoryServiceJSONClient) DeleteRepository(ctx context.Context, in *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
+func (c *repositoryServiceJSONClient) UpdateRepositoryDefaultBranchByFullName(ctx context.Context, in *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
 	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteRepository")
-	caller := c.callDeleteRepository
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryDefaultBranchByFullName")
+	caller := c.callUpdateRepositoryDefaultBranchByFullName
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
+		caller = func(ctx context.Context, req *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteRepositoryRequest)
+					typedReq, ok := req.(*UpdateRepositoryDefaultBranchByFullNameRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteRepositoryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryDefaultBranchByFullNameRequest) when calling interceptor")
 					}
-					return c.callDeleteRepository(ctx, typedReq)
+					return c.callUpdateRepositoryDefaultBranchByFullName(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteRepositoryResponse)
+				typedResp, ok := resp.(*UpdateRepositoryDefaultBranchByFullNameResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRepositoryResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryDefaultBranchByFullNameResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1218,9 +1520,101 @@ func (c *repositoryServiceJSONClient) DeleteRepository(ctx context.Context, in *
 	return caller(ctx, in)
 }
 
-func (c *repositoryServiceJSONClient) callDeleteRepository(ctx context.Context, in *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
+func (c *repositoryServiceJSONClient) callUpdateRepositoryDefaultBranchByFullName(ctx context.Context, in *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+	out := new(UpdateRepositoryDefaultBranchByFullNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryServiceJSONClient) TransferRepositoryByFullName(ctx context.Context, in *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "TransferRepositoryByFullName")
+	caller := c.callTransferRepositoryByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TransferRepositoryByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TransferRepositoryByFullNameRequest) when calling interceptor")
+					}
+					return c.callTransferRepositoryByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TransferRepositoryByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TransferRepositoryByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceJSONClient) callTransferRepositoryByFullName(ctx context.Context, in *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+	out := new(TransferRepositoryByFullNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryServiceJSONClient) DeleteRepository(ctx context.Context, in *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRepository")
+	caller := c.callDeleteRepository
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRepositoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRepositoryRequest) when calling interceptor")
+					}
+					return c.callDeleteRepository(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRepositoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRepositoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryServiceJSONClient) callDeleteRepository(ctx context.Context, in *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
 	out := new(DeleteRepositoryResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1266,7 +1660,7 @@ func (c *repositoryServiceJSONClient) DeleteRepositoryByFullName(ctx context.Con
 
 func (c *repositoryServiceJSONClient) callDeleteRepositoryByFullName(ctx context.Context, in *DeleteRepositoryByFullNameRequest) (*DeleteRepositoryByFullNameResponse, error) {
 	out := new(DeleteRepositoryByFullNameResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1371,6 +1765,9 @@ func (s *repositoryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.
 	case "GetRepositoryByFullName":
 		s.serveGetRepositoryByFullName(ctx, resp, req)
 		return
+	case "GetRepositoryStatsByFullName":
+		s.serveGetRepositoryStatsByFullName(ctx, resp, req)
+		return
 	case "ListRepositories":
 		s.serveListRepositories(ctx, resp, req)
 		return
@@ -1380,6 +1777,9 @@ func (s *repositoryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.
 	case "ListOrganizationRepositories":
 		s.serveListOrganizationRepositories(ctx, resp, req)
 		return
+	case "SearchRepositories":
+		s.serveSearchRepositories(ctx, resp, req)
+		return
 	case "CreateRepositoryByFullName":
 		s.serveCreateRepositoryByFullName(ctx, resp, req)
 		return
@@ -1395,6 +1795,12 @@ func (s *repositoryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.
 	case "UpdateRepositoryVisibilityByName":
 		s.serveUpdateRepositoryVisibilityByName(ctx, resp, req)
 		return
+	case "UpdateRepositoryDefaultBranchByFullName":
+		s.serveUpdateRepositoryDefaultBranchByFullName(ctx, resp, req)
+		return
+	case "TransferRepositoryByFullName":
+		s.serveTransferRepositoryByFullName(ctx, resp, req)
+		return
 	case "DeleteRepository":
 		s.serveDeleteRepository(ctx, resp, req)
 		return
@@ -1758,6 +2164,181 @@ func (s *repositoryServiceServer) serveGetRepositoryByFullNameProtobuf(ctx conte
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *repositoryServiceServer) serveGetRepositoryStatsByFullName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRepositoryStatsByFullNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRepositoryStatsByFullNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryServiceServer) serveGetRepositoryStatsByFullNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryStatsByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GetRepositoryStatsByFullNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.GetRepositoryStatsByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryStatsByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryStatsByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.GetRepositoryStatsByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryStatsByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryStatsByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRepositoryStatsByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRepositoryStatsByFullNameResponse and nil error while calling GetRepositoryStatsByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryServiceServer) serveGetRepositoryStatsByFullNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryStatsByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GetRepositoryStatsByFullNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.GetRepositoryStatsByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryStatsByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryStatsByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.GetRepositoryStatsByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryStatsByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryStatsByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRepositoryStatsByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRepositoryStatsByFullNameResponse and nil error while calling GetRepositoryStatsByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *repositoryServiceServer) serveListRepositories(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
@@ -2283,7 +2864,7 @@ func (s *repositoryServiceServer) serveListOrganizationRepositoriesProtobuf(ctx
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *repositoryServiceServer) serveCreateRepositoryByFullName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *repositoryServiceServer) serveSearchRepositories(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2291,9 +2872,9 @@ func (s *repositoryServiceServer) serveCreateRepositoryByFullName(ctx context.Co
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateRepositoryByFullNameJSON(ctx, resp, req)
+		s.serveSearchRepositoriesJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateRepositoryByFullNameProtobuf(ctx, resp, req)
+		s.serveSearchRepositoriesProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2301,38 +2882,38 @@ func (s *repositoryServiceServer) serveCreateRepositoryByFullName(ctx context.Co
 	}
 }
 
-func (s *repositoryServiceServer) serveCreateRepositoryByFullNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *repositoryServiceServer) serveSearchRepositoriesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateRepositoryByFullName")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRepositories")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	reqContent := new(CreateRepositoryByFullNameRequest)
+	reqContent := new(SearchRepositoriesRequest)
 	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
 	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
 		return
 	}
 
-	handler := s.RepositoryService.CreateRepositoryByFullName
+	handler := s.RepositoryService.SearchRepositories
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error) {
+		handler = func(ctx context.Context, req *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateRepositoryByFullNameRequest)
+					typedReq, ok := req.(*SearchRepositoriesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateRepositoryByFullNameRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRepositoriesRequest) when calling interceptor")
 					}
-					return s.RepositoryService.CreateRepositoryByFullName(ctx, typedReq)
+					return s.RepositoryService.SearchRepositories(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateRepositoryByFullNameResponse)
+				typedResp, ok := resp.(*SearchRepositoriesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateRepositoryByFullNameResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRepositoriesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2341,7 +2922,7 @@ func (s *repositoryServiceServer) serveCreateRepositoryByFullNameJSON(ctx contex
 	}
 
 	// Call service method
-	var respContent *CreateRepositoryByFullNameResponse
+	var respContent *SearchRepositoriesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2352,7 +2933,7 @@ func (s *repositoryServiceServer) serveCreateRepositoryByFullNameJSON(ctx contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateRepositoryByFullNameResponse and nil error while calling CreateRepositoryByFullName. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchRepositoriesResponse and nil error while calling SearchRepositories. nil responses are not supported"))
 		return
 	}
 
@@ -2379,9 +2960,9 @@ func (s *repositoryServiceServer) serveCreateRepositoryByFullNameJSON(ctx contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *repositoryServiceServer) serveCreateRepositoryByFullNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *repositoryServiceServer) serveSearchRepositoriesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateRepositoryByFullName")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRepositories")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2393,26 +2974,201 @@ func (s *repositoryServiceServer) serveCreateRepositoryByFullNameProtobuf(ctx co
 		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
 		return
 	}
-	reqContent := new(CreateRepositoryByFullNameRequest)
+	reqContent := new(SearchRepositoriesRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.RepositoryService.CreateRepositoryByFullName
+	handler := s.RepositoryService.SearchRepositories
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error) {
+		handler = func(ctx context.Context, req *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateRepositoryByFullNameRequest)
+					typedReq, ok := req.(*SearchRepositoriesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateRepositoryByFullNameRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRepositoriesRequest) when calling interceptor")
 					}
-					return s.RepositoryService.CreateRepositoryByFullName(ctx, typedReq)
+					return s.RepositoryService.SearchRepositories(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateRepositoryByFullNameResponse)
+				typedResp, ok := resp.(*SearchRepositoriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRepositoriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchRepositoriesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchRepositoriesResponse and nil error while calling SearchRepositories. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryServiceServer) serveCreateRepositoryByFullName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateRepositoryByFullNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateRepositoryByFullNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryServiceServer) serveCreateRepositoryByFullNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateRepositoryByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(CreateRepositoryByFullNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.CreateRepositoryByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateRepositoryByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateRepositoryByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.CreateRepositoryByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateRepositoryByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateRepositoryByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateRepositoryByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateRepositoryByFullNameResponse and nil error while calling CreateRepositoryByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryServiceServer) serveCreateRepositoryByFullNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateRepositoryByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(CreateRepositoryByFullNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.CreateRepositoryByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateRepositoryByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateRepositoryByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.CreateRepositoryByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateRepositoryByFullNameResponse)
 				if !ok {
 					return nil, twirp.InternalError("failed type assertion resp.(*CreateRepositoryByFullNameResponse) when calling interceptor")
 				}
@@ -3158,6 +3914,356 @@ func (s *repositoryServiceServer) serveUpdateRepositoryVisibilityByNameProtobuf(
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *repositoryServiceServer) serveUpdateRepositoryDefaultBranchByFullName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateRepositoryDefaultBranchByFullNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateRepositoryDefaultBranchByFullNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryServiceServer) serveUpdateRepositoryDefaultBranchByFullNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryDefaultBranchByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(UpdateRepositoryDefaultBranchByFullNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.UpdateRepositoryDefaultBranchByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryDefaultBranchByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryDefaultBranchByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.UpdateRepositoryDefaultBranchByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryDefaultBranchByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryDefaultBranchByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateRepositoryDefaultBranchByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateRepositoryDefaultBranchByFullNameResponse and nil error while calling UpdateRepositoryDefaultBranchByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryServiceServer) serveUpdateRepositoryDefaultBranchByFullNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryDefaultBranchByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(UpdateRepositoryDefaultBranchByFullNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.UpdateRepositoryDefaultBranchByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryDefaultBranchByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryDefaultBranchByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.UpdateRepositoryDefaultBranchByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryDefaultBranchByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryDefaultBranchByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateRepositoryDefaultBranchByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateRepositoryDefaultBranchByFullNameResponse and nil error while calling UpdateRepositoryDefaultBranchByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryServiceServer) serveTransferRepositoryByFullName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveTransferRepositoryByFullNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveTransferRepositoryByFullNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryServiceServer) serveTransferRepositoryByFullNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "TransferRepositoryByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(TransferRepositoryByFullNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.TransferRepositoryByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TransferRepositoryByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TransferRepositoryByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.TransferRepositoryByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TransferRepositoryByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TransferRepositoryByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TransferRepositoryByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TransferRepositoryByFullNameResponse and nil error while calling TransferRepositoryByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryServiceServer) serveTransferRepositoryByFullNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "TransferRepositoryByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(TransferRepositoryByFullNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryService.TransferRepositoryByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TransferRepositoryByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TransferRepositoryByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryService.TransferRepositoryByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TransferRepositoryByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TransferRepositoryByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TransferRepositoryByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TransferRepositoryByFullNameResponse and nil error while calling TransferRepositoryByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *repositoryServiceServer) serveDeleteRepository(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
@@ -3509,7 +4615,7 @@ func (s *repositoryServiceServer) serveDeleteRepositoryByFullNameProtobuf(ctx co
 }
 
 func (s *repositoryServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor3, 0
+	return twirpFileDescriptor6, 0
 }
 
 func (s *repositoryServiceServer) ProtocGenTwirpVersion() string {
@@ -3523,75 +4629,98 @@ func (s *repositoryServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "RepositoryService")
 }
 
-var twirpFileDescriptor3 = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5b, 0x6f, 0xe3, 0x44,
-	0x14, 0xde, 0x49, 0xbb, 0x4d, 0x7b, 0x4a, 0xd3, 0xee, 0x68, 0x77, 0x9b, 0x75, 0xf7, 0x92, 0x9a,
-	0xa5, 0xed, 0x22, 0x64, 0xab, 0x05, 0x24, 0x50, 0xb9, 0xec, 0xa6, 0xed, 0xee, 0x5a, 0x54, 0xa5,
-	0x72, 0x2f, 0x88, 0x15, 0x52, 0x70, 0x9a, 0x49, 0x76, 0xc0, 0xb1, 0xbd, 0xbe, 0xb4, 0xb4, 0xe2,
-	0x0d, 0x21, 0x81, 0x84, 0x84, 0x10, 0x0f, 0x48, 0xf0, 0x08, 0x2f, 0x3c, 0xf1, 0xc4, 0x0f, 0x80,
-	0x1f, 0xc4, 0x6f, 0x40, 0x1e, 0xc7, 0xf5, 0x25, 0xb6, 0x87, 0xa4, 0x41, 0x7d, 0x4b, 0xce, 0x9c,
-	0xcb, 0x37, 0xdf, 0x99, 0x9c, 0xf3, 0x29, 0xf0, 0x5a, 0xd3, 0x6b, 0xcb, 0x9a, 0x6e, 0x3d, 0xd7,
-	0x64, 0x9b, 0x74, 0xa8, 0xe3, 0xda, 0xa7, 0xf2, 0xf1, 0x2a, 0x33, 0xac, 0xca, 0x36, 0xb1, 0x4c,
-	0x87, 0xba, 0xa6, 0x7d, 0x2a, 0x59, 0xb6, 0xe9, 0x9a, 0x78, 0xa1, 0xe9, 0xb5, 0x25, 0x76, 0x28,
-	0x85, 0xde, 0x52, 0xe8, 0x2d, 0xd4, 0xa2, 0x54, 0x9a, 0x45, 0xa3, 0x2c, 0x9a, 0x45, 0x83, 0x70,
-	0xe1, 0x5e, 0xc7, 0x34, 0x3b, 0x3a, 0x91, 0xd9, 0x37, 0xdf, 0xdb, 0xa5, 0x5d, 0xe2, 0xb8, 0x5a,
-	0xd7, 0x0a, 0x1c, 0xc4, 0xbf, 0x4b, 0x00, 0xea, 0x79, 0x51, 0x5c, 0x81, 0x12, 0x6d, 0x55, 0x51,
-	0x0d, 0xad, 0x4c, 0xa9, 0x25, 0xda, 0xc2, 0xeb, 0x30, 0x7d, 0x64, 0x13, 0xcd, 0x25, 0x0d, 0x3f,
-	0xb0, 0x5a, 0xaa, 0xa1, 0x95, 0xe9, 0x35, 0x41, 0x0a, 0xb2, 0x4a, 0x61, 0x56, 0x69, 0x3f, 0xcc,
-	0xaa, 0x42, 0xe0, 0xee, 0x1b, 0xfc, 0x60, 0xcf, 0x6a, 0x9d, 0x07, 0x8f, 0xf1, 0x83, 0x03, 0x77,
-	0x16, 0x8c, 0x61, 0xdc, 0xd0, 0xba, 0xa4, 0x3a, 0xce, 0xb0, 0xb0, 0xcf, 0xf8, 0x16, 0x94, 0x3d,
-	0x87, 0xd8, 0x0d, 0xda, 0xaa, 0x5e, 0xf5, 0xcd, 0x4f, 0xaf, 0xa8, 0x13, 0xbe, 0x41, 0x69, 0xe1,
-	0x07, 0x30, 0x6b, 0xda, 0x1d, 0xcd, 0xa0, 0x67, 0x9a, 0x4b, 0x4d, 0xc3, 0x77, 0x99, 0xe8, 0xb9,
-	0x54, 0xe2, 0x07, 0x4a, 0x0b, 0x3f, 0x01, 0x38, 0xa6, 0x0e, 0x6d, 0x52, 0x9d, 0xba, 0xa7, 0xd5,
-	0x72, 0x0d, 0xad, 0x54, 0xd6, 0x96, 0xa5, 0x02, 0x9e, 0xa5, 0xc3, 0x73, 0x77, 0x35, 0x16, 0x5a,
-	0x2f, 0xc3, 0x55, 0xf3, 0xc4, 0x20, 0xb6, 0xb8, 0x04, 0xd7, 0x9f, 0x10, 0x37, 0xa2, 0x51, 0x25,
-	0x2f, 0x3c, 0xe2, 0xb8, 0x69, 0x36, 0xc5, 0x4f, 0xe1, 0x46, 0xca, 0xcf, 0xb1, 0x4c, 0xc3, 0x21,
-	0x3e, 0xa4, 0xa8, 0xf3, 0x2c, 0x60, 0x9a, 0x03, 0x29, 0x96, 0x24, 0x16, 0x2a, 0xbe, 0x0b, 0x77,
-	0x13, 0x15, 0xea, 0xa7, 0x8f, 0x3d, 0x5d, 0xdf, 0xd1, 0xba, 0x24, 0xc4, 0xb4, 0x00, 0x53, 0x6d,
-	0x4f, 0xd7, 0x1b, 0x8c, 0xdc, 0x00, 0xda, 0x64, 0xbb, 0xe7, 0x23, 0x7e, 0x06, 0xf7, 0x72, 0xc3,
-	0x47, 0x0d, 0xd5, 0x84, 0xf9, 0x6d, 0xea, 0x44, 0xc5, 0x28, 0x71, 0x62, 0x18, 0x2d, 0xad, 0x43,
-	0x1a, 0x0e, 0x3d, 0x0b, 0x30, 0xce, 0xa8, 0x93, 0xbe, 0x61, 0x8f, 0x9e, 0x11, 0x7c, 0x07, 0x80,
-	0x1d, 0xba, 0xe6, 0xe7, 0xc4, 0x60, 0x2f, 0x72, 0x4a, 0x65, 0xee, 0xfb, 0xbe, 0x01, 0x57, 0xa1,
-	0x6c, 0x93, 0x63, 0x62, 0x3b, 0xc1, 0x83, 0x9b, 0x54, 0xc3, 0xaf, 0xe2, 0xf7, 0x08, 0xaa, 0xfd,
-	0x15, 0x7b, 0xd7, 0xfa, 0x00, 0x5e, 0xb2, 0x63, 0xf6, 0x2a, 0xaa, 0x8d, 0x0d, 0x72, 0xb1, 0x44,
-	0x30, 0x5e, 0x82, 0x59, 0x83, 0x7c, 0xe1, 0x36, 0xfa, 0x70, 0xce, 0xf8, 0xe6, 0xdd, 0x10, 0xab,
-	0xf8, 0x1d, 0x82, 0x05, 0x1f, 0xd1, 0x81, 0x43, 0xec, 0x2c, 0x1e, 0xe6, 0xa3, 0xf7, 0x1e, 0x74,
-	0x2a, 0x7c, 0xed, 0x09, 0x82, 0x4a, 0x85, 0x04, 0x8d, 0x15, 0x10, 0x34, 0x9e, 0x24, 0xe8, 0x47,
-	0x04, 0xb7, 0xb3, 0xe1, 0x5c, 0x26, 0x49, 0xbf, 0x21, 0x78, 0xd9, 0x47, 0xf5, 0x61, 0xec, 0x57,
-	0x9c, 0x45, 0xd6, 0x72, 0xff, 0x04, 0x08, 0x48, 0x4b, 0xff, 0xfe, 0xff, 0x1f, 0xf2, 0x7e, 0x41,
-	0x70, 0xbf, 0x18, 0xe6, 0x65, 0x92, 0xf8, 0x2d, 0x82, 0xc5, 0x0d, 0x36, 0x99, 0x87, 0x9d, 0x0d,
-	0xa9, 0xb1, 0x59, 0x1a, 0x7a, 0x6c, 0x8a, 0x5d, 0x10, 0x8b, 0xa0, 0x8c, 0x7a, 0xce, 0x3c, 0x85,
-	0x85, 0x03, 0xb6, 0x56, 0xa2, 0xf3, 0xf8, 0x9d, 0xd3, 0x1b, 0xef, 0x16, 0x4c, 0x1a, 0xe4, 0x24,
-	0xa0, 0x20, 0xa0, 0xb2, 0x6c, 0x90, 0x13, 0x36, 0x1d, 0x3b, 0x70, 0x3b, 0x3b, 0xd3, 0xa8, 0x21,
-	0x37, 0xe0, 0x95, 0xac, 0x42, 0x03, 0x36, 0xac, 0xe0, 0x26, 0x2f, 0x60, 0x89, 0x57, 0x60, 0xd4,
-	0x77, 0xfa, 0x0a, 0xc1, 0x62, 0xba, 0x66, 0xec, 0x81, 0xe4, 0x74, 0x63, 0x07, 0x2a, 0xfe, 0x1d,
-	0x86, 0x7f, 0x78, 0x33, 0x06, 0x39, 0x39, 0x4c, 0xbc, 0xbd, 0x22, 0x10, 0xa3, 0xbe, 0xf4, 0x5f,
-	0x08, 0x96, 0xf3, 0xeb, 0xd5, 0x13, 0x0f, 0xf1, 0x0e, 0x00, 0x53, 0x13, 0xf1, 0x66, 0x4e, 0x31,
-	0x0b, 0xeb, 0xe6, 0x32, 0xcc, 0x46, 0x89, 0xe3, 0x4d, 0xad, 0xd8, 0x89, 0x1e, 0x66, 0x50, 0x36,
-	0x76, 0x21, 0xca, 0x1c, 0x58, 0xe1, 0x5f, 0x61, 0xd4, 0xc4, 0x3d, 0x80, 0xf9, 0x4d, 0xa2, 0x93,
-	0x78, 0xd1, 0x3c, 0x51, 0x25, 0x40, 0xb5, 0xdf, 0x35, 0xc0, 0x23, 0x3e, 0x84, 0xc5, 0xf4, 0xd9,
-	0x80, 0x8a, 0xe8, 0x3e, 0x88, 0x45, 0x19, 0x82, 0x3a, 0xaf, 0x7e, 0x04, 0x10, 0x71, 0x82, 0x05,
-	0xb8, 0x79, 0xa8, 0xec, 0x29, 0x75, 0x65, 0x5b, 0xd9, 0xff, 0xb8, 0x71, 0xb0, 0xb3, 0xb7, 0xbb,
-	0xb5, 0xa1, 0x3c, 0x56, 0xb6, 0x36, 0xe7, 0xae, 0xe0, 0x1b, 0x70, 0x2d, 0x76, 0xb6, 0x7b, 0x50,
-	0xdf, 0x56, 0x36, 0xe6, 0x10, 0xbe, 0x09, 0x38, 0x6e, 0x56, 0x95, 0xc3, 0x47, 0xfb, 0x5b, 0x73,
-	0xa5, 0xb5, 0x7f, 0x2a, 0x70, 0x2d, 0xaa, 0xbc, 0x47, 0xec, 0x63, 0x7a, 0x44, 0xf0, 0x97, 0x30,
-	0x93, 0x90, 0x69, 0x78, 0xb5, 0x90, 0xe3, 0x2c, 0x6d, 0x2a, 0xac, 0x0d, 0x12, 0xd2, 0xa3, 0x73,
-	0xfc, 0x9b, 0x9f, 0x44, 0x84, 0x7f, 0x46, 0x30, 0x9f, 0xa3, 0x12, 0xf1, 0xfa, 0x7f, 0xcf, 0xda,
-	0xd7, 0x08, 0xe1, 0x9d, 0xe1, 0x82, 0x13, 0xe0, 0xbe, 0x46, 0x30, 0x97, 0x16, 0x79, 0xf8, 0x8d,
-	0xc2, 0xc4, 0x39, 0x2a, 0x54, 0x78, 0x73, 0xc0, 0xa8, 0x04, 0x8e, 0x1f, 0x10, 0x5c, 0xcf, 0xd2,
-	0x52, 0xf8, 0x2d, 0x6e, 0xd6, 0x1c, 0x35, 0x28, 0xbc, 0x3d, 0x44, 0x64, 0x02, 0xd3, 0xef, 0x3d,
-	0x7d, 0x97, 0x27, 0x51, 0xf0, 0x43, 0x6e, 0x05, 0x8e, 0x08, 0x13, 0x1e, 0x5d, 0x20, 0x43, 0x02,
-	0xeb, 0xaf, 0x08, 0x84, 0x7c, 0x95, 0x80, 0xdf, 0x2b, 0xac, 0xc3, 0x55, 0x3a, 0xc2, 0xfb, 0x43,
-	0xc7, 0xc7, 0x50, 0x96, 0x58, 0x97, 0xb3, 0x16, 0x29, 0xa7, 0xcb, 0x05, 0x7a, 0x84, 0xd3, 0xe5,
-	0x22, 0xfd, 0xd1, 0xc3, 0xf4, 0x07, 0x82, 0xbb, 0xc5, 0xcb, 0x1d, 0xd7, 0x07, 0xae, 0xd1, 0xcf,
-	0xe0, 0xc6, 0x85, 0x72, 0x24, 0x10, 0xfb, 0xbd, 0xce, 0x5f, 0x31, 0x9c, 0x5e, 0x73, 0x35, 0x05,
-	0xa7, 0xd7, 0x7c, 0x39, 0xd0, 0x43, 0xf9, 0x27, 0x82, 0x1a, 0x6f, 0x11, 0xe2, 0xcd, 0x21, 0x6b,
-	0x25, 0xa4, 0x80, 0xb0, 0x75, 0xc1, 0x2c, 0x09, 0xdc, 0xfe, 0x44, 0x4c, 0xaf, 0x30, 0xce, 0x44,
-	0xcc, 0x59, 0xbd, 0x9c, 0x89, 0x98, 0xbb, 0x85, 0xa3, 0x2e, 0xe7, 0xaf, 0x52, 0x4e, 0x97, 0xb9,
-	0x5b, 0x9c, 0xd3, 0x65, 0xfe, 0x0e, 0x0f, 0x50, 0xd6, 0x3f, 0x79, 0xf6, 0xac, 0x43, 0xdd, 0xe7,
-	0x5e, 0x53, 0x3a, 0x32, 0xbb, 0x72, 0xd3, 0x6b, 0x37, 0x3d, 0xaa, 0xb7, 0xfc, 0x0f, 0x32, 0x35,
-	0x5c, 0x62, 0x1b, 0x9a, 0x2e, 0x77, 0x88, 0x11, 0xfc, 0xa1, 0x26, 0x77, 0x4c, 0xb9, 0xe0, 0xdf,
-	0xbc, 0xf5, 0xd0, 0x12, 0x1a, 0x9a, 0x13, 0x2c, 0xec, 0xf5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x59, 0x87, 0x64, 0x75, 0x04, 0x14, 0x00, 0x00,
+var twirpFileDescriptor6 = []byte{
+	// 1459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xee, 0xb5, 0xd3, 0x3c, 0x4e, 0x12, 0x27, 0xbd, 0x4a, 0x1b, 0x77, 0x52, 0xda, 0x64, 0x92,
+	0x36, 0x29, 0xaa, 0x6c, 0x35, 0x50, 0xa0, 0x2a, 0xd0, 0xc6, 0x49, 0xda, 0x5a, 0x8d, 0xda, 0x68,
+	0x92, 0xb4, 0xa2, 0x42, 0x32, 0x63, 0xfb, 0xda, 0xbe, 0x60, 0xcf, 0xb8, 0xf3, 0x48, 0x48, 0x40,
+	0x62, 0x81, 0x90, 0x90, 0x40, 0x42, 0x15, 0x0b, 0x24, 0x58, 0x21, 0xd8, 0xb0, 0x82, 0x0d, 0x3f,
+	0x80, 0x2d, 0xff, 0x84, 0xbf, 0xc0, 0x0a, 0xdd, 0x3b, 0x76, 0x66, 0xee, 0x78, 0x1e, 0x7e, 0xa1,
+	0xee, 0x3c, 0xe7, 0x9e, 0xc7, 0x77, 0xbf, 0x73, 0xe6, 0xcc, 0x39, 0x32, 0xdc, 0x28, 0xda, 0x95,
+	0xac, 0x5a, 0x6f, 0xd6, 0xd4, 0xac, 0x41, 0xaa, 0xd4, 0xb4, 0x8c, 0xe3, 0xec, 0xe1, 0x4d, 0x2e,
+	0xb8, 0x99, 0x35, 0x48, 0x53, 0x37, 0xa9, 0xa5, 0x1b, 0xc7, 0x99, 0xa6, 0xa1, 0x5b, 0x3a, 0x5e,
+	0x28, 0xda, 0x95, 0x0c, 0x3f, 0xcc, 0xb4, 0xb5, 0x33, 0x6d, 0x6d, 0x69, 0xd1, 0x75, 0xa5, 0x36,
+	0xa9, 0xeb, 0x45, 0x6d, 0x52, 0xc7, 0x5c, 0xba, 0x52, 0xd5, 0xf5, 0x6a, 0x9d, 0x64, 0xf9, 0x13,
+	0xd3, 0xb6, 0x68, 0x83, 0x98, 0x96, 0xda, 0x68, 0x3a, 0x0a, 0xf2, 0x3f, 0x09, 0x00, 0xe5, 0x34,
+	0x28, 0x4e, 0x41, 0x82, 0x96, 0xd3, 0x68, 0x11, 0xad, 0x4d, 0x28, 0x09, 0x5a, 0xc6, 0x77, 0x60,
+	0xb2, 0x64, 0x10, 0xd5, 0x22, 0x05, 0x66, 0x98, 0x4e, 0x2c, 0xa2, 0xb5, 0xc9, 0x75, 0x29, 0xe3,
+	0x78, 0xcd, 0xb4, 0xbd, 0x66, 0xf6, 0xdb, 0x5e, 0x15, 0x70, 0xd4, 0x99, 0x80, 0x19, 0xdb, 0xcd,
+	0xf2, 0xa9, 0x71, 0x32, 0xde, 0xd8, 0x51, 0xe7, 0xc6, 0x18, 0x46, 0x34, 0xb5, 0x41, 0xd2, 0x23,
+	0x1c, 0x0b, 0xff, 0x8d, 0x2f, 0xc2, 0x98, 0x6d, 0x12, 0xa3, 0x40, 0xcb, 0xe9, 0xb3, 0x4c, 0xfc,
+	0xf0, 0x8c, 0x32, 0xca, 0x04, 0xf9, 0x32, 0xbe, 0x0e, 0x33, 0xba, 0x51, 0x55, 0x35, 0x7a, 0xa2,
+	0x5a, 0x54, 0xd7, 0x98, 0xca, 0x68, 0x4b, 0x25, 0xe5, 0x3d, 0xc8, 0x97, 0xf1, 0x03, 0x80, 0x43,
+	0x6a, 0xd2, 0x22, 0xad, 0x53, 0xeb, 0x38, 0x3d, 0xb6, 0x88, 0xd6, 0x52, 0xeb, 0xab, 0x99, 0x08,
+	0x9e, 0x33, 0x4f, 0x4f, 0xd5, 0x15, 0x8f, 0x29, 0xbe, 0x0a, 0xa9, 0x32, 0xa9, 0xa8, 0x76, 0xdd,
+	0x2a, 0x14, 0x0d, 0x55, 0x2b, 0xd5, 0xd2, 0xe3, 0x1c, 0xec, 0x74, 0x4b, 0x9a, 0xe3, 0xc2, 0xdc,
+	0x18, 0x9c, 0xd5, 0x8f, 0x34, 0x62, 0xc8, 0xff, 0x22, 0x98, 0x71, 0xb9, 0xde, 0xb3, 0x54, 0xcb,
+	0xc4, 0xcb, 0x30, 0xed, 0xe6, 0xbc, 0x70, 0xca, 0xfd, 0x94, 0x2b, 0xcc, 0x97, 0xf1, 0x12, 0x4c,
+	0x95, 0xf4, 0x46, 0x83, 0x5a, 0x85, 0x92, 0x6e, 0x6b, 0x16, 0x4f, 0x43, 0x52, 0x99, 0x74, 0x64,
+	0x9b, 0x4c, 0x84, 0x17, 0x60, 0xc2, 0x52, 0xab, 0xad, 0xf3, 0x24, 0x3f, 0x1f, 0xb7, 0xd4, 0xaa,
+	0x73, 0xb8, 0x04, 0x53, 0x0e, 0xc0, 0xd6, 0xf9, 0x88, 0x63, 0xef, 0xc8, 0x1c, 0x95, 0xd7, 0x00,
+	0x4c, 0x7a, 0x42, 0x0a, 0xc5, 0x63, 0x8b, 0x98, 0x9c, 0xdd, 0xa4, 0x32, 0xc1, 0x24, 0x39, 0x26,
+	0xc0, 0xf7, 0x20, 0x55, 0x57, 0x4d, 0xab, 0xd0, 0xb4, 0xcd, 0x9a, 0x93, 0xcd, 0xd1, 0xd8, 0x6c,
+	0x4e, 0x31, 0x8b, 0x5d, 0xdb, 0xac, 0x31, 0x91, 0x7c, 0x0d, 0xe6, 0x1e, 0x10, 0xcb, 0xbd, 0xbe,
+	0x42, 0x5e, 0xd8, 0xc4, 0xb4, 0xfc, 0x15, 0x27, 0x7f, 0x04, 0xe7, 0x7d, 0x7a, 0x66, 0x53, 0xd7,
+	0x4c, 0xc2, 0xd2, 0xe6, 0x92, 0xc2, 0x0d, 0x26, 0x63, 0xd2, 0xe6, 0x71, 0xe2, 0x31, 0x95, 0xdf,
+	0x83, 0xcb, 0x42, 0x84, 0xdc, 0xf1, 0x7d, 0xbb, 0x5e, 0x7f, 0xac, 0x36, 0x48, 0x1b, 0xd3, 0x02,
+	0x4c, 0x54, 0xec, 0x7a, 0xbd, 0xc0, 0x0b, 0xd0, 0x81, 0x36, 0x5e, 0x69, 0xe9, 0xc8, 0x1f, 0xc3,
+	0x95, 0x50, 0xf3, 0x61, 0x43, 0xcd, 0xc1, 0xb2, 0x10, 0x8b, 0xd7, 0x4c, 0x8f, 0x78, 0xbf, 0x80,
+	0x95, 0x68, 0x1f, 0x2d, 0xd0, 0xcf, 0x60, 0xd6, 0x53, 0x89, 0x26, 0xd3, 0x6a, 0x41, 0xbf, 0xd1,
+	0x25, 0x74, 0xee, 0x59, 0x99, 0x31, 0x44, 0x81, 0xac, 0xc3, 0xfc, 0x0e, 0x35, 0x5d, 0x04, 0x94,
+	0x98, 0x1e, 0xe0, 0x4d, 0xb5, 0x4a, 0x0a, 0xac, 0xd0, 0x78, 0xb0, 0x69, 0x65, 0x9c, 0x09, 0xf6,
+	0xe8, 0x09, 0x61, 0x25, 0xc9, 0x0f, 0x2d, 0xfd, 0x13, 0xa2, 0xf1, 0x9a, 0x9f, 0x50, 0xb8, 0xfa,
+	0x3e, 0x13, 0xe0, 0x34, 0x8c, 0x19, 0xe4, 0x90, 0x18, 0xa6, 0xd3, 0x59, 0xc6, 0x95, 0xf6, 0xa3,
+	0xfc, 0x1d, 0x82, 0x74, 0x67, 0xc4, 0xd6, 0x35, 0x1f, 0x81, 0xfb, 0x6e, 0x51, 0xc2, 0xae, 0x98,
+	0xec, 0x25, 0x3b, 0x82, 0x31, 0xbe, 0x06, 0x33, 0x1a, 0xf9, 0xd4, 0x2a, 0x74, 0xe0, 0x9c, 0x66,
+	0xe2, 0xdd, 0x36, 0x56, 0xf9, 0x5b, 0x04, 0x0b, 0x0c, 0xd1, 0x81, 0x49, 0x8c, 0x20, 0x1e, 0xe6,
+	0xdd, 0xc6, 0xe6, 0xa4, 0xaf, 0xdd, 0xd6, 0x04, 0x82, 0x12, 0x91, 0x04, 0x25, 0x23, 0x08, 0x1a,
+	0x11, 0x09, 0xfa, 0x1e, 0xc1, 0xa5, 0x60, 0x38, 0xaf, 0x92, 0xa4, 0x5f, 0x11, 0x2c, 0x33, 0x54,
+	0x4f, 0x3c, 0xed, 0x3a, 0x88, 0xac, 0xd5, 0xce, 0x56, 0xef, 0x90, 0xe6, 0x6f, 0xf4, 0xff, 0x0f,
+	0x79, 0x3f, 0x21, 0x58, 0x89, 0x86, 0xf9, 0x8a, 0x2b, 0xed, 0xe2, 0x1e, 0x51, 0x8d, 0x52, 0x2d,
+	0x88, 0xba, 0x39, 0x38, 0xfb, 0xc2, 0x26, 0xad, 0x9e, 0x34, 0xa1, 0x38, 0x0f, 0x8c, 0x0a, 0xfe,
+	0x81, 0x72, 0xfa, 0x47, 0xeb, 0x45, 0xe3, 0x12, 0xd6, 0x20, 0x44, 0x1a, 0x93, 0x91, 0x34, 0x8e,
+	0xf8, 0x68, 0x94, 0x5f, 0x22, 0x90, 0x82, 0xe0, 0xbc, 0x4a, 0x8a, 0xfe, 0x40, 0xb0, 0xb4, 0xc9,
+	0xa7, 0x94, 0x7e, 0xbf, 0x01, 0xbe, 0x11, 0x22, 0x31, 0xcc, 0x11, 0x22, 0x19, 0x30, 0x42, 0xc8,
+	0x0d, 0x90, 0xa3, 0x10, 0x0f, 0xfb, 0xb3, 0xf3, 0x10, 0x16, 0x0e, 0xf8, 0x24, 0xe6, 0x9e, 0x7b,
+	0xa9, 0xf1, 0x0f, 0x89, 0x17, 0x61, 0x5c, 0x23, 0x47, 0xde, 0xea, 0x19, 0xd3, 0xc8, 0x11, 0xff,
+	0xf8, 0x54, 0xe1, 0x52, 0xb0, 0xa7, 0x61, 0x43, 0x2e, 0xc0, 0xd5, 0xa0, 0x40, 0x3d, 0xe6, 0x35,
+	0xe2, 0x26, 0x2f, 0xe0, 0x5a, 0x5c, 0x80, 0x61, 0xdf, 0xe9, 0x4b, 0x04, 0x4b, 0xfe, 0x98, 0x9e,
+	0x3a, 0x0a, 0xc9, 0xc6, 0x63, 0x48, 0xb1, 0x3b, 0xf4, 0x5f, 0x9f, 0xd3, 0x1a, 0x39, 0x72, 0x1f,
+	0x59, 0xed, 0x45, 0x81, 0x18, 0xf6, 0xa5, 0xff, 0x42, 0xb0, 0x1a, 0x1e, 0x2f, 0x27, 0x14, 0xa2,
+	0xd8, 0xb8, 0x90, 0xbf, 0x71, 0xad, 0x82, 0x67, 0x16, 0xf1, 0x26, 0x35, 0x65, 0x08, 0x39, 0x0c,
+	0xa0, 0x2c, 0x39, 0x10, 0x65, 0x26, 0xac, 0xc5, 0x5f, 0x61, 0xd8, 0xc4, 0x7d, 0x06, 0x19, 0x7f,
+	0xd0, 0x2d, 0x61, 0x0f, 0xe9, 0xed, 0x55, 0xb8, 0x01, 0x98, 0x71, 0xe2, 0xeb, 0x4e, 0x0e, 0x7f,
+	0xb3, 0x1a, 0x39, 0x12, 0x7c, 0xcb, 0x27, 0x90, 0xed, 0x3a, 0xf8, 0xb0, 0x2f, 0x5e, 0x83, 0xe5,
+	0x7d, 0x43, 0xd5, 0xcc, 0x0a, 0x31, 0x82, 0xdb, 0x63, 0x17, 0xb7, 0x5d, 0x71, 0x2a, 0xa0, 0xe3,
+	0x33, 0x38, 0xa5, 0x91, 0xa3, 0x27, 0xed, 0x82, 0x92, 0x75, 0x58, 0x89, 0x8e, 0x34, 0xec, 0xab,
+	0x5d, 0x87, 0xf9, 0x2d, 0x52, 0x27, 0x5e, 0x5a, 0xc3, 0xf6, 0x26, 0x09, 0xd2, 0x9d, 0xaa, 0x0e,
+	0x1e, 0xf9, 0x1e, 0x2c, 0xf9, 0xcf, 0x7a, 0x5c, 0x22, 0x56, 0x40, 0x8e, 0xf2, 0xe0, 0xc4, 0x79,
+	0xfd, 0x19, 0x80, 0x5b, 0xe7, 0x58, 0x82, 0x0b, 0x4f, 0xf3, 0x7b, 0xf9, 0x5c, 0x7e, 0x27, 0xbf,
+	0xff, 0x41, 0xe1, 0xe0, 0xf1, 0xde, 0xee, 0xf6, 0x66, 0xfe, 0x7e, 0x7e, 0x7b, 0x6b, 0xf6, 0x0c,
+	0x3e, 0x0f, 0xe7, 0x3c, 0x67, 0xbb, 0x07, 0xb9, 0x9d, 0xfc, 0xe6, 0x2c, 0xc2, 0x17, 0x00, 0x7b,
+	0xc5, 0x4a, 0xfe, 0xe9, 0xc6, 0xfe, 0xf6, 0x6c, 0x62, 0xfd, 0xe7, 0x39, 0x38, 0xe7, 0xd9, 0x33,
+	0x88, 0x71, 0x48, 0x4b, 0x04, 0x7f, 0x0e, 0xd3, 0xc2, 0x66, 0x83, 0x6f, 0x46, 0x72, 0x1c, 0xb4,
+	0x7e, 0x4a, 0xeb, 0xbd, 0x98, 0xb4, 0xe8, 0x1c, 0xf9, 0xfa, 0x07, 0x19, 0xe1, 0x1f, 0x11, 0xcc,
+	0x87, 0x2c, 0x82, 0xf8, 0x4e, 0xf7, 0x5e, 0x3b, 0x12, 0x21, 0xbd, 0xdb, 0x9f, 0xb1, 0x00, 0xee,
+	0x37, 0x04, 0x97, 0xa2, 0xb6, 0x3e, 0x7c, 0xaf, 0xfb, 0x20, 0xc1, 0x4b, 0xa7, 0xb4, 0x31, 0x80,
+	0x07, 0x01, 0xeb, 0x57, 0x08, 0x66, 0xfd, 0xeb, 0x1a, 0x7e, 0x33, 0xd2, 0x7b, 0xc8, 0x3e, 0x29,
+	0xdd, 0xea, 0xd1, 0x4a, 0xc0, 0xf1, 0x12, 0xc1, 0x5c, 0xd0, 0x56, 0x84, 0xdf, 0x89, 0xf5, 0x1a,
+	0xb2, 0xd7, 0x49, 0xb7, 0xfb, 0xb0, 0xec, 0xc8, 0x63, 0xd4, 0xb2, 0x11, 0x93, 0xc7, 0x2e, 0xd6,
+	0x29, 0x69, 0x63, 0x00, 0x0f, 0x02, 0xd6, 0x6f, 0x10, 0xe0, 0xce, 0x59, 0x1f, 0xbf, 0x15, 0xe9,
+	0x3f, 0x74, 0x57, 0x91, 0xde, 0xee, 0xd9, 0x4e, 0x40, 0xf3, 0x0b, 0x02, 0x29, 0x7c, 0x66, 0xc6,
+	0xef, 0x47, 0x7a, 0x8f, 0x5d, 0x0f, 0xa4, 0xbb, 0x7d, 0xdb, 0x7b, 0x50, 0x26, 0x78, 0xcd, 0x05,
+	0x8d, 0x95, 0x31, 0x35, 0x17, 0x31, 0x9d, 0x4b, 0xb7, 0xfb, 0xb0, 0x14, 0x30, 0xfd, 0x8e, 0xe0,
+	0x72, 0xf4, 0xa8, 0x8b, 0x73, 0x3d, 0xc7, 0xe8, 0x64, 0x70, 0x73, 0x20, 0x1f, 0x02, 0x62, 0x96,
+	0xeb, 0xf0, 0x81, 0x2b, 0x26, 0xd7, 0xb1, 0x13, 0xb6, 0x74, 0xb7, 0x6f, 0x7b, 0x01, 0xe5, 0x9f,
+	0x08, 0x16, 0xe3, 0xc6, 0x42, 0xbc, 0xd5, 0x67, 0x2c, 0x61, 0x30, 0x96, 0xb6, 0x07, 0xf4, 0x22,
+	0xe0, 0xfe, 0x3b, 0x60, 0x22, 0x0f, 0x19, 0xee, 0xf0, 0xa3, 0x9e, 0x02, 0x47, 0xcf, 0xa7, 0xd2,
+	0xce, 0x70, 0x9c, 0x09, 0x97, 0x61, 0x0d, 0x35, 0x6a, 0x86, 0x8b, 0x69, 0xa8, 0x5d, 0x0c, 0x9a,
+	0xd2, 0xc6, 0x00, 0x1e, 0x04, 0xac, 0xec, 0xc3, 0xe8, 0x9f, 0xba, 0x62, 0x3e, 0x8c, 0x21, 0xd3,
+	0xa2, 0x74, 0xab, 0x47, 0xab, 0x8e, 0xd7, 0x2b, 0x7c, 0xfa, 0x8b, 0x79, 0xbd, 0x62, 0x07, 0x4f,
+	0xe9, 0x6e, 0xdf, 0xf6, 0x5e, 0x94, 0xb9, 0x0f, 0x9f, 0x3f, 0xaf, 0x52, 0xab, 0x66, 0x17, 0x33,
+	0x25, 0xbd, 0x91, 0x2d, 0xda, 0x95, 0xa2, 0x4d, 0xeb, 0x65, 0xf6, 0x23, 0x4b, 0x35, 0x8b, 0x18,
+	0x9a, 0x5a, 0xcf, 0x56, 0x89, 0xe6, 0xfc, 0x15, 0x96, 0xad, 0xea, 0xd9, 0x88, 0xff, 0xe1, 0xee,
+	0xb4, 0x25, 0x6d, 0x41, 0x71, 0x94, 0x9b, 0xbd, 0xf1, 0xdf, 0x00, 0xc4, 0x7e, 0xa5, 0xaa, 0xbe,
+	0x1b, 0x00, 0x00,
 }
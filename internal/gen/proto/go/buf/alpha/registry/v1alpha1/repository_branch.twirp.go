@@ -759,7 +759,7 @@ func (s *repositoryBranchServiceServer) serveListRepositoryBranchesProtobuf(ctx
 }
 
 func (s *repositoryBranchServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor4, 0
+	return twirpFileDescriptor7, 0
 }
 
 func (s *repositoryBranchServiceServer) ProtocGenTwirpVersion() string {
@@ -773,38 +773,38 @@ func (s *repositoryBranchServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "RepositoryBranchService")
 }
 
-var twirpFileDescriptor4 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
+var twirpFileDescriptor7 = []byte{
+	// 501 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x55, 0xb2, 0x02, 0xdb, 0x2d, 0x85, 0x61, 0x24, 0x88, 0x3a, 0x75, 0x54, 0x41, 0x42, 0x7b,
-	0xc1, 0xd6, 0xba, 0x37, 0xfa, 0x36, 0x9e, 0x90, 0x78, 0x40, 0xd9, 0x9e, 0x2a, 0x44, 0xe5, 0x34,
+	0x14, 0x95, 0xb3, 0x01, 0xdb, 0x2d, 0x85, 0x61, 0x24, 0x88, 0x3a, 0x75, 0x54, 0x41, 0x42, 0x7b,
+	0xc1, 0xd6, 0xba, 0x37, 0xfa, 0x36, 0x9e, 0x90, 0x78, 0x40, 0xd9, 0x9e, 0x2a, 0x44, 0xe5, 0xb4,
 	0xb7, 0xa9, 0x45, 0x1b, 0x07, 0xdb, 0xa9, 0xd8, 0x04, 0xef, 0xfc, 0xc1, 0x10, 0x5f, 0xc0, 0xcf,
-	0xf0, 0x4f, 0x28, 0x76, 0xb3, 0x8e, 0x66, 0xcb, 0x34, 0xde, 0x92, 0xe3, 0x7b, 0xcf, 0x39, 0xf7,
-	0x5c, 0xcb, 0x70, 0x14, 0x17, 0x53, 0xc6, 0xe7, 0xf9, 0x8c, 0x33, 0x85, 0xa9, 0xd0, 0x46, 0x9d,
-	0xb1, 0xe5, 0xa1, 0x05, 0x0e, 0x99, 0xc2, 0x5c, 0x6a, 0x61, 0xa4, 0x3a, 0x1b, 0xc7, 0x8a, 0x67,
-	0x93, 0x19, 0xcd, 0x95, 0x34, 0x92, 0xec, 0xc5, 0xc5, 0x94, 0xda, 0x1a, 0x5a, 0x35, 0xd1, 0xaa,
-	0xa9, 0xdb, 0x5f, 0x33, 0xf2, 0x5c, 0xac, 0xc9, 0x78, 0x2e, 0x5c, 0x7b, 0xf7, 0x45, 0x2a, 0x65,
-	0x3a, 0x47, 0x66, 0xff, 0xca, 0x6a, 0x23, 0x16, 0xa8, 0x0d, 0x5f, 0xe4, 0xae, 0x20, 0xfc, 0xe9,
-	0xc1, 0x6e, 0x74, 0xa9, 0x7d, 0x6c, 0xa5, 0xc9, 0x23, 0xf0, 0x45, 0x12, 0x78, 0x7d, 0xef, 0x60,
-	0x27, 0xf2, 0x45, 0x42, 0x86, 0xd0, 0x9e, 0x28, 0xe4, 0x06, 0xc7, 0x65, 0x7b, 0xe0, 0xf7, 0xbd,
-	0x83, 0xf6, 0xa0, 0x4b, 0x1d, 0x37, 0xad, 0xb8, 0xe9, 0x69, 0xc5, 0x1d, 0x81, 0x2b, 0x2f, 0x01,
-	0x42, 0xa0, 0x95, 0xf1, 0x05, 0x06, 0x2d, 0x4b, 0x67, 0xbf, 0xc9, 0x4b, 0xe8, 0x5c, 0x19, 0x58,
-	0x24, 0xc1, 0x3d, 0x7b, 0xf8, 0x70, 0x0d, 0xbe, 0x4b, 0xc2, 0xef, 0xd0, 0x7b, 0x6b, 0x69, 0x36,
-	0xfd, 0x45, 0xf8, 0xa5, 0x40, 0x6d, 0xea, 0x2c, 0x5e, 0x9d, 0xe5, 0x52, 0xde, 0xff, 0x57, 0x3e,
-	0xe7, 0x0a, 0x33, 0xb3, 0xca, 0x3a, 0xd8, 0x72, 0x8d, 0x0e, 0x74, 0x22, 0xe1, 0x37, 0xd8, 0xbf,
-	0x49, 0x5e, 0xe7, 0x32, 0xd3, 0x48, 0x46, 0xf0, 0xa4, 0xb6, 0x36, 0xeb, 0xa1, 0x3d, 0x78, 0x4d,
-	0x1b, 0xf6, 0x46, 0x6b, 0x8c, 0xbb, 0x6a, 0x03, 0x09, 0x7f, 0x79, 0xd0, 0x7b, 0x2f, 0xb4, 0xd9,
-	0x2c, 0x45, 0x7d, 0xa7, 0xe9, 0xf7, 0x60, 0x27, 0xe7, 0x29, 0x8e, 0xb5, 0x38, 0x77, 0x11, 0x74,
-	0xa2, 0xed, 0x12, 0x38, 0x11, 0xe7, 0x48, 0x7a, 0x00, 0xf6, 0xd0, 0xc8, 0xcf, 0x98, 0xad, 0x32,
-	0xb0, 0xe5, 0xa7, 0x25, 0x40, 0x02, 0x78, 0xa0, 0x70, 0x89, 0x4a, 0xbb, 0xdd, 0x6d, 0x47, 0xd5,
-	0x6f, 0xf8, 0xdb, 0x83, 0xfd, 0x9b, 0xcc, 0xad, 0xb2, 0xf9, 0x04, 0x4f, 0x6b, 0xd9, 0xa0, 0x0e,
-	0xbc, 0xfe, 0xd6, 0xdd, 0xd3, 0x21, 0xaa, 0xa6, 0x43, 0x5e, 0xc1, 0xe3, 0x0c, 0xbf, 0x9a, 0xf1,
-	0x95, 0x01, 0xdc, 0x86, 0x3b, 0x25, 0xfc, 0xa1, 0x1a, 0x62, 0xf0, 0xc7, 0x87, 0xe7, 0x9b, 0x84,
-	0x27, 0xa8, 0x96, 0x62, 0x82, 0xe4, 0xc2, 0x83, 0x67, 0xd7, 0xaf, 0x98, 0xbc, 0x69, 0x74, 0xd8,
-	0x78, 0x2d, 0xbb, 0xc3, 0xff, 0xea, 0x75, 0xb9, 0x85, 0xad, 0x1f, 0x17, 0xa1, 0x6f, 0x9d, 0x5d,
-	0x1f, 0xf0, 0x2d, 0xce, 0x1a, 0xaf, 0xcc, 0x2d, 0xce, 0x9a, 0x37, 0x6a, 0x9d, 0x79, 0xc7, 0x1f,
-	0x47, 0xa3, 0x54, 0x98, 0x59, 0x11, 0xd3, 0x89, 0x5c, 0xb0, 0xb8, 0x98, 0xc6, 0x85, 0x98, 0x27,
-	0xe5, 0x07, 0x13, 0x99, 0x41, 0x95, 0xf1, 0x39, 0x4b, 0x31, 0x73, 0x0f, 0x0e, 0x4b, 0x25, 0x6b,
-	0x78, 0xf4, 0x86, 0x15, 0x52, 0x01, 0xf1, 0x7d, 0xdb, 0x76, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x0b, 0xb7, 0xf3, 0x73, 0x2b, 0x05, 0x00, 0x00,
+	0xf0, 0x4f, 0x28, 0x76, 0xb3, 0x96, 0x66, 0x2b, 0xda, 0xde, 0x92, 0xe3, 0x7b, 0xce, 0x3d, 0xf7,
+	0x5c, 0xcb, 0x70, 0x9c, 0x14, 0x63, 0x2e, 0xa6, 0xf9, 0x44, 0x70, 0x8d, 0xa9, 0x34, 0x56, 0x9f,
+	0xf3, 0xf9, 0x91, 0x03, 0x8e, 0xb8, 0xc6, 0x5c, 0x19, 0x69, 0x95, 0x3e, 0x1f, 0x24, 0x5a, 0x64,
+	0xc3, 0x09, 0xcb, 0xb5, 0xb2, 0x8a, 0xee, 0x27, 0xc5, 0x98, 0xb9, 0x1a, 0x56, 0x91, 0x58, 0x45,
+	0x6a, 0x75, 0x96, 0x8a, 0x22, 0x97, 0x4b, 0x31, 0x91, 0x4b, 0x4f, 0x6f, 0xbd, 0x48, 0x95, 0x4a,
+	0xa7, 0xc8, 0xdd, 0x5f, 0x59, 0x6d, 0xe5, 0x0c, 0x8d, 0x15, 0xb3, 0xdc, 0x17, 0x44, 0x3f, 0x09,
+	0xec, 0xc5, 0x57, 0xbd, 0x4f, 0x5c, 0x6b, 0xfa, 0x08, 0x02, 0x39, 0x0a, 0x49, 0x87, 0x1c, 0xee,
+	0xc6, 0x81, 0x1c, 0xd1, 0x1e, 0x34, 0x86, 0x1a, 0x85, 0xc5, 0x41, 0x49, 0x0f, 0x83, 0x0e, 0x39,
+	0x6c, 0x74, 0x5b, 0xcc, 0x6b, 0xb3, 0x4a, 0x9b, 0x9d, 0x55, 0xda, 0x31, 0xf8, 0xf2, 0x12, 0xa0,
+	0x14, 0xb6, 0x33, 0x31, 0xc3, 0x70, 0xdb, 0xc9, 0xb9, 0x6f, 0xfa, 0x12, 0x9a, 0x2b, 0x03, 0xcb,
+	0x51, 0x78, 0xcf, 0x1d, 0x3e, 0x5c, 0x82, 0xef, 0x46, 0xd1, 0x77, 0x68, 0xbf, 0x75, 0x32, 0xeb,
+	0xfe, 0x62, 0xfc, 0x52, 0xa0, 0xb1, 0x75, 0x15, 0x52, 0x57, 0xb9, 0x6a, 0x1f, 0xfc, 0xdb, 0x3e,
+	0x17, 0x1a, 0x33, 0xbb, 0xc8, 0x3a, 0xdc, 0xf2, 0x44, 0x0f, 0xfa, 0x26, 0xd1, 0x37, 0x38, 0xb8,
+	0xa9, 0xbd, 0xc9, 0x55, 0x66, 0x90, 0xf6, 0xe1, 0x49, 0x6d, 0x6d, 0xce, 0x43, 0xa3, 0xfb, 0x9a,
+	0x6d, 0xd8, 0x1b, 0xab, 0x29, 0xee, 0xe9, 0x35, 0x24, 0xfa, 0x45, 0xa0, 0xfd, 0x5e, 0x1a, 0xbb,
+	0x5e, 0x8a, 0xe6, 0x56, 0xd3, 0xef, 0xc3, 0x6e, 0x2e, 0x52, 0x1c, 0x18, 0x79, 0xe1, 0x23, 0x68,
+	0xc6, 0x3b, 0x25, 0x70, 0x2a, 0x2f, 0x90, 0xb6, 0x01, 0xdc, 0xa1, 0x55, 0x9f, 0x31, 0x5b, 0x64,
+	0xe0, 0xca, 0xcf, 0x4a, 0x80, 0x86, 0xf0, 0x40, 0xe3, 0x1c, 0xb5, 0xf1, 0xbb, 0xdb, 0x89, 0xab,
+	0xdf, 0xe8, 0x37, 0x81, 0x83, 0x9b, 0xcc, 0x2d, 0xb2, 0xf9, 0x04, 0x4f, 0x6b, 0xd9, 0xa0, 0x09,
+	0x49, 0x67, 0xeb, 0xf6, 0xe9, 0x50, 0x5d, 0xeb, 0x43, 0x5f, 0xc1, 0xe3, 0x0c, 0xbf, 0xda, 0xc1,
+	0xca, 0x00, 0x7e, 0xc3, 0xcd, 0x12, 0xfe, 0x50, 0x0d, 0xd1, 0xfd, 0x13, 0xc0, 0xf3, 0x75, 0xc1,
+	0x53, 0xd4, 0x73, 0x39, 0x44, 0x7a, 0x49, 0xe0, 0xd9, 0xf5, 0x2b, 0xa6, 0x6f, 0x36, 0x3a, 0xdc,
+	0x78, 0x2d, 0x5b, 0xbd, 0x3b, 0x71, 0x7d, 0x6e, 0xd1, 0xf6, 0x8f, 0xcb, 0x28, 0x70, 0xce, 0xae,
+	0x0f, 0xf8, 0x3f, 0xce, 0x36, 0x5e, 0x99, 0x56, 0xef, 0x4e, 0xdc, 0x15, 0x67, 0xe4, 0xe4, 0x63,
+	0xbf, 0x9f, 0x4a, 0x3b, 0x29, 0x12, 0x36, 0x54, 0x33, 0x9e, 0x14, 0xe3, 0xa4, 0x90, 0xd3, 0x51,
+	0xf9, 0xc1, 0x65, 0x66, 0x51, 0x67, 0x62, 0xca, 0x53, 0xcc, 0xfc, 0x83, 0xc3, 0x53, 0xc5, 0x37,
+	0x3c, 0x7a, 0xbd, 0x0a, 0xa9, 0x80, 0xe4, 0xbe, 0xa3, 0x1d, 0xff, 0x1d, 0x00, 0x0b, 0xb7, 0xf3,
+	0x73, 0x2b, 0x05, 0x00, 0x00,
 }
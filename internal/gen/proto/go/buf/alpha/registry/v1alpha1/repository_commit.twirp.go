@@ -44,6 +44,14 @@ const _ = twirp.TwirpPackageIsVersion7
 type RepositoryCommitService interface {
 	// ListRepositoryCommits lists the repository commits associated with a repository branch.
 	ListRepositoryCommits(context.Context, *ListRepositoryCommitsRequest) (*ListRepositoryCommitsResponse, error)
+
+	// GetRepositoryCommitByName gets a repository commit by name.
+	GetRepositoryCommitByName(context.Context, *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error)
+
+	// UpdateRepositoryCommitAnnotation sets the annotation of a repository commit.
+	//
+	// An empty annotation removes the annotation of the commit.
+	UpdateRepositoryCommitAnnotation(context.Context, *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error)
 }
 
 // =======================================
@@ -52,7 +60,7 @@ type RepositoryCommitService interface {
 
 type repositoryCommitServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [1]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -72,8 +80,10 @@ func NewRepositoryCommitServiceProtobufClient(baseURL string, client HTTPClient,
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryCommitService")
-	urls := [1]string{
+	urls := [3]string{
 		serviceURL + "ListRepositoryCommits",
+		serviceURL + "GetRepositoryCommitByName",
+		serviceURL + "UpdateRepositoryCommitAnnotation",
 	}
 
 	return &repositoryCommitServiceProtobufClient{
@@ -130,13 +140,105 @@ func (c *repositoryCommitServiceProtobufClient) callListRepositoryCommits(ctx co
 	return out, nil
 }
 
+func (c *repositoryCommitServiceProtobufClient) GetRepositoryCommitByName(ctx context.Context, in *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryCommitService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryCommitByName")
+	caller := c.callGetRepositoryCommitByName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryCommitByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryCommitByNameRequest) when calling interceptor")
+					}
+					return c.callGetRepositoryCommitByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryCommitByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryCommitByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryCommitServiceProtobufClient) callGetRepositoryCommitByName(ctx context.Context, in *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+	out := new(GetRepositoryCommitByNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryCommitServiceProtobufClient) UpdateRepositoryCommitAnnotation(ctx context.Context, in *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryCommitService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryCommitAnnotation")
+	caller := c.callUpdateRepositoryCommitAnnotation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryCommitAnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryCommitAnnotationRequest) when calling interceptor")
+					}
+					return c.callUpdateRepositoryCommitAnnotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryCommitAnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryCommitAnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryCommitServiceProtobufClient) callUpdateRepositoryCommitAnnotation(ctx context.Context, in *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+	out := new(UpdateRepositoryCommitAnnotationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===================================
 // RepositoryCommitService JSON Client
 // ===================================
 
 type repositoryCommitServiceJSONClient struct {
 	client      HTTPClient
-	urls        [1]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -156,8 +258,10 @@ func NewRepositoryCommitServiceJSONClient(baseURL string, client HTTPClient, opt
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryCommitService")
-	urls := [1]string{
+	urls := [3]string{
 		serviceURL + "ListRepositoryCommits",
+		serviceURL + "GetRepositoryCommitByName",
+		serviceURL + "UpdateRepositoryCommitAnnotation",
 	}
 
 	return &repositoryCommitServiceJSONClient{
@@ -214,6 +318,98 @@ func (c *repositoryCommitServiceJSONClient) callListRepositoryCommits(ctx contex
 	return out, nil
 }
 
+func (c *repositoryCommitServiceJSONClient) GetRepositoryCommitByName(ctx context.Context, in *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryCommitService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryCommitByName")
+	caller := c.callGetRepositoryCommitByName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryCommitByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryCommitByNameRequest) when calling interceptor")
+					}
+					return c.callGetRepositoryCommitByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryCommitByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryCommitByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryCommitServiceJSONClient) callGetRepositoryCommitByName(ctx context.Context, in *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+	out := new(GetRepositoryCommitByNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryCommitServiceJSONClient) UpdateRepositoryCommitAnnotation(ctx context.Context, in *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryCommitService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryCommitAnnotation")
+	caller := c.callUpdateRepositoryCommitAnnotation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryCommitAnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryCommitAnnotationRequest) when calling interceptor")
+					}
+					return c.callUpdateRepositoryCommitAnnotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryCommitAnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryCommitAnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryCommitServiceJSONClient) callUpdateRepositoryCommitAnnotation(ctx context.Context, in *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+	out := new(UpdateRepositoryCommitAnnotationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ======================================
 // RepositoryCommitService Server Handler
 // ======================================
@@ -301,6 +497,12 @@ func (s *repositoryCommitServiceServer) ServeHTTP(resp http.ResponseWriter, req
 	case "ListRepositoryCommits":
 		s.serveListRepositoryCommits(ctx, resp, req)
 		return
+	case "GetRepositoryCommitByName":
+		s.serveGetRepositoryCommitByName(ctx, resp, req)
+		return
+	case "UpdateRepositoryCommitAnnotation":
+		s.serveUpdateRepositoryCommitAnnotation(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -483,8 +685,358 @@ func (s *repositoryCommitServiceServer) serveListRepositoryCommitsProtobuf(ctx c
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *repositoryCommitServiceServer) serveGetRepositoryCommitByName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRepositoryCommitByNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRepositoryCommitByNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryCommitServiceServer) serveGetRepositoryCommitByNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryCommitByName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GetRepositoryCommitByNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryCommitService.GetRepositoryCommitByName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryCommitByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryCommitByNameRequest) when calling interceptor")
+					}
+					return s.RepositoryCommitService.GetRepositoryCommitByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryCommitByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryCommitByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRepositoryCommitByNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRepositoryCommitByNameResponse and nil error while calling GetRepositoryCommitByName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryCommitServiceServer) serveGetRepositoryCommitByNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryCommitByName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GetRepositoryCommitByNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryCommitService.GetRepositoryCommitByName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryCommitByNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryCommitByNameRequest) when calling interceptor")
+					}
+					return s.RepositoryCommitService.GetRepositoryCommitByName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryCommitByNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryCommitByNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRepositoryCommitByNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRepositoryCommitByNameResponse and nil error while calling GetRepositoryCommitByName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryCommitServiceServer) serveUpdateRepositoryCommitAnnotation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateRepositoryCommitAnnotationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateRepositoryCommitAnnotationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryCommitServiceServer) serveUpdateRepositoryCommitAnnotationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryCommitAnnotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(UpdateRepositoryCommitAnnotationRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryCommitService.UpdateRepositoryCommitAnnotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryCommitAnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryCommitAnnotationRequest) when calling interceptor")
+					}
+					return s.RepositoryCommitService.UpdateRepositoryCommitAnnotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryCommitAnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryCommitAnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateRepositoryCommitAnnotationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateRepositoryCommitAnnotationResponse and nil error while calling UpdateRepositoryCommitAnnotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryCommitServiceServer) serveUpdateRepositoryCommitAnnotationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryCommitAnnotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(UpdateRepositoryCommitAnnotationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryCommitService.UpdateRepositoryCommitAnnotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryCommitAnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryCommitAnnotationRequest) when calling interceptor")
+					}
+					return s.RepositoryCommitService.UpdateRepositoryCommitAnnotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryCommitAnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryCommitAnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateRepositoryCommitAnnotationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateRepositoryCommitAnnotationResponse and nil error while calling UpdateRepositoryCommitAnnotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *repositoryCommitServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor5, 0
+	return twirpFileDescriptor8, 0
 }
 
 func (s *repositoryCommitServiceServer) ProtocGenTwirpVersion() string {
@@ -498,36 +1050,44 @@ func (s *repositoryCommitServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "RepositoryCommitService")
 }
 
-var twirpFileDescriptor5 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x5d, 0x6f, 0xd3, 0x30,
-	0x14, 0x95, 0xbb, 0x32, 0xb6, 0x5b, 0xca, 0x87, 0x05, 0x23, 0xea, 0x98, 0x88, 0x82, 0x84, 0xfa,
-	0x82, 0xad, 0x75, 0xbc, 0xc0, 0xde, 0xc6, 0x13, 0x12, 0x42, 0x28, 0xdb, 0xd3, 0x34, 0x29, 0x72,
-	0x92, 0xdb, 0xd4, 0xa2, 0x89, 0x83, 0xed, 0x54, 0x6c, 0xbf, 0x00, 0x89, 0x57, 0x24, 0x7e, 0x01,
-	0xef, 0xfc, 0x13, 0xfe, 0x12, 0x8a, 0x33, 0xaf, 0x55, 0x81, 0x3e, 0xf0, 0x66, 0x1f, 0x9f, 0x73,
-	0xee, 0xb9, 0xf7, 0x26, 0x70, 0x94, 0x36, 0x53, 0x2e, 0xe6, 0xf5, 0x4c, 0x70, 0x8d, 0x85, 0x34,
-	0x56, 0x5f, 0xf2, 0xc5, 0xa1, 0x03, 0x0e, 0xb9, 0xc6, 0x5a, 0x19, 0x69, 0x95, 0xbe, 0x4c, 0x32,
-	0x55, 0x96, 0xd2, 0xb2, 0x5a, 0x2b, 0xab, 0xe8, 0x7e, 0xda, 0x4c, 0x99, 0xe3, 0x30, 0x2f, 0x62,
-	0x5e, 0x34, 0x0a, 0x97, 0x8e, 0xa2, 0x96, 0x4b, 0x33, 0x51, 0xcb, 0x4e, 0x3e, 0x7a, 0x5a, 0x28,
-	0x55, 0xcc, 0x91, 0xbb, 0x5b, 0xcb, 0xb6, 0xb2, 0x44, 0x63, 0x45, 0x59, 0x77, 0x84, 0xe8, 0x2b,
-	0x81, 0xfb, 0xf1, 0x4d, 0xed, 0x37, 0xae, 0x34, 0xbd, 0x0b, 0x3d, 0x99, 0x07, 0x24, 0x24, 0xe3,
-	0xdd, 0xb8, 0x27, 0x73, 0x7a, 0x0c, 0x83, 0x4c, 0xa3, 0xb0, 0x98, 0xb4, 0xf2, 0xa0, 0x17, 0x92,
-	0xf1, 0x60, 0x32, 0x62, 0x9d, 0x37, 0xf3, 0xde, 0xec, 0xcc, 0x7b, 0xc7, 0xd0, 0xd1, 0x5b, 0x80,
-	0xee, 0xc1, 0x76, 0x2e, 0x0b, 0x34, 0x36, 0xd8, 0x72, 0x86, 0xd7, 0x37, 0x4a, 0xa1, 0x5f, 0x89,
-	0x12, 0x83, 0xbe, 0x43, 0xdd, 0x39, 0xfa, 0x45, 0xe0, 0xc9, 0x3b, 0x69, 0xec, 0x7a, 0x22, 0x13,
-	0xe3, 0xa7, 0xa6, 0x15, 0x3d, 0x83, 0xe1, 0xca, 0xa4, 0x6e, 0x42, 0xde, 0x59, 0x82, 0x6f, 0x73,
-	0xfa, 0x12, 0xf6, 0x56, 0x48, 0xa9, 0x16, 0x55, 0x36, 0x4b, 0x5c, 0xad, 0x9e, 0x63, 0x3f, 0x5c,
-	0xbe, 0x9e, 0xb8, 0xc7, 0xf7, 0xa2, 0x44, 0xba, 0x0f, 0xbb, 0xb5, 0x28, 0x30, 0x31, 0xf2, 0x0a,
-	0x5d, 0xd4, 0x61, 0xbc, 0xd3, 0x02, 0xa7, 0xf2, 0x0a, 0xe9, 0x01, 0x80, 0x7b, 0xb4, 0xea, 0x23,
-	0x56, 0xd7, 0x91, 0x1d, 0xfd, 0xac, 0x05, 0x68, 0x00, 0xb7, 0x35, 0x2e, 0x50, 0x1b, 0x0c, 0x6e,
-	0x85, 0x64, 0xbc, 0x13, 0xfb, 0x6b, 0xf4, 0x83, 0xc0, 0xc1, 0x3f, 0x3a, 0x32, 0xb5, 0xaa, 0x0c,
-	0xd2, 0x0b, 0xa0, 0x7f, 0x2c, 0xdf, 0x04, 0x24, 0xdc, 0x1a, 0x0f, 0x26, 0x2f, 0xd8, 0x86, 0xf5,
-	0xb3, 0x75, 0xcf, 0xf8, 0x81, 0x5e, 0xaf, 0x42, 0x9f, 0xc3, 0xbd, 0x0a, 0x3f, 0xdb, 0x64, 0x25,
-	0x7d, 0x37, 0x84, 0x61, 0x0b, 0x7f, 0xf0, 0x1d, 0x4c, 0x7e, 0x12, 0x78, 0xbc, 0xee, 0x77, 0x8a,
-	0x7a, 0x21, 0x33, 0xa4, 0xdf, 0x08, 0x3c, 0xfa, 0x6b, 0x0f, 0xf4, 0xd5, 0xc6, 0x7c, 0x9b, 0x36,
-	0x39, 0x7a, 0xfd, 0x3f, 0xd2, 0x6e, 0x64, 0x51, 0xff, 0xcb, 0xf7, 0x88, 0x9c, 0x5c, 0x9c, 0x9f,
-	0x17, 0xd2, 0xce, 0x9a, 0x94, 0x65, 0xaa, 0xe4, 0x69, 0x33, 0x4d, 0x1b, 0x39, 0xcf, 0xdb, 0x03,
-	0x97, 0x95, 0x45, 0x5d, 0x89, 0x39, 0x2f, 0xb0, 0xea, 0xbe, 0x7d, 0x5e, 0x28, 0xbe, 0xe1, 0xff,
-	0x3b, 0xf6, 0x88, 0x07, 0xd2, 0x6d, 0x27, 0x3b, 0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x98,
-	0x07, 0x49, 0xb6, 0x03, 0x00, 0x00,
+var twirpFileDescriptor8 = []byte{
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0xce, 0x2c, 0xfb, 0xe3, 0x07, 0x0f, 0x51, 0x98, 0x28, 0xd6, 0x45, 0xb4, 0xd6, 0x44, 0xf7,
+	0x62, 0x1b, 0xc0, 0x8b, 0x12, 0x4d, 0x44, 0x8d, 0x31, 0x31, 0xc6, 0x14, 0xbc, 0x20, 0xc9, 0x66,
+	0xba, 0x7d, 0x94, 0x89, 0xdb, 0x99, 0x3a, 0x9d, 0x12, 0xe1, 0x62, 0xe2, 0xc1, 0x98, 0x78, 0x35,
+	0xf1, 0xea, 0xc5, 0x93, 0x67, 0xff, 0x0e, 0xff, 0x25, 0xd3, 0xe9, 0x96, 0x5d, 0xbb, 0xd2, 0x15,
+	0x88, 0xb7, 0xce, 0xf7, 0xde, 0xfb, 0xde, 0x7b, 0xdf, 0x7c, 0xcd, 0xc0, 0x6a, 0x90, 0xed, 0x78,
+	0xac, 0x97, 0xec, 0x32, 0x4f, 0x61, 0xc4, 0x53, 0xad, 0xf6, 0xbd, 0xbd, 0x65, 0x03, 0x2c, 0x7b,
+	0x0a, 0x13, 0x99, 0x72, 0x2d, 0xd5, 0x7e, 0xa7, 0x2b, 0xe3, 0x98, 0x6b, 0x37, 0x51, 0x52, 0x4b,
+	0xba, 0x18, 0x64, 0x3b, 0xae, 0xc9, 0x71, 0xcb, 0x22, 0xb7, 0x2c, 0x6a, 0xd9, 0x03, 0x46, 0x96,
+	0xf0, 0x01, 0x19, 0x4b, 0x78, 0x51, 0xde, 0xba, 0x1a, 0x49, 0x19, 0xf5, 0xd0, 0x33, 0xa7, 0x3c,
+	0x5b, 0xf3, 0x18, 0x53, 0xcd, 0xe2, 0xa4, 0x48, 0x70, 0xbe, 0x13, 0x98, 0xf3, 0x0f, 0x7b, 0x3f,
+	0x34, 0xad, 0xe9, 0x59, 0x68, 0xf0, 0xd0, 0x22, 0x36, 0x69, 0x4f, 0xfb, 0x0d, 0x1e, 0xd2, 0x35,
+	0x98, 0xe9, 0x2a, 0x64, 0x1a, 0x3b, 0x79, 0xb9, 0xd5, 0xb0, 0x49, 0x7b, 0x66, 0xa5, 0xe5, 0x16,
+	0xdc, 0x6e, 0xc9, 0xed, 0x6e, 0x96, 0xdc, 0x3e, 0x14, 0xe9, 0x39, 0x40, 0x17, 0x60, 0x32, 0xe4,
+	0x11, 0xa6, 0xda, 0x9a, 0x30, 0x84, 0xfd, 0x13, 0xa5, 0xd0, 0x14, 0x2c, 0x46, 0xab, 0x69, 0x50,
+	0xf3, 0x4d, 0xaf, 0x00, 0x30, 0x21, 0xa4, 0x66, 0x9a, 0x4b, 0x61, 0xfd, 0x67, 0x22, 0x43, 0x88,
+	0xf3, 0x93, 0xc0, 0xe5, 0x67, 0x3c, 0xd5, 0xd5, 0x89, 0x53, 0x1f, 0xdf, 0x64, 0x39, 0xe9, 0x75,
+	0x98, 0x1d, 0x52, 0xf2, 0x70, 0x89, 0x33, 0x03, 0xf0, 0x69, 0x48, 0x6f, 0xc3, 0xc2, 0x50, 0x52,
+	0xa0, 0x98, 0xe8, 0xee, 0x76, 0x04, 0xeb, 0x6f, 0x36, 0xed, 0x9f, 0x1f, 0x44, 0xd7, 0x4d, 0xf0,
+	0x79, 0x3e, 0xdb, 0x22, 0x4c, 0x27, 0x2c, 0xc2, 0x4e, 0xca, 0x0f, 0xd0, 0xac, 0x32, 0xeb, 0x4f,
+	0xe5, 0xc0, 0x06, 0x3f, 0x40, 0xba, 0x04, 0x60, 0x82, 0x5a, 0xbe, 0x46, 0xd1, 0x5f, 0xc9, 0xa4,
+	0x6f, 0xe6, 0x00, 0xb5, 0xe0, 0x7f, 0x85, 0x7b, 0xa8, 0x52, 0x34, 0x4b, 0x4d, 0xf9, 0xe5, 0xd1,
+	0xf9, 0x46, 0x60, 0xe9, 0x88, 0x8d, 0xd2, 0x44, 0x8a, 0x14, 0xe9, 0x36, 0xd0, 0x11, 0x73, 0xa4,
+	0x16, 0xb1, 0x27, 0xda, 0x33, 0x2b, 0xb7, 0xdc, 0x1a, 0x7b, 0xb8, 0x55, 0x4e, 0x7f, 0x5e, 0x55,
+	0xbb, 0xd0, 0x1b, 0x70, 0x4e, 0xe0, 0x5b, 0xdd, 0x19, 0x9a, 0xbe, 0x10, 0x61, 0x36, 0x87, 0x5f,
+	0x94, 0x1b, 0x38, 0xaf, 0xc0, 0x7e, 0x82, 0x23, 0x53, 0xae, 0xef, 0xe7, 0xd2, 0x1c, 0x4b, 0xfc,
+	0xf2, 0xda, 0x1b, 0x83, 0x6b, 0x77, 0xde, 0xc1, 0xb5, 0x1a, 0xf2, 0xbe, 0x0e, 0x5b, 0x30, 0x3f,
+	0xa2, 0x83, 0xe9, 0x70, 0x6c, 0x19, 0xe6, 0xaa, 0x32, 0x38, 0xef, 0x09, 0xdc, 0x7c, 0x99, 0x84,
+	0x4c, 0x63, 0x35, 0xf9, 0xc1, 0xa1, 0xf9, 0x4e, 0xbb, 0x65, 0xc5, 0xdc, 0x13, 0x23, 0xe6, 0xfe,
+	0x40, 0xa0, 0x3d, 0x7e, 0x88, 0x7f, 0xaf, 0xc6, 0xca, 0xa7, 0x26, 0x5c, 0xac, 0xa6, 0x6d, 0xa0,
+	0xda, 0xe3, 0x5d, 0xa4, 0x9f, 0x09, 0x5c, 0xf8, 0xa3, 0x5f, 0xe9, 0x9d, 0xda, 0xb6, 0x75, 0x7f,
+	0x6d, 0xeb, 0xee, 0x49, 0x4a, 0x0b, 0x21, 0x9c, 0xe6, 0xc7, 0x2f, 0x0e, 0xa1, 0x5f, 0x09, 0x5c,
+	0x3a, 0xd2, 0x42, 0xf4, 0x5e, 0x2d, 0xff, 0x38, 0x5f, 0xb7, 0xee, 0x9f, 0xb4, 0xfc, 0xb7, 0x11,
+	0x7f, 0x10, 0xb0, 0xc7, 0x5d, 0x2f, 0x7d, 0x54, 0xdb, 0xea, 0x2f, 0x2d, 0xda, 0x7a, 0x7c, 0x4a,
+	0x96, 0xa1, 0xb9, 0x1b, 0xeb, 0xdb, 0x5b, 0x5b, 0x11, 0xd7, 0xbb, 0x59, 0xe0, 0x76, 0x65, 0xec,
+	0x05, 0xd9, 0x4e, 0x90, 0xf1, 0x5e, 0x98, 0x7f, 0x78, 0x5c, 0x68, 0x54, 0x82, 0xf5, 0xbc, 0x08,
+	0x45, 0xf1, 0xc4, 0x78, 0x91, 0xf4, 0x6a, 0x9e, 0xb9, 0xb5, 0x12, 0x29, 0x81, 0x60, 0xd2, 0x94,
+	0xad, 0xfe, 0x1a, 0x00, 0x7a, 0x34, 0x61, 0xaf, 0x1d, 0x07, 0x00, 0x00,
 }
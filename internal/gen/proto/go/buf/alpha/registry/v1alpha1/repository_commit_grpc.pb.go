@@ -33,6 +33,12 @@ const _ = grpc.SupportPackageIsVersion7
 type RepositoryCommitServiceClient interface {
 	// ListRepositoryCommits lists the repository commits associated with a repository branch.
 	ListRepositoryCommits(ctx context.Context, in *ListRepositoryCommitsRequest, opts ...grpc.CallOption) (*ListRepositoryCommitsResponse, error)
+	// GetRepositoryCommitByName gets a repository commit by name.
+	GetRepositoryCommitByName(ctx context.Context, in *GetRepositoryCommitByNameRequest, opts ...grpc.CallOption) (*GetRepositoryCommitByNameResponse, error)
+	// UpdateRepositoryCommitAnnotation sets the annotation of a repository commit.
+	//
+	// An empty annotation removes the annotation of the commit.
+	UpdateRepositoryCommitAnnotation(ctx context.Context, in *UpdateRepositoryCommitAnnotationRequest, opts ...grpc.CallOption) (*UpdateRepositoryCommitAnnotationResponse, error)
 }
 
 type repositoryCommitServiceClient struct {
@@ -52,12 +58,36 @@ func (c *repositoryCommitServiceClient) ListRepositoryCommits(ctx context.Contex
 	return out, nil
 }
 
+func (c *repositoryCommitServiceClient) GetRepositoryCommitByName(ctx context.Context, in *GetRepositoryCommitByNameRequest, opts ...grpc.CallOption) (*GetRepositoryCommitByNameResponse, error) {
+	out := new(GetRepositoryCommitByNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryCommitService/GetRepositoryCommitByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryCommitServiceClient) UpdateRepositoryCommitAnnotation(ctx context.Context, in *UpdateRepositoryCommitAnnotationRequest, opts ...grpc.CallOption) (*UpdateRepositoryCommitAnnotationResponse, error) {
+	out := new(UpdateRepositoryCommitAnnotationResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryCommitService/UpdateRepositoryCommitAnnotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryCommitServiceServer is the server API for RepositoryCommitService service.
 // All implementations should embed UnimplementedRepositoryCommitServiceServer
 // for forward compatibility
 type RepositoryCommitServiceServer interface {
 	// ListRepositoryCommits lists the repository commits associated with a repository branch.
 	ListRepositoryCommits(context.Context, *ListRepositoryCommitsRequest) (*ListRepositoryCommitsResponse, error)
+	// GetRepositoryCommitByName gets a repository commit by name.
+	GetRepositoryCommitByName(context.Context, *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error)
+	// UpdateRepositoryCommitAnnotation sets the annotation of a repository commit.
+	//
+	// An empty annotation removes the annotation of the commit.
+	UpdateRepositoryCommitAnnotation(context.Context, *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error)
 }
 
 // UnimplementedRepositoryCommitServiceServer should be embedded to have forward compatible implementations.
@@ -67,6 +97,12 @@ type UnimplementedRepositoryCommitServiceServer struct {
 func (UnimplementedRepositoryCommitServiceServer) ListRepositoryCommits(context.Context, *ListRepositoryCommitsRequest) (*ListRepositoryCommitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepositoryCommits not implemented")
 }
+func (UnimplementedRepositoryCommitServiceServer) GetRepositoryCommitByName(context.Context, *GetRepositoryCommitByNameRequest) (*GetRepositoryCommitByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositoryCommitByName not implemented")
+}
+func (UnimplementedRepositoryCommitServiceServer) UpdateRepositoryCommitAnnotation(context.Context, *UpdateRepositoryCommitAnnotationRequest) (*UpdateRepositoryCommitAnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepositoryCommitAnnotation not implemented")
+}
 
 // UnsafeRepositoryCommitServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RepositoryCommitServiceServer will
@@ -97,6 +133,42 @@ func _RepositoryCommitService_ListRepositoryCommits_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryCommitService_GetRepositoryCommitByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepositoryCommitByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryCommitServiceServer).GetRepositoryCommitByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryCommitService/GetRepositoryCommitByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryCommitServiceServer).GetRepositoryCommitByName(ctx, req.(*GetRepositoryCommitByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryCommitService_UpdateRepositoryCommitAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepositoryCommitAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryCommitServiceServer).UpdateRepositoryCommitAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryCommitService/UpdateRepositoryCommitAnnotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryCommitServiceServer).UpdateRepositoryCommitAnnotation(ctx, req.(*UpdateRepositoryCommitAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepositoryCommitService_ServiceDesc is the grpc.ServiceDesc for RepositoryCommitService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -108,6 +180,14 @@ var RepositoryCommitService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRepositoryCommits",
 			Handler:    _RepositoryCommitService_ListRepositoryCommits_Handler,
 		},
+		{
+			MethodName: "GetRepositoryCommitByName",
+			Handler:    _RepositoryCommitService_GetRepositoryCommitByName_Handler,
+		},
+		{
+			MethodName: "UpdateRepositoryCommitAnnotation",
+			Handler:    _RepositoryCommitService_UpdateRepositoryCommitAnnotation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "buf/alpha/registry/v1alpha1/repository_commit.proto",
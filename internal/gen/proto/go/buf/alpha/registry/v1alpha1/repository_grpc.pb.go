@@ -35,12 +35,18 @@ type RepositoryServiceClient interface {
 	GetRepository(ctx context.Context, in *GetRepositoryRequest, opts ...grpc.CallOption) (*GetRepositoryResponse, error)
 	// GetRepositoryByFullName gets a repository by full name.
 	GetRepositoryByFullName(ctx context.Context, in *GetRepositoryByFullNameRequest, opts ...grpc.CallOption) (*GetRepositoryByFullNameResponse, error)
+	// GetRepositoryStatsByFullName gets the usage statistics of a repository by full name.
+	GetRepositoryStatsByFullName(ctx context.Context, in *GetRepositoryStatsByFullNameRequest, opts ...grpc.CallOption) (*GetRepositoryStatsByFullNameResponse, error)
 	// ListRepositories lists all repositories.
 	ListRepositories(ctx context.Context, in *ListRepositoriesRequest, opts ...grpc.CallOption) (*ListRepositoriesResponse, error)
 	// ListUserRepositories lists all repositories belonging to a user.
 	ListUserRepositories(ctx context.Context, in *ListUserRepositoriesRequest, opts ...grpc.CallOption) (*ListUserRepositoriesResponse, error)
 	// ListOrganizationRepositories lists all repositories for an organization.
 	ListOrganizationRepositories(ctx context.Context, in *ListOrganizationRepositoriesRequest, opts ...grpc.CallOption) (*ListOrganizationRepositoriesResponse, error)
+	// SearchRepositories searches for repositories whose name or owner name matches the query.
+	//
+	// Only repositories visible to the caller are returned.
+	SearchRepositories(ctx context.Context, in *SearchRepositoriesRequest, opts ...grpc.CallOption) (*SearchRepositoriesResponse, error)
 	// CreateRepositoryByFullName creates a new repository by full name.
 	CreateRepositoryByFullName(ctx context.Context, in *CreateRepositoryByFullNameRequest, opts ...grpc.CallOption) (*CreateRepositoryByFullNameResponse, error)
 	// UpdateRepositoryName updates a repository's name.
@@ -51,6 +57,14 @@ type RepositoryServiceClient interface {
 	UpdateRepositoryVisibility(ctx context.Context, in *UpdateRepositoryVisibilityRequest, opts ...grpc.CallOption) (*UpdateRepositoryVisibilityResponse, error)
 	// UpdateRepositoryVisibilityByName updates a repository's visibility by name.
 	UpdateRepositoryVisibilityByName(ctx context.Context, in *UpdateRepositoryVisibilityByNameRequest, opts ...grpc.CallOption) (*UpdateRepositoryVisibilityByNameResponse, error)
+	// UpdateRepositoryDefaultBranchByFullName updates a repository's default branch by full name.
+	//
+	// The branch must already exist on the repository.
+	UpdateRepositoryDefaultBranchByFullName(ctx context.Context, in *UpdateRepositoryDefaultBranchByFullNameRequest, opts ...grpc.CallOption) (*UpdateRepositoryDefaultBranchByFullNameResponse, error)
+	// TransferRepositoryByFullName transfers a repository to a new owner by full name.
+	//
+	// The new owner must be an existing organization.
+	TransferRepositoryByFullName(ctx context.Context, in *TransferRepositoryByFullNameRequest, opts ...grpc.CallOption) (*TransferRepositoryByFullNameResponse, error)
 	// DeleteRepository deletes a repository.
 	DeleteRepository(ctx context.Context, in *DeleteRepositoryRequest, opts ...grpc.CallOption) (*DeleteRepositoryResponse, error)
 	// DeleteRepositoryByFullName deletes a repository by full name.
@@ -83,6 +97,15 @@ func (c *repositoryServiceClient) GetRepositoryByFullName(ctx context.Context, i
 	return out, nil
 }
 
+func (c *repositoryServiceClient) GetRepositoryStatsByFullName(ctx context.Context, in *GetRepositoryStatsByFullNameRequest, opts ...grpc.CallOption) (*GetRepositoryStatsByFullNameResponse, error) {
+	out := new(GetRepositoryStatsByFullNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryService/GetRepositoryStatsByFullName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ListRepositories(ctx context.Context, in *ListRepositoriesRequest, opts ...grpc.CallOption) (*ListRepositoriesResponse, error) {
 	out := new(ListRepositoriesResponse)
 	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryService/ListRepositories", in, out, opts...)
@@ -110,6 +133,15 @@ func (c *repositoryServiceClient) ListOrganizationRepositories(ctx context.Conte
 	return out, nil
 }
 
+func (c *repositoryServiceClient) SearchRepositories(ctx context.Context, in *SearchRepositoriesRequest, opts ...grpc.CallOption) (*SearchRepositoriesResponse, error) {
+	out := new(SearchRepositoriesResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryService/SearchRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) CreateRepositoryByFullName(ctx context.Context, in *CreateRepositoryByFullNameRequest, opts ...grpc.CallOption) (*CreateRepositoryByFullNameResponse, error) {
 	out := new(CreateRepositoryByFullNameResponse)
 	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryService/CreateRepositoryByFullName", in, out, opts...)
@@ -155,6 +187,24 @@ func (c *repositoryServiceClient) UpdateRepositoryVisibilityByName(ctx context.C
 	return out, nil
 }
 
+func (c *repositoryServiceClient) UpdateRepositoryDefaultBranchByFullName(ctx context.Context, in *UpdateRepositoryDefaultBranchByFullNameRequest, opts ...grpc.CallOption) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+	out := new(UpdateRepositoryDefaultBranchByFullNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryService/UpdateRepositoryDefaultBranchByFullName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) TransferRepositoryByFullName(ctx context.Context, in *TransferRepositoryByFullNameRequest, opts ...grpc.CallOption) (*TransferRepositoryByFullNameResponse, error) {
+	out := new(TransferRepositoryByFullNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryService/TransferRepositoryByFullName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) DeleteRepository(ctx context.Context, in *DeleteRepositoryRequest, opts ...grpc.CallOption) (*DeleteRepositoryResponse, error) {
 	out := new(DeleteRepositoryResponse)
 	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryService/DeleteRepository", in, out, opts...)
@@ -181,12 +231,18 @@ type RepositoryServiceServer interface {
 	GetRepository(context.Context, *GetRepositoryRequest) (*GetRepositoryResponse, error)
 	// GetRepositoryByFullName gets a repository by full name.
 	GetRepositoryByFullName(context.Context, *GetRepositoryByFullNameRequest) (*GetRepositoryByFullNameResponse, error)
+	// GetRepositoryStatsByFullName gets the usage statistics of a repository by full name.
+	GetRepositoryStatsByFullName(context.Context, *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error)
 	// ListRepositories lists all repositories.
 	ListRepositories(context.Context, *ListRepositoriesRequest) (*ListRepositoriesResponse, error)
 	// ListUserRepositories lists all repositories belonging to a user.
 	ListUserRepositories(context.Context, *ListUserRepositoriesRequest) (*ListUserRepositoriesResponse, error)
 	// ListOrganizationRepositories lists all repositories for an organization.
 	ListOrganizationRepositories(context.Context, *ListOrganizationRepositoriesRequest) (*ListOrganizationRepositoriesResponse, error)
+	// SearchRepositories searches for repositories whose name or owner name matches the query.
+	//
+	// Only repositories visible to the caller are returned.
+	SearchRepositories(context.Context, *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error)
 	// CreateRepositoryByFullName creates a new repository by full name.
 	CreateRepositoryByFullName(context.Context, *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error)
 	// UpdateRepositoryName updates a repository's name.
@@ -197,6 +253,14 @@ type RepositoryServiceServer interface {
 	UpdateRepositoryVisibility(context.Context, *UpdateRepositoryVisibilityRequest) (*UpdateRepositoryVisibilityResponse, error)
 	// UpdateRepositoryVisibilityByName updates a repository's visibility by name.
 	UpdateRepositoryVisibilityByName(context.Context, *UpdateRepositoryVisibilityByNameRequest) (*UpdateRepositoryVisibilityByNameResponse, error)
+	// UpdateRepositoryDefaultBranchByFullName updates a repository's default branch by full name.
+	//
+	// The branch must already exist on the repository.
+	UpdateRepositoryDefaultBranchByFullName(context.Context, *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error)
+	// TransferRepositoryByFullName transfers a repository to a new owner by full name.
+	//
+	// The new owner must be an existing organization.
+	TransferRepositoryByFullName(context.Context, *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error)
 	// DeleteRepository deletes a repository.
 	DeleteRepository(context.Context, *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error)
 	// DeleteRepositoryByFullName deletes a repository by full name.
@@ -213,6 +277,9 @@ func (UnimplementedRepositoryServiceServer) GetRepository(context.Context, *GetR
 func (UnimplementedRepositoryServiceServer) GetRepositoryByFullName(context.Context, *GetRepositoryByFullNameRequest) (*GetRepositoryByFullNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRepositoryByFullName not implemented")
 }
+func (UnimplementedRepositoryServiceServer) GetRepositoryStatsByFullName(context.Context, *GetRepositoryStatsByFullNameRequest) (*GetRepositoryStatsByFullNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositoryStatsByFullName not implemented")
+}
 func (UnimplementedRepositoryServiceServer) ListRepositories(context.Context, *ListRepositoriesRequest) (*ListRepositoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepositories not implemented")
 }
@@ -222,6 +289,9 @@ func (UnimplementedRepositoryServiceServer) ListUserRepositories(context.Context
 func (UnimplementedRepositoryServiceServer) ListOrganizationRepositories(context.Context, *ListOrganizationRepositoriesRequest) (*ListOrganizationRepositoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationRepositories not implemented")
 }
+func (UnimplementedRepositoryServiceServer) SearchRepositories(context.Context, *SearchRepositoriesRequest) (*SearchRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRepositories not implemented")
+}
 func (UnimplementedRepositoryServiceServer) CreateRepositoryByFullName(context.Context, *CreateRepositoryByFullNameRequest) (*CreateRepositoryByFullNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRepositoryByFullName not implemented")
 }
@@ -237,6 +307,12 @@ func (UnimplementedRepositoryServiceServer) UpdateRepositoryVisibility(context.C
 func (UnimplementedRepositoryServiceServer) UpdateRepositoryVisibilityByName(context.Context, *UpdateRepositoryVisibilityByNameRequest) (*UpdateRepositoryVisibilityByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepositoryVisibilityByName not implemented")
 }
+func (UnimplementedRepositoryServiceServer) UpdateRepositoryDefaultBranchByFullName(context.Context, *UpdateRepositoryDefaultBranchByFullNameRequest) (*UpdateRepositoryDefaultBranchByFullNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepositoryDefaultBranchByFullName not implemented")
+}
+func (UnimplementedRepositoryServiceServer) TransferRepositoryByFullName(context.Context, *TransferRepositoryByFullNameRequest) (*TransferRepositoryByFullNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRepositoryByFullName not implemented")
+}
 func (UnimplementedRepositoryServiceServer) DeleteRepository(context.Context, *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepository not implemented")
 }
@@ -291,6 +367,24 @@ func _RepositoryService_GetRepositoryByFullName_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_GetRepositoryStatsByFullName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepositoryStatsByFullNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetRepositoryStatsByFullName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryService/GetRepositoryStatsByFullName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetRepositoryStatsByFullName(ctx, req.(*GetRepositoryStatsByFullNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ListRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRepositoriesRequest)
 	if err := dec(in); err != nil {
@@ -345,6 +439,24 @@ func _RepositoryService_ListOrganizationRepositories_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_SearchRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRepositoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).SearchRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryService/SearchRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).SearchRepositories(ctx, req.(*SearchRepositoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_CreateRepositoryByFullName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRepositoryByFullNameRequest)
 	if err := dec(in); err != nil {
@@ -435,6 +547,42 @@ func _RepositoryService_UpdateRepositoryVisibilityByName_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_UpdateRepositoryDefaultBranchByFullName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepositoryDefaultBranchByFullNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).UpdateRepositoryDefaultBranchByFullName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryService/UpdateRepositoryDefaultBranchByFullName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).UpdateRepositoryDefaultBranchByFullName(ctx, req.(*UpdateRepositoryDefaultBranchByFullNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_TransferRepositoryByFullName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRepositoryByFullNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).TransferRepositoryByFullName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryService/TransferRepositoryByFullName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).TransferRepositoryByFullName(ctx, req.(*TransferRepositoryByFullNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_DeleteRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRepositoryRequest)
 	if err := dec(in); err != nil {
@@ -486,6 +634,10 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRepositoryByFullName",
 			Handler:    _RepositoryService_GetRepositoryByFullName_Handler,
 		},
+		{
+			MethodName: "GetRepositoryStatsByFullName",
+			Handler:    _RepositoryService_GetRepositoryStatsByFullName_Handler,
+		},
 		{
 			MethodName: "ListRepositories",
 			Handler:    _RepositoryService_ListRepositories_Handler,
@@ -498,6 +650,10 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrganizationRepositories",
 			Handler:    _RepositoryService_ListOrganizationRepositories_Handler,
 		},
+		{
+			MethodName: "SearchRepositories",
+			Handler:    _RepositoryService_SearchRepositories_Handler,
+		},
 		{
 			MethodName: "CreateRepositoryByFullName",
 			Handler:    _RepositoryService_CreateRepositoryByFullName_Handler,
@@ -518,6 +674,14 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRepositoryVisibilityByName",
 			Handler:    _RepositoryService_UpdateRepositoryVisibilityByName_Handler,
 		},
+		{
+			MethodName: "UpdateRepositoryDefaultBranchByFullName",
+			Handler:    _RepositoryService_UpdateRepositoryDefaultBranchByFullName_Handler,
+		},
+		{
+			MethodName: "TransferRepositoryByFullName",
+			Handler:    _RepositoryService_TransferRepositoryByFullName_Handler,
+		},
 		{
 			MethodName: "DeleteRepository",
 			Handler:    _RepositoryService_DeleteRepository_Handler,
@@ -0,0 +1,813 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-twirp v7.1.0, DO NOT EDIT.
+// source: buf/alpha/registry/v1alpha1/repository_mirror.proto
+
+package registryv1alpha1
+
+import bytes "bytes"
+import strings "strings"
+import context "context"
+import fmt "fmt"
+import ioutil "io/ioutil"
+import http "net/http"
+import strconv "strconv"
+
+import jsonpb "github.com/golang/protobuf/jsonpb"
+import proto "github.com/golang/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the twirp package used in your project.
+// A compilation error at this line likely means your copy of the
+// twirp package needs to be updated.
+const _ = twirp.TwirpPackageIsVersion7
+
+// =================================
+// RepositoryMirrorService Interface
+// =================================
+
+// RepositoryMirrorService is the Repository mirror service.
+type RepositoryMirrorService interface {
+	// SetRepositoryMirrorByFullName configures the repository to be mirrored from a git remote.
+	//
+	// This overwrites any existing mirror configuration for the repository.
+	SetRepositoryMirrorByFullName(context.Context, *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error)
+
+	// GetRepositoryMirrorByFullName gets the mirror configuration and last sync status of a repository.
+	GetRepositoryMirrorByFullName(context.Context, *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error)
+}
+
+// =======================================
+// RepositoryMirrorService Protobuf Client
+// =======================================
+
+type repositoryMirrorServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRepositoryMirrorServiceProtobufClient creates a Protobuf client that implements the RepositoryMirrorService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewRepositoryMirrorServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RepositoryMirrorService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryMirrorService")
+	urls := [2]string{
+		serviceURL + "SetRepositoryMirrorByFullName",
+		serviceURL + "GetRepositoryMirrorByFullName",
+	}
+
+	return &repositoryMirrorServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *repositoryMirrorServiceProtobufClient) SetRepositoryMirrorByFullName(ctx context.Context, in *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryMirrorService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRepositoryMirrorByFullName")
+	caller := c.callSetRepositoryMirrorByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return c.callSetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryMirrorServiceProtobufClient) callSetRepositoryMirrorByFullName(ctx context.Context, in *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+	out := new(SetRepositoryMirrorByFullNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryMirrorServiceProtobufClient) GetRepositoryMirrorByFullName(ctx context.Context, in *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryMirrorService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryMirrorByFullName")
+	caller := c.callGetRepositoryMirrorByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return c.callGetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryMirrorServiceProtobufClient) callGetRepositoryMirrorByFullName(ctx context.Context, in *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+	out := new(GetRepositoryMirrorByFullNameResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===================================
+// RepositoryMirrorService JSON Client
+// ===================================
+
+type repositoryMirrorServiceJSONClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRepositoryMirrorServiceJSONClient creates a JSON client that implements the RepositoryMirrorService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewRepositoryMirrorServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RepositoryMirrorService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryMirrorService")
+	urls := [2]string{
+		serviceURL + "SetRepositoryMirrorByFullName",
+		serviceURL + "GetRepositoryMirrorByFullName",
+	}
+
+	return &repositoryMirrorServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *repositoryMirrorServiceJSONClient) SetRepositoryMirrorByFullName(ctx context.Context, in *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryMirrorService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRepositoryMirrorByFullName")
+	caller := c.callSetRepositoryMirrorByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return c.callSetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryMirrorServiceJSONClient) callSetRepositoryMirrorByFullName(ctx context.Context, in *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+	out := new(SetRepositoryMirrorByFullNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryMirrorServiceJSONClient) GetRepositoryMirrorByFullName(ctx context.Context, in *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryMirrorService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryMirrorByFullName")
+	caller := c.callGetRepositoryMirrorByFullName
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return c.callGetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryMirrorServiceJSONClient) callGetRepositoryMirrorByFullName(ctx context.Context, in *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+	out := new(GetRepositoryMirrorByFullNameResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ======================================
+// RepositoryMirrorService Server Handler
+// ======================================
+
+type repositoryMirrorServiceServer struct {
+	RepositoryMirrorService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewRepositoryMirrorServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewRepositoryMirrorServiceServer(svc RepositoryMirrorService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewRepositoryMirrorServiceServer", o))
+		}
+	}
+
+	return &repositoryMirrorServiceServer{
+		RepositoryMirrorService: svc,
+		pathPrefix:              serverOpts.PathPrefix(),
+		interceptor:             twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:                   serverOpts.Hooks,
+		jsonSkipDefaults:        serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *repositoryMirrorServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// RepositoryMirrorServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const RepositoryMirrorServicePathPrefix = "/twirp/buf.alpha.registry.v1alpha1.RepositoryMirrorService/"
+
+func (s *repositoryMirrorServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryMirrorService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "buf.alpha.registry.v1alpha1.RepositoryMirrorService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "SetRepositoryMirrorByFullName":
+		s.serveSetRepositoryMirrorByFullName(ctx, resp, req)
+		return
+	case "GetRepositoryMirrorByFullName":
+		s.serveGetRepositoryMirrorByFullName(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *repositoryMirrorServiceServer) serveSetRepositoryMirrorByFullName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetRepositoryMirrorByFullNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetRepositoryMirrorByFullNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryMirrorServiceServer) serveSetRepositoryMirrorByFullNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRepositoryMirrorByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(SetRepositoryMirrorByFullNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryMirrorService.SetRepositoryMirrorByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryMirrorService.SetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetRepositoryMirrorByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetRepositoryMirrorByFullNameResponse and nil error while calling SetRepositoryMirrorByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryMirrorServiceServer) serveSetRepositoryMirrorByFullNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRepositoryMirrorByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(SetRepositoryMirrorByFullNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryMirrorService.SetRepositoryMirrorByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryMirrorService.SetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetRepositoryMirrorByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetRepositoryMirrorByFullNameResponse and nil error while calling SetRepositoryMirrorByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryMirrorServiceServer) serveGetRepositoryMirrorByFullName(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRepositoryMirrorByFullNameJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRepositoryMirrorByFullNameProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryMirrorServiceServer) serveGetRepositoryMirrorByFullNameJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryMirrorByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GetRepositoryMirrorByFullNameRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryMirrorService.GetRepositoryMirrorByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryMirrorService.GetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRepositoryMirrorByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRepositoryMirrorByFullNameResponse and nil error while calling GetRepositoryMirrorByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryMirrorServiceServer) serveGetRepositoryMirrorByFullNameProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRepositoryMirrorByFullName")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GetRepositoryMirrorByFullNameRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryMirrorService.GetRepositoryMirrorByFullName
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRepositoryMirrorByFullNameRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRepositoryMirrorByFullNameRequest) when calling interceptor")
+					}
+					return s.RepositoryMirrorService.GetRepositoryMirrorByFullName(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRepositoryMirrorByFullNameResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRepositoryMirrorByFullNameResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRepositoryMirrorByFullNameResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRepositoryMirrorByFullNameResponse and nil error while calling GetRepositoryMirrorByFullName. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryMirrorServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor9, 0
+}
+
+func (s *repositoryMirrorServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *repositoryMirrorServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "RepositoryMirrorService")
+}
+
+var twirpFileDescriptor9 = []byte{
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x55, 0xba, 0xd2, 0xad, 0xee, 0x06, 0x9b, 0x85, 0x58, 0xd4, 0x69, 0xa2, 0x0a, 0x03, 0xed,
+	0x82, 0xad, 0x75, 0xc7, 0x5d, 0xa0, 0x13, 0x44, 0x1c, 0xe0, 0xd0, 0xc2, 0xa5, 0x42, 0xaa, 0x9c,
+	0xd4, 0x4d, 0x2d, 0x39, 0x71, 0x70, 0xec, 0x49, 0x39, 0x73, 0xe1, 0xce, 0x81, 0x0b, 0x3f, 0x81,
+	0xff, 0xc7, 0x15, 0xd9, 0x5e, 0x96, 0xaa, 0x88, 0x74, 0x52, 0x0f, 0xdc, 0x92, 0xe7, 0xf7, 0xbe,
+	0xf7, 0xfc, 0x6c, 0x19, 0x5c, 0x46, 0x7a, 0x81, 0x09, 0xcf, 0x97, 0x04, 0x4b, 0x9a, 0xb0, 0x42,
+	0xc9, 0x12, 0xdf, 0x5c, 0x58, 0xe0, 0x02, 0x4b, 0x9a, 0x8b, 0x82, 0x29, 0x21, 0xcb, 0x59, 0xca,
+	0xa4, 0x14, 0x12, 0xe5, 0x52, 0x28, 0x01, 0x4f, 0x22, 0xbd, 0x40, 0x96, 0x83, 0x2a, 0x11, 0xaa,
+	0x44, 0xfd, 0x41, 0x3d, 0x91, 0xe4, 0xac, 0x1e, 0x46, 0x72, 0xe6, 0xe4, 0xfd, 0xa7, 0x89, 0x10,
+	0x09, 0xa7, 0xd8, 0xfe, 0x19, 0xb6, 0x62, 0x29, 0x2d, 0x14, 0x49, 0x73, 0x47, 0x08, 0x7e, 0xee,
+	0x80, 0xc3, 0xf1, 0x9d, 0xf7, 0x7b, 0x6b, 0x0d, 0x9f, 0x81, 0x83, 0x95, 0x3c, 0x6c, 0xee, 0x7b,
+	0x03, 0xef, 0xbc, 0x3b, 0xde, 0xaf, 0xc1, 0x77, 0x73, 0x78, 0x05, 0x7a, 0xb1, 0xa4, 0x44, 0xd1,
+	0x99, 0x99, 0xe9, 0xb7, 0x06, 0xde, 0x79, 0x6f, 0xd8, 0x47, 0xce, 0x10, 0x55, 0x86, 0xe8, 0x63,
+	0x65, 0x38, 0x06, 0x8e, 0x6e, 0x00, 0x23, 0xd6, 0xf9, 0xfc, 0x4e, 0xbc, 0xb3, 0x59, 0xec, 0xe8,
+	0x56, 0x7c, 0x0c, 0x76, 0x13, 0xa6, 0x66, 0x5a, 0x72, 0xbf, 0x6d, 0x83, 0x75, 0x12, 0xa6, 0x3e,
+	0x49, 0x0e, 0x4f, 0x01, 0x30, 0x0b, 0x91, 0x24, 0x59, 0xbc, 0xf4, 0x1f, 0xd8, 0xb5, 0x6e, 0xc2,
+	0xd4, 0xc8, 0x02, 0xf0, 0x09, 0xe8, 0x14, 0x3a, 0x9a, 0x33, 0xe9, 0x77, 0x9c, 0xcc, 0xfd, 0xc1,
+	0x57, 0xe0, 0x21, 0x27, 0x85, 0x9a, 0x15, 0x65, 0x16, 0xbb, 0x3c, 0xbb, 0x1b, 0xf3, 0xec, 0x1b,
+	0xc5, 0xa4, 0xcc, 0x62, 0x9b, 0x08, 0x83, 0xc7, 0xf5, 0x04, 0x13, 0x21, 0x16, 0x69, 0xca, 0x94,
+	0xbf, 0x67, 0x7d, 0x8e, 0x2a, 0x6e, 0xc8, 0xd4, 0xb5, 0x5d, 0x80, 0x2f, 0xc0, 0xa3, 0x5a, 0x40,
+	0x4d, 0xe9, 0x7e, 0xd7, 0x72, 0x0f, 0x2a, 0xee, 0x1b, 0x03, 0x06, 0xdf, 0x3d, 0x70, 0x36, 0xa1,
+	0x6a, 0xfd, 0x84, 0x46, 0xe5, 0x5b, 0xcd, 0xf9, 0x07, 0x92, 0xd2, 0x31, 0xfd, 0xa2, 0x69, 0xa1,
+	0xe0, 0x09, 0xe8, 0x2e, 0x34, 0xe7, 0xb3, 0x8c, 0xa4, 0xf4, 0xf6, 0xb8, 0xf6, 0x16, 0xb7, 0x9c,
+	0xd5, 0xc2, 0x5a, 0x0d, 0x85, 0xed, 0xfc, 0xbb, 0xb0, 0xf6, 0x6a, 0x61, 0xc1, 0x57, 0x0f, 0x3c,
+	0xdf, 0x90, 0xaa, 0xc8, 0x45, 0x56, 0x50, 0x38, 0x05, 0x47, 0x7f, 0xdd, 0x6c, 0x1b, 0xaf, 0x37,
+	0x7c, 0x89, 0x1a, 0xae, 0x36, 0x5a, 0x9f, 0x3d, 0x3e, 0x94, 0x6b, 0x48, 0x70, 0x0d, 0xce, 0xc2,
+	0x6d, 0xab, 0xb1, 0x5b, 0x09, 0xff, 0xf7, 0x56, 0x86, 0xbf, 0x5b, 0xe0, 0x78, 0x9d, 0x36, 0xa1,
+	0xf2, 0x86, 0xc5, 0x14, 0xfe, 0xf2, 0xc0, 0x69, 0x63, 0xd9, 0xf0, 0x75, 0xa3, 0xfd, 0x7d, 0xae,
+	0x4f, 0x7f, 0xb4, 0xcd, 0x08, 0x57, 0x50, 0xd0, 0xfe, 0xf6, 0x23, 0x68, 0xd9, 0xb8, 0xe1, 0x16,
+	0x71, 0xc3, 0xed, 0xe3, 0x86, 0xf7, 0x8e, 0xeb, 0x8d, 0x3e, 0x4f, 0xa7, 0x09, 0x53, 0x4b, 0x1d,
+	0xa1, 0x58, 0xa4, 0x38, 0xd2, 0x8b, 0x48, 0x33, 0x3e, 0x37, 0x1f, 0x98, 0x65, 0x8a, 0xca, 0x8c,
+	0x70, 0x9c, 0xd0, 0xcc, 0x3d, 0xa0, 0x38, 0x11, 0xb8, 0xe1, 0x11, 0xbf, 0xaa, 0x90, 0x0a, 0x88,
+	0x3a, 0x56, 0x76, 0xf9, 0x67, 0x00, 0xe0, 0x6b, 0xb8, 0x27, 0xfb, 0x05, 0x00, 0x00,
+}
@@ -0,0 +1,156 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package registryv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// RepositoryMirrorServiceClient is the client API for RepositoryMirrorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RepositoryMirrorServiceClient interface {
+	// SetRepositoryMirrorByFullName configures the repository to be mirrored from a git remote.
+	//
+	// This overwrites any existing mirror configuration for the repository.
+	SetRepositoryMirrorByFullName(ctx context.Context, in *SetRepositoryMirrorByFullNameRequest, opts ...grpc.CallOption) (*SetRepositoryMirrorByFullNameResponse, error)
+	// GetRepositoryMirrorByFullName gets the mirror configuration and last sync status of a repository.
+	GetRepositoryMirrorByFullName(ctx context.Context, in *GetRepositoryMirrorByFullNameRequest, opts ...grpc.CallOption) (*GetRepositoryMirrorByFullNameResponse, error)
+}
+
+type repositoryMirrorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRepositoryMirrorServiceClient(cc grpc.ClientConnInterface) RepositoryMirrorServiceClient {
+	return &repositoryMirrorServiceClient{cc}
+}
+
+func (c *repositoryMirrorServiceClient) SetRepositoryMirrorByFullName(ctx context.Context, in *SetRepositoryMirrorByFullNameRequest, opts ...grpc.CallOption) (*SetRepositoryMirrorByFullNameResponse, error) {
+	out := new(SetRepositoryMirrorByFullNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryMirrorService/SetRepositoryMirrorByFullName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryMirrorServiceClient) GetRepositoryMirrorByFullName(ctx context.Context, in *GetRepositoryMirrorByFullNameRequest, opts ...grpc.CallOption) (*GetRepositoryMirrorByFullNameResponse, error) {
+	out := new(GetRepositoryMirrorByFullNameResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryMirrorService/GetRepositoryMirrorByFullName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RepositoryMirrorServiceServer is the server API for RepositoryMirrorService service.
+// All implementations should embed UnimplementedRepositoryMirrorServiceServer
+// for forward compatibility
+type RepositoryMirrorServiceServer interface {
+	// SetRepositoryMirrorByFullName configures the repository to be mirrored from a git remote.
+	//
+	// This overwrites any existing mirror configuration for the repository.
+	SetRepositoryMirrorByFullName(context.Context, *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error)
+	// GetRepositoryMirrorByFullName gets the mirror configuration and last sync status of a repository.
+	GetRepositoryMirrorByFullName(context.Context, *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error)
+}
+
+// UnimplementedRepositoryMirrorServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedRepositoryMirrorServiceServer struct {
+}
+
+func (UnimplementedRepositoryMirrorServiceServer) SetRepositoryMirrorByFullName(context.Context, *SetRepositoryMirrorByFullNameRequest) (*SetRepositoryMirrorByFullNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRepositoryMirrorByFullName not implemented")
+}
+func (UnimplementedRepositoryMirrorServiceServer) GetRepositoryMirrorByFullName(context.Context, *GetRepositoryMirrorByFullNameRequest) (*GetRepositoryMirrorByFullNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositoryMirrorByFullName not implemented")
+}
+
+// UnsafeRepositoryMirrorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RepositoryMirrorServiceServer will
+// result in compilation errors.
+type UnsafeRepositoryMirrorServiceServer interface {
+	mustEmbedUnimplementedRepositoryMirrorServiceServer()
+}
+
+func RegisterRepositoryMirrorServiceServer(s grpc.ServiceRegistrar, srv RepositoryMirrorServiceServer) {
+	s.RegisterService(&RepositoryMirrorService_ServiceDesc, srv)
+}
+
+func _RepositoryMirrorService_SetRepositoryMirrorByFullName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepositoryMirrorByFullNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryMirrorServiceServer).SetRepositoryMirrorByFullName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryMirrorService/SetRepositoryMirrorByFullName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryMirrorServiceServer).SetRepositoryMirrorByFullName(ctx, req.(*SetRepositoryMirrorByFullNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryMirrorService_GetRepositoryMirrorByFullName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepositoryMirrorByFullNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryMirrorServiceServer).GetRepositoryMirrorByFullName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryMirrorService/GetRepositoryMirrorByFullName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryMirrorServiceServer).GetRepositoryMirrorByFullName(ctx, req.(*GetRepositoryMirrorByFullNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RepositoryMirrorService_ServiceDesc is the grpc.ServiceDesc for RepositoryMirrorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RepositoryMirrorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buf.alpha.registry.v1alpha1.RepositoryMirrorService",
+	HandlerType: (*RepositoryMirrorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetRepositoryMirrorByFullName",
+			Handler:    _RepositoryMirrorService_SetRepositoryMirrorByFullName_Handler,
+		},
+		{
+			MethodName: "GetRepositoryMirrorByFullName",
+			Handler:    _RepositoryMirrorService_GetRepositoryMirrorByFullName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "buf/alpha/registry/v1alpha1/repository_mirror.proto",
+}
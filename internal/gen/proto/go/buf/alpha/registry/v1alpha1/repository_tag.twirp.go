@@ -47,6 +47,20 @@ type RepositoryTagService interface {
 
 	// ListRepositoryTags lists the repository tags associated with a Repository.
 	ListRepositoryTags(context.Context, *ListRepositoryTagsRequest) (*ListRepositoryTagsResponse, error)
+
+	// DeleteRepositoryTag deletes a repository tag.
+	DeleteRepositoryTag(context.Context, *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error)
+
+	// UpdateRepositoryTag moves an existing repository tag to a different commit.
+	//
+	// The tag is moved atomically.
+	UpdateRepositoryTag(context.Context, *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error)
+
+	// ListRepositoryTagHistory lists the commits a repository tag has pointed to,
+	// including the commit it currently points to.
+	//
+	// Entries are returned from the most recent to the oldest, unless reverse is set.
+	ListRepositoryTagHistory(context.Context, *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error)
 }
 
 // ====================================
@@ -55,7 +69,7 @@ type RepositoryTagService interface {
 
 type repositoryTagServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [5]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -75,9 +89,12 @@ func NewRepositoryTagServiceProtobufClient(baseURL string, client HTTPClient, op
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryTagService")
-	urls := [2]string{
+	urls := [5]string{
 		serviceURL + "CreateRepositoryTag",
 		serviceURL + "ListRepositoryTags",
+		serviceURL + "DeleteRepositoryTag",
+		serviceURL + "UpdateRepositoryTag",
+		serviceURL + "ListRepositoryTagHistory",
 	}
 
 	return &repositoryTagServiceProtobufClient{
@@ -180,13 +197,151 @@ func (c *repositoryTagServiceProtobufClient) callListRepositoryTags(ctx context.
 	return out, nil
 }
 
+func (c *repositoryTagServiceProtobufClient) DeleteRepositoryTag(ctx context.Context, in *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryTagService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRepositoryTag")
+	caller := c.callDeleteRepositoryTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRepositoryTagRequest) when calling interceptor")
+					}
+					return c.callDeleteRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryTagServiceProtobufClient) callDeleteRepositoryTag(ctx context.Context, in *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+	out := new(DeleteRepositoryTagResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryTagServiceProtobufClient) UpdateRepositoryTag(ctx context.Context, in *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryTagService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryTag")
+	caller := c.callUpdateRepositoryTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryTagRequest) when calling interceptor")
+					}
+					return c.callUpdateRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryTagServiceProtobufClient) callUpdateRepositoryTag(ctx context.Context, in *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+	out := new(UpdateRepositoryTagResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryTagServiceProtobufClient) ListRepositoryTagHistory(ctx context.Context, in *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryTagService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListRepositoryTagHistory")
+	caller := c.callListRepositoryTagHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRepositoryTagHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRepositoryTagHistoryRequest) when calling interceptor")
+					}
+					return c.callListRepositoryTagHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRepositoryTagHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRepositoryTagHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryTagServiceProtobufClient) callListRepositoryTagHistory(ctx context.Context, in *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+	out := new(ListRepositoryTagHistoryResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ================================
 // RepositoryTagService JSON Client
 // ================================
 
 type repositoryTagServiceJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [5]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -206,9 +361,12 @@ func NewRepositoryTagServiceJSONClient(baseURL string, client HTTPClient, opts .
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "RepositoryTagService")
-	urls := [2]string{
+	urls := [5]string{
 		serviceURL + "CreateRepositoryTag",
 		serviceURL + "ListRepositoryTags",
+		serviceURL + "DeleteRepositoryTag",
+		serviceURL + "UpdateRepositoryTag",
+		serviceURL + "ListRepositoryTagHistory",
 	}
 
 	return &repositoryTagServiceJSONClient{
@@ -311,6 +469,144 @@ func (c *repositoryTagServiceJSONClient) callListRepositoryTags(ctx context.Cont
 	return out, nil
 }
 
+func (c *repositoryTagServiceJSONClient) DeleteRepositoryTag(ctx context.Context, in *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryTagService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRepositoryTag")
+	caller := c.callDeleteRepositoryTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRepositoryTagRequest) when calling interceptor")
+					}
+					return c.callDeleteRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryTagServiceJSONClient) callDeleteRepositoryTag(ctx context.Context, in *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+	out := new(DeleteRepositoryTagResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryTagServiceJSONClient) UpdateRepositoryTag(ctx context.Context, in *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryTagService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryTag")
+	caller := c.callUpdateRepositoryTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryTagRequest) when calling interceptor")
+					}
+					return c.callUpdateRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryTagServiceJSONClient) callUpdateRepositoryTag(ctx context.Context, in *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+	out := new(UpdateRepositoryTagResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *repositoryTagServiceJSONClient) ListRepositoryTagHistory(ctx context.Context, in *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "RepositoryTagService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListRepositoryTagHistory")
+	caller := c.callListRepositoryTagHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRepositoryTagHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRepositoryTagHistoryRequest) when calling interceptor")
+					}
+					return c.callListRepositoryTagHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRepositoryTagHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRepositoryTagHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *repositoryTagServiceJSONClient) callListRepositoryTagHistory(ctx context.Context, in *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+	out := new(ListRepositoryTagHistoryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===================================
 // RepositoryTagService Server Handler
 // ===================================
@@ -401,6 +697,15 @@ func (s *repositoryTagServiceServer) ServeHTTP(resp http.ResponseWriter, req *ht
 	case "ListRepositoryTags":
 		s.serveListRepositoryTags(ctx, resp, req)
 		return
+	case "DeleteRepositoryTag":
+		s.serveDeleteRepositoryTag(ctx, resp, req)
+		return
+	case "UpdateRepositoryTag":
+		s.serveUpdateRepositoryTag(ctx, resp, req)
+		return
+	case "ListRepositoryTagHistory":
+		s.serveListRepositoryTagHistory(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -758,8 +1063,533 @@ func (s *repositoryTagServiceServer) serveListRepositoryTagsProtobuf(ctx context
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *repositoryTagServiceServer) serveDeleteRepositoryTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteRepositoryTagJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteRepositoryTagProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryTagServiceServer) serveDeleteRepositoryTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRepositoryTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(DeleteRepositoryTagRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryTagService.DeleteRepositoryTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRepositoryTagRequest) when calling interceptor")
+					}
+					return s.RepositoryTagService.DeleteRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteRepositoryTagResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteRepositoryTagResponse and nil error while calling DeleteRepositoryTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryTagServiceServer) serveDeleteRepositoryTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRepositoryTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(DeleteRepositoryTagRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryTagService.DeleteRepositoryTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRepositoryTagRequest) when calling interceptor")
+					}
+					return s.RepositoryTagService.DeleteRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteRepositoryTagResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteRepositoryTagResponse and nil error while calling DeleteRepositoryTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryTagServiceServer) serveUpdateRepositoryTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateRepositoryTagJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateRepositoryTagProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryTagServiceServer) serveUpdateRepositoryTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(UpdateRepositoryTagRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryTagService.UpdateRepositoryTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryTagRequest) when calling interceptor")
+					}
+					return s.RepositoryTagService.UpdateRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateRepositoryTagResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateRepositoryTagResponse and nil error while calling UpdateRepositoryTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryTagServiceServer) serveUpdateRepositoryTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepositoryTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(UpdateRepositoryTagRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryTagService.UpdateRepositoryTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepositoryTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepositoryTagRequest) when calling interceptor")
+					}
+					return s.RepositoryTagService.UpdateRepositoryTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateRepositoryTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateRepositoryTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateRepositoryTagResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateRepositoryTagResponse and nil error while calling UpdateRepositoryTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryTagServiceServer) serveListRepositoryTagHistory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListRepositoryTagHistoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListRepositoryTagHistoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *repositoryTagServiceServer) serveListRepositoryTagHistoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListRepositoryTagHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(ListRepositoryTagHistoryRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryTagService.ListRepositoryTagHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRepositoryTagHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRepositoryTagHistoryRequest) when calling interceptor")
+					}
+					return s.RepositoryTagService.ListRepositoryTagHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRepositoryTagHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRepositoryTagHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListRepositoryTagHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListRepositoryTagHistoryResponse and nil error while calling ListRepositoryTagHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *repositoryTagServiceServer) serveListRepositoryTagHistoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListRepositoryTagHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(ListRepositoryTagHistoryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RepositoryTagService.ListRepositoryTagHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRepositoryTagHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRepositoryTagHistoryRequest) when calling interceptor")
+					}
+					return s.RepositoryTagService.ListRepositoryTagHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRepositoryTagHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRepositoryTagHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListRepositoryTagHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListRepositoryTagHistoryResponse and nil error while calling ListRepositoryTagHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *repositoryTagServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor6, 0
+	return twirpFileDescriptor10, 0
 }
 
 func (s *repositoryTagServiceServer) ProtocGenTwirpVersion() string {
@@ -773,38 +1603,52 @@ func (s *repositoryTagServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "RepositoryTagService")
 }
 
-var twirpFileDescriptor6 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x99, 0x6c, 0xd5, 0xdd, 0xb7, 0xb6, 0x0b, 0xa3, 0x87, 0x98, 0x22, 0x5b, 0x22, 0xc8,
-	0xe2, 0x21, 0x71, 0x2b, 0xb8, 0xc2, 0xde, 0xf4, 0x24, 0x88, 0xb8, 0x69, 0x4f, 0x8b, 0x10, 0x26,
-	0xed, 0xdb, 0xec, 0x60, 0x93, 0x89, 0x33, 0x93, 0xe2, 0xee, 0x27, 0x10, 0x04, 0xc1, 0x8b, 0xe2,
-	0x07, 0xf1, 0xfb, 0x49, 0x66, 0x36, 0x6d, 0x43, 0xff, 0x40, 0x6f, 0x99, 0x67, 0x9e, 0xe7, 0x97,
-	0x37, 0x4f, 0x86, 0x81, 0x97, 0x49, 0x39, 0x0d, 0xd9, 0xac, 0xb8, 0x66, 0xa1, 0xc4, 0x94, 0x2b,
-	0x2d, 0x6f, 0xc2, 0xf9, 0x99, 0x11, 0xce, 0x42, 0x89, 0x85, 0x50, 0x5c, 0x0b, 0x79, 0x13, 0x6b,
-	0x96, 0x06, 0x85, 0x14, 0x5a, 0xd0, 0x5e, 0x52, 0x4e, 0x03, 0x63, 0x08, 0xea, 0x44, 0x50, 0x27,
-	0xbc, 0xfe, 0x12, 0xc7, 0x0a, 0xbe, 0x24, 0xb1, 0x82, 0xdb, 0xb8, 0x77, 0x92, 0x0a, 0x91, 0xce,
-	0x30, 0x34, 0xab, 0xca, 0xad, 0x79, 0x86, 0x4a, 0xb3, 0xac, 0xb0, 0x06, 0xff, 0x17, 0x81, 0x4e,
-	0xb4, 0x78, 0xf1, 0x88, 0xa5, 0xb4, 0x0b, 0x0e, 0x9f, 0xb8, 0xa4, 0x4f, 0x4e, 0x8f, 0x22, 0x87,
-	0x4f, 0xe8, 0x05, 0xb4, 0xc7, 0x12, 0x99, 0xc6, 0xb8, 0xca, 0xba, 0x4e, 0x9f, 0x9c, 0xb6, 0x07,
-	0x5e, 0x60, 0xc1, 0x41, 0x0d, 0x0e, 0x46, 0x35, 0x38, 0x02, 0x6b, 0xaf, 0x04, 0x4a, 0xa1, 0x95,
-	0xb3, 0x0c, 0xdd, 0x96, 0xc1, 0x99, 0x67, 0x7a, 0x02, 0xed, 0xb1, 0xc8, 0x32, 0xae, 0x63, 0xb3,
-	0x75, 0xcf, 0x6c, 0x81, 0x95, 0x3e, 0xb2, 0x0c, 0xfd, 0x4b, 0xf0, 0xde, 0x19, 0x44, 0x63, 0xb0,
-	0x08, 0xbf, 0x96, 0xa8, 0xf4, 0x02, 0x49, 0xb6, 0x23, 0x9d, 0x35, 0x64, 0x01, 0xbd, 0x8d, 0x48,
-	0x55, 0x88, 0x5c, 0x21, 0xbd, 0x84, 0x6e, 0xb3, 0x7d, 0x43, 0x6f, 0x0f, 0x5e, 0x04, 0x3b, 0xea,
-	0x0f, 0x9a, 0xac, 0x8e, 0x5c, 0x5d, 0xfa, 0xbf, 0x09, 0x3c, 0xf9, 0xc0, 0x95, 0x6e, 0x98, 0x54,
-	0xfd, 0x11, 0xcf, 0x60, 0xc5, 0x1e, 0x2f, 0xfa, 0x7e, 0xb8, 0x14, 0xdf, 0x4f, 0x68, 0x0f, 0x8e,
-	0x0a, 0x96, 0x62, 0xac, 0xf8, 0xad, 0xfd, 0xa6, 0x4e, 0x74, 0x58, 0x09, 0x43, 0x7e, 0x8b, 0xf4,
-	0x29, 0x80, 0xd9, 0xd4, 0xe2, 0x0b, 0xe6, 0xee, 0x81, 0x89, 0x1b, 0xfb, 0xa8, 0x12, 0xa8, 0x0b,
-	0x0f, 0x24, 0xce, 0x51, 0x2a, 0xdb, 0xfd, 0x61, 0x54, 0x2f, 0xfd, 0xbf, 0x04, 0xbc, 0x4d, 0x83,
-	0xdd, 0x55, 0x31, 0x84, 0xe3, 0x66, 0x15, 0xca, 0x25, 0xfd, 0x83, 0x3d, 0xbb, 0xe8, 0x36, 0xba,
-	0x50, 0xf4, 0x39, 0x1c, 0xe7, 0xf8, 0x4d, 0xc7, 0x2b, 0x13, 0xdb, 0x7f, 0xd4, 0xa9, 0xe4, 0x4f,
-	0xf5, 0xd4, 0x83, 0x7f, 0x0e, 0x3c, 0x6e, 0x90, 0x86, 0x28, 0xe7, 0x7c, 0x8c, 0xf4, 0x27, 0x81,
-	0x47, 0x1b, 0x7e, 0x20, 0x3d, 0xdf, 0x39, 0xd4, 0xf6, 0x53, 0xe4, 0xbd, 0xd9, 0x3f, 0x68, 0x0b,
-	0xf2, 0x5b, 0xdf, 0xff, 0xf8, 0x0e, 0xfd, 0x41, 0x80, 0xae, 0xb7, 0x48, 0x5f, 0xef, 0xc4, 0x6e,
-	0x3d, 0x0f, 0xde, 0xf9, 0xde, 0xb9, 0x95, 0x69, 0xc8, 0xdb, 0xcf, 0x57, 0x57, 0x29, 0xd7, 0xd7,
-	0x65, 0x12, 0x8c, 0x45, 0x16, 0x26, 0xe5, 0x34, 0x29, 0xf9, 0x6c, 0x52, 0x3d, 0x84, 0x3c, 0xd7,
-	0x28, 0x73, 0x36, 0x0b, 0x53, 0xcc, 0xed, 0x35, 0x10, 0xa6, 0x22, 0xdc, 0x71, 0x0f, 0x5d, 0xd4,
-	0x4a, 0x2d, 0x24, 0xf7, 0x4d, 0xec, 0xd5, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x26, 0x00,
-	0xc6, 0xbe, 0x04, 0x00, 0x00,
+var twirpFileDescriptor10 = []byte{
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xd6, 0x24, 0xe9, 0x6d, 0x7b, 0x72, 0xd3, 0x4a, 0x73, 0xbb, 0x48, 0x1d, 0xf5, 0x36, 0xf2,
+	0x95, 0xae, 0x2a, 0x16, 0x76, 0x5b, 0x04, 0xad, 0x54, 0xd8, 0x50, 0x90, 0x40, 0x42, 0x08, 0xdc,
+	0x74, 0x53, 0x21, 0x59, 0x93, 0x64, 0xea, 0x8e, 0x88, 0x7f, 0x98, 0x19, 0x47, 0xb4, 0xbc, 0x00,
+	0x12, 0x12, 0x12, 0x1b, 0x10, 0x0b, 0x36, 0x3c, 0x01, 0x6f, 0xc1, 0x23, 0xb1, 0x45, 0x1e, 0xc7,
+	0x4d, 0xa6, 0xb6, 0xd3, 0x84, 0x9f, 0x9d, 0x7d, 0xe6, 0x7c, 0x67, 0xbe, 0xf3, 0xf9, 0x3b, 0x47,
+	0x86, 0xed, 0x6e, 0x7c, 0x6a, 0x93, 0x41, 0x74, 0x46, 0x6c, 0x4e, 0x3d, 0x26, 0x24, 0x3f, 0xb7,
+	0x87, 0x3b, 0x2a, 0xb0, 0x63, 0x73, 0x1a, 0x85, 0x82, 0xc9, 0x90, 0x9f, 0xbb, 0x92, 0x78, 0x56,
+	0xc4, 0x43, 0x19, 0xe2, 0x56, 0x37, 0x3e, 0xb5, 0x54, 0x82, 0x95, 0x21, 0xac, 0x0c, 0x61, 0xb4,
+	0xc7, 0xe5, 0x48, 0xc4, 0xc6, 0x95, 0x48, 0xc4, 0x52, 0xb8, 0xb1, 0xe9, 0x85, 0xa1, 0x37, 0xa0,
+	0xb6, 0x7a, 0x4b, 0xb2, 0x25, 0xf3, 0xa9, 0x90, 0xc4, 0x8f, 0xd2, 0x04, 0xf3, 0x3d, 0x82, 0x86,
+	0x73, 0x79, 0x71, 0x87, 0x78, 0x78, 0x05, 0x2a, 0xac, 0xdf, 0x44, 0x6d, 0xb4, 0xb5, 0xec, 0x54,
+	0x58, 0x1f, 0x1f, 0x40, 0xbd, 0xc7, 0x29, 0x91, 0xd4, 0x4d, 0xb0, 0xcd, 0x4a, 0x1b, 0x6d, 0xd5,
+	0x77, 0x0d, 0x2b, 0x2d, 0x6c, 0x65, 0x85, 0xad, 0x4e, 0x56, 0xd8, 0x81, 0x34, 0x3d, 0x09, 0x60,
+	0x0c, 0xb5, 0x80, 0xf8, 0xb4, 0x59, 0x53, 0xe5, 0xd4, 0x33, 0xde, 0x84, 0x7a, 0x2f, 0xf4, 0x7d,
+	0x26, 0x5d, 0x75, 0xb4, 0xa0, 0x8e, 0x20, 0x0d, 0x3d, 0x21, 0x3e, 0x35, 0x05, 0xac, 0x6b, 0x94,
+	0x1e, 0x32, 0x91, 0x3c, 0x3d, 0x08, 0x24, 0x3f, 0xbf, 0x8a, 0x46, 0x57, 0xd1, 0xf8, 0x16, 0x2c,
+	0x49, 0xe2, 0xcd, 0x4a, 0x76, 0x51, 0x12, 0x2f, 0x79, 0x33, 0x87, 0x60, 0x1c, 0x2a, 0xde, 0xda,
+	0xd5, 0x0e, 0x7d, 0x19, 0x53, 0x21, 0x2f, 0xfb, 0x40, 0xe5, 0x7d, 0x54, 0x72, 0x4c, 0xfe, 0x83,
+	0xc6, 0xc4, 0x37, 0x65, 0xfd, 0x66, 0x55, 0xa5, 0xfc, 0x3d, 0x0e, 0x3e, 0xea, 0x9b, 0x11, 0xb4,
+	0x0a, 0xef, 0x15, 0x51, 0x18, 0x08, 0x8a, 0x9f, 0xc1, 0x8a, 0xee, 0x0b, 0x45, 0xa1, 0xbe, 0x7b,
+	0xc3, 0x9a, 0x62, 0x0c, 0x4b, 0xaf, 0x35, 0xc1, 0xa2, 0x43, 0x3c, 0xf3, 0x03, 0x82, 0xf5, 0xc7,
+	0x4c, 0x48, 0x2d, 0x49, 0x64, 0x9d, 0xe6, 0x48, 0xa3, 0x3c, 0x69, 0xdc, 0x82, 0xe5, 0x88, 0x78,
+	0xd4, 0x15, 0xec, 0x22, 0x6d, 0xbc, 0xe1, 0x2c, 0x25, 0x81, 0x23, 0x76, 0x41, 0xf1, 0x06, 0x80,
+	0x3a, 0x94, 0xe1, 0x0b, 0x1a, 0x8c, 0x7a, 0x56, 0xe9, 0x9d, 0x24, 0x80, 0x9b, 0xb0, 0xc8, 0xe9,
+	0x90, 0x72, 0x91, 0xba, 0x62, 0xc9, 0xc9, 0x5e, 0xcd, 0x4f, 0x08, 0x8c, 0x22, 0x62, 0x23, 0x29,
+	0x8e, 0x60, 0x55, 0x97, 0x42, 0x34, 0x51, 0xbb, 0x3a, 0xa7, 0x16, 0x2b, 0x9a, 0x16, 0x02, 0xff,
+	0x0f, 0xab, 0x01, 0x7d, 0x25, 0xdd, 0x09, 0xc6, 0xe9, 0x87, 0x6c, 0x24, 0xe1, 0xa7, 0x19, 0x6b,
+	0xf3, 0x18, 0x8c, 0xfb, 0x74, 0x40, 0x4b, 0xec, 0x31, 0x93, 0x68, 0x99, 0x87, 0x2a, 0x63, 0x0f,
+	0x99, 0x1b, 0xd0, 0x2a, 0x2c, 0x9b, 0xb6, 0x9c, 0x98, 0xf2, 0x38, 0xea, 0x93, 0xdf, 0x7c, 0xeb,
+	0x55, 0xe7, 0x56, 0x73, 0x13, 0xf8, 0x05, 0x41, 0xab, 0xf0, 0xe2, 0x3f, 0xe6, 0x4a, 0xbc, 0x0d,
+	0x6b, 0x11, 0xa7, 0x43, 0x16, 0xc6, 0xc2, 0xcd, 0x8f, 0x15, 0xce, 0xce, 0x0e, 0xc7, 0x24, 0xbf,
+	0x22, 0xd8, 0xcc, 0xd9, 0x65, 0xb4, 0x2b, 0x7e, 0x59, 0x22, 0xcd, 0xe1, 0xd5, 0xa9, 0x0e, 0xaf,
+	0x4d, 0x71, 0xf8, 0x82, 0xee, 0xf0, 0x6f, 0x08, 0xda, 0xe5, 0x94, 0x47, 0xe2, 0xbe, 0x86, 0x7f,
+	0x75, 0x71, 0xdd, 0xb3, 0x34, 0xc3, 0xa5, 0x81, 0xe4, 0x8c, 0x66, 0xb6, 0xbf, 0x3d, 0xbb, 0xd8,
+	0x93, 0x1b, 0xd4, 0x69, 0xf1, 0x92, 0x23, 0x46, 0x67, 0x9e, 0x87, 0xdd, 0xef, 0x0b, 0xb0, 0xa6,
+	0x5d, 0x71, 0x44, 0xf9, 0x90, 0xf5, 0x28, 0x7e, 0x87, 0xe0, 0x9f, 0x82, 0x85, 0x86, 0xf7, 0xa6,
+	0xb2, 0x2d, 0x5f, 0xbd, 0xc6, 0xfe, 0xfc, 0xc0, 0xd1, 0xf4, 0xd4, 0xde, 0x7c, 0x34, 0x2b, 0xf8,
+	0x2d, 0x02, 0x9c, 0xdf, 0x2a, 0x78, 0xba, 0x7a, 0xa5, 0xfb, 0xd1, 0xd8, 0x9b, 0x1b, 0x37, 0xc1,
+	0x06, 0x29, 0x79, 0x0a, 0x26, 0xfe, 0x1a, 0x79, 0xca, 0x57, 0x8f, 0xb1, 0x3f, 0x3f, 0x50, 0x93,
+	0x27, 0x21, 0x54, 0x30, 0xea, 0xd7, 0x10, 0x2a, 0xdf, 0x4a, 0xc6, 0xfe, 0xfc, 0x40, 0x8d, 0xd0,
+	0x67, 0x04, 0xcd, 0xb2, 0x19, 0xc1, 0x77, 0xe6, 0x53, 0x5f, 0xdf, 0x06, 0xc6, 0xdd, 0x9f, 0x44,
+	0x4f, 0x7e, 0xc1, 0x7b, 0xcf, 0x4f, 0x4e, 0x3c, 0x26, 0xcf, 0xe2, 0xae, 0xd5, 0x0b, 0x7d, 0xbb,
+	0x1b, 0x9f, 0x76, 0x63, 0x36, 0xe8, 0x27, 0x0f, 0x36, 0x0b, 0x24, 0xe5, 0x01, 0x19, 0xd8, 0x1e,
+	0x0d, 0xd2, 0x5f, 0x2e, 0xdb, 0x0b, 0xed, 0x29, 0xff, 0x7c, 0x07, 0x59, 0x24, 0x0b, 0x74, 0xff,
+	0x52, 0xb0, 0x9b, 0x3f, 0x06, 0x00, 0x5c, 0x3b, 0x55, 0xf5, 0x2a, 0x0a, 0x00, 0x00,
 }
@@ -35,6 +35,17 @@ type RepositoryTagServiceClient interface {
 	CreateRepositoryTag(ctx context.Context, in *CreateRepositoryTagRequest, opts ...grpc.CallOption) (*CreateRepositoryTagResponse, error)
 	// ListRepositoryTags lists the repository tags associated with a Repository.
 	ListRepositoryTags(ctx context.Context, in *ListRepositoryTagsRequest, opts ...grpc.CallOption) (*ListRepositoryTagsResponse, error)
+	// DeleteRepositoryTag deletes a repository tag.
+	DeleteRepositoryTag(ctx context.Context, in *DeleteRepositoryTagRequest, opts ...grpc.CallOption) (*DeleteRepositoryTagResponse, error)
+	// UpdateRepositoryTag moves an existing repository tag to a different commit.
+	//
+	// The tag is moved atomically.
+	UpdateRepositoryTag(ctx context.Context, in *UpdateRepositoryTagRequest, opts ...grpc.CallOption) (*UpdateRepositoryTagResponse, error)
+	// ListRepositoryTagHistory lists the commits a repository tag has pointed to,
+	// including the commit it currently points to.
+	//
+	// Entries are returned from the most recent to the oldest, unless reverse is set.
+	ListRepositoryTagHistory(ctx context.Context, in *ListRepositoryTagHistoryRequest, opts ...grpc.CallOption) (*ListRepositoryTagHistoryResponse, error)
 }
 
 type repositoryTagServiceClient struct {
@@ -63,6 +74,33 @@ func (c *repositoryTagServiceClient) ListRepositoryTags(ctx context.Context, in
 	return out, nil
 }
 
+func (c *repositoryTagServiceClient) DeleteRepositoryTag(ctx context.Context, in *DeleteRepositoryTagRequest, opts ...grpc.CallOption) (*DeleteRepositoryTagResponse, error) {
+	out := new(DeleteRepositoryTagResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryTagService/DeleteRepositoryTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryTagServiceClient) UpdateRepositoryTag(ctx context.Context, in *UpdateRepositoryTagRequest, opts ...grpc.CallOption) (*UpdateRepositoryTagResponse, error) {
+	out := new(UpdateRepositoryTagResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryTagService/UpdateRepositoryTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryTagServiceClient) ListRepositoryTagHistory(ctx context.Context, in *ListRepositoryTagHistoryRequest, opts ...grpc.CallOption) (*ListRepositoryTagHistoryResponse, error) {
+	out := new(ListRepositoryTagHistoryResponse)
+	err := c.cc.Invoke(ctx, "/buf.alpha.registry.v1alpha1.RepositoryTagService/ListRepositoryTagHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryTagServiceServer is the server API for RepositoryTagService service.
 // All implementations should embed UnimplementedRepositoryTagServiceServer
 // for forward compatibility
@@ -71,6 +109,17 @@ type RepositoryTagServiceServer interface {
 	CreateRepositoryTag(context.Context, *CreateRepositoryTagRequest) (*CreateRepositoryTagResponse, error)
 	// ListRepositoryTags lists the repository tags associated with a Repository.
 	ListRepositoryTags(context.Context, *ListRepositoryTagsRequest) (*ListRepositoryTagsResponse, error)
+	// DeleteRepositoryTag deletes a repository tag.
+	DeleteRepositoryTag(context.Context, *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error)
+	// UpdateRepositoryTag moves an existing repository tag to a different commit.
+	//
+	// The tag is moved atomically.
+	UpdateRepositoryTag(context.Context, *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error)
+	// ListRepositoryTagHistory lists the commits a repository tag has pointed to,
+	// including the commit it currently points to.
+	//
+	// Entries are returned from the most recent to the oldest, unless reverse is set.
+	ListRepositoryTagHistory(context.Context, *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error)
 }
 
 // UnimplementedRepositoryTagServiceServer should be embedded to have forward compatible implementations.
@@ -83,6 +132,15 @@ func (UnimplementedRepositoryTagServiceServer) CreateRepositoryTag(context.Conte
 func (UnimplementedRepositoryTagServiceServer) ListRepositoryTags(context.Context, *ListRepositoryTagsRequest) (*ListRepositoryTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepositoryTags not implemented")
 }
+func (UnimplementedRepositoryTagServiceServer) DeleteRepositoryTag(context.Context, *DeleteRepositoryTagRequest) (*DeleteRepositoryTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepositoryTag not implemented")
+}
+func (UnimplementedRepositoryTagServiceServer) UpdateRepositoryTag(context.Context, *UpdateRepositoryTagRequest) (*UpdateRepositoryTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepositoryTag not implemented")
+}
+func (UnimplementedRepositoryTagServiceServer) ListRepositoryTagHistory(context.Context, *ListRepositoryTagHistoryRequest) (*ListRepositoryTagHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepositoryTagHistory not implemented")
+}
 
 // UnsafeRepositoryTagServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RepositoryTagServiceServer will
@@ -131,6 +189,60 @@ func _RepositoryTagService_ListRepositoryTags_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryTagService_DeleteRepositoryTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepositoryTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryTagServiceServer).DeleteRepositoryTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryTagService/DeleteRepositoryTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryTagServiceServer).DeleteRepositoryTag(ctx, req.(*DeleteRepositoryTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryTagService_UpdateRepositoryTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepositoryTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryTagServiceServer).UpdateRepositoryTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryTagService/UpdateRepositoryTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryTagServiceServer).UpdateRepositoryTag(ctx, req.(*UpdateRepositoryTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryTagService_ListRepositoryTagHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepositoryTagHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryTagServiceServer).ListRepositoryTagHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buf.alpha.registry.v1alpha1.RepositoryTagService/ListRepositoryTagHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryTagServiceServer).ListRepositoryTagHistory(ctx, req.(*ListRepositoryTagHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepositoryTagService_ServiceDesc is the grpc.ServiceDesc for RepositoryTagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +258,18 @@ var RepositoryTagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRepositoryTags",
 			Handler:    _RepositoryTagService_ListRepositoryTags_Handler,
 		},
+		{
+			MethodName: "DeleteRepositoryTag",
+			Handler:    _RepositoryTagService_DeleteRepositoryTag_Handler,
+		},
+		{
+			MethodName: "UpdateRepositoryTag",
+			Handler:    _RepositoryTagService_UpdateRepositoryTag_Handler,
+		},
+		{
+			MethodName: "ListRepositoryTagHistory",
+			Handler:    _RepositoryTagService_ListRepositoryTagHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "buf/alpha/registry/v1alpha1/repository_tag.proto",
@@ -492,7 +492,7 @@ func (s *resolveServiceServer) serveGetModulePinsProtobuf(ctx context.Context, r
 }
 
 func (s *resolveServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor7, 0
+	return twirpFileDescriptor11, 0
 }
 
 func (s *resolveServiceServer) ProtocGenTwirpVersion() string {
@@ -506,8 +506,8 @@ func (s *resolveServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "ResolveService")
 }
 
-var twirpFileDescriptor7 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
+var twirpFileDescriptor11 = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4f, 0x4b, 0xc4, 0x30,
 	0x10, 0xc5, 0x29, 0x8a, 0x87, 0x2c, 0x8a, 0x16, 0x05, 0xa9, 0x97, 0xa5, 0x88, 0xac, 0x1e, 0x12,
 	0x5a, 0x8f, 0xde, 0x04, 0xf1, 0x24, 0x2c, 0xf5, 0x20, 0x2c, 0xa2, 0x34, 0xdd, 0x69, 0x37, 0xd0,
@@ -526,5 +526,5 @@ var twirpFileDescriptor7 = []byte{
 	0x4d, 0x14, 0x6f, 0xbf, 0x7f, 0xc6, 0xc1, 0xf5, 0xe3, 0x62, 0x51, 0x31, 0xb3, 0xb2, 0x14, 0x17,
 	0xa2, 0x21, 0xd4, 0x96, 0xd4, 0xb2, 0x7a, 0xf9, 0xfd, 0x20, 0x8c, 0x1b, 0x50, 0x3c, 0xaf, 0x49,
 	0x05, 0x9c, 0x74, 0xf7, 0x20, 0x95, 0x20, 0x23, 0x37, 0xbe, 0xf2, 0x8a, 0x17, 0xe8, 0x4e, 0x17,
-	0xbb, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x58, 0x05, 0xab, 0xcc, 0x84, 0x02, 0x00, 0x00,
+	0xbb, 0xfc, 0x1a, 0x00, 0x58, 0x05, 0xab, 0xcc, 0x84, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,530 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-twirp v7.1.0, DO NOT EDIT.
+// source: buf/alpha/registry/v1alpha1/token.proto
+
+package registryv1alpha1
+
+import bytes "bytes"
+import strings "strings"
+import context "context"
+import fmt "fmt"
+import ioutil "io/ioutil"
+import http "net/http"
+import strconv "strconv"
+
+import jsonpb "github.com/golang/protobuf/jsonpb"
+import proto "github.com/golang/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the twirp package used in your project.
+// A compilation error at this line likely means your copy of the
+// twirp package needs to be updated.
+const _ = twirp.TwirpPackageIsVersion7
+
+// ======================
+// TokenService Interface
+// ======================
+
+// TokenService is the Token service.
+type TokenService interface {
+	// ListTokens lists the tokens of the current user.
+	//
+	// The token values are never returned.
+	ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error)
+}
+
+// ============================
+// TokenService Protobuf Client
+// ============================
+
+type tokenServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewTokenServiceProtobufClient creates a Protobuf client that implements the TokenService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewTokenServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TokenService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "TokenService")
+	urls := [1]string{
+		serviceURL + "ListTokens",
+	}
+
+	return &tokenServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *tokenServiceProtobufClient) ListTokens(ctx context.Context, in *ListTokensRequest) (*ListTokensResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "TokenService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTokens")
+	caller := c.callListTokens
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTokensRequest) (*ListTokensResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTokensRequest) when calling interceptor")
+					}
+					return c.callListTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTokensResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTokensResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tokenServiceProtobufClient) callListTokens(ctx context.Context, in *ListTokensRequest) (*ListTokensResponse, error) {
+	out := new(ListTokensResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ========================
+// TokenService JSON Client
+// ========================
+
+type tokenServiceJSONClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewTokenServiceJSONClient creates a JSON client that implements the TokenService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewTokenServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TokenService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "buf.alpha.registry.v1alpha1", "TokenService")
+	urls := [1]string{
+		serviceURL + "ListTokens",
+	}
+
+	return &tokenServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *tokenServiceJSONClient) ListTokens(ctx context.Context, in *ListTokensRequest) (*ListTokensResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "TokenService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTokens")
+	caller := c.callListTokens
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTokensRequest) (*ListTokensResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTokensRequest) when calling interceptor")
+					}
+					return c.callListTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTokensResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTokensResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tokenServiceJSONClient) callListTokens(ctx context.Context, in *ListTokensRequest) (*ListTokensResponse, error) {
+	out := new(ListTokensResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// TokenService Server Handler
+// ===========================
+
+type tokenServiceServer struct {
+	TokenService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewTokenServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewTokenServiceServer(svc TokenService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewTokenServiceServer", o))
+		}
+	}
+
+	return &tokenServiceServer{
+		TokenService:     svc,
+		pathPrefix:       serverOpts.PathPrefix(),
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:            serverOpts.Hooks,
+		jsonSkipDefaults: serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *tokenServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// TokenServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const TokenServicePathPrefix = "/twirp/buf.alpha.registry.v1alpha1.TokenService/"
+
+func (s *tokenServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "buf.alpha.registry.v1alpha1")
+	ctx = ctxsetters.WithServiceName(ctx, "TokenService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "buf.alpha.registry.v1alpha1.TokenService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "ListTokens":
+		s.serveListTokens(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *tokenServiceServer) serveListTokens(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListTokensJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListTokensProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tokenServiceServer) serveListTokensJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTokens")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(ListTokensRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.TokenService.ListTokens
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTokensRequest) (*ListTokensResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTokensRequest) when calling interceptor")
+					}
+					return s.TokenService.ListTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTokensResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTokensResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTokensResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTokensResponse and nil error while calling ListTokens. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tokenServiceServer) serveListTokensProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTokens")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(ListTokensRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TokenService.ListTokens
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTokensRequest) (*ListTokensResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTokensRequest) when calling interceptor")
+					}
+					return s.TokenService.ListTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTokensResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTokensResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTokensResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTokensResponse and nil error while calling ListTokens. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tokenServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor12, 0
+}
+
+func (s *tokenServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *tokenServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "TokenService")
+}
+
+var twirpFileDescriptor12 = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6f, 0xd3, 0x30,
+	0x14, 0xc6, 0x95, 0xae, 0x8c, 0xf5, 0x95, 0x81, 0xf0, 0x29, 0xca, 0x84, 0x88, 0x72, 0x80, 0x9e,
+	0x6c, 0xad, 0xdc, 0xd8, 0x8d, 0x33, 0x07, 0x94, 0xed, 0x34, 0x21, 0x55, 0x4e, 0xfb, 0x9a, 0x59,
+	0xa4, 0xb1, 0xb1, 0x9d, 0xaa, 0xec, 0xca, 0x85, 0xff, 0x80, 0xbf, 0x80, 0xff, 0x13, 0xf9, 0xb9,
+	0x56, 0x91, 0x2a, 0x95, 0xdd, 0xec, 0x2f, 0xdf, 0xef, 0x7b, 0x9f, 0x1d, 0xc3, 0xfb, 0x66, 0x58,
+	0x0b, 0xd9, 0x99, 0x07, 0x29, 0x2c, 0xb6, 0xca, 0x79, 0xfb, 0x43, 0x6c, 0xaf, 0x49, 0xb8, 0x16,
+	0x5e, 0x7f, 0xc3, 0x9e, 0x1b, 0xab, 0xbd, 0x66, 0x57, 0xcd, 0xb0, 0xe6, 0xa4, 0xf3, 0x64, 0xe4,
+	0xc9, 0x58, 0x94, 0x87, 0x14, 0x69, 0xd4, 0x21, 0x40, 0x1a, 0x15, 0xf1, 0xe2, 0x6d, 0xab, 0x75,
+	0xdb, 0xa1, 0xa0, 0x5d, 0x70, 0x7b, 0xb5, 0x41, 0xe7, 0xe5, 0xc6, 0x44, 0x43, 0xf5, 0x27, 0x83,
+	0x67, 0x77, 0x61, 0x1e, 0x7b, 0x09, 0x23, 0xb5, 0xca, 0xb3, 0x32, 0x9b, 0x4d, 0xea, 0x91, 0x5a,
+	0xb1, 0x1b, 0x98, 0x2e, 0x2d, 0x4a, 0x8f, 0x8b, 0xc0, 0xe4, 0xa3, 0x32, 0x9b, 0x4d, 0xe7, 0x05,
+	0x8f, 0x81, 0x3c, 0x05, 0xf2, 0xbb, 0x14, 0x58, 0x43, 0xb4, 0x07, 0x21, 0xc0, 0xb8, 0x33, 0xca,
+	0xee, 0xe1, 0xb3, 0xff, 0xc3, 0xd1, 0x4e, 0x30, 0x83, 0x71, 0xaf, 0x3d, 0xe6, 0x63, 0xea, 0x42,
+	0xeb, 0x4a, 0xc1, 0xeb, 0xcf, 0xca, 0x79, 0xaa, 0xea, 0x6a, 0xfc, 0x3e, 0xa0, 0xf3, 0xec, 0x0a,
+	0x26, 0x46, 0xb6, 0xb8, 0x70, 0xea, 0x11, 0xa9, 0xf9, 0x65, 0x7d, 0x11, 0x84, 0x5b, 0xf5, 0x88,
+	0xec, 0x0d, 0x00, 0x7d, 0xa4, 0xdb, 0xa4, 0xfa, 0x93, 0x9a, 0xec, 0xf1, 0xb8, 0x39, 0x3c, 0xb7,
+	0xb8, 0x45, 0xeb, 0x62, 0xbb, 0x8b, 0x3a, 0x6d, 0xab, 0x1d, 0xb0, 0x7f, 0x47, 0x39, 0xa3, 0x7b,
+	0x87, 0xec, 0x23, 0x9c, 0x53, 0x92, 0xcb, 0xb3, 0xf2, 0x6c, 0x36, 0x9d, 0x57, 0xfc, 0xc4, 0x9f,
+	0xe1, 0x04, 0xd7, 0x7b, 0x82, 0xbd, 0x83, 0x57, 0x3d, 0xee, 0xfc, 0xe2, 0xa8, 0xcf, 0x65, 0x90,
+	0xbf, 0xa4, 0x4e, 0xf3, 0x9f, 0x19, 0xbc, 0xa0, 0xd5, 0x2d, 0xda, 0xad, 0x5a, 0x22, 0x73, 0x00,
+	0x87, 0x2a, 0x8c, 0x9f, 0x1c, 0x79, 0x74, 0x3d, 0x85, 0x78, 0xb2, 0x3f, 0x9e, 0xb1, 0x1a, 0xff,
+	0xfa, 0x5d, 0x65, 0x9f, 0xbe, 0xde, 0xdf, 0xb7, 0xca, 0x3f, 0x0c, 0x0d, 0x5f, 0xea, 0x8d, 0x68,
+	0x86, 0x75, 0x33, 0xa8, 0x6e, 0x15, 0x16, 0x42, 0xf5, 0x1e, 0x6d, 0x2f, 0x3b, 0xd1, 0x62, 0x1f,
+	0xdf, 0x94, 0x68, 0xb5, 0x38, 0xf1, 0x96, 0x6f, 0x92, 0x92, 0x84, 0xe6, 0x9c, 0xb0, 0x0f, 0x7f,
+	0x07, 0x00, 0x06, 0x12, 0x5f, 0x70, 0x02, 0x03, 0x00, 0x00,
+}
@@ -15,6 +15,28 @@
 // Code generated by protoc-gen-twirp v7.1.0, DO NOT EDIT.
 // source: buf/alpha/registry/v1alpha1/user.proto
 
+/*
+Package registryv1alpha1 is a generated twirp stub package.
+This code was generated with github.com/twitchtv/twirp/protoc-gen-twirp v7.1.0.
+
+It is generated from these files:
+
+	buf/alpha/registry/v1alpha1/scope.proto
+	buf/alpha/registry/v1alpha1/user.proto
+	buf/alpha/registry/v1alpha1/authn.proto
+	buf/alpha/registry/v1alpha1/doc.proto
+	buf/alpha/registry/v1alpha1/download.proto
+	buf/alpha/registry/v1alpha1/module.proto
+	buf/alpha/registry/v1alpha1/organization.proto
+	buf/alpha/registry/v1alpha1/push.proto
+	buf/alpha/registry/v1alpha1/repository.proto
+	buf/alpha/registry/v1alpha1/repository_branch.proto
+	buf/alpha/registry/v1alpha1/repository_commit.proto
+	buf/alpha/registry/v1alpha1/repository_mirror.proto
+	buf/alpha/registry/v1alpha1/repository_tag.proto
+	buf/alpha/registry/v1alpha1/resolve.proto
+	buf/alpha/registry/v1alpha1/token.proto
+*/
 package registryv1alpha1
 
 import bytes "bytes"
@@ -30,6 +52,12 @@ import proto "github.com/golang/protobuf/proto"
 import twirp "github.com/twitchtv/twirp"
 import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
 
+// Imports only used by utility functions:
+import io "io"
+import json "encoding/json"
+import path "path"
+import url "net/url"
+
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the twirp package used in your project.
 // A compilation error at this line likely means your copy of the
@@ -4334,7 +4362,7 @@ func (s *userServiceServer) serveRemoveUserServerScopeByNameProtobuf(ctx context
 }
 
 func (s *userServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor8, 0
+	return twirpFileDescriptor0, 0
 }
 
 func (s *userServiceServer) ProtocGenTwirpVersion() string {
@@ -4348,73 +4376,621 @@ func (s *userServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "buf.alpha.registry.v1alpha1", "UserService")
 }
 
-var twirpFileDescriptor8 = []byte{
-	// 1062 bytes of a gzipped FileDescriptorProto
+// =====
+// Utils
+// =====
+
+// HTTPClient is the interface used by generated clients to send HTTP requests.
+// It is fulfilled by *(net/http).Client, which is sufficient for most users.
+// Users can provide their own implementation for special retry policies.
+//
+// HTTPClient implementations should not follow redirects. Redirects are
+// automatically disabled if *(net/http).Client is passed to client
+// constructors. See the withoutRedirects function in this file for more
+// details.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// TwirpServer is the interface generated server structs will support: they're
+// HTTP handlers with additional methods for accessing metadata about the
+// service. Those accessors are a low-level API for building reflection tools.
+// Most people can think of TwirpServers as just http.Handlers.
+type TwirpServer interface {
+	http.Handler
+
+	// ServiceDescriptor returns gzipped bytes describing the .proto file that
+	// this service was generated from. Once unzipped, the bytes can be
+	// unmarshalled as a
+	// github.com/golang/protobuf/protoc-gen-go/descriptor.FileDescriptorProto.
+	//
+	// The returned integer is the index of this particular service within that
+	// FileDescriptorProto's 'Service' slice of ServiceDescriptorProtos. This is a
+	// low-level field, expected to be used for reflection.
+	ServiceDescriptor() ([]byte, int)
+
+	// ProtocGenTwirpVersion is the semantic version string of the version of
+	// twirp used to generate this file.
+	ProtocGenTwirpVersion() string
+
+	// PathPrefix returns the HTTP URL path prefix for all methods handled by this
+	// service. This can be used with an HTTP mux to route Twirp requests.
+	// The path prefix is in the form: "/<prefix>/<package>.<Service>/"
+	// that is, everything in a Twirp route except for the <Method> at the end.
+	PathPrefix() string
+}
+
+// WriteError writes an HTTP response with a valid Twirp error format (code, msg, meta).
+// Useful outside of the Twirp server (e.g. http middleware), but does not trigger hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func WriteError(resp http.ResponseWriter, err error) {
+	writeError(context.Background(), resp, err, nil)
+}
+
+// writeError writes Twirp errors in the response and triggers hooks.
+func writeError(ctx context.Context, resp http.ResponseWriter, err error, hooks *twirp.ServerHooks) {
+	// Non-twirp errors are wrapped as Internal (default)
+	twerr, ok := err.(twirp.Error)
+	if !ok {
+		twerr = twirp.InternalErrorWith(err)
+	}
+
+	statusCode := twirp.ServerHTTPStatusFromErrorCode(twerr.Code())
+	ctx = ctxsetters.WithStatusCode(ctx, statusCode)
+	ctx = callError(ctx, hooks, twerr)
+
+	respBody := marshalErrorToJSON(twerr)
+
+	resp.Header().Set("Content-Type", "application/json") // Error responses are always JSON
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBody)))
+	resp.WriteHeader(statusCode) // set HTTP status code and send response
+
+	_, writeErr := resp.Write(respBody)
+	if writeErr != nil {
+		// We have three options here. We could log the error, call the Error
+		// hook, or just silently ignore the error.
+		//
+		// Logging is unacceptable because we don't have a user-controlled
+		// logger; writing out to stderr without permission is too rude.
+		//
+		// Calling the Error hook would confuse users: it would mean the Error
+		// hook got called twice for one request, which is likely to lead to
+		// duplicated log messages and metrics, no matter how well we document
+		// the behavior.
+		//
+		// Silently ignoring the error is our least-bad option. It's highly
+		// likely that the connection is broken and the original 'err' says
+		// so anyway.
+		_ = writeErr
+	}
+
+	callResponseSent(ctx, hooks)
+}
+
+// sanitizeBaseURL parses the the baseURL, and adds the "http" scheme if needed.
+// If the URL is unparsable, the baseURL is returned unchaged.
+func sanitizeBaseURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL // invalid URL will fail later when making requests
+	}
+	if u.Scheme == "" {
+		u.Scheme = "http"
+	}
+	return u.String()
+}
+
+// baseServicePath composes the path prefix for the service (without <Method>).
+// e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
+//
+//	returns => "/twirp/my.pkg.MyService/"
+//
+// e.g.: baseServicePath("", "", "MyService")
+//
+//	returns => "/MyService/"
+func baseServicePath(prefix, pkg, service string) string {
+	fullServiceName := service
+	if pkg != "" {
+		fullServiceName = pkg + "." + service
+	}
+	return path.Join("/", prefix, fullServiceName) + "/"
+}
+
+// parseTwirpPath extracts path components form a valid Twirp route.
+// Expected format: "[<prefix>]/<package>.<Service>/<Method>"
+// e.g.: prefix, pkgService, method := parseTwirpPath("/twirp/pkg.Svc/MakeHat")
+func parseTwirpPath(path string) (string, string, string) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return "", "", ""
+	}
+	method := parts[len(parts)-1]
+	pkgService := parts[len(parts)-2]
+	prefix := strings.Join(parts[0:len(parts)-2], "/")
+	return prefix, pkgService, method
+}
+
+// getCustomHTTPReqHeaders retrieves a copy of any headers that are set in
+// a context through the twirp.WithHTTPRequestHeaders function.
+// If there are no headers set, or if they have the wrong type, nil is returned.
+func getCustomHTTPReqHeaders(ctx context.Context) http.Header {
+	header, ok := twirp.HTTPRequestHeaders(ctx)
+	if !ok || header == nil {
+		return nil
+	}
+	copied := make(http.Header)
+	for k, vv := range header {
+		if vv == nil {
+			copied[k] = nil
+			continue
+		}
+		copied[k] = make([]string, len(vv))
+		copy(copied[k], vv)
+	}
+	return copied
+}
+
+// newRequest makes an http.Request from a client, adding common headers.
+func newRequest(ctx context.Context, url string, reqBody io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequest("POST", url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if customHeader := getCustomHTTPReqHeaders(ctx); customHeader != nil {
+		req.Header = customHeader
+	}
+	req.Header.Set("Accept", contentType)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Twirp-Version", "v7.1.0")
+	return req, nil
+}
+
+// JSON serialization for errors
+type twerrJSON struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Meta map[string]string `json:"meta,omitempty"`
+}
+
+// marshalErrorToJSON returns JSON from a twirp.Error, that can be used as HTTP error response body.
+// If serialization fails, it will use a descriptive Internal error instead.
+func marshalErrorToJSON(twerr twirp.Error) []byte {
+	// make sure that msg is not too large
+	msg := twerr.Msg()
+	if len(msg) > 1e6 {
+		msg = msg[:1e6]
+	}
+
+	tj := twerrJSON{
+		Code: string(twerr.Code()),
+		Msg:  msg,
+		Meta: twerr.MetaMap(),
+	}
+
+	buf, err := json.Marshal(&tj)
+	if err != nil {
+		buf = []byte("{\"type\": \"" + twirp.Internal + "\", \"msg\": \"There was an error but it could not be serialized into JSON\"}") // fallback
+	}
+
+	return buf
+}
+
+// errorFromResponse builds a twirp.Error from a non-200 HTTP response.
+// If the response has a valid serialized Twirp error, then it's returned.
+// If not, the response status code is used to generate a similar twirp
+// error. See twirpErrorFromIntermediary for more info on intermediary errors.
+func errorFromResponse(resp *http.Response) twirp.Error {
+	statusCode := resp.StatusCode
+	statusText := http.StatusText(statusCode)
+
+	if isHTTPRedirect(statusCode) {
+		// Unexpected redirect: it must be an error from an intermediary.
+		// Twirp clients don't follow redirects automatically, Twirp only handles
+		// POST requests, redirects should only happen on GET and HEAD requests.
+		location := resp.Header.Get("Location")
+		msg := fmt.Sprintf("unexpected HTTP status code %d %q received, Location=%q", statusCode, statusText, location)
+		return twirpErrorFromIntermediary(statusCode, msg, location)
+	}
+
+	respBodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return wrapInternal(err, "failed to read server error response body")
+	}
+
+	var tj twerrJSON
+	dec := json.NewDecoder(bytes.NewReader(respBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&tj); err != nil || tj.Code == "" {
+		// Invalid JSON response; it must be an error from an intermediary.
+		msg := fmt.Sprintf("Error from intermediary with HTTP status code %d %q", statusCode, statusText)
+		return twirpErrorFromIntermediary(statusCode, msg, string(respBodyBytes))
+	}
+
+	errorCode := twirp.ErrorCode(tj.Code)
+	if !twirp.IsValidErrorCode(errorCode) {
+		msg := "invalid type returned from server error response: " + tj.Code
+		return twirp.InternalError(msg).WithMeta("body", string(respBodyBytes))
+	}
+
+	twerr := twirp.NewError(errorCode, tj.Msg)
+	for k, v := range tj.Meta {
+		twerr = twerr.WithMeta(k, v)
+	}
+	return twerr
+}
+
+// twirpErrorFromIntermediary maps HTTP errors from non-twirp sources to twirp errors.
+// The mapping is similar to gRPC: https://github.com/grpc/grpc/blob/master/doc/http-grpc-status-mapping.md.
+// Returned twirp Errors have some additional metadata for inspection.
+func twirpErrorFromIntermediary(status int, msg string, bodyOrLocation string) twirp.Error {
+	var code twirp.ErrorCode
+	if isHTTPRedirect(status) { // 3xx
+		code = twirp.Internal
+	} else {
+		switch status {
+		case 400: // Bad Request
+			code = twirp.Internal
+		case 401: // Unauthorized
+			code = twirp.Unauthenticated
+		case 403: // Forbidden
+			code = twirp.PermissionDenied
+		case 404: // Not Found
+			code = twirp.BadRoute
+		case 429: // Too Many Requests
+			code = twirp.ResourceExhausted
+		case 502, 503, 504: // Bad Gateway, Service Unavailable, Gateway Timeout
+			code = twirp.Unavailable
+		default: // All other codes
+			code = twirp.Unknown
+		}
+	}
+
+	twerr := twirp.NewError(code, msg)
+	twerr = twerr.WithMeta("http_error_from_intermediary", "true") // to easily know if this error was from intermediary
+	twerr = twerr.WithMeta("status_code", strconv.Itoa(status))
+	if isHTTPRedirect(status) {
+		twerr = twerr.WithMeta("location", bodyOrLocation)
+	} else {
+		twerr = twerr.WithMeta("body", bodyOrLocation)
+	}
+	return twerr
+}
+
+func isHTTPRedirect(status int) bool {
+	return status >= 300 && status <= 399
+}
+
+// wrapInternal wraps an error with a prefix as an Internal error.
+// The original error cause is accessible by github.com/pkg/errors.Cause.
+func wrapInternal(err error, prefix string) twirp.Error {
+	return twirp.InternalErrorWith(&wrappedError{prefix: prefix, cause: err})
+}
+
+type wrappedError struct {
+	prefix string
+	cause  error
+}
+
+func (e *wrappedError) Error() string { return e.prefix + ": " + e.cause.Error() }
+func (e *wrappedError) Unwrap() error { return e.cause } // for go1.13 + errors.Is/As
+func (e *wrappedError) Cause() error  { return e.cause } // for github.com/pkg/errors
+
+// ensurePanicResponses makes sure that rpc methods causing a panic still result in a Twirp Internal
+// error response (status 500), and error hooks are properly called with the panic wrapped as an error.
+// The panic is re-raised so it can be handled normally with middleware.
+func ensurePanicResponses(ctx context.Context, resp http.ResponseWriter, hooks *twirp.ServerHooks) {
+	if r := recover(); r != nil {
+		// Wrap the panic as an error so it can be passed to error hooks.
+		// The original error is accessible from error hooks, but not visible in the response.
+		err := errFromPanic(r)
+		twerr := &internalWithCause{msg: "Internal service panic", cause: err}
+		// Actually write the error
+		writeError(ctx, resp, twerr, hooks)
+		// If possible, flush the error to the wire.
+		f, ok := resp.(http.Flusher)
+		if ok {
+			f.Flush()
+		}
+
+		panic(r)
+	}
+}
+
+// errFromPanic returns the typed error if the recovered panic is an error, otherwise formats as error.
+func errFromPanic(p interface{}) error {
+	if err, ok := p.(error); ok {
+		return err
+	}
+	return fmt.Errorf("panic: %v", p)
+}
+
+// internalWithCause is a Twirp Internal error wrapping an original error cause,
+// but the original error message is not exposed on Msg(). The original error
+// can be checked with go1.13+ errors.Is/As, and also by (github.com/pkg/errors).Unwrap
+type internalWithCause struct {
+	msg   string
+	cause error
+}
+
+func (e *internalWithCause) Unwrap() error                               { return e.cause } // for go1.13 + errors.Is/As
+func (e *internalWithCause) Cause() error                                { return e.cause } // for github.com/pkg/errors
+func (e *internalWithCause) Error() string                               { return e.msg + ": " + e.cause.Error() }
+func (e *internalWithCause) Code() twirp.ErrorCode                       { return twirp.Internal }
+func (e *internalWithCause) Msg() string                                 { return e.msg }
+func (e *internalWithCause) Meta(key string) string                      { return "" }
+func (e *internalWithCause) MetaMap() map[string]string                  { return nil }
+func (e *internalWithCause) WithMeta(key string, val string) twirp.Error { return e }
+
+// malformedRequestError is used when the twirp server cannot unmarshal a request
+func malformedRequestError(msg string) twirp.Error {
+	return twirp.NewError(twirp.Malformed, msg)
+}
+
+// badRouteError is used when the twirp server cannot route a request
+func badRouteError(msg string, method, url string) twirp.Error {
+	err := twirp.NewError(twirp.BadRoute, msg)
+	err = err.WithMeta("twirp_invalid_route", method+" "+url)
+	return err
+}
+
+// withoutRedirects makes sure that the POST request can not be redirected.
+// The standard library will, by default, redirect requests (including POSTs) if it gets a 302 or
+// 303 response, and also 301s in go1.8. It redirects by making a second request, changing the
+// method to GET and removing the body. This produces very confusing error messages, so instead we
+// set a redirect policy that always errors. This stops Go from executing the redirect.
+//
+// We have to be a little careful in case the user-provided http.Client has its own CheckRedirect
+// policy - if so, we'll run through that policy first.
+//
+// Because this requires modifying the http.Client, we make a new copy of the client and return it.
+func withoutRedirects(in *http.Client) *http.Client {
+	copy := *in
+	copy.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if in.CheckRedirect != nil {
+			// Run the input's redirect if it exists, in case it has side effects, but ignore any error it
+			// returns, since we want to use ErrUseLastResponse.
+			err := in.CheckRedirect(req, via)
+			_ = err // Silly, but this makes sure generated code passes errcheck -blank, which some people use.
+		}
+		return http.ErrUseLastResponse
+	}
+	return &copy
+}
+
+// doProtobufRequest makes a Protobuf request to the remote Twirp service.
+func doProtobufRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	reqBodyBytes, err := proto.Marshal(in)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to marshal proto request")
+	}
+	reqBody := bytes.NewBuffer(reqBodyBytes)
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, reqBody, "application/protobuf")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+
+	defer func() {
+		cerr := resp.Body.Close()
+		if err == nil && cerr != nil {
+			err = wrapInternal(cerr, "failed to close response body")
+		}
+	}()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	respBodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to read response body")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if err = proto.Unmarshal(respBodyBytes, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal proto response")
+	}
+	return ctx, nil
+}
+
+// doJSONRequest makes a JSON request to the remote Twirp service.
+func doJSONRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	reqBody := bytes.NewBuffer(nil)
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(reqBody, in); err != nil {
+		return ctx, wrapInternal(err, "failed to marshal json request")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, reqBody, "application/json")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+
+	defer func() {
+		cerr := resp.Body.Close()
+		if err == nil && cerr != nil {
+			err = wrapInternal(cerr, "failed to close response body")
+		}
+	}()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(resp.Body, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal json response")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+	return ctx, nil
+}
+
+// Call twirp.ServerHooks.RequestReceived if the hook is available
+func callRequestReceived(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestReceived == nil {
+		return ctx, nil
+	}
+	return h.RequestReceived(ctx)
+}
+
+// Call twirp.ServerHooks.RequestRouted if the hook is available
+func callRequestRouted(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestRouted == nil {
+		return ctx, nil
+	}
+	return h.RequestRouted(ctx)
+}
+
+// Call twirp.ServerHooks.ResponsePrepared if the hook is available
+func callResponsePrepared(ctx context.Context, h *twirp.ServerHooks) context.Context {
+	if h == nil || h.ResponsePrepared == nil {
+		return ctx
+	}
+	return h.ResponsePrepared(ctx)
+}
+
+// Call twirp.ServerHooks.ResponseSent if the hook is available
+func callResponseSent(ctx context.Context, h *twirp.ServerHooks) {
+	if h == nil || h.ResponseSent == nil {
+		return
+	}
+	h.ResponseSent(ctx)
+}
+
+// Call twirp.ServerHooks.Error if the hook is available
+func callError(ctx context.Context, h *twirp.ServerHooks, err twirp.Error) context.Context {
+	if h == nil || h.Error == nil {
+		return ctx
+	}
+	return h.Error(ctx, err)
+}
+
+func callClientResponseReceived(ctx context.Context, h *twirp.ClientHooks) {
+	if h == nil || h.ResponseReceived == nil {
+		return
+	}
+	h.ResponseReceived(ctx)
+}
+
+func callClientRequestPrepared(ctx context.Context, h *twirp.ClientHooks, req *http.Request) (context.Context, error) {
+	if h == nil || h.RequestPrepared == nil {
+		return ctx, nil
+	}
+	return h.RequestPrepared(ctx, req)
+}
+
+func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error) {
+	if h == nil || h.Error == nil {
+		return
+	}
+	h.Error(ctx, err)
+}
+
+var twirpFileDescriptor0 = []byte{
+	// 1054 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0xd6, 0x4d, 0x03, 0x6b, 0x4f, 0xb6, 0x76, 0xb9, 0x80, 0x94, 0xba, 0x2d, 0x4d, 0x8d, 0xd6,
-	0x05, 0x15, 0x6c, 0xad, 0x68, 0x9b, 0x60, 0xc0, 0x46, 0xc7, 0x4f, 0x0d, 0x01, 0x4a, 0xd7, 0x97,
-	0x09, 0x14, 0x39, 0xcd, 0xad, 0x6b, 0x48, 0x6c, 0xe3, 0x6b, 0x67, 0x6b, 0x79, 0x41, 0x48, 0x48,
-	0x93, 0x78, 0x45, 0xe2, 0x69, 0xaf, 0x48, 0x48, 0x48, 0xbc, 0x82, 0xc4, 0x3b, 0x12, 0x7f, 0x15,
-	0xba, 0xd7, 0x76, 0xec, 0x1b, 0xdb, 0xd7, 0x6e, 0x02, 0x43, 0x7d, 0x8b, 0x8f, 0xbf, 0x73, 0xce,
-	0x77, 0xce, 0xb9, 0xe7, 0xfa, 0x53, 0x60, 0xbb, 0x1f, 0x1c, 0xe9, 0xc6, 0xd0, 0x3d, 0x36, 0x74,
-	0x8f, 0x98, 0x16, 0xf5, 0xbd, 0x13, 0x7d, 0x7c, 0x8d, 0x1b, 0xae, 0xe9, 0x01, 0x25, 0x9e, 0xe6,
-	0x7a, 0x8e, 0xef, 0xe0, 0xb5, 0x7e, 0x70, 0xa4, 0x71, 0xb3, 0x16, 0xe3, 0xb4, 0x18, 0xa7, 0xb4,
-	0x93, 0x20, 0x86, 0x6b, 0x25, 0xfe, 0x86, 0x6b, 0x85, 0xee, 0xca, 0x55, 0x59, 0x1a, 0x7a, 0xe8,
-	0xb8, 0x24, 0x02, 0x6e, 0x9a, 0x8e, 0x63, 0x0e, 0x89, 0xce, 0x9f, 0x98, 0x93, 0x6f, 0x8d, 0x08,
-	0xf5, 0x8d, 0x91, 0x1b, 0x02, 0xd4, 0x5f, 0x11, 0xd4, 0x0f, 0x28, 0xf1, 0xf0, 0x32, 0xd4, 0xac,
-	0x41, 0x0b, 0xb5, 0x51, 0x67, 0xa9, 0x5b, 0xb3, 0x06, 0xf8, 0x16, 0x34, 0x0e, 0x3d, 0x62, 0xf8,
-	0xa4, 0xc7, 0x5c, 0x5a, 0xb5, 0x36, 0xea, 0x34, 0x76, 0x15, 0x2d, 0x8c, 0xa7, 0xc5, 0xf1, 0xb4,
-	0xfb, 0x71, 0xbc, 0x2e, 0x84, 0x70, 0x66, 0x60, 0xce, 0x81, 0x3b, 0x98, 0x38, 0x2f, 0x94, 0x3b,
-	0x87, 0x70, 0xee, 0xac, 0xc0, 0x22, 0xeb, 0x94, 0x6d, 0x8c, 0x48, 0xab, 0xce, 0xf9, 0x4c, 0x9e,
-	0x55, 0x1d, 0x9a, 0x77, 0x79, 0x1a, 0xc6, 0xb9, 0x4b, 0xbe, 0x0e, 0x08, 0xf5, 0x05, 0x07, 0x34,
-	0xe5, 0x70, 0x0f, 0x70, 0xda, 0x81, 0xba, 0x8e, 0x4d, 0x09, 0xbe, 0x0e, 0x75, 0x86, 0xe0, 0xe8,
-	0xc6, 0xee, 0x96, 0x26, 0x99, 0x86, 0xc6, 0x1d, 0x39, 0x5c, 0x6d, 0xc3, 0xf2, 0x07, 0xc4, 0x4f,
-	0xa7, 0x9e, 0xea, 0x9a, 0xfa, 0x21, 0xac, 0x4c, 0x10, 0xf3, 0xe5, 0xba, 0x01, 0xad, 0x28, 0xd2,
-	0xde, 0xc9, 0x41, 0x54, 0x4d, 0x95, 0x82, 0xbb, 0xb0, 0x9a, 0xe3, 0x37, 0x1f, 0x97, 0x63, 0xb8,
-	0xfc, 0xb1, 0x45, 0x79, 0x50, 0x1a, 0x73, 0x58, 0x83, 0x25, 0xd7, 0x30, 0x49, 0x8f, 0x5a, 0xa7,
-	0x21, 0x89, 0x4b, 0xdd, 0x45, 0x66, 0xd8, 0xb7, 0x4e, 0x09, 0xde, 0x00, 0xe0, 0x2f, 0x7d, 0xe7,
-	0x2b, 0x62, 0xf3, 0xb3, 0xb3, 0xd4, 0xe5, 0xf0, 0xfb, 0xcc, 0x80, 0x5b, 0x70, 0xc1, 0x23, 0x63,
-	0xe2, 0xd1, 0xf0, 0x68, 0x2c, 0x76, 0xe3, 0x47, 0xd5, 0x87, 0x66, 0x2a, 0x53, 0xc4, 0xfa, 0x26,
-	0x3c, 0xc3, 0x68, 0xd0, 0x16, 0x6a, 0x2f, 0x54, 0xa3, 0x1d, 0xe2, 0xf1, 0x36, 0xac, 0xd8, 0xe4,
-	0x91, 0xdf, 0xcb, 0x70, 0xb9, 0xc4, 0xcc, 0x9f, 0xc5, 0x7c, 0xd4, 0x27, 0x08, 0xd6, 0x59, 0xda,
-	0x4f, 0x3d, 0xd3, 0xb0, 0xad, 0x53, 0xc3, 0xb7, 0x1c, 0x5b, 0x28, 0xf6, 0x2a, 0xac, 0x38, 0xa9,
-	0x77, 0xbd, 0xc9, 0xcc, 0x97, 0xd3, 0xe6, 0x8f, 0x06, 0x62, 0x57, 0x6a, 0xd2, 0xae, 0x2c, 0x48,
-	0xba, 0x52, 0x17, 0xbb, 0xf2, 0x2d, 0x82, 0x8d, 0x02, 0x7e, 0x4f, 0xab, 0x45, 0x6f, 0xc3, 0xea,
-	0x01, 0x5f, 0x51, 0xe6, 0x3c, 0x7d, 0x1e, 0xb7, 0xe0, 0xa2, 0x4d, 0x1e, 0xf6, 0xa6, 0xce, 0x64,
-	0xc3, 0x26, 0x0f, 0x63, 0xa4, 0xba, 0x0f, 0x4a, 0x9e, 0xff, 0x7c, 0xe7, 0xf2, 0x39, 0x68, 0xbe,
-	0x4b, 0x86, 0x44, 0xb8, 0x0d, 0xd4, 0xe7, 0x01, 0xa7, 0x8d, 0x61, 0x06, 0xf5, 0x0f, 0x04, 0x9b,
-	0xef, 0x0c, 0x06, 0xcc, 0x96, 0xee, 0xe2, 0x3e, 0xbb, 0x2b, 0x0b, 0x96, 0x39, 0x6f, 0xea, 0xb5,
-	0xdc, 0xa9, 0x7f, 0x01, 0x58, 0x00, 0xf2, 0x1b, 0x98, 0x0f, 0x78, 0x79, 0x57, 0x93, 0x16, 0x93,
-	0xe5, 0xd2, 0x74, 0xa6, 0x4d, 0xaa, 0x0a, 0xed, 0x62, 0xea, 0x51, 0x7d, 0x7f, 0x21, 0xb8, 0x52,
-	0x04, 0xda, 0x3b, 0xf9, 0x24, 0x35, 0x2c, 0x0c, 0xf5, 0xd4, 0x90, 0xf8, 0x6f, 0xbc, 0x03, 0x42,
-	0xda, 0x1e, 0x07, 0x84, 0xb5, 0x5e, 0x4e, 0xbf, 0x60, 0x71, 0xfe, 0xeb, 0x6a, 0x3b, 0xb0, 0x5d,
-	0x56, 0x48, 0x54, 0xf3, 0x9f, 0x08, 0xd4, 0x2e, 0x19, 0x39, 0x63, 0x72, 0x2e, 0xc7, 0x7a, 0x05,
-	0x5e, 0x92, 0xb2, 0x8f, 0xaa, 0xfc, 0x1b, 0x41, 0x47, 0x82, 0x3b, 0x5f, 0xc3, 0xdd, 0x81, 0x97,
-	0x2b, 0xd4, 0x12, 0x55, 0xfe, 0x08, 0x56, 0xa3, 0x93, 0xb0, 0x4f, 0xbc, 0x31, 0xf1, 0xa4, 0x53,
-	0xbd, 0x07, 0x17, 0x29, 0x47, 0x45, 0x94, 0x6b, 0x9c, 0x72, 0x47, 0x4a, 0x39, 0x1d, 0xb6, 0x41,
-	0x93, 0x07, 0x75, 0x1d, 0x94, 0xbc, 0xcc, 0x11, 0xaf, 0xef, 0x92, 0xbb, 0x24, 0xf5, 0xba, 0x7c,
-	0x10, 0xff, 0x2a, 0xc5, 0xe4, 0x52, 0xc8, 0xe1, 0x10, 0x11, 0xfd, 0x06, 0xd6, 0x93, 0x6e, 0x3f,
-	0xed, 0x1e, 0x6e, 0xc2, 0x46, 0x41, 0xf2, 0x88, 0xdd, 0xf7, 0xc2, 0xfa, 0xfe, 0x7f, 0x9d, 0x14,
-	0xf6, 0xb0, 0xb0, 0x99, 0xbb, 0xbf, 0x34, 0xa1, 0x11, 0x23, 0xac, 0x43, 0x82, 0x29, 0x40, 0xa2,
-	0x2c, 0xb1, 0x7c, 0x37, 0x32, 0x9a, 0x55, 0xd1, 0x2b, 0xe3, 0xa3, 0x6e, 0xd5, 0x1f, 0xff, 0xa4,
-	0xd6, 0xf0, 0x97, 0x70, 0x21, 0x52, 0x77, 0x78, 0x47, 0x1a, 0x41, 0xd4, 0xa9, 0xca, 0x2b, 0xd5,
-	0xc0, 0xa9, 0x5c, 0x08, 0x3f, 0x46, 0xd0, 0xcc, 0x48, 0x49, 0x7c, 0xbd, 0x4a, 0xa4, 0x8c, 0x64,
-	0x55, 0x6e, 0x9c, 0xd5, 0x4d, 0xa0, 0xe2, 0xc2, 0xd2, 0x44, 0x16, 0xe2, 0x57, 0xa5, 0xa1, 0xa6,
-	0x85, 0xaa, 0xa2, 0x55, 0x85, 0x0b, 0x19, 0x7f, 0x44, 0xf0, 0x42, 0xae, 0xe4, 0xc2, 0xaf, 0x97,
-	0xc6, 0x2b, 0x92, 0x91, 0xca, 0x1b, 0xb3, 0xb8, 0x0a, 0xb4, 0x7e, 0x40, 0x80, 0xb3, 0x3a, 0x0a,
-	0xcb, 0xbb, 0x5b, 0x28, 0xdc, 0x94, 0x9b, 0x67, 0xf6, 0x13, 0x4e, 0x23, 0x05, 0x48, 0xa4, 0x56,
-	0xc9, 0x0a, 0x64, 0x84, 0x5a, 0xc9, 0x0a, 0xe4, 0x68, 0xb8, 0x30, 0xe9, 0x13, 0x04, 0xad, 0x22,
-	0x81, 0x80, 0xdf, 0x94, 0xc6, 0x2c, 0x11, 0x80, 0xca, 0x5b, 0x33, 0x7a, 0x0b, 0xfc, 0x7e, 0x43,
-	0xf0, 0xa2, 0x5c, 0xc0, 0xe0, 0xbd, 0x99, 0xf2, 0x08, 0xd7, 0xa2, 0x72, 0x77, 0xae, 0x18, 0x02,
-	0xe3, 0x9f, 0x11, 0xac, 0x49, 0xbe, 0xca, 0xf8, 0xb6, 0x34, 0x55, 0xb9, 0x02, 0x53, 0xee, 0xcc,
-	0x1e, 0x40, 0x20, 0xfa, 0x3b, 0x82, 0xad, 0x52, 0xf9, 0x80, 0xdf, 0x9b, 0x35, 0x9b, 0xd8, 0xe0,
-	0xf7, 0xe7, 0x0d, 0x23, 0x50, 0x67, 0x8b, 0x9b, 0xfd, 0x5e, 0x97, 0x2c, 0x6e, 0xa1, 0xfa, 0x29,
-	0x59, 0x5c, 0x89, 0x76, 0xc9, 0xec, 0x50, 0xe6, 0x83, 0x57, 0x6d, 0x87, 0x8a, 0x3e, 0xd7, 0xd5,
-	0x76, 0xa8, 0x58, 0xb2, 0x84, 0xfc, 0xd8, 0xed, 0x9b, 0xfb, 0x4d, 0x2e, 0xb9, 0x7d, 0x65, 0x6a,
-	0xa7, 0xe4, 0xf6, 0x95, 0x6b, 0x95, 0xbc, 0x45, 0xc9, 0x76, 0xee, 0xf6, 0xd9, 0x33, 0x88, 0xcd,
-	0xbb, 0x33, 0x7b, 0x80, 0x34, 0xd1, 0xbd, 0xcf, 0x1f, 0x3c, 0x30, 0x2d, 0xff, 0x38, 0xe8, 0x6b,
-	0x87, 0xce, 0x48, 0xef, 0x07, 0x47, 0xfd, 0xc0, 0x1a, 0x0e, 0xd8, 0x0f, 0xdd, 0xb2, 0x7d, 0x76,
-	0x97, 0x0f, 0x75, 0x93, 0xd8, 0xe1, 0xdf, 0x82, 0xba, 0xe9, 0xe8, 0x92, 0xff, 0x13, 0x6f, 0xc5,
-	0x96, 0xd8, 0xd0, 0x7f, 0x96, 0xbb, 0xbd, 0xf6, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0x4e,
-	0xf3, 0x47, 0xed, 0x14, 0x00, 0x00,
+	0x14, 0xd6, 0x4d, 0x03, 0x6b, 0x4f, 0xb6, 0x76, 0xb9, 0x80, 0x94, 0xde, 0xb6, 0x34, 0x35, 0x5a,
+	0x17, 0x54, 0xb0, 0xb5, 0xa2, 0xad, 0x62, 0x63, 0x6c, 0x74, 0xfc, 0xd4, 0x10, 0xa0, 0x74, 0x7d,
+	0x99, 0x40, 0x91, 0xd3, 0xdc, 0xa6, 0x86, 0xc4, 0x36, 0xbe, 0x4e, 0xb7, 0x96, 0x17, 0x84, 0x84,
+	0x34, 0x89, 0x57, 0x24, 0x9e, 0xf6, 0x8a, 0x84, 0x84, 0xc4, 0x2b, 0x48, 0xbc, 0x23, 0xf1, 0x57,
+	0xa1, 0x7b, 0x6d, 0x27, 0xbe, 0xb1, 0x7d, 0xed, 0x3a, 0x30, 0xd4, 0xb7, 0xf8, 0xf8, 0xfb, 0xce,
+	0xf9, 0xce, 0x39, 0xf7, 0x5c, 0x1f, 0x05, 0x36, 0xbb, 0xa3, 0x43, 0xc3, 0x1c, 0xb8, 0x47, 0xa6,
+	0xe1, 0xd1, 0xbe, 0xc5, 0x7c, 0xef, 0xc4, 0x38, 0xbe, 0x26, 0x0c, 0xd7, 0x8c, 0x11, 0xa3, 0x9e,
+	0xee, 0x7a, 0x8e, 0xef, 0xe0, 0x95, 0xee, 0xe8, 0x50, 0x17, 0x66, 0x3d, 0xc2, 0xe9, 0x11, 0x8e,
+	0x34, 0x27, 0x4e, 0x4c, 0xd7, 0x9a, 0xf0, 0x4d, 0xd7, 0x0a, 0xe8, 0xe4, 0xaa, 0x2a, 0x0c, 0x3b,
+	0x70, 0x5c, 0x1a, 0x02, 0xd7, 0xfb, 0x8e, 0xd3, 0x1f, 0x50, 0x43, 0x3c, 0x71, 0x92, 0x6f, 0x0d,
+	0x29, 0xf3, 0xcd, 0xa1, 0x1b, 0x00, 0xb4, 0x5f, 0x11, 0x54, 0xf7, 0x19, 0xf5, 0xf0, 0x22, 0x54,
+	0xac, 0x5e, 0x03, 0x35, 0x51, 0x6b, 0xa1, 0x5d, 0xb1, 0x7a, 0xf8, 0x16, 0xd4, 0x0e, 0x3c, 0x6a,
+	0xfa, 0xb4, 0xc3, 0x29, 0x8d, 0x4a, 0x13, 0xb5, 0x6a, 0xdb, 0x44, 0x0f, 0xfc, 0xe9, 0x91, 0x3f,
+	0xfd, 0x41, 0xe4, 0xaf, 0x0d, 0x01, 0x9c, 0x1b, 0x38, 0x79, 0xe4, 0xf6, 0xc6, 0xe4, 0xb9, 0x7c,
+	0x72, 0x00, 0x17, 0x64, 0x02, 0xf3, 0xbc, 0x52, 0xb6, 0x39, 0xa4, 0x8d, 0xaa, 0xd0, 0x33, 0x7e,
+	0xd6, 0x0c, 0xa8, 0xdf, 0x13, 0x61, 0xb8, 0xe6, 0x36, 0xfd, 0x7a, 0x44, 0x99, 0x2f, 0x11, 0xd0,
+	0x14, 0xe1, 0x3e, 0xe0, 0x38, 0x81, 0xb9, 0x8e, 0xcd, 0x28, 0xbe, 0x0e, 0x55, 0x8e, 0x10, 0xe8,
+	0xda, 0xf6, 0x86, 0xae, 0xe8, 0x86, 0x2e, 0x88, 0x02, 0xae, 0x35, 0x61, 0xf1, 0x03, 0xea, 0xc7,
+	0x43, 0x4f, 0x55, 0x4d, 0xfb, 0x10, 0x96, 0xc6, 0x88, 0xd9, 0x62, 0xdd, 0x80, 0x46, 0xe8, 0x69,
+	0xf7, 0x64, 0x3f, 0xcc, 0xa6, 0x48, 0xc2, 0x6d, 0x58, 0x4e, 0xe1, 0xcd, 0xa6, 0xe5, 0x08, 0x2e,
+	0x7f, 0x6c, 0x31, 0xe1, 0x94, 0x45, 0x1a, 0x56, 0x60, 0xc1, 0x35, 0xfb, 0xb4, 0xc3, 0xac, 0xd3,
+	0x40, 0xc4, 0xa5, 0xf6, 0x3c, 0x37, 0xec, 0x59, 0xa7, 0x14, 0xaf, 0x01, 0x88, 0x97, 0xbe, 0xf3,
+	0x15, 0xb5, 0xc5, 0xd9, 0x59, 0x68, 0x0b, 0xf8, 0x03, 0x6e, 0xc0, 0x0d, 0xb8, 0xe0, 0xd1, 0x63,
+	0xea, 0xb1, 0xe0, 0x68, 0xcc, 0xb7, 0xa3, 0x47, 0xcd, 0x87, 0x7a, 0x2c, 0x52, 0xa8, 0x7a, 0x07,
+	0x9e, 0xe3, 0x32, 0x58, 0x03, 0x35, 0xe7, 0x8a, 0xc9, 0x0e, 0xf0, 0x78, 0x13, 0x96, 0x6c, 0xfa,
+	0xd8, 0xef, 0x24, 0xb4, 0x5c, 0xe2, 0xe6, 0xcf, 0x22, 0x3d, 0xda, 0x53, 0x04, 0xab, 0x3c, 0xec,
+	0xa7, 0x5e, 0xdf, 0xb4, 0xad, 0x53, 0xd3, 0xb7, 0x1c, 0x5b, 0x4a, 0xf6, 0x2a, 0x2c, 0x39, 0xb1,
+	0x77, 0x9d, 0x71, 0xcf, 0x17, 0xe3, 0xe6, 0x8f, 0x7a, 0x72, 0x55, 0x2a, 0xca, 0xaa, 0xcc, 0x29,
+	0xaa, 0x52, 0x95, 0xab, 0xf2, 0x2d, 0x82, 0xb5, 0x0c, 0x7d, 0xcf, 0xaa, 0x44, 0x6f, 0xc3, 0xf2,
+	0xbe, 0x18, 0x51, 0x4e, 0x9e, 0x3e, 0x8f, 0x1b, 0x70, 0xd1, 0xa6, 0x8f, 0x3a, 0x53, 0x67, 0xb2,
+	0x66, 0xd3, 0x47, 0x11, 0x52, 0xdb, 0x03, 0x92, 0xc6, 0x9f, 0xed, 0x5c, 0xbe, 0x00, 0xf5, 0x77,
+	0xe9, 0x80, 0x4a, 0xb7, 0x81, 0xf6, 0x22, 0xe0, 0xb8, 0x31, 0x88, 0xa0, 0xfd, 0x81, 0x60, 0xfd,
+	0x9d, 0x5e, 0x8f, 0xdb, 0xe2, 0x55, 0xdc, 0xe3, 0x77, 0x65, 0xc6, 0x30, 0xa7, 0x75, 0xbd, 0x92,
+	0xda, 0xf5, 0x2f, 0x00, 0x4b, 0x40, 0x71, 0x03, 0x8b, 0x06, 0x2f, 0x6e, 0xeb, 0xca, 0x64, 0x92,
+	0x5a, 0xea, 0xce, 0xb4, 0x49, 0xd3, 0xa0, 0x99, 0x2d, 0x3d, 0xcc, 0xef, 0x2f, 0x04, 0x57, 0xb2,
+	0x40, 0xbb, 0x27, 0x9f, 0xc4, 0x9a, 0x85, 0xa1, 0x1a, 0x6b, 0x92, 0xf8, 0x8d, 0xb7, 0x40, 0x0a,
+	0xdb, 0x11, 0x80, 0x20, 0xd7, 0xcb, 0xf1, 0x17, 0xdc, 0xcf, 0x7f, 0x9d, 0x6d, 0x0b, 0x36, 0xf3,
+	0x12, 0x09, 0x73, 0xfe, 0x13, 0x81, 0xd6, 0xa6, 0x43, 0xe7, 0x98, 0x9e, 0xcb, 0xb6, 0x5e, 0x81,
+	0x57, 0x94, 0xea, 0xc3, 0x2c, 0xff, 0x46, 0xd0, 0x52, 0xe0, 0xce, 0x57, 0x73, 0xb7, 0xe0, 0xd5,
+	0x02, 0xb9, 0x84, 0x99, 0x3f, 0x86, 0xe5, 0xf0, 0x24, 0xec, 0x51, 0xef, 0x98, 0x7a, 0xca, 0xae,
+	0xde, 0x87, 0x8b, 0x4c, 0xa0, 0x42, 0xc9, 0x15, 0x21, 0xb9, 0xa5, 0x94, 0x1c, 0x77, 0x5b, 0x63,
+	0x93, 0x07, 0x6d, 0x15, 0x48, 0x5a, 0xe4, 0x50, 0xd7, 0x77, 0x93, 0xbb, 0x24, 0xf6, 0x3a, 0xbf,
+	0x11, 0xff, 0xaa, 0xc4, 0xc9, 0xa5, 0x90, 0xa2, 0x21, 0x14, 0xfa, 0x0d, 0xac, 0x4e, 0xaa, 0xfd,
+	0xac, 0x6b, 0xb8, 0x0e, 0x6b, 0x19, 0xc1, 0x43, 0x75, 0xdf, 0x4b, 0xe3, 0xfb, 0xff, 0x55, 0x52,
+	0x9a, 0xc3, 0xcc, 0x62, 0x6e, 0xff, 0x52, 0x87, 0x5a, 0x84, 0xb0, 0x0e, 0x28, 0x66, 0x00, 0x93,
+	0xcd, 0x12, 0xab, 0x67, 0x23, 0xb1, 0xb3, 0x12, 0xa3, 0x30, 0x3e, 0xac, 0x56, 0xf5, 0xc9, 0x4f,
+	0x5a, 0x05, 0x7f, 0x09, 0x17, 0xc2, 0xed, 0x0e, 0x6f, 0x29, 0x3d, 0xc8, 0x7b, 0x2a, 0x79, 0xad,
+	0x18, 0x38, 0x16, 0x0b, 0xe1, 0x27, 0x08, 0xea, 0x89, 0x55, 0x12, 0x5f, 0x2f, 0xe2, 0x29, 0xb1,
+	0xb2, 0x92, 0x1b, 0x67, 0xa5, 0x49, 0x52, 0x5c, 0x58, 0x18, 0xaf, 0x85, 0xf8, 0x75, 0xa5, 0xab,
+	0xe9, 0x45, 0x95, 0xe8, 0x45, 0xe1, 0x52, 0xc4, 0x1f, 0x11, 0xbc, 0x94, 0xba, 0x72, 0xe1, 0x37,
+	0x73, 0xfd, 0x65, 0xad, 0x91, 0xe4, 0x66, 0x19, 0xaa, 0x24, 0xeb, 0x07, 0x04, 0x38, 0xb9, 0x47,
+	0x61, 0x75, 0x75, 0x33, 0x17, 0x37, 0xb2, 0x73, 0x66, 0x9e, 0x74, 0x1a, 0x19, 0xc0, 0x64, 0xd5,
+	0xca, 0x19, 0x81, 0xc4, 0xa2, 0x46, 0x8c, 0xc2, 0x78, 0x29, 0xe8, 0x53, 0x04, 0x8d, 0xac, 0x05,
+	0x01, 0xbf, 0xa5, 0xf4, 0x99, 0xb3, 0x00, 0x92, 0xdb, 0x25, 0xd9, 0x92, 0xbe, 0xdf, 0x10, 0xbc,
+	0xac, 0x5e, 0x60, 0xf0, 0x6e, 0xa9, 0x38, 0xd2, 0xb5, 0x48, 0xee, 0xcd, 0xe4, 0x43, 0x52, 0xfc,
+	0x33, 0x82, 0x15, 0xc5, 0x57, 0x19, 0xdf, 0x51, 0x86, 0xca, 0xdf, 0xc0, 0xc8, 0xdd, 0xf2, 0x0e,
+	0x24, 0xa1, 0xbf, 0x23, 0xd8, 0xc8, 0x5d, 0x1f, 0xf0, 0x7b, 0x65, 0xa3, 0xc9, 0x05, 0x7e, 0x7f,
+	0x56, 0x37, 0x92, 0x74, 0x3e, 0xb8, 0xc9, 0xef, 0x75, 0xce, 0xe0, 0x66, 0x6e, 0x3f, 0x64, 0xe7,
+	0xcc, 0xbc, 0xac, 0x19, 0x4a, 0x7c, 0xf0, 0x8a, 0xcd, 0x50, 0xd6, 0xe7, 0x9a, 0xdc, 0x2e, 0xc9,
+	0x96, 0xf4, 0xf1, 0xdb, 0x37, 0xf5, 0x9b, 0x9c, 0x73, 0xfb, 0xaa, 0xb6, 0x1d, 0x72, 0xb3, 0x0c,
+	0x55, 0x31, 0x28, 0xc9, 0xca, 0xdd, 0x39, 0x7b, 0x04, 0xb9, 0x78, 0x77, 0xcb, 0x3b, 0x88, 0x0b,
+	0xdd, 0xfd, 0xfc, 0xe1, 0xc3, 0xbe, 0xe5, 0x1f, 0x8d, 0xba, 0xfa, 0x81, 0x33, 0x34, 0xba, 0xa3,
+	0xc3, 0xee, 0xc8, 0x1a, 0xf4, 0xf8, 0x0f, 0xc3, 0xb2, 0x7d, 0xea, 0xd9, 0xe6, 0xc0, 0xe8, 0x53,
+	0x3b, 0xf8, 0x5b, 0xd0, 0xe8, 0x3b, 0x86, 0xe2, 0xff, 0xc4, 0x5b, 0x91, 0x25, 0x32, 0x74, 0x9f,
+	0x17, 0xb4, 0x37, 0xfe, 0x19, 0x00, 0x25, 0x4e, 0xf3, 0x47, 0xed, 0x14, 0x00, 0x00,
 }
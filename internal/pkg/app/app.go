@@ -17,6 +17,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -306,6 +307,7 @@ func Run(ctx context.Context, container Container, f func(context.Context, Conta
 // NewError returns a new Error that contains an exit code.
 //
 // The exit code cannot be 0.
+// If the message is empty, nothing is printed when the error is returned from Run.
 func NewError(exitCode int, message string) error {
 	return newAppError(exitCode, message)
 }
@@ -320,13 +322,15 @@ func NewErrorf(exitCode int, format string, args ...interface{}) error {
 // GetExitCode gets the exit code.
 //
 // If err == nil, this returns 0.
-// If err was created by this package, this returns the exit code from the error.
+// If err was created by this package, or wraps an error created by
+// this package, this returns the exit code from the error.
 // Otherwise, this returns 1.
 func GetExitCode(err error) int {
 	if err == nil {
 		return 0
 	}
-	if appError, ok := err.(*appError); ok {
+	var appError *appError
+	if errors.As(err, &appError) {
 		return appError.exitCode
 	}
 	return 1
@@ -15,6 +15,8 @@
 package app
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -134,3 +136,11 @@ func TestIsDev(t *testing.T) {
 	assert.False(t, IsDevStderr("foo"))
 	assert.False(t, IsDevNull("foo"))
 }
+
+func TestGetExitCode(t *testing.T) {
+	assert.Equal(t, 0, GetExitCode(nil))
+	assert.Equal(t, 1, GetExitCode(errors.New("foo")))
+	assert.Equal(t, 5, GetExitCode(NewError(5, "foo")))
+	assert.Equal(t, 5, GetExitCode(fmt.Errorf("bar: %w", NewError(5, "foo"))))
+	assert.Equal(t, "", NewError(5, "").Error())
+}
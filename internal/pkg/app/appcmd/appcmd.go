@@ -145,7 +145,11 @@ func run(
 		})
 	}
 
-	cobraCommand.SetArgs(app.Args(container)[1:])
+	args, err := ExpandArgFiles(app.Args(container)[1:])
+	if err != nil {
+		return err
+	}
+	cobraCommand.SetArgs(args)
 	cobraCommand.SetOut(container.Stderr())
 	cobraCommand.SetErr(container.Stderr())
 
@@ -16,7 +16,9 @@ package appcmd
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -106,3 +108,156 @@ func TestError(t *testing.T) {
 	)
 	require.Equal(t, app.NewError(5, "bar"), Run(context.Background(), container, rootCommand))
 }
+
+func TestArgFile(t *testing.T) {
+	var foo string
+	var bar int
+
+	var actualArgs []string
+	var actualFoo string
+	var actualBar int
+
+	rootCommand := &Command{
+		Use: "test",
+		BindPersistentFlags: func(flagSet *pflag.FlagSet) {
+			flagSet.StringVar(&foo, "foo", "", "Foo.")
+		},
+		SubCommands: []*Command{
+			{
+				Use: "sub",
+				BindFlags: func(flagSet *pflag.FlagSet) {
+					flagSet.IntVar(&bar, "bar", 1, "Bar.")
+				},
+				Run: func(ctx context.Context, container app.Container) error {
+					actualArgs = app.Args(container)
+					actualFoo = foo
+					actualBar = bar
+					return nil
+				},
+			},
+		},
+	}
+	tempDirPath := t.TempDir()
+	argFilePath1 := filepath.Join(tempDirPath, "args1.txt")
+	argFilePath2 := filepath.Join(tempDirPath, "args2.txt")
+	require.NoError(
+		t,
+		ioutil.WriteFile(
+			argFilePath1,
+			[]byte(`# this is a comment
+--foo
+  hello world  
+
+"  two  "
+@`+argFilePath2+`
+'#three'
+`),
+			0600,
+		),
+	)
+	require.NoError(
+		t,
+		ioutil.WriteFile(
+			argFilePath2,
+			[]byte("--bar\n2\n"),
+			0600,
+		),
+	)
+	container := app.NewContainer(
+		nil,
+		nil,
+		nil,
+		nil,
+		"test",
+		"sub",
+		"one",
+		"@"+argFilePath1,
+		"four",
+	)
+	require.NoError(t, Run(context.Background(), container, rootCommand))
+	assert.Equal(t, []string{"one", "  two  ", "#three", "four"}, actualArgs)
+	assert.Equal(t, "hello world", actualFoo)
+	assert.Equal(t, 2, actualBar)
+}
+
+func TestArgFileRecursive(t *testing.T) {
+	rootCommand := &Command{
+		Use: "test",
+		Run: func(ctx context.Context, container app.Container) error {
+			return nil
+		},
+	}
+	tempDirPath := t.TempDir()
+	argFilePath1 := filepath.Join(tempDirPath, "args1.txt")
+	argFilePath2 := filepath.Join(tempDirPath, "args2.txt")
+	require.NoError(t, ioutil.WriteFile(argFilePath1, []byte("@"+argFilePath2+"\n"), 0600))
+	require.NoError(t, ioutil.WriteFile(argFilePath2, []byte("@"+argFilePath1+"\n"), 0600))
+	container := app.NewContainer(
+		nil,
+		nil,
+		nil,
+		nil,
+		"test",
+		"@"+argFilePath1,
+	)
+	require.Equal(
+		t,
+		fmt.Errorf("%s recursively referenced", argFilePath1),
+		Run(context.Background(), container, rootCommand),
+	)
+}
+
+func TestArgFileReferencedTwice(t *testing.T) {
+	var actualArgs []string
+	rootCommand := &Command{
+		Use: "test",
+		Run: func(ctx context.Context, container app.Container) error {
+			actualArgs = app.Args(container)
+			return nil
+		},
+	}
+	tempDirPath := t.TempDir()
+	argFilePath1 := filepath.Join(tempDirPath, "args1.txt")
+	argFilePath2 := filepath.Join(tempDirPath, "args2.txt")
+	argFilePath3 := filepath.Join(tempDirPath, "args3.txt")
+	require.NoError(t, ioutil.WriteFile(argFilePath1, []byte("one\n"), 0600))
+	require.NoError(t, ioutil.WriteFile(argFilePath2, []byte("two\n@"+argFilePath1+"\n"), 0600))
+	require.NoError(t, ioutil.WriteFile(argFilePath3, []byte("three\n@"+argFilePath1+"\n"), 0600))
+	container := app.NewContainer(
+		nil,
+		nil,
+		nil,
+		nil,
+		"test",
+		"@"+argFilePath1,
+		"@"+argFilePath1,
+		"@"+argFilePath2,
+		"@"+argFilePath3,
+	)
+	require.NoError(t, Run(context.Background(), container, rootCommand))
+	assert.Equal(t, []string{"one", "one", "two", "one", "three", "one"}, actualArgs)
+}
+
+func TestArgFileUnterminatedQuote(t *testing.T) {
+	rootCommand := &Command{
+		Use: "test",
+		Run: func(ctx context.Context, container app.Container) error {
+			return nil
+		},
+	}
+	argFilePath := filepath.Join(t.TempDir(), "args.txt")
+	require.NoError(t, ioutil.WriteFile(argFilePath, []byte("one\n\"two\n"), 0600))
+	container := app.NewContainer(
+		nil,
+		nil,
+		nil,
+		nil,
+		"test",
+		"@"+argFilePath,
+	)
+	require.Equal(
+		t,
+		fmt.Errorf("%s:2: unterminated quote", argFilePath),
+		Run(context.Background(), container, rootCommand),
+	)
+}
@@ -0,0 +1,93 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appcmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// ExpandArgFiles expands all arguments of the form @file into the
+// arguments contained within file.
+//
+// Run calls this for every command before flags are parsed. Each line of the
+// file is a single argument, regardless of whitespace or quotes within the
+// line, as with protoc. Unlike protoc:
+//
+//   - Leading and trailing whitespace is trimmed, and empty lines are ignored.
+//   - Lines starting with # are comments and are ignored.
+//   - Lines wrapped in matching single or double quotes have the quotes removed,
+//     which allows arguments with leading or trailing whitespace, or that start
+//     with #. Quotes elsewhere in a line are kept as-is.
+//   - Argument files may reference other argument files, and the same argument
+//     file may be referenced more than once, but an argument file may not
+//     reference itself, directly or transitively.
+//
+// A file path that is relative is interpreted as relative to the current
+// working directory, not the directory of the referencing file, as with protoc.
+func ExpandArgFiles(args []string) ([]string, error) {
+	return expandArgFilesRec(args, make(map[string]struct{}))
+}
+
+// expandArgFilesRec expands args, where argFilePathStack contains the
+// argument files currently being expanded.
+func expandArgFilesRec(args []string, argFilePathStack map[string]struct{}) ([]string, error) {
+	expandedArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		if len(arg) < 2 || arg[0] != '@' {
+			expandedArgs = append(expandedArgs, arg)
+			continue
+		}
+		argFilePath := arg[1:]
+		if _, ok := argFilePathStack[argFilePath]; ok {
+			return nil, fmt.Errorf("%s recursively referenced", argFilePath)
+		}
+		argFileArgs, err := readArgFile(argFilePath)
+		if err != nil {
+			return nil, err
+		}
+		argFilePathStack[argFilePath] = struct{}{}
+		expandedArgFileArgs, err := expandArgFilesRec(argFileArgs, argFilePathStack)
+		delete(argFilePathStack, argFilePath)
+		if err != nil {
+			return nil, err
+		}
+		expandedArgs = append(expandedArgs, expandedArgFileArgs...)
+	}
+	return expandedArgs, nil
+}
+
+func readArgFile(argFilePath string) ([]string, error) {
+	data, err := ioutil.ReadFile(argFilePath)
+	if err != nil {
+		return nil, err
+	}
+	var args []string
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if quote := line[0]; quote == '"' || quote == '\'' {
+			if len(line) < 2 || line[len(line)-1] != quote {
+				return nil, fmt.Errorf("%s:%d: unterminated quote", argFilePath, i+1)
+			}
+			line = line[1 : len(line)-1]
+		}
+		args = append(args, line)
+	}
+	return args, nil
+}
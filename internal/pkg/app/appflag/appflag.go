@@ -56,6 +56,17 @@ func BuilderWithTimeout(defaultTimeout time.Duration) BuilderOption {
 	}
 }
 
+// BuilderWithTimeoutProvider returns a new BuilderOption that overrides the default
+// timeout with the timeout returned by timeoutProvider if the timeout flag is not set.
+//
+// If timeoutProvider returns 0, the default timeout is used. This has no effect
+// unless BuilderWithTimeout is also used.
+func BuilderWithTimeoutProvider(timeoutProvider func(Container) (time.Duration, error)) BuilderOption {
+	return func(builder *builder) {
+		builder.timeoutProvider = timeoutProvider
+	}
+}
+
 // BuilderWithTracing enables zap tracing for the builder.
 func BuilderWithTracing() BuilderOption {
 	return func(builder *builder) {
@@ -50,6 +50,9 @@ type builder struct {
 	defaultTimeout time.Duration
 
 	tracing bool
+
+	timeoutFlag     *pflag.Flag
+	timeoutProvider func(Container) (time.Duration, error)
 }
 
 func newBuilder(appName string, options ...BuilderOption) *builder {
@@ -73,6 +76,7 @@ func (b *builder) BindRoot(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&b.logFormat, "log-format", "color", "The log format [text,color,json].")
 	if b.defaultTimeout > 0 {
 		flagSet.DurationVar(&b.timeout, "timeout", b.defaultTimeout, `The duration until timing out.`)
+		b.timeoutFlag = flagSet.Lookup("timeout")
 	}
 
 	flagSet.BoolVar(&b.profile, "profile", false, "Run profiling.")
@@ -118,9 +122,19 @@ func (b *builder) run(
 		return err
 	}
 
+	timeout := b.timeout
+	if b.timeoutFlag != nil && !b.timeoutFlag.Changed && b.timeoutProvider != nil {
+		providedTimeout, err := b.timeoutProvider(container)
+		if err != nil {
+			return err
+		}
+		if providedTimeout != 0 {
+			timeout = providedTimeout
+		}
+	}
 	var cancel context.CancelFunc
-	if !b.profile && b.timeout != 0 {
-		ctx, cancel = context.WithTimeout(ctx, b.timeout)
+	if !b.profile && timeout != 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
 		defer cancel()
 	}
 
@@ -108,6 +108,26 @@ type Generator interface {
 		requests []*pluginpb.CodeGeneratorRequest,
 		options ...GenerateOption,
 	) error
+	// Execute executes the requests and returns the files of the combined
+	// response without writing them.
+	//
+	// The files can be written later with PutResponseFiles.
+	Execute(
+		ctx context.Context,
+		container app.EnvStderrContainer,
+		requests []*pluginpb.CodeGeneratorRequest,
+	) ([]*pluginpb.CodeGeneratorResponse_File, error)
+}
+
+// PutResponseFiles writes the files returned by Generator.Execute to the
+// bucket, applying insertion points.
+func PutResponseFiles(
+	ctx context.Context,
+	writeBucket storage.WriteBucket,
+	files []*pluginpb.CodeGeneratorResponse_File,
+	options ...GenerateOption,
+) error {
+	return putResponseFiles(ctx, writeBucket, files, options...)
 }
 
 // GenerateOption is an option for Generate.
@@ -19,10 +19,16 @@
 package appprotoexec
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os/exec"
+	"path/filepath"
+	"strings"
 
+	"github.com/bufbuild/buf/internal/pkg/app"
 	"github.com/bufbuild/buf/internal/pkg/app/appproto"
+	"github.com/bufbuild/buf/internal/pkg/ioutilextended"
 	"github.com/bufbuild/buf/internal/pkg/storage/storageos"
 	"go.uber.org/zap"
 )
@@ -58,7 +64,8 @@ var (
 // protocPath and pluginPath are optional.
 //
 // - If the plugin path is set, this returns a new binary handler for that path.
-// - If the plugin path is unset, this does exec.LookPath for a binary named protoc-gen-pluginName,
+// - If the plugin path is unset, this looks for a binary named protoc-gen-pluginName in the
+//   plugin search directories, in order, and then does exec.LookPath for this binary,
 //   and if one is found, a new binary handler is returned for this.
 // - Else, if the name is in ProtocProxyPluginNames, this returns a new protoc proxy handler.
 // - Else, this returns error.
@@ -72,28 +79,53 @@ func NewHandler(
 	for _, option := range options {
 		option(handlerOptions)
 	}
-	if handlerOptions.pluginPath != "" {
-		pluginPath, err := exec.LookPath(handlerOptions.pluginPath)
-		if err != nil {
-			return nil, err
-		}
-		return newBinaryHandler(logger, pluginPath), nil
+	binaryPath, isProtocProxy, err := getBinaryPath(pluginName, handlerOptions)
+	if err != nil {
+		return nil, err
 	}
-	pluginPath, err := exec.LookPath("protoc-gen-" + pluginName)
-	if err == nil {
-		return newBinaryHandler(logger, pluginPath), nil
+	if isProtocProxy {
+		return newProtocProxyHandler(logger, storageosProvider, binaryPath, pluginName), nil
 	}
-	if _, ok := ProtocProxyPluginNames[pluginName]; ok {
-		if handlerOptions.protocPath == "" {
-			handlerOptions.protocPath = "protoc"
-		}
-		protocPath, err := exec.LookPath(handlerOptions.protocPath)
-		if err != nil {
-			return nil, err
-		}
-		return newProtocProxyHandler(logger, storageosProvider, protocPath, pluginName), nil
+	return newBinaryHandler(logger, binaryPath), nil
+}
+
+// GetPluginVersion returns the output of invoking the plugin with --version,
+// with surrounding whitespace trimmed.
+//
+// The plugin is resolved in the same manner as NewHandler. If the plugin is
+// proxied through protoc, this is the version of protoc.
+func GetPluginVersion(
+	ctx context.Context,
+	container app.EnvContainer,
+	pluginName string,
+	options ...HandlerOption,
+) (string, error) {
+	handlerOptions := newHandlerOptions()
+	for _, option := range options {
+		option(handlerOptions)
+	}
+	binaryPath, _, err := getBinaryPath(pluginName, handlerOptions)
+	if err != nil {
+		return "", err
+	}
+	return getBinaryVersion(ctx, container, binaryPath)
+}
+
+// GetPluginBinaryPath returns the path of the binary that is executed for the
+// plugin.
+//
+// The plugin is resolved in the same manner as NewHandler. If the plugin is
+// proxied through protoc, this is the path of protoc.
+func GetPluginBinaryPath(pluginName string, options ...HandlerOption) (string, error) {
+	handlerOptions := newHandlerOptions()
+	for _, option := range options {
+		option(handlerOptions)
 	}
-	return nil, fmt.Errorf("could not find protoc plugin for name %s", pluginName)
+	binaryPath, _, err := getBinaryPath(pluginName, handlerOptions)
+	if err != nil {
+		return "", err
+	}
+	return binaryPath, nil
 }
 
 // HandlerOption is an option for a new Handler.
@@ -117,9 +149,71 @@ func HandlerWithPluginPath(pluginPath string) HandlerOption {
 	}
 }
 
+// HandlerWithPluginSearchDirPaths returns a new HandlerOption that sets directories
+// to look for the plugin binary in if the plugin path is not set.
+//
+// The directories are checked in order for a binary named "protoc-gen-" + pluginName
+// before exec.LookPath is done, that is they take precedence over the PATH.
+func HandlerWithPluginSearchDirPaths(pluginSearchDirPaths []string) HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.pluginSearchDirPaths = pluginSearchDirPaths
+	}
+}
+
+// getBinaryPath returns the path to the binary to execute for the plugin, and
+// whether the binary is protoc being used as a proxy for the plugin.
+func getBinaryPath(pluginName string, handlerOptions *handlerOptions) (string, bool, error) {
+	if handlerOptions.pluginPath != "" {
+		pluginPath, err := exec.LookPath(handlerOptions.pluginPath)
+		if err != nil {
+			return "", false, err
+		}
+		return pluginPath, false, nil
+	}
+	for _, pluginSearchDirPath := range handlerOptions.pluginSearchDirPaths {
+		// exec.LookPath does not search the PATH for a path with a separator,
+		// but still checks that the file is executable
+		pluginPath, err := exec.LookPath(filepath.Join(pluginSearchDirPath, "protoc-gen-"+pluginName))
+		if err == nil {
+			return pluginPath, false, nil
+		}
+	}
+	pluginPath, err := exec.LookPath("protoc-gen-" + pluginName)
+	if err == nil {
+		return pluginPath, false, nil
+	}
+	if _, ok := ProtocProxyPluginNames[pluginName]; ok {
+		protocName := handlerOptions.protocPath
+		if protocName == "" {
+			protocName = "protoc"
+		}
+		protocPath, err := exec.LookPath(protocName)
+		if err != nil {
+			return "", false, err
+		}
+		return protocPath, true, nil
+	}
+	return "", false, fmt.Errorf("could not find protoc plugin for name %s", pluginName)
+}
+
+func getBinaryVersion(ctx context.Context, container app.EnvContainer, binaryPath string) (string, error) {
+	stdoutBuffer := bytes.NewBuffer(nil)
+	stderrBuffer := bytes.NewBuffer(nil)
+	cmd := exec.CommandContext(ctx, binaryPath, "--version")
+	cmd.Env = app.Environ(container)
+	cmd.Stdin = ioutilextended.DiscardReader
+	cmd.Stdout = stdoutBuffer
+	cmd.Stderr = stderrBuffer
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%v\n%v", err, stderrBuffer.String())
+	}
+	return strings.TrimSpace(stdoutBuffer.String()), nil
+}
+
 type handlerOptions struct {
-	protocPath string
-	pluginPath string
+	protocPath           string
+	pluginPath           string
+	pluginSearchDirPaths []string
 }
 
 func newHandlerOptions() *handlerOptions {
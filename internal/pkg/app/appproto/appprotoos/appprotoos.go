@@ -37,6 +37,27 @@ type Generator interface {
 		requests []*pluginpb.CodeGeneratorRequest,
 		options ...GenerateOption,
 	) error
+	// Execute executes the plugin and returns the files of the combined
+	// response without writing them.
+	//
+	// Only GenerateWithPluginPath and GenerateWithPluginSearchDirPaths are used by Execute.
+	Execute(
+		ctx context.Context,
+		container app.EnvStderrContainer,
+		pluginName string,
+		requests []*pluginpb.CodeGeneratorRequest,
+		options ...GenerateOption,
+	) ([]*pluginpb.CodeGeneratorResponse_File, error)
+	// Write writes the files returned by Execute to the os filesystem,
+	// switching on the file extension of pluginOut in the same manner as Generate.
+	//
+	// GenerateWithPluginPath and GenerateWithPluginSearchDirPaths are ignored by Write.
+	Write(
+		ctx context.Context,
+		pluginOut string,
+		files []*pluginpb.CodeGeneratorResponse_File,
+		options ...GenerateOption,
+	) error
 }
 
 // NewGenerator returns a new Generator.
@@ -58,6 +79,14 @@ func GenerateWithPluginPath(pluginPath string) GenerateOption {
 	}
 }
 
+// GenerateWithPluginSearchDirPaths returns a new GenerateOption that looks for
+// the plugin in the given directories before the PATH if no plugin path is given.
+func GenerateWithPluginSearchDirPaths(pluginSearchDirPaths []string) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.pluginSearchDirPaths = pluginSearchDirPaths
+	}
+}
+
 // GenerateWithCreateOutDirIfNotExists returns a new GenerateOption that creates
 // the directory if it does not exist.
 func GenerateWithCreateOutDirIfNotExists() GenerateOption {
@@ -65,3 +94,17 @@ func GenerateWithCreateOutDirIfNotExists() GenerateOption {
 		generateOptions.createOutDirIfNotExists = true
 	}
 }
+
+// GenerateWithOutputFilePathFunc returns a new GenerateOption that calls
+// the given function with the path of every file that is written.
+//
+// If the plugin output is a .jar or .zip file, this is called once with
+// the path of the archive. Otherwise, this is called with the path of each
+// file written to the output directory, joined with the output directory.
+// The same path may be given more than once if a plugin writes to an
+// insertion point.
+func GenerateWithOutputFilePathFunc(outputFilePathFunc func(string)) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.outputFilePathFunc = outputFilePathFunc
+	}
+}
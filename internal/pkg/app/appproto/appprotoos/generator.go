@@ -63,7 +63,21 @@ func (g *generator) Generate(
 	pluginOut string,
 	requests []*pluginpb.CodeGeneratorRequest,
 	options ...GenerateOption,
-) (retErr error) {
+) error {
+	files, err := g.Execute(ctx, container, pluginName, requests, options...)
+	if err != nil {
+		return err
+	}
+	return g.Write(ctx, pluginOut, files, options...)
+}
+
+func (g *generator) Execute(
+	ctx context.Context,
+	container app.EnvStderrContainer,
+	pluginName string,
+	requests []*pluginpb.CodeGeneratorRequest,
+	options ...GenerateOption,
+) ([]*pluginpb.CodeGeneratorResponse_File, error) {
 	generateOptions := newGenerateOptions()
 	for _, option := range options {
 		option(generateOptions)
@@ -73,52 +87,61 @@ func (g *generator) Generate(
 		g.storageosProvider,
 		pluginName,
 		appprotoexec.HandlerWithPluginPath(generateOptions.pluginPath),
+		appprotoexec.HandlerWithPluginSearchDirPaths(generateOptions.pluginSearchDirPaths),
 	)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return appproto.NewGenerator(g.logger, handler).Execute(ctx, container, requests)
+}
+
+func (g *generator) Write(
+	ctx context.Context,
+	pluginOut string,
+	files []*pluginpb.CodeGeneratorResponse_File,
+	options ...GenerateOption,
+) error {
+	generateOptions := newGenerateOptions()
+	for _, option := range options {
+		option(generateOptions)
 	}
-	appprotoGenerator := appproto.NewGenerator(g.logger, handler)
 	switch filepath.Ext(pluginOut) {
 	case ".jar":
-		return g.generateZip(
+		return g.writeZip(
 			ctx,
-			container,
-			appprotoGenerator,
 			pluginOut,
-			requests,
+			files,
 			true,
 			generateOptions.createOutDirIfNotExists,
+			generateOptions.outputFilePathFunc,
 		)
 	case ".zip":
-		return g.generateZip(
+		return g.writeZip(
 			ctx,
-			container,
-			appprotoGenerator,
 			pluginOut,
-			requests,
+			files,
 			false,
 			generateOptions.createOutDirIfNotExists,
+			generateOptions.outputFilePathFunc,
 		)
 	default:
-		return g.generateDirectory(
+		return g.writeDirectory(
 			ctx,
-			container,
-			appprotoGenerator,
 			pluginOut,
-			requests,
+			files,
 			generateOptions.createOutDirIfNotExists,
+			generateOptions.outputFilePathFunc,
 		)
 	}
 }
 
-func (g *generator) generateZip(
+func (g *generator) writeZip(
 	ctx context.Context,
-	container app.EnvStderrContainer,
-	appprotoGenerator appproto.Generator,
 	outFilePath string,
-	requests []*pluginpb.CodeGeneratorRequest,
+	files []*pluginpb.CodeGeneratorResponse_File,
 	includeManifest bool,
 	createOutDirIfNotExists bool,
+	outputFilePathFunc func(string),
 ) (retErr error) {
 	outDirPath := filepath.Dir(outFilePath)
 	// OK to use os.Stat instead of os.Lstat here
@@ -138,7 +161,7 @@ func (g *generator) generateZip(
 		return fmt.Errorf("not a directory: %s", outDirPath)
 	}
 	readBucketBuilder := storagemem.NewReadBucketBuilder()
-	if err := appprotoGenerator.Generate(ctx, container, readBucketBuilder, requests); err != nil {
+	if err := appproto.PutResponseFiles(ctx, readBucketBuilder, files); err != nil {
 		return err
 	}
 	if includeManifest {
@@ -158,16 +181,21 @@ func (g *generator) generateZip(
 		return err
 	}
 	// protoc does not compress
-	return storagearchive.Zip(ctx, readBucket, file, false)
+	if err := storagearchive.Zip(ctx, readBucket, file, false); err != nil {
+		return err
+	}
+	if outputFilePathFunc != nil {
+		outputFilePathFunc(outFilePath)
+	}
+	return nil
 }
 
-func (g *generator) generateDirectory(
+func (g *generator) writeDirectory(
 	ctx context.Context,
-	container app.EnvStderrContainer,
-	appprotoGenerator appproto.Generator,
 	outDirPath string,
-	requests []*pluginpb.CodeGeneratorRequest,
+	files []*pluginpb.CodeGeneratorResponse_File,
 	createOutDirIfNotExists bool,
+	outputFilePathFunc func(string),
 ) error {
 	if createOutDirIfNotExists {
 		if err := os.MkdirAll(outDirPath, 0755); err != nil {
@@ -182,20 +210,55 @@ func (g *generator) generateDirectory(
 	if err != nil {
 		return err
 	}
-	return appprotoGenerator.Generate(
+	var writeBucket storage.WriteBucket = readWriteBucket
+	if outputFilePathFunc != nil {
+		writeBucket = newOutputFilePathWriteBucket(writeBucket, outDirPath, outputFilePathFunc)
+	}
+	return appproto.PutResponseFiles(
 		ctx,
-		container,
-		readWriteBucket,
-		requests,
+		writeBucket,
+		files,
 		appproto.GenerateWithInsertionPointReadBucket(readWriteBucket),
 	)
 }
 
 type generateOptions struct {
 	pluginPath              string
+	pluginSearchDirPaths    []string
 	createOutDirIfNotExists bool
+	outputFilePathFunc      func(string)
 }
 
 func newGenerateOptions() *generateOptions {
 	return &generateOptions{}
 }
+
+// outputFilePathWriteBucket is a storage.WriteBucket that calls
+// outputFilePathFunc for every path that is put.
+type outputFilePathWriteBucket struct {
+	storage.WriteBucket
+
+	outDirPath         string
+	outputFilePathFunc func(string)
+}
+
+func newOutputFilePathWriteBucket(
+	writeBucket storage.WriteBucket,
+	outDirPath string,
+	outputFilePathFunc func(string),
+) *outputFilePathWriteBucket {
+	return &outputFilePathWriteBucket{
+		WriteBucket:        writeBucket,
+		outDirPath:         outDirPath,
+		outputFilePathFunc: outputFilePathFunc,
+	}
+}
+
+func (w *outputFilePathWriteBucket) Put(ctx context.Context, path string) (storage.WriteObjectCloser, error) {
+	writeObjectCloser, err := w.WriteBucket.Put(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	w.outputFilePathFunc(normalpath.Join(normalpath.Normalize(w.outDirPath), path))
+	return writeObjectCloser, nil
+}
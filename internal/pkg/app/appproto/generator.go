@@ -54,30 +54,14 @@ func (g *generator) Generate(
 	requests []*pluginpb.CodeGeneratorRequest,
 	options ...GenerateOption,
 ) error {
-	generateOptions := newGenerateOptions()
-	for _, option := range options {
-		option(generateOptions)
-	}
-	files, err := g.getResponseFiles(ctx, container, requests)
+	files, err := g.Execute(ctx, container, requests)
 	if err != nil {
 		return err
 	}
-	for _, file := range files {
-		if file.GetInsertionPoint() != "" {
-			if generateOptions.insertionPointReadBucket == nil {
-				return storage.NewErrNotExist(file.GetName())
-			}
-			if err := applyInsertionPoint(ctx, file, generateOptions.insertionPointReadBucket, writeBucket); err != nil {
-				return err
-			}
-		} else if err := storage.PutPath(ctx, writeBucket, file.GetName(), []byte(file.GetContent())); err != nil {
-			return err
-		}
-	}
-	return nil
+	return putResponseFiles(ctx, writeBucket, files, options...)
 }
 
-func (g *generator) getResponseFiles(
+func (g *generator) Execute(
 	ctx context.Context,
 	container app.EnvStderrContainer,
 	requests []*pluginpb.CodeGeneratorRequest,
@@ -106,6 +90,31 @@ func (g *generator) getResponseFiles(
 	return response.File, nil
 }
 
+func putResponseFiles(
+	ctx context.Context,
+	writeBucket storage.WriteBucket,
+	files []*pluginpb.CodeGeneratorResponse_File,
+	options ...GenerateOption,
+) error {
+	generateOptions := newGenerateOptions()
+	for _, option := range options {
+		option(generateOptions)
+	}
+	for _, file := range files {
+		if file.GetInsertionPoint() != "" {
+			if generateOptions.insertionPointReadBucket == nil {
+				return storage.NewErrNotExist(file.GetName())
+			}
+			if err := applyInsertionPoint(ctx, file, generateOptions.insertionPointReadBucket, writeBucket); err != nil {
+				return err
+			}
+		} else if err := storage.PutPath(ctx, writeBucket, file.GetName(), []byte(file.GetContent())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // applyInsertionPoint inserts the content of the given file at the insertion point that it specfiies.
 // For more details on insertion points, see the following:
 //
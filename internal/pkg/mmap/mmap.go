@@ -0,0 +1,94 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mmap provides read-only memory-mapped files.
+package mmap
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+)
+
+// File is a file that was read into memory.
+//
+// The file is memory-mapped if supported by the platform, otherwise it is
+// read fully into memory. Bytes must not be modified, and must not be used
+// after Close is called.
+type File interface {
+	// Read reads from the file.
+	Read(p []byte) (int, error)
+	// Bytes returns the content of the file.
+	Bytes() []byte
+	// Close unmaps the file.
+	Close() error
+}
+
+// Open opens the file at the path.
+//
+// If the file cannot be memory-mapped, for example if the platform does not
+// support mmap or the file is not a regular file, the file is read instead.
+func Open(filePath string) (File, error) {
+	osFile, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	// the mapping remains valid after the file is closed
+	defer func() {
+		_ = osFile.Close()
+	}()
+	fileInfo, err := osFile.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fileInfo.Mode().IsRegular() && fileInfo.Size() > 0 {
+		data, err := mmap(osFile, fileInfo.Size())
+		if err == nil {
+			return newFile(data, munmap), nil
+		}
+	}
+	data, err := ioutil.ReadAll(osFile)
+	if err != nil {
+		return nil, err
+	}
+	return newFile(data, nil), nil
+}
+
+type file struct {
+	*bytes.Reader
+
+	data   []byte
+	closer func([]byte) error
+}
+
+func newFile(data []byte, closer func([]byte) error) *file {
+	return &file{
+		Reader: bytes.NewReader(data),
+		data:   data,
+		closer: closer,
+	}
+}
+
+func (f *file) Bytes() []byte {
+	return f.data
+}
+
+func (f *file) Close() error {
+	if f.closer == nil {
+		return nil
+	}
+	closer := f.closer
+	f.closer = nil
+	return closer(f.data)
+}
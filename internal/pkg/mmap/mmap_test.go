@@ -0,0 +1,54 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmap
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+	filePath := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("one"), 0600))
+	file, err := Open(filePath)
+	require.NoError(t, err)
+	require.Equal(t, "one", string(file.Bytes()))
+	data, err := ioutil.ReadAll(file)
+	require.NoError(t, err)
+	require.Equal(t, "one", string(data))
+	require.NoError(t, file.Close())
+	// closing twice does not unmap twice
+	require.NoError(t, file.Close())
+}
+
+func TestOpenEmpty(t *testing.T) {
+	t.Parallel()
+	filePath := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, ioutil.WriteFile(filePath, nil, 0600))
+	file, err := Open(filePath)
+	require.NoError(t, err)
+	require.Empty(t, file.Bytes())
+	require.NoError(t, file.Close())
+}
+
+func TestOpenNotExist(t *testing.T) {
+	t.Parallel()
+	_, err := Open(filepath.Join(t.TempDir(), "file"))
+	require.Error(t, err)
+}
@@ -16,10 +16,16 @@
 // and more validation.
 package netconfig
 
+import (
+	"github.com/bufbuild/buf/internal/pkg/cert/certclient"
+)
+
 // ExternalRemote represents a remote configuration in json or yaml.
 type ExternalRemote struct {
 	Address string        `json:"address,omitempty" yaml:"address,omitempty"`
 	Login   ExternalLogin `json:"login,omitempty" yaml:"login,omitempty"`
+	// TLS overrides the top-level TLS configuration for this remote if set.
+	TLS certclient.ExternalClientTLSConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
 }
 
 // ExternalLogin represents a credentials configuration in json or yaml.
@@ -31,6 +37,10 @@ type ExternalLogin struct {
 type Remote interface {
 	Address() string
 	Login() (Login, bool)
+	// TLS returns the TLS configuration for this remote.
+	//
+	// Returns false if the remote does not override the top-level TLS configuration.
+	TLS() (certclient.ExternalClientTLSConfig, bool)
 
 	toExternalRemote() ExternalRemote
 }
@@ -50,7 +60,8 @@ type RemoteProvider interface {
 	GetRemote(address string) (Remote, bool)
 	// WithUpdatedRemote returns a new RemoteProvider with the Remote updated at the given address.
 	//
-	// If this Remote already existed, this overwrites the existing remote.
+	// If this Remote already existed, this overwrites the existing remote, keeping
+	// its TLS configuration.
 	// If this Remote did not exist, this adds a new Remote.
 	WithUpdatedRemote(address string, updatedToken string) (RemoteProvider, error)
 	// WithoutRemote returns a new RemoteProvider with the Remove deleted.
@@ -17,6 +17,7 @@ package netconfig
 import (
 	"testing"
 
+	"github.com/bufbuild/buf/internal/pkg/cert/certclient"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,3 +99,46 @@ func TestBasic(t *testing.T) {
 		outputExternalRemotes,
 	)
 }
+
+func TestTLS(t *testing.T) {
+	externalTLS := certclient.ExternalClientTLSConfig{
+		Use:               "local",
+		RootCertFilePaths: []string{"root.pem"},
+	}
+	externalRemotes := []ExternalRemote{
+		{
+			Address: "foo",
+			TLS:     externalTLS,
+		},
+		{
+			Address: "goo",
+		},
+	}
+
+	remoteProvider, err := NewRemoteProvider(externalRemotes)
+	require.NoError(t, err)
+
+	remote, ok := remoteProvider.GetRemote("foo")
+	require.True(t, ok)
+	tls, ok := remote.TLS()
+	require.True(t, ok)
+	require.Equal(t, externalTLS, tls)
+	remote, ok = remoteProvider.GetRemote("goo")
+	require.True(t, ok)
+	_, ok = remote.TLS()
+	require.False(t, ok)
+
+	// the TLS configuration is kept when the login is updated
+	updatedRemoteProvider, err := remoteProvider.WithUpdatedRemote(
+		"foo",
+		"ban",
+	)
+	require.NoError(t, err)
+	remote, ok = updatedRemoteProvider.GetRemote("foo")
+	require.True(t, ok)
+	tls, ok = remote.TLS()
+	require.True(t, ok)
+	require.Equal(t, externalTLS, tls)
+
+	require.Equal(t, externalRemotes, remoteProvider.ToExternalRemotes())
+}
@@ -16,14 +16,17 @@ package netconfig
 
 import (
 	"errors"
+
+	"github.com/bufbuild/buf/internal/pkg/cert/certclient"
 )
 
 type remote struct {
 	address string
 	login   *login
+	tls     certclient.ExternalClientTLSConfig
 }
 
-func newRemote(address string, token string) (*remote, error) {
+func newRemote(address string, token string, tls certclient.ExternalClientTLSConfig) (*remote, error) {
 	login, err := newLogin(token)
 	if err != nil {
 		return nil, err
@@ -31,6 +34,7 @@ func newRemote(address string, token string) (*remote, error) {
 	remote := &remote{
 		address: address,
 		login:   login,
+		tls:     tls,
 	}
 	if err := validateRemote(remote); err != nil {
 		return nil, err
@@ -49,9 +53,14 @@ func (r *remote) Login() (Login, bool) {
 	return r.login, true
 }
 
+func (r *remote) TLS() (certclient.ExternalClientTLSConfig, bool) {
+	return r.tls, !r.tls.IsEmpty()
+}
+
 func (r *remote) toExternalRemote() ExternalRemote {
 	externalRemote := ExternalRemote{
 		Address: r.address,
+		TLS:     r.tls,
 	}
 	if r.login != nil {
 		externalRemote.Login = r.login.toExternalLogin()
@@ -17,6 +17,8 @@ package netconfig
 import (
 	"fmt"
 	"sort"
+
+	"github.com/bufbuild/buf/internal/pkg/cert/certclient"
 )
 
 type remoteProvider struct {
@@ -29,6 +31,7 @@ func newRemoteProvider(externalRemotes []ExternalRemote) (*remoteProvider, error
 		remote, err := newRemote(
 			externalRemote.Address,
 			externalRemote.Login.Token,
+			externalRemote.TLS,
 		)
 		if err != nil {
 			return nil, err
@@ -53,9 +56,14 @@ func (r *remoteProvider) GetRemote(address string) (Remote, bool) {
 }
 
 func (r *remoteProvider) WithUpdatedRemote(address string, updatedToken string) (RemoteProvider, error) {
+	var tls certclient.ExternalClientTLSConfig
+	if curRemote, ok := r.addressToRemote[address]; ok {
+		tls, _ = curRemote.TLS()
+	}
 	updatedRemote, err := newRemote(
 		address,
 		updatedToken,
+		tls,
 	)
 	if err != nil {
 		return nil, err
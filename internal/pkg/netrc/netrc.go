@@ -67,6 +67,19 @@ func PutMachine(envContainer app.EnvContainer, machine Machine) error {
 	return putMachineForFilePath(machine, filePath)
 }
 
+// DeleteMachineForName deletes the Machine for the given name from the
+// configured netrc file.
+//
+// The default machine is never deleted.
+// Returns false if there was no such Machine.
+func DeleteMachineForName(envContainer app.EnvContainer, name string) (bool, error) {
+	filePath, err := getFilePath(envContainer)
+	if err != nil {
+		return false, err
+	}
+	return deleteMachineForNameAndFilePath(name, filePath)
+}
+
 func getFilePath(envContainer app.EnvContainer) (string, error) {
 	if netrcFilePath := envContainer.Env("NETRC"); netrcFilePath != "" {
 		return netrcFilePath, nil
@@ -112,7 +125,8 @@ func putMachineForFilePath(machine Machine, filePath string) (retErr error) {
 			return err
 		}
 		// If a netrc file does not already exist, create one and continue.
-		file, err = os.Create(filePath)
+		// The netrc file contains credentials, so it is only readable by the user.
+		file, err = os.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 		if err != nil {
 			return err
 		}
@@ -145,3 +159,38 @@ func putMachineForFilePath(machine Machine, filePath string) (retErr error) {
 	}
 	return ioutil.WriteFile(filePath, bytes, info.Mode())
 }
+
+func deleteMachineForNameAndFilePath(name string, filePath string) (_ bool, retErr error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, file.Close())
+	}()
+	netrc, err := netrc.Parse(file)
+	if err != nil {
+		return false, err
+	}
+	// FindMachine falls back to the default machine if there is no
+	// machine with the given name.
+	if foundMachine := netrc.FindMachine(name); foundMachine == nil || foundMachine.IsDefault() {
+		return false, nil
+	}
+	netrc.RemoveMachine(name)
+	bytes, err := netrc.MarshalText()
+	if err != nil {
+		return false, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if err := ioutil.WriteFile(filePath, bytes, info.Mode()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
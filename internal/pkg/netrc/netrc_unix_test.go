@@ -114,6 +114,34 @@ func TestPutMachine(t *testing.T) {
 	)
 }
 
+func TestDeleteMachineForName(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), netrcFilename)
+	envContainer := app.NewEnvContainer(map[string]string{"NETRC": filePath})
+	deleted, err := DeleteMachineForName(envContainer, "foo.com")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	require.NoError(t, PutMachine(envContainer, NewMachine("foo.com", "test@foo.com", "password", "")))
+	require.NoError(t, PutMachine(envContainer, NewMachine("bar.com", "test@bar.com", "password", "")))
+	deleted, err = DeleteMachineForName(envContainer, "foo.com")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	machine, err := GetMachineForName(envContainer, "foo.com")
+	require.NoError(t, err)
+	assert.Nil(t, machine)
+	machine, err = GetMachineForName(envContainer, "bar.com")
+	require.NoError(t, err)
+	assert.Equal(t, NewMachine("bar.com", "test@bar.com", "password", ""), machine)
+	deleted, err = DeleteMachineForName(envContainer, "foo.com")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	// the default machine is never deleted
+	deleted, err = DeleteMachineForName(app.NewEnvContainer(map[string]string{"HOME": "testdata/unix/home2"}), "foo.com")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
+
 func testPutMachineSuccess(
 	t *testing.T,
 	name string,
@@ -74,6 +74,14 @@ func NewJSONMarshalerUseProtoNames(resolver Resolver) Marshaler {
 	return newJSONMarshaler(resolver, "", true)
 }
 
+// NewTextMarshaler returns a new Marshaler for the protobuf text format.
+//
+// The output is intentionally unstable, see https://pkg.go.dev/google.golang.org/protobuf/encoding/prototext.
+// resolver can be nil if unknown and are only needed for extensions.
+func NewTextMarshaler(resolver Resolver) Marshaler {
+	return newTextMarshaler(resolver)
+}
+
 // Unmarshaler unmarshals Messages.
 type Unmarshaler interface {
 	Unmarshal(data []byte, message proto.Message) error
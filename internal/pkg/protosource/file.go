@@ -37,6 +37,13 @@ func (f *file) Syntax() Syntax {
 	return f.syntax
 }
 
+func (f *file) IsSyntaxUnspecified() bool {
+	if f.syntax != SyntaxProto2 || len(f.fileDescriptorProto.GetSourceCodeInfo().GetLocation()) == 0 {
+		return false
+	}
+	return f.SyntaxLocation() == nil
+}
+
 func (f *file) Package() string {
 	return f.fileDescriptorProto.GetPackage()
 }
@@ -227,6 +234,8 @@ func newFile(inputFile InputFile) (*file, error) {
 		f.syntax = SyntaxProto2
 	} else if syntaxString == "proto3" {
 		f.syntax = SyntaxProto3
+	} else if syntaxString == "editions" {
+		f.syntax = SyntaxEditions
 	} else {
 		return nil, fmt.Errorf("unknown syntax: %q", syntaxString)
 	}
@@ -680,6 +689,10 @@ func (f *file) populateService(
 		if err != nil {
 			return nil, err
 		}
+		optionExtensionNumbers, err := getOptionExtensionNumbers(methodDescriptorProto.GetOptions())
+		if err != nil {
+			return nil, err
+		}
 		method, err := newMethod(
 			methodNamedDescriptor,
 			service,
@@ -691,6 +704,7 @@ func (f *file) populateService(
 			getMethodOutputTypePath(serviceIndex, methodIndex),
 			idempotencyLevel,
 			getMethodIdempotencyLevelPath(serviceIndex, methodIndex),
+			optionExtensionNumbers,
 		)
 		if err != nil {
 			return nil, err
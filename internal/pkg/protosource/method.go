@@ -28,6 +28,8 @@ type method struct {
 	outputTypePath       []int32
 	idempotencyLevel     MethodOptionsIdempotencyLevel
 	idempotencyLevelPath []int32
+
+	optionExtensionNumbers map[int32]struct{}
 }
 
 func newMethod(
@@ -41,6 +43,7 @@ func newMethod(
 	outputTypePath []int32,
 	idempotencyLevel MethodOptionsIdempotencyLevel,
 	idempotencyLevelPath []int32,
+	optionExtensionNumbers map[int32]struct{},
 ) (*method, error) {
 	if inputTypeName == "" {
 		return nil, fmt.Errorf("no inputTypeName on %q", namedDescriptor.name)
@@ -59,6 +62,8 @@ func newMethod(
 		outputTypePath:       outputTypePath,
 		idempotencyLevel:     idempotencyLevel,
 		idempotencyLevelPath: idempotencyLevelPath,
+
+		optionExtensionNumbers: optionExtensionNumbers,
 	}, nil
 }
 
@@ -97,3 +102,8 @@ func (m *method) IdempotencyLevel() MethodOptionsIdempotencyLevel {
 func (m *method) IdempotencyLevelLocation() Location {
 	return m.getLocation(m.idempotencyLevelPath)
 }
+
+func (m *method) HasOptionExtension(fieldNumber int32) bool {
+	_, ok := m.optionExtensionNumbers[fieldNumber]
+	return ok
+}
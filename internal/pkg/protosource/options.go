@@ -0,0 +1,58 @@
+// Copyright 2020-2021 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protosource
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// getOptionExtensionNumbers returns the field numbers of the extensions set on the options message.
+//
+// Extensions that are not registered with the global registry, such as google.api.http
+// when its Golang package is not linked in, are only present in the unknown fields, so
+// these are read as well.
+func getOptionExtensionNumbers(options proto.Message) (map[int32]struct{}, error) {
+	optionExtensionNumbers := make(map[int32]struct{})
+	if options == nil {
+		return optionExtensionNumbers, nil
+	}
+	message := options.ProtoReflect()
+	if !message.IsValid() {
+		return optionExtensionNumbers, nil
+	}
+	message.Range(
+		func(fieldDescriptor protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if fieldDescriptor.IsExtension() {
+				optionExtensionNumbers[int32(fieldDescriptor.Number())] = struct{}{}
+			}
+			return true
+		},
+	)
+	extensionRanges := message.Descriptor().ExtensionRanges()
+	unknown := message.GetUnknown()
+	for len(unknown) > 0 {
+		fieldNumber, _, length := protowire.ConsumeField(unknown)
+		if length < 0 {
+			return nil, protowire.ParseError(length)
+		}
+		unknown = unknown[length:]
+		if extensionRanges.Has(fieldNumber) {
+			optionExtensionNumbers[int32(fieldNumber)] = struct{}{}
+		}
+	}
+	return optionExtensionNumbers, nil
+}
@@ -39,6 +39,8 @@ const (
 	SyntaxProto2 Syntax = iota + 1
 	// SyntaxProto3 represents the proto3 syntax.
 	SyntaxProto3
+	// SyntaxEditions represents files that declare an edition.
+	SyntaxEditions
 )
 
 // Syntax is the syntax of a file.
@@ -51,6 +53,8 @@ func (s Syntax) String() string {
 		return "proto2"
 	case SyntaxProto3:
 		return "proto3"
+	case SyntaxEditions:
+		return "editions"
 	default:
 		return strconv.Itoa(int(s))
 	}
@@ -154,6 +158,13 @@ type File interface {
 	ContainerDescriptor
 
 	Syntax() Syntax
+	// IsSyntaxUnspecified returns true if the file has no syntax declaration,
+	// in which case its syntax defaults to proto2.
+	//
+	// Explicit proto2 files also have no syntax set in their FileDescriptorProto,
+	// so this uses source code info. If the file has no source code info, it is
+	// not known whether the syntax was declared, and false is returned.
+	IsSyntaxUnspecified() bool
 	Package() string
 	FileImports() []FileImport
 	Services() []Service
@@ -353,6 +364,10 @@ type Method interface {
 
 	IdempotencyLevel() MethodOptionsIdempotencyLevel
 	IdempotencyLevelLocation() Location
+
+	// HasOptionExtension returns true if the extension of google.protobuf.MethodOptions
+	// with the given field number is set on this Method.
+	HasOptionExtension(fieldNumber int32) bool
 }
 
 // InputFile is an input file for NewFile.
@@ -41,6 +41,9 @@ var (
 		rpc.ErrorCodeUnauthenticated:    twirp.Unauthenticated,
 	}
 
+	// twirp.BadRoute is returned for a method the server does not have, which
+	// gRPC reports as unimplemented, so that it is not mistaken for an entity
+	// that was not found.
 	twirpErrorCodeToErrorCode = map[twirp.ErrorCode]rpc.ErrorCode{
 		twirp.Canceled:           rpc.ErrorCodeCanceled,
 		twirp.Unknown:            rpc.ErrorCodeUnknown,
@@ -48,7 +51,7 @@ var (
 		twirp.Malformed:          rpc.ErrorCodeInvalidArgument,
 		twirp.DeadlineExceeded:   rpc.ErrorCodeDeadlineExceeded,
 		twirp.NotFound:           rpc.ErrorCodeNotFound,
-		twirp.BadRoute:           rpc.ErrorCodeNotFound,
+		twirp.BadRoute:           rpc.ErrorCodeUnimplemented,
 		twirp.AlreadyExists:      rpc.ErrorCodeAlreadyExists,
 		twirp.PermissionDenied:   rpc.ErrorCodePermissionDenied,
 		twirp.ResourceExhausted:  rpc.ErrorCodeResourceExhausted,